@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sync"
 	"sync/atomic"
 	"syscall"
 
@@ -11,6 +12,7 @@ import (
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/tevjef/uct-backend/common/try"
 )
@@ -24,6 +26,19 @@ type Handler interface {
 	Get(query string, dest interface{}, args interface{}) error
 	Stats() *Stats
 	ResetStats()
+	Begin() (Tx, error)
+}
+
+// Tx is a Handler that runs every statement in a single transaction. A transaction holds a single
+// connection so statements are run one at a time, even when invoked from multiple goroutines.
+type Tx interface {
+	Handler
+	// Exec runs a statement that was not prepared and returns the number of rows affected.
+	Exec(query string, args ...interface{}) (int64, error)
+	// CopyIn loads rows into the columns of table with COPY.
+	CopyIn(table string, columns []string, rows [][]interface{}) error
+	Commit() error
+	Rollback() error
 }
 
 type handlerImpl struct {
@@ -31,6 +46,14 @@ type handlerImpl struct {
 	database   *sqlx.DB
 	statements map[string]*sqlx.NamedStmt
 	stats      *Stats
+
+	tx           *sqlx.Tx
+	txStatements map[string]*sqlx.NamedStmt
+	txMutex      *sync.Mutex
+}
+
+type txHandler struct {
+	handlerImpl
 }
 
 type Stats struct {
@@ -69,6 +92,8 @@ func ToContext(s Setter, h Handler) {
 }
 
 func (db handlerImpl) invoke(query string, data interface{}, fields log.Fields) (id int64, err error) {
+	defer db.lock()()
+
 	typeName := fmt.Sprintf("%T", data)
 
 	err = try.Do(func(attempt int) (retry bool, err error) {
@@ -154,13 +179,88 @@ func (db handlerImpl) Upsert(insertQuery, updateQuery string, data interface{})
 }
 
 func (db handlerImpl) Select(query string, dest interface{}, args interface{}) error {
+	defer db.lock()()
 	return db.getCachedStmt(query).Select(dest, args)
 }
 
 func (db handlerImpl) Get(query string, dest interface{}, args interface{}) error {
+	defer db.lock()()
 	return db.getCachedStmt(query).Get(dest, args)
 }
 
+// Begin starts a transaction. The returned Tx shares the prepared statements and stats of this handler.
+func (db handlerImpl) Begin() (Tx, error) {
+	if db.tx != nil {
+		return nil, errors.New("nested transactions are not supported")
+	}
+
+	tx, err := db.database.Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+
+	db.tx = tx
+	db.txStatements = map[string]*sqlx.NamedStmt{}
+	db.txMutex = &sync.Mutex{}
+
+	return txHandler{db}, nil
+}
+
+func (db txHandler) Exec(query string, args ...interface{}) (int64, error) {
+	defer db.lock()()
+
+	result, err := db.tx.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (db txHandler) CopyIn(table string, columns []string, rows [][]interface{}) error {
+	defer db.lock()()
+
+	stmt, err := db.tx.Prepare(pq.CopyIn(table, columns...))
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare copy into "+table)
+	}
+
+	for _, row := range rows {
+		if _, err := stmt.Exec(row...); err != nil {
+			stmt.Close()
+			return errors.Wrap(err, "failed to copy into "+table)
+		}
+	}
+
+	// Flush the buffered rows
+	if _, err := stmt.Exec(); err != nil {
+		stmt.Close()
+		return errors.Wrap(err, "failed to copy into "+table)
+	}
+
+	return stmt.Close()
+}
+
+func (db txHandler) Commit() error {
+	defer db.lock()()
+	return db.tx.Commit()
+}
+
+func (db txHandler) Rollback() error {
+	defer db.lock()()
+	return db.tx.Rollback()
+}
+
+// lock serializes statements run in a transaction, it returns the function that releases the lock.
+func (db handlerImpl) lock() func() {
+	if db.txMutex == nil {
+		return func() {}
+	}
+
+	db.txMutex.Lock()
+	return db.txMutex.Unlock
+}
+
 func (db handlerImpl) Stats() *Stats {
 	return &Stats{
 		Insertions: atomic.LoadInt64(&db.stats.Insertions),
@@ -189,7 +289,17 @@ func isConnectionError(err error) bool {
 }
 
 func (db handlerImpl) getCachedStmt(key string) *sqlx.NamedStmt {
-	return db.statements[key]
+	if db.tx == nil {
+		return db.statements[key]
+	}
+
+	if stmt, ok := db.txStatements[key]; ok {
+		return stmt
+	}
+
+	stmt := db.tx.NamedStmt(db.statements[key])
+	db.txStatements[key] = stmt
+	return stmt
 }
 
 func (db handlerImpl) prepare(query string) *sqlx.NamedStmt {
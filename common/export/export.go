@@ -0,0 +1,262 @@
+// Package export flattens universities into a table with a row for every section, for spreadsheets and
+// analysis tools, and writes it as CSV or as columnar JSON.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+const (
+	CSV      = "csv"
+	Columnar = "columnar"
+
+	CSVContentType      = "text/csv; charset=utf-8"
+	ColumnarContentType = "application/json; charset=utf-8"
+)
+
+// Row is a section along with the course, subject and university it belongs to.
+type Row struct {
+	University *model.University
+	Subject    *model.Subject
+	Course     *model.Course
+	Section    *model.Section
+}
+
+// Column is a field of a row. Value is a string, or an int64 for counts.
+type Column struct {
+	Name  string
+	Value func(row Row) interface{}
+}
+
+// Columns are the columns known to the export, in the order they are written by default.
+var Columns = []Column{
+	{"university", func(row Row) interface{} { return row.University.Name }},
+	{"season", func(row Row) interface{} { return row.Subject.Season }},
+	{"year", func(row Row) interface{} { return row.Subject.Year }},
+	{"subject_number", func(row Row) interface{} { return row.Subject.Number }},
+	{"subject_name", func(row Row) interface{} { return row.Subject.Name }},
+	{"course_number", func(row Row) interface{} { return row.Course.Number }},
+	{"course_name", func(row Row) interface{} { return row.Course.Name }},
+	{"section_number", func(row Row) interface{} { return row.Section.Number }},
+	{"call_number", func(row Row) interface{} { return row.Section.CallNumber }},
+	{"status", func(row Row) interface{} { return row.Section.Status }},
+	{"credits", func(row Row) interface{} { return row.Section.Credits }},
+	{"now", func(row Row) interface{} { return row.Section.Now }},
+	{"max", func(row Row) interface{} { return row.Section.Max }},
+	{"waitlist_now", func(row Row) interface{} { return row.Section.GetWaitlistNow() }},
+	{"waitlist_max", func(row Row) interface{} { return row.Section.GetWaitlistMax() }},
+	{"instructors", func(row Row) interface{} { return instructors(row.Section) }},
+	{"meetings", func(row Row) interface{} { return meetings(row.Section) }},
+	{"topic_name", func(row Row) interface{} { return row.Section.TopicName }},
+}
+
+// LookupColumns returns the columns with the names in the order of the names, or every column when no names
+// are given.
+func LookupColumns(names ...string) ([]Column, error) {
+	if len(names) == 0 {
+		return Columns, nil
+	}
+
+	var columns []Column
+	for _, name := range names {
+		found := false
+		for _, column := range Columns {
+			if column.Name == name {
+				columns = append(columns, column)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+	}
+	return columns, nil
+}
+
+// ParseSemester parses a semester written as season-year or season year, e.g fall-2017.
+func ParseSemester(s string) (model.Semester, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == '-' || r == ' ' })
+	if len(fields) != 2 {
+		return model.Semester{}, fmt.Errorf("invalid semester %q, expected season-year e.g fall-2017", s)
+	}
+
+	year, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return model.Semester{}, fmt.Errorf("invalid year in semester %q", s)
+	}
+	return *model.NewSemester(strings.ToLower(fields[0]), int32(year)), nil
+}
+
+// Flatten returns a row for every section of the university in the semesters, or in every semester when none
+// are given.
+func Flatten(university *model.University, semesters []model.Semester) []Row {
+	var rows []Row
+	for _, subject := range university.Subjects {
+		rows = append(rows, FlattenSubject(university, subject, semesters)...)
+	}
+	return rows
+}
+
+// FlattenSubject returns a row for every section of the subject, or none if the subject is not in the
+// semesters. Only the header of the university is used, so it may be read from a stream.
+func FlattenSubject(university *model.University, subject *model.Subject, semesters []model.Semester) []Row {
+	if !inSemesters(subject, semesters) {
+		return nil
+	}
+
+	var rows []Row
+	for _, course := range subject.Courses {
+		for _, section := range course.Sections {
+			rows = append(rows, Row{University: university, Subject: subject, Course: course, Section: section})
+		}
+	}
+	return rows
+}
+
+func inSemesters(subject *model.Subject, semesters []model.Semester) bool {
+	if len(semesters) == 0 {
+		return true
+	}
+	for _, semester := range semesters {
+		if strings.EqualFold(subject.Season, semester.Season) && subject.Year == strconv.Itoa(int(semester.Year)) {
+			return true
+		}
+	}
+	return false
+}
+
+func instructors(section *model.Section) string {
+	var names []string
+	for _, instructor := range section.Instructors {
+		names = append(names, instructor.Name)
+	}
+	return strings.Join(names, "; ")
+}
+
+// meetings describes the meetings of the section e.g Monday 10:20 AM-11:40 AM HLL-114; Online
+func meetings(section *model.Section) string {
+	var descriptions []string
+	for _, meeting := range section.Meetings {
+		var parts []string
+		if day := meeting.GetDay(); day != "" {
+			parts = append(parts, day)
+		}
+		if meeting.GetStartTime() != "" && meeting.GetEndTime() != "" {
+			parts = append(parts, meeting.GetStartTime()+"-"+meeting.GetEndTime())
+		}
+		if room := meeting.GetRoom(); room != "" {
+			parts = append(parts, room)
+		}
+		if len(parts) == 0 && meeting.GetClassType() != "" {
+			parts = append(parts, meeting.GetClassType())
+		}
+		if len(parts) > 0 {
+			descriptions = append(descriptions, strings.Join(parts, " "))
+		}
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// CSVWriter writes rows as CSV with a header of the column names, so that a university can be written as it
+// is read a subject at a time.
+type CSVWriter struct {
+	w       *csv.Writer
+	columns []Column
+	header  bool
+	record  []string
+}
+
+// NewCSVWriter returns a writer of the columns to w.
+func NewCSVWriter(w io.Writer, columns []Column) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), columns: columns, record: make([]string, len(columns))}
+}
+
+// Write writes the rows, preceded by the header if it has not been written.
+func (cw *CSVWriter) Write(rows []Row) error {
+	if !cw.header {
+		for i, column := range cw.columns {
+			cw.record[i] = column.Name
+		}
+		if err := cw.w.Write(cw.record); err != nil {
+			return err
+		}
+		cw.header = true
+	}
+
+	for _, row := range rows {
+		for i, column := range cw.columns {
+			cw.record[i] = fmt.Sprint(column.Value(row))
+		}
+		if err := cw.w.Write(cw.record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes the header if no rows were written and any buffered rows.
+func (cw *CSVWriter) Flush() error {
+	if !cw.header {
+		if err := cw.Write(nil); err != nil {
+			return err
+		}
+	}
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+type column struct {
+	Name   string        `json:"name"`
+	Values []interface{} `json:"values"`
+}
+
+type columnarTable struct {
+	Rows    int      `json:"rows"`
+	Columns []column `json:"columns"`
+}
+
+// WriteColumnar writes the rows as a JSON object with the values of each column in an array, in the order of
+// the rows.
+func WriteColumnar(w io.Writer, columns []Column, rows []Row) error {
+	table := columnarTable{Rows: len(rows), Columns: make([]column, len(columns))}
+	for i, c := range columns {
+		values := make([]interface{}, len(rows))
+		for j, row := range rows {
+			values[j] = c.Value(row)
+		}
+		table.Columns[i] = column{Name: c.Name, Values: values}
+	}
+	return json.NewEncoder(w).Encode(table)
+}
+
+// Write writes the rows in the format, one of csv or columnar.
+func Write(format string, w io.Writer, columns []Column, rows []Row) error {
+	switch format {
+	case CSV:
+		cw := NewCSVWriter(w, columns)
+		if err := cw.Write(rows); err != nil {
+			return err
+		}
+		return cw.Flush()
+	case Columnar:
+		return WriteColumnar(w, columns, rows)
+	default:
+		return fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+// ContentType returns the content type of the format.
+func ContentType(format string) string {
+	if format == Columnar {
+		return ColumnarContentType
+	}
+	return CSVContentType
+}
@@ -0,0 +1,99 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+func str(s string) *string {
+	return &s
+}
+
+func exportUniversity() *model.University {
+	return &model.University{
+		Name: "Rutgers University–New Brunswick",
+		Subjects: []*model.Subject{
+			{Name: "Mathematics", Number: "640", Season: model.Fall, Year: "2017", Courses: []*model.Course{
+				{Name: "Calculus I", Number: "151", Sections: []*model.Section{
+					{
+						Number: "01", CallNumber: "12345", Status: "Open", Now: 10, Max: 30, Credits: "4.0",
+						Instructors: []*model.Instructor{{Name: "Smith, John"}, {Name: "Doe, Jane"}},
+						Meetings: []*model.Meeting{
+							{Day: str("Monday"), StartTime: str("10:20 AM"), EndTime: str("11:40 AM"), Room: str("HLL-114")},
+							{ClassType: str("Online")},
+						},
+					},
+					{Number: "02", CallNumber: "12346", Status: "Closed", Max: 30, Credits: "4.0"},
+				}},
+			}},
+			{Name: "Physics", Number: "750", Season: model.Spring, Year: "2018", Courses: []*model.Course{
+				{Name: "Analytical Physics", Number: "203", Sections: []*model.Section{{Number: "01", CallNumber: "22222"}}},
+			}},
+		},
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	university := exportUniversity()
+	assert.Len(t, Flatten(university, nil), 3)
+
+	semester, err := ParseSemester("Fall-2017")
+	assert.NoError(t, err)
+	rows := Flatten(university, []model.Semester{semester})
+	if assert.Len(t, rows, 2) {
+		assert.Equal(t, "Calculus I", rows[0].Course.Name)
+		assert.Equal(t, "02", rows[1].Section.Number)
+	}
+
+	_, err = ParseSemester("fall")
+	assert.Error(t, err)
+}
+
+func TestWrite_CSV(t *testing.T) {
+	university := exportUniversity()
+	columns, err := LookupColumns("course_name", "section_number", "now", "instructors", "meetings")
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, Write(CSV, &buf, columns, Flatten(university, nil)[:2]))
+	assert.Equal(t, "course_name,section_number,now,instructors,meetings\n"+
+		"Calculus I,01,10,\"Smith, John; Doe, Jane\",Monday 10:20 AM-11:40 AM HLL-114; Online\n"+
+		"Calculus I,02,0,,\n", buf.String())
+
+	_, err = LookupColumns("unknown")
+	assert.Error(t, err)
+
+	// Only the header is written without rows
+	buf.Reset()
+	assert.NoError(t, Write(CSV, &buf, columns, nil))
+	assert.Equal(t, "course_name,section_number,now,instructors,meetings\n", buf.String())
+}
+
+func TestWrite_Columnar(t *testing.T) {
+	columns, err := LookupColumns("section_number", "max")
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, Write(Columnar, &buf, columns, Flatten(exportUniversity(), nil)))
+
+	var table struct {
+		Rows    int `json:"rows"`
+		Columns []struct {
+			Name   string        `json:"name"`
+			Values []interface{} `json:"values"`
+		} `json:"columns"`
+	}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &table))
+	assert.Equal(t, 3, table.Rows)
+	if assert.Len(t, table.Columns, 2) {
+		assert.Equal(t, "section_number", table.Columns[0].Name)
+		assert.Equal(t, []interface{}{"01", "02", "01"}, table.Columns[0].Values)
+		assert.Equal(t, []interface{}{30.0, 30.0, 0.0}, table.Columns[1].Values)
+	}
+
+	assert.Error(t, Write("xlsx", &buf, columns, nil))
+}
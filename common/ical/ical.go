@@ -0,0 +1,228 @@
+// Package ical renders the meetings of sections as an RFC 5545 iCalendar.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+
+	prodId       = "-//tevjef//University Course Tracker//EN"
+	localLayout  = "20060102T150405"
+	utcLayout    = "20060102T150405Z"
+	maxLineBytes = 75
+)
+
+type event struct {
+	uid         string
+	summary     string
+	location    string
+	description string
+	start       time.Time
+	end         time.Time
+	until       time.Time
+}
+
+// Calendar collects the meetings of sections as weekly recurring events in the time zone of a university.
+type Calendar struct {
+	name     string
+	location *time.Location
+	stamp    time.Time
+	events   []event
+}
+
+// New creates an empty calendar. Stamp is the time the calendar was created.
+func New(name string, location *time.Location, stamp time.Time) *Calendar {
+	return &Calendar{name: name, location: location, stamp: stamp}
+}
+
+// AddSection adds an event for every scheduled meeting of the section that repeats weekly from the first
+// to the last day of the semester. Meetings without a day and time are skipped.
+func (c *Calendar) AddSection(courseName string, section *model.Section, first, last time.Time) {
+	first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, c.location)
+	until := time.Date(last.Year(), last.Month(), last.Day(), 23, 59, 59, 0, c.location).UTC()
+
+	for _, meeting := range section.Meetings {
+		interval, ok := meeting.Interval()
+		if !ok {
+			continue
+		}
+
+		day := first.AddDate(0, 0, (int(interval.Day)-int(first.Weekday())+7)%7)
+		start := time.Date(day.Year(), day.Month(), day.Day(), 0, interval.Start, 0, 0, c.location)
+		if start.After(until) {
+			continue
+		}
+
+		summary := courseName
+		if meeting.ClassType != nil && *meeting.ClassType != "" {
+			summary += " " + *meeting.ClassType
+		}
+
+		c.events = append(c.events, event{
+			uid:         section.TopicName + "." + strconv.Itoa(int(meeting.Index)) + "@uct",
+			summary:     summary,
+			location:    meeting.GetRoom(),
+			description: describe(section),
+			start:       start,
+			end:         time.Date(day.Year(), day.Month(), day.Day(), 0, interval.End, 0, 0, c.location),
+			until:       until,
+		})
+	}
+}
+
+// Len returns the number of events in the calendar.
+func (c *Calendar) Len() int {
+	return len(c.events)
+}
+
+func describe(section *model.Section) string {
+	lines := []string{"Section " + section.Number}
+	if section.CallNumber != "" {
+		lines[0] += " (" + section.CallNumber + ")"
+	}
+
+	var instructors []string
+	for _, instructor := range section.Instructors {
+		instructors = append(instructors, instructor.Name)
+	}
+	if len(instructors) > 0 {
+		lines = append(lines, "Instructors: "+strings.Join(instructors, ", "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// WriteTo writes the calendar with CRLF line endings and lines folded at 75 octets.
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	b := &bytes.Buffer{}
+	tzid := c.location.String()
+
+	writeLine(b, "BEGIN:VCALENDAR")
+	writeLine(b, "VERSION:2.0")
+	writeLine(b, "PRODID:"+prodId)
+	writeLine(b, "CALSCALE:GREGORIAN")
+	writeLine(b, "METHOD:PUBLISH")
+	writeLine(b, "X-WR-CALNAME:"+escape(c.name))
+	writeLine(b, "X-WR-TIMEZONE:"+tzid)
+
+	if len(c.events) > 0 {
+		c.writeTimezone(b)
+	}
+
+	for _, e := range c.events {
+		writeLine(b, "BEGIN:VEVENT")
+		writeLine(b, "UID:"+e.uid)
+		writeLine(b, "DTSTAMP:"+c.stamp.UTC().Format(utcLayout))
+		writeLine(b, "DTSTART;TZID="+tzid+":"+e.start.Format(localLayout))
+		writeLine(b, "DTEND;TZID="+tzid+":"+e.end.Format(localLayout))
+		writeLine(b, "RRULE:FREQ=WEEKLY;UNTIL="+e.until.Format(utcLayout))
+		writeLine(b, "SUMMARY:"+escape(e.summary))
+		if e.location != "" {
+			writeLine(b, "LOCATION:"+escape(e.location))
+		}
+		writeLine(b, "DESCRIPTION:"+escape(e.description))
+		writeLine(b, "END:VEVENT")
+	}
+
+	writeLine(b, "END:VCALENDAR")
+
+	return b.WriteTo(w)
+}
+
+// writeTimezone describes the offsets of the calendar's location in every year its events occur, beginning
+// with the offset in effect at the start of the first year.
+func (c *Calendar) writeTimezone(b *bytes.Buffer) {
+	from, to := c.events[0].start, c.events[0].until
+	for _, e := range c.events {
+		if e.start.Before(from) {
+			from = e.start
+		}
+		if e.until.After(to) {
+			to = e.until
+		}
+	}
+
+	t := time.Date(from.Year(), time.January, 1, 0, 0, 0, 0, c.location)
+	stop := time.Date(to.Year()+1, time.January, 1, 0, 0, 0, 0, c.location)
+
+	writeLine(b, "BEGIN:VTIMEZONE")
+	writeLine(b, "TZID:"+c.location.String())
+
+	_, offset := t.Zone()
+	writeObservance(b, t, offset)
+
+	for day := t; day.Before(stop); day = day.Add(24 * time.Hour) {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			// Find the second the offset changes
+			seconds := sort.Search(int(next.Sub(day)/time.Second), func(i int) bool {
+				_, o := day.Add(time.Duration(i) * time.Second).Zone()
+				return o != offset
+			})
+			writeObservance(b, day.Add(time.Duration(seconds)*time.Second), offset)
+			offset = nextOffset
+		}
+	}
+
+	writeLine(b, "END:VTIMEZONE")
+}
+
+// writeObservance writes the offset that takes effect at t, given the offset in effect before it.
+func writeObservance(b *bytes.Buffer, t time.Time, before int) {
+	name, after := t.Zone()
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	writeLine(b, "BEGIN:"+kind)
+	writeLine(b, "DTSTART:"+t.In(time.FixedZone("", before)).Format(localLayout))
+	writeLine(b, "TZOFFSETFROM:"+formatOffset(before))
+	writeLine(b, "TZOFFSETTO:"+formatOffset(after))
+	writeLine(b, "TZNAME:"+name)
+	writeLine(b, "END:"+kind)
+}
+
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escape(text string) string {
+	return escaper.Replace(text)
+}
+
+// writeLine folds lines longer than 75 octets without splitting a UTF-8 sequence.
+func writeLine(b *bytes.Buffer, line string) {
+	limit := maxLineBytes
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines begin with a space
+		limit = maxLineBytes - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,84 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+func str(s string) *string {
+	return &s
+}
+
+func TestCalendar_WriteTo(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	section := &model.Section{
+		Number:      "01",
+		CallNumber:  "09214",
+		TopicName:   "rutgers.universitynew.brunswick.640.135.01",
+		Instructors: []*model.Instructor{{Name: "SMITH, JOHN"}, {Name: "DOE, JANE"}},
+		Meetings: []*model.Meeting{
+			{Day: str("Monday"), StartTime: str("10:20 AM"), EndTime: str("11:40 AM"), Room: str("HLL-114"), ClassType: str("Lecture")},
+			{Day: str("Thursday"), StartTime: str("6:10 PM"), EndTime: str("9:00 PM"), Room: str("ARC-103, Busch"), Index: 1},
+			{ClassType: str("Online"), Index: 2},
+		},
+	}
+
+	stamp := time.Date(2017, time.August, 1, 12, 0, 0, 0, time.UTC)
+	first := time.Date(2017, time.September, 6, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2017, time.December, 22, 0, 0, 0, 0, time.UTC)
+
+	calendar := New("Fall 2017", location, stamp)
+	calendar.AddSection("CALCULUS I", section, first, last)
+	assert.Equal(t, 2, calendar.Len())
+
+	b := &bytes.Buffer{}
+	_, err = calendar.WriteTo(b)
+	assert.NoError(t, err)
+	out := b.String()
+
+	for _, line := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"TZID:America/New_York\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20170312T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n",
+		"BEGIN:STANDARD\r\nDTSTART:20171105T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n",
+		"UID:rutgers.universitynew.brunswick.640.135.01.0@uct\r\n",
+		"DTSTAMP:20170801T120000Z\r\n",
+		// The first Monday on or after the first day of the semester
+		"DTSTART;TZID=America/New_York:20170911T102000\r\n",
+		"DTEND;TZID=America/New_York:20170911T114000\r\n",
+		"RRULE:FREQ=WEEKLY;UNTIL=20171223T045959Z\r\n",
+		"SUMMARY:CALCULUS I Lecture\r\n",
+		"LOCATION:HLL-114\r\n",
+		"DESCRIPTION:Section 01 (09214)\\nInstructors: SMITH\\, JOHN\\, DOE\\, JANE\r\n",
+		"DTSTART;TZID=America/New_York:20170907T181000\r\n",
+		"SUMMARY:CALCULUS I\r\n",
+		"LOCATION:ARC-103\\, Busch\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		assert.Contains(t, out, line)
+	}
+
+	for _, line := range strings.Split(out, "\r\n") {
+		assert.True(t, len(line) <= maxLineBytes, line)
+	}
+}
+
+func TestWriteLine(t *testing.T) {
+	b := &bytes.Buffer{}
+	writeLine(b, "DESCRIPTION:"+strings.Repeat("é", 60))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, 74, len(lines[0]))
+	assert.True(t, strings.HasPrefix(lines[1], " "))
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("é", 60), lines[0]+lines[1][1:])
+}
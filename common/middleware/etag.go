@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+const (
+	etagHeader        = "ETag"
+	ifNoneMatchHeader = "If-None-Match"
+)
+
+// entityTag returns a strong entity tag for a response made of only subjects, courses and sections, built from
+// their content hashes in the order they are returned. Returns an empty string for any other response, or when
+// an entity was not hashed.
+func entityTag(data *model.Data, contentType string) string {
+	if data == nil {
+		return ""
+	}
+
+	rest := *data
+	rest.Subject, rest.Course, rest.Section = nil, nil, nil
+	rest.Subjects, rest.Courses, rest.Sections = nil, nil, nil
+	if rest.Size() != 0 {
+		return ""
+	}
+
+	var hashes []string
+	if data.Subject != nil {
+		hashes = append(hashes, data.Subject.ContentHash)
+	}
+	if data.Course != nil {
+		hashes = append(hashes, data.Course.ContentHash)
+	}
+	if data.Section != nil {
+		hashes = append(hashes, data.Section.ContentHash)
+	}
+	for _, subject := range data.Subjects {
+		hashes = append(hashes, subject.ContentHash)
+	}
+	for _, course := range data.Courses {
+		hashes = append(hashes, course.ContentHash)
+	}
+	for _, section := range data.Sections {
+		hashes = append(hashes, section.ContentHash)
+	}
+
+	if len(hashes) == 0 {
+		return ""
+	}
+
+	h := sha256.New()
+	// Each representation of the same content has its own tag
+	h.Write([]byte(contentType))
+	for _, hash := range hashes {
+		if hash == "" {
+			return ""
+		}
+		h.Write([]byte(hash))
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)) + `"`
+}
+
+// notModified returns whether the If-None-Match header of the request matches the entity tag.
+func notModified(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if candidate = strings.TrimSpace(candidate); candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
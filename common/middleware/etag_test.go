@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+func TestEntityTag(t *testing.T) {
+	section := &model.Section{TopicName: "section", ContentHash: "abc"}
+	etag := entityTag(&model.Data{Section: section}, JsonContentType)
+	assert.NotEmpty(t, etag)
+	assert.Equal(t, etag, entityTag(&model.Data{Section: section}, JsonContentType))
+	assert.NotEqual(t, etag, entityTag(&model.Data{Section: section}, ProtobufContentType))
+
+	changed := &model.Section{TopicName: "section", ContentHash: "abd"}
+	assert.NotEqual(t, etag, entityTag(&model.Data{Section: changed}, JsonContentType))
+
+	// Responses with other data or unhashed entities have no tag
+	assert.Empty(t, entityTag(&model.Data{Section: section, University: &model.University{}}, JsonContentType))
+	assert.Empty(t, entityTag(&model.Data{Sections: []*model.Section{section, {}}}, JsonContentType))
+	assert.Empty(t, entityTag(&model.Data{}, JsonContentType))
+	assert.Empty(t, entityTag(nil, JsonContentType))
+}
+
+func TestNotModified(t *testing.T) {
+	assert.True(t, notModified(`"a", "b"`, `"b"`))
+	assert.True(t, notModified(`*`, `"b"`))
+	assert.False(t, notModified(`"a"`, `"b"`))
+	assert.False(t, notModified(``, `"b"`))
+}
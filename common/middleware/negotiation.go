@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -47,6 +48,17 @@ func ContentNegotiation(contentType string) gin.HandlerFunc {
 
 		if value, exists := c.Get(ResponseKey); exists {
 			if response, ok := value.(model.Response); ok {
+				if *response.Meta.Code == http.StatusOK {
+					if etag := entityTag(response.Data, responseType); etag != "" {
+						c.Header(etagHeader, etag)
+						if notModified(c.Request.Header.Get(ifNoneMatchHeader), etag) {
+							c.Writer.WriteHeader(http.StatusNotModified)
+							c.Writer.Flush()
+							return
+						}
+					}
+				}
+
 				// Write status header
 				c.Writer.WriteHeader(int(*response.Meta.Code))
 
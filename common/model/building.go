@@ -0,0 +1,175 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Rooms registrars use for meetings that are not held in a building
+var placeholderRooms = map[string]bool{
+	"ARRANGED":        true,
+	"ASYNC":           true,
+	"ONLINE":          true,
+	"REMOTE":          true,
+	"TBA":             true,
+	"TBD":             true,
+	"TO BE ANNOUNCED": true,
+}
+
+// BuildingCatalog finds the buildings of a university by the code their rooms are written with.
+type BuildingCatalog struct {
+	buildings map[string]*Building
+	// Longest first, so a code is never shadowed by a shorter code it starts with
+	codes []string
+}
+
+// NewBuildingCatalog returns a catalog of the buildings. Codes are matched ignoring case.
+func NewBuildingCatalog(buildings []*Building) *BuildingCatalog {
+	catalog := &BuildingCatalog{buildings: map[string]*Building{}}
+	for _, building := range buildings {
+		code := normalizeRoom(building.Code)
+		if code == "" {
+			continue
+		}
+		if _, ok := catalog.buildings[code]; !ok {
+			catalog.codes = append(catalog.codes, code)
+		}
+		catalog.buildings[code] = building
+	}
+
+	sort.SliceStable(catalog.codes, func(i, j int) bool {
+		return len(catalog.codes[i]) > len(catalog.codes[j])
+	})
+
+	return catalog
+}
+
+// Building returns the building with the code, or nil when it is not in the catalog.
+func (catalog *BuildingCatalog) Building(code string) *Building {
+	return catalog.buildings[normalizeRoom(code)]
+}
+
+// ParseRoom splits a room such as "HLL-116" or "SEC 111" into its building code and room number.
+// Codes in the catalog are matched first so codes containing a space or dash are kept whole, otherwise
+// the room is split at its first dash or space. Returns false for rooms that are not in a building, e.g TBA.
+func (catalog *BuildingCatalog) ParseRoom(room string) (code, number string, ok bool) {
+	room = normalizeRoom(room)
+	if room == "" || placeholderRooms[room] {
+		return "", "", false
+	}
+
+	for _, code := range catalog.codes {
+		if !strings.HasPrefix(room, code) {
+			continue
+		}
+		rest := room[len(code):]
+		if rest != "" && unicode.IsLetter(rune(rest[0])) {
+			// Another building whose code starts with this one
+			continue
+		}
+		return code, trimRoomNumber(rest), true
+	}
+
+	if i := strings.IndexAny(room, " -"); i > 0 {
+		return room[:i], trimRoomNumber(room[i:]), true
+	}
+
+	// Building code directly followed by the room number, e.g ARC103
+	i := strings.IndexFunc(room, unicode.IsDigit)
+	if i == 0 {
+		return "", "", false
+	} else if i > 0 {
+		return room[:i], room[i:], true
+	}
+
+	return room, "", true
+}
+
+func normalizeRoom(room string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(room), " "))
+}
+
+func trimRoomNumber(number string) string {
+	return strings.Trim(number, " -:")
+}
+
+func (building *Building) Validate() error {
+	building.Code = normalizeRoom(building.Code)
+	if building.Code == "" {
+		return ruleError("building.code", "code", "Building code is empty")
+	}
+
+	if building.Name != nil {
+		name := TrimAll(*building.Name)
+		if name == "" {
+			building.Name = nil
+		} else {
+			building.Name = &name
+		}
+	}
+
+	if building.Campus != nil {
+		campus := TrimAll(*building.Campus)
+		if campus == "" {
+			building.Campus = nil
+		} else {
+			building.Campus = &campus
+		}
+	}
+
+	if (building.Latitude == nil) != (building.Longitude == nil) {
+		return ruleError("building.location", "latitude", "Building %s must have both a latitude and longitude", building.Code)
+	}
+
+	if building.Latitude != nil && (*building.Latitude < -90 || *building.Latitude > 90 ||
+		*building.Longitude < -180 || *building.Longitude > 180) {
+		return ruleError("building.location", "latitude", "Building %s location %f, %f is out of range", building.Code, *building.Latitude, *building.Longitude)
+	}
+
+	return nil
+}
+
+// makeUniqueBuildings removes buildings with the same code, keeping the first.
+func makeUniqueBuildings(buildings []*Building) []*Building {
+	seen := map[string]bool{}
+	unique := buildings[:0]
+	for _, building := range buildings {
+		if seen[building.Code] {
+			continue
+		}
+		seen[building.Code] = true
+		unique = append(unique, building)
+	}
+	return unique
+}
+
+// resolveLocations splits the room of every meeting of the university into its building code and room
+// number, and sets the building from the catalog of the university.
+func resolveLocations(university *University) {
+	catalog := NewBuildingCatalog(university.Buildings)
+
+	for _, subject := range university.Subjects {
+		for _, course := range subject.Courses {
+			for _, section := range course.Sections {
+				for _, meeting := range section.Meetings {
+					meeting.BuildingCode, meeting.RoomNumber, meeting.Building = nil, nil, nil
+					if meeting.Room == nil {
+						continue
+					}
+
+					code, number, ok := catalog.ParseRoom(*meeting.Room)
+					if !ok {
+						continue
+					}
+
+					meeting.BuildingCode = &code
+					if number != "" {
+						meeting.RoomNumber = &number
+					}
+					meeting.Building = catalog.Building(code)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildingCatalog_ParseRoom(t *testing.T) {
+	catalog := NewBuildingCatalog([]*Building{
+		{Code: "HLL"},
+		{Code: "SEC"},
+		{Code: "B - VERT"},
+		{Code: "ARC"},
+		{Code: "ARCH"},
+	})
+
+	tests := []struct {
+		room   string
+		code   string
+		number string
+		ok     bool
+	}{
+		{"HLL-116", "HLL", "116", true},
+		{"sec 111", "SEC", "111", true},
+		{"B - Vert 10-165", "B - VERT", "10-165", true},
+		{"ARCH-201", "ARCH", "201", true},
+		{"ARC103", "ARC", "103", true},
+		{"KMC 2-115", "KMC", "2-115", true},
+		{"GITC2315", "GITC", "2315", true},
+		{"GYM", "GYM", "", true},
+		{"TBA", "", "", false},
+		{" online ", "", "", false},
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		code, number, ok := catalog.ParseRoom(tt.room)
+		assert.Equal(t, tt.ok, ok, tt.room)
+		assert.Equal(t, tt.code, code, tt.room)
+		assert.Equal(t, tt.number, number, tt.room)
+	}
+}
+
+func TestBuilding_Validate(t *testing.T) {
+	building := &Building{Code: " hll ", Name: proto.String(" Hill Center "), Campus: proto.String("")}
+	assert.Nil(t, building.Validate())
+	assert.Equal(t, "HLL", building.Code)
+	assert.Equal(t, "Hill Center", building.GetName())
+	assert.Nil(t, building.Campus)
+
+	assert.NotNil(t, (&Building{}).Validate())
+	assert.NotNil(t, (&Building{Code: "HLL", Latitude: proto.Float64(40.5)}).Validate())
+	assert.NotNil(t, (&Building{Code: "HLL", Latitude: proto.Float64(91), Longitude: proto.Float64(0)}).Validate())
+}
+
+func TestResolveLocations(t *testing.T) {
+	hill := &Building{Code: "HLL", Name: proto.String("Hill Center"), Campus: proto.String("Busch")}
+	inCatalog := &Meeting{Room: proto.String("HLL-116")}
+	notInCatalog := &Meeting{Room: proto.String("SEC 111")}
+	online := &Meeting{Room: proto.String("ONLINE")}
+	noRoom := &Meeting{}
+
+	university := &University{Buildings: []*Building{hill}, Subjects: []*Subject{
+		{Courses: []*Course{{Sections: []*Section{{Meetings: []*Meeting{inCatalog, notInCatalog, online, noRoom}}}}}},
+	}}
+
+	resolveLocations(university)
+
+	assert.Equal(t, "HLL", inCatalog.GetBuildingCode())
+	assert.Equal(t, "116", inCatalog.GetRoomNumber())
+	assert.Equal(t, hill, inCatalog.Building)
+
+	assert.Equal(t, "SEC", notInCatalog.GetBuildingCode())
+	assert.Equal(t, "111", notInCatalog.GetRoomNumber())
+	assert.Nil(t, notInCatalog.Building)
+
+	assert.Nil(t, online.BuildingCode)
+	assert.Nil(t, noRoom.BuildingCode)
+}
@@ -0,0 +1,216 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Date is a day of the year, without the year.
+type Date struct {
+	Month time.Month
+	Day   int
+}
+
+// In returns the date in the year, at midnight UTC.
+func (d Date) In(year int) time.Time {
+	return time.Date(year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// Term is a period of classes in the academic calendar of a university, e.g the fall semester or the first
+// summer session. A season may have several terms in a year, together they are the semester of that season.
+type Term struct {
+	Season string
+	// Year of the semester the term belongs to
+	Year int
+	// Display name, e.g Summer Session I. Optional for seasons with a single term
+	Name              string
+	RegistrationOpens time.Time
+	Start             time.Time
+	// Last day of classes
+	End time.Time
+}
+
+// TermTemplate declares a term that is held on the same dates every year. The year of the term is the year
+// it starts in. An end before the start falls in the following year, e.g a winter session that ends in
+// January, and a registration date after the start falls in the previous year.
+type TermTemplate struct {
+	Season            string
+	Name              string
+	RegistrationOpens Date
+	Start             Date
+	End               Date
+}
+
+// In returns the term held in the year.
+func (template TermTemplate) In(year int) Term {
+	term := Term{
+		Season:            template.Season,
+		Year:              year,
+		Name:              template.Name,
+		RegistrationOpens: template.RegistrationOpens.In(year),
+		Start:             template.Start.In(year),
+		End:               template.End.In(year),
+	}
+	if term.End.Before(term.Start) {
+		term.End = template.End.In(year + 1)
+	}
+	if term.RegistrationOpens.After(term.Start) {
+		term.RegistrationOpens = template.RegistrationOpens.In(year - 1)
+	}
+	return term
+}
+
+// AcademicCalendar is the terms of a university over one or more years.
+type AcademicCalendar struct {
+	terms []Term
+}
+
+// NewAcademicCalendar returns a calendar of the terms.
+func NewAcademicCalendar(terms ...Term) *AcademicCalendar {
+	calendar := &AcademicCalendar{}
+	calendar.Add(terms...)
+	return calendar
+}
+
+// AnnualCalendar returns a calendar with the templates held in every year from first to last.
+func AnnualCalendar(first, last int, templates ...TermTemplate) *AcademicCalendar {
+	calendar := &AcademicCalendar{}
+	for year := first; year <= last; year++ {
+		for _, template := range templates {
+			calendar.Add(template.In(year))
+		}
+	}
+	return calendar
+}
+
+// Add adds the terms to the calendar. A term replaces the term with the same season, year and name, so a year
+// that differs from an annual calendar can be declared after it.
+func (calendar *AcademicCalendar) Add(terms ...Term) {
+	for _, term := range terms {
+		replaced := false
+		for i := range calendar.terms {
+			existing := calendar.terms[i]
+			if existing.Season == term.Season && existing.Year == term.Year && existing.Name == term.Name {
+				calendar.terms[i] = term
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			calendar.terms = append(calendar.terms, term)
+		}
+	}
+
+	sort.SliceStable(calendar.terms, func(i, j int) bool {
+		return calendar.terms[i].Start.Before(calendar.terms[j].Start)
+	})
+}
+
+// Terms returns the terms of the calendar ordered by their start.
+func (calendar *AcademicCalendar) Terms() []Term {
+	return calendar.terms
+}
+
+// semesterSpan is the terms of a season in a year taken together.
+type semesterSpan struct {
+	semester          Semester
+	registrationOpens time.Time
+	start             time.Time
+	end               time.Time
+}
+
+// semesters groups the terms of the calendar into semesters ordered by their start.
+func (calendar *AcademicCalendar) semesters() (spans []*semesterSpan) {
+	index := map[string]*semesterSpan{}
+	for _, term := range calendar.terms {
+		key := fmt.Sprintf("%s:%d", term.Season, term.Year)
+		span, ok := index[key]
+		if !ok {
+			semester := NewSemester(term.Season, int32(term.Year))
+			span = &semesterSpan{semester: *semester, registrationOpens: term.RegistrationOpens, start: term.Start, end: term.End}
+			index[key] = span
+			spans = append(spans, span)
+			continue
+		}
+		if term.RegistrationOpens.Before(span.registrationOpens) {
+			span.registrationOpens = term.RegistrationOpens
+		}
+		if term.End.After(span.end) {
+			span.end = term.End
+		}
+	}
+	return
+}
+
+// Resolve returns the last, current and next semester at the time. The current semester is the one in session,
+// or the one about to start when classes are out. The next semester follows it, unless registration has already
+// opened for a later semester, in which case that semester is next and the current semester moves up with it,
+// so every semester open for registration is resolved.
+func (calendar *AcademicCalendar) Resolve(t time.Time) (*ResolvedSemester, error) {
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	semesters := calendar.semesters()
+
+	session := -1
+	for i, span := range semesters {
+		if !span.end.Before(today) {
+			session = i
+			break
+		}
+	}
+	if session == -1 {
+		return nil, fmt.Errorf("no semester in session after %s", today.Format("2006-01-02"))
+	}
+
+	next := session + 1
+	for i, span := range semesters {
+		if i > next && !span.registrationOpens.After(today) {
+			next = i
+		}
+	}
+
+	if next-2 < 0 || next >= len(semesters) {
+		return nil, fmt.Errorf("calendar does not have the semesters around %s", today.Format("2006-01-02"))
+	}
+
+	last, current := semesters[next-2].semester, semesters[next-1].semester
+	following := semesters[next].semester
+	return &ResolvedSemester{Last: &last, Current: &current, Next: &following}, nil
+}
+
+// Registrations returns the dates of the semester of each registered term type that is in session or coming up at
+// the time. The semester begins on the day its season is in session and ends on the day its session ends, and
+// registration opens on the start period of its season.
+func (calendar *AcademicCalendar) Registrations(t time.Time) (registrations []*Registration) {
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	seasons := map[string]*semesterSpan{}
+	for _, span := range calendar.semesters() {
+		season := span.semester.Season
+		if existing, ok := seasons[season]; !ok || existing.end.Before(today) {
+			seasons[season] = span
+		}
+	}
+
+	var spans []*semesterSpan
+	for _, termType := range TermTypes() {
+		if span, ok := seasons[termType.Season]; ok {
+			spans = append(spans, span)
+		}
+	}
+
+	add := func(period string, date time.Time) {
+		registrations = append(registrations, &Registration{Period: period, PeriodDate: date.Unix()})
+	}
+
+	for _, span := range spans {
+		add(inSessionPeriod(span.semester.Season), span.start)
+	}
+	for _, span := range spans {
+		add(registrationOpensPeriod(span.semester.Season), span.registrationOpens)
+	}
+	for _, span := range spans {
+		add(sessionEndsPeriod(span.semester.Season), span.end)
+	}
+	return
+}
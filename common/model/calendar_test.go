@@ -0,0 +1,141 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var semesterTerms = []TermTemplate{
+	{Season: Spring, RegistrationOpens: Date{time.October, 18}, Start: Date{time.January, 17}, End: Date{time.May, 10}},
+	{Season: Summer, Name: "Summer Session I", RegistrationOpens: Date{time.January, 14}, Start: Date{time.May, 30}, End: Date{time.July, 7}},
+	{Season: Summer, Name: "Summer Session II", RegistrationOpens: Date{time.February, 1}, Start: Date{time.July, 10}, End: Date{time.August, 16}},
+	{Season: Fall, RegistrationOpens: Date{time.March, 20}, Start: Date{time.September, 6}, End: Date{time.December, 22}},
+	{Season: Winter, RegistrationOpens: Date{time.September, 21}, Start: Date{time.December, 23}, End: Date{time.January, 16}},
+}
+
+var quarterTerms = []TermTemplate{
+	{Season: Fall, RegistrationOpens: Date{time.May, 1}, Start: Date{time.September, 25}, End: Date{time.December, 8}},
+	{Season: Winter, RegistrationOpens: Date{time.November, 1}, Start: Date{time.January, 8}, End: Date{time.March, 16}},
+	{Season: Spring, RegistrationOpens: Date{time.February, 15}, Start: Date{time.March, 26}, End: Date{time.June, 8}},
+	{Season: Summer, RegistrationOpens: Date{time.April, 15}, Start: Date{time.June, 25}, End: Date{time.August, 31}},
+}
+
+func TestTermTemplate_In(t *testing.T) {
+	winter := semesterTerms[4].In(2016)
+	assert.Equal(t, 2016, winter.Year)
+	assert.Equal(t, time.Date(2016, time.September, 21, 0, 0, 0, 0, time.UTC), winter.RegistrationOpens)
+	assert.Equal(t, time.Date(2016, time.December, 23, 0, 0, 0, 0, time.UTC), winter.Start)
+	assert.Equal(t, time.Date(2017, time.January, 16, 0, 0, 0, 0, time.UTC), winter.End)
+
+	spring := semesterTerms[0].In(2017)
+	assert.Equal(t, time.Date(2016, time.October, 18, 0, 0, 0, 0, time.UTC), spring.RegistrationOpens)
+}
+
+func TestAcademicCalendar_Resolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		templates []TermTemplate
+		date      time.Time
+		last      Semester
+		current   Semester
+		next      Semester
+	}{
+		{"winter in session", semesterTerms, time.Date(2015, time.December, 24, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Fall, 2015), *NewSemester(Winter, 2015), *NewSemester(Spring, 2016)},
+		{"summer registration open", semesterTerms, time.Date(2016, time.January, 14, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Winter, 2015), *NewSemester(Spring, 2016), *NewSemester(Summer, 2016)},
+		{"fall registration open", semesterTerms, time.Date(2016, time.March, 20, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Spring, 2016), *NewSemester(Summer, 2016), *NewSemester(Fall, 2016)},
+		{"between summer sessions", semesterTerms, time.Date(2016, time.July, 8, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Spring, 2016), *NewSemester(Summer, 2016), *NewSemester(Fall, 2016)},
+		{"last day of summer", semesterTerms, time.Date(2016, time.August, 16, 23, 0, 0, 0, time.UTC),
+			*NewSemester(Spring, 2016), *NewSemester(Summer, 2016), *NewSemester(Fall, 2016)},
+		{"classes out before fall", semesterTerms, time.Date(2016, time.August, 20, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Summer, 2016), *NewSemester(Fall, 2016), *NewSemester(Winter, 2016)},
+		{"spring registration open", semesterTerms, time.Date(2016, time.October, 18, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Fall, 2016), *NewSemester(Winter, 2016), *NewSemester(Spring, 2017)},
+		{"winter quarter", quarterTerms, time.Date(2017, time.January, 20, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Fall, 2016), *NewSemester(Winter, 2017), *NewSemester(Spring, 2017)},
+		{"spring and summer quarter registration open", quarterTerms, time.Date(2017, time.April, 20, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Winter, 2017), *NewSemester(Spring, 2017), *NewSemester(Summer, 2017)},
+		{"fall quarter registration open", quarterTerms, time.Date(2017, time.May, 2, 0, 0, 0, 0, time.UTC),
+			*NewSemester(Spring, 2017), *NewSemester(Summer, 2017), *NewSemester(Fall, 2017)},
+	}
+	for _, tt := range tests {
+		resolved, err := AnnualCalendar(2014, 2018, tt.templates...).Resolve(tt.date)
+		if assert.NoError(t, err, tt.name) {
+			assert.Equal(t, tt.last, *resolved.Last, tt.name)
+			assert.Equal(t, tt.current, *resolved.Current, tt.name)
+			assert.Equal(t, tt.next, *resolved.Next, tt.name)
+		}
+	}
+}
+
+func TestAcademicCalendar_ResolveOutOfRange(t *testing.T) {
+	calendar := AnnualCalendar(2016, 2016, semesterTerms...)
+
+	_, err := calendar.Resolve(time.Date(2018, time.March, 1, 0, 0, 0, 0, time.UTC))
+	assert.Error(t, err)
+
+	_, err = calendar.Resolve(time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))
+	assert.Error(t, err)
+}
+
+func TestAcademicCalendar_Add(t *testing.T) {
+	calendar := AnnualCalendar(2016, 2017, semesterTerms...)
+
+	// Fall 2017 starts a week late
+	fall := semesterTerms[3].In(2017)
+	fall.Start = fall.Start.AddDate(0, 0, 7)
+	calendar.Add(fall)
+
+	assert.Len(t, calendar.Terms(), 10)
+	resolved, err := calendar.Resolve(time.Date(2017, time.September, 10, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, *NewSemester(Fall, 2017), *resolved.Current)
+
+	registrations := calendar.Registrations(time.Date(2017, time.September, 10, 0, 0, 0, 0, time.UTC))
+	start, _, err := SemesterDates(registrations, *NewSemester(Fall, 2017))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, time.September, 13, 0, 0, 0, 0, time.UTC), start)
+}
+
+func TestAcademicCalendar_Registrations(t *testing.T) {
+	registrations := AnnualCalendar(2016, 2017, semesterTerms...).Registrations(time.Date(2016, time.August, 20, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, registrations, 12)
+
+	// Ordered by term code within each kind of period
+	periods := []Period{InWinter, InSpring, InSummer, InFall, StartWinter, StartSpring, StartSummer, StartFall, EndWinter, EndSpring, EndSummer, EndFall}
+	for i, registration := range registrations {
+		assert.Equal(t, periods[i].String(), registration.Period)
+	}
+
+	start, end, err := SemesterDates(registrations, *NewSemester(Summer, 2017))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, time.May, 30, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2017, time.August, 16, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestAcademicCalendar_RegistrationsTermTypes(t *testing.T) {
+	RegisterTermType(TermType{Season: "summer_1", Code: 31, Name: "Summer Session I"})
+
+	calendar := AnnualCalendar(2026, 2026,
+		TermTemplate{Season: Spring, RegistrationOpens: Date{time.October, 18}, Start: Date{time.January, 17}, End: Date{time.May, 10}},
+		TermTemplate{Season: "summer_1", RegistrationOpens: Date{time.January, 14}, Start: Date{time.May, 30}, End: Date{time.July, 7}},
+		TermTemplate{Season: Fall, RegistrationOpens: Date{time.March, 20}, Start: Date{time.September, 6}, End: Date{time.December, 22}},
+	)
+	registrations := calendar.Registrations(time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC))
+
+	var periods []string
+	for _, registration := range registrations {
+		periods = append(periods, registration.Period)
+	}
+	assert.Equal(t, []string{"spring", "summer_1", "fall", "start_spring", "start_summer_1", "start_fall", "end_spring", "end_summer_1", "end_fall"}, periods)
+
+	start, end, err := SemesterDates(registrations, *NewSemester("summer_1", 2026))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.May, 30, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, time.July, 7, 0, 0, 0, 0, time.UTC), end)
+}
@@ -26,10 +26,18 @@ func MarshalMessage(format string, m University) (*bytes.Reader, error) {
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to encode message")
 		}
+	} else if format == Stream {
+		var buf bytes.Buffer
+		if err = NewStreamEncoder(&buf).Encode(&m); err != nil {
+			return nil, errors.Wrap(err, "failed to encode message")
+		}
+		out = buf.Bytes()
 	}
 	return bytes.NewReader(out), nil
 }
 
+// UnmarshalMessage decodes a whole university in the format. A university in the stream format can be read one
+// subject at a time with a StreamDecoder instead.
 func UnmarshalMessage(format string, r io.Reader, m *University) error {
 	if format == Json {
 		dec := ffjson.NewDecoder()
@@ -41,6 +49,10 @@ func UnmarshalMessage(format string, r io.Reader, m *University) error {
 		if err = m.Unmarshal(data); err != nil {
 			return err
 		}
+	} else if format == Stream {
+		if err := NewStreamDecoder(r).Decode(m); err != nil {
+			return err
+		}
 	}
 	if m.Equal(University{}) {
 		return fmt.Errorf("%s Reason %s", "Failed to unmarshal message:", "empty data")
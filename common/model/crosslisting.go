@@ -0,0 +1,125 @@
+package model
+
+import "strings"
+
+// CrossListedTopicNames returns the topic names of the sections linked to the section.
+func (section *Section) CrossListedTopicNames() (topicNames []string) {
+	for _, crossListing := range section.CrossListings {
+		if crossListing.TopicName != "" {
+			topicNames = append(topicNames, crossListing.TopicName)
+		}
+	}
+	return
+}
+
+// resolveCrossListings links each cross-listing to the section with the same subject, course and section
+// number in the same semester. Links are made in both directions, since universities may only list one
+// side, and references to the section itself or to sections that were not scraped are left unresolved.
+func resolveCrossListings(university *University) {
+	index := newCrossListingIndex()
+	for _, subject := range university.Subjects {
+		index.add(subject)
+	}
+
+	for _, subject := range university.Subjects {
+		index.list(subject)
+	}
+
+	for _, subject := range university.Subjects {
+		index.link(subject)
+	}
+}
+
+// crossListingIndex is the topic names of sections by their cross-listing key, along with the listings back to
+// the sections that list them.
+type crossListingIndex struct {
+	topicNames map[string]string
+	listedBy   map[string][]*CrossListing
+}
+
+func newCrossListingIndex() *crossListingIndex {
+	return &crossListingIndex{topicNames: map[string]string{}, listedBy: map[string][]*CrossListing{}}
+}
+
+func (index *crossListingIndex) add(subject *Subject) {
+	for _, course := range subject.Courses {
+		for _, section := range course.Sections {
+			index.topicNames[crossListingKey(subject.Season, subject.Year, subject.Number, course.Number, section.Number)] = section.TopicName
+		}
+	}
+}
+
+// list removes duplicate cross-listings from the sections of the subject and records a listing back to each
+// section for the sections it lists. It returns the keys of the listed sections.
+func (index *crossListingIndex) list(subject *Subject) (keys []string) {
+	for _, course := range subject.Courses {
+		for _, section := range course.Sections {
+			listings := section.CrossListings
+			section.CrossListings = nil
+			for _, listing := range listings {
+				section.addCrossListing(listing)
+			}
+
+			for _, listing := range section.CrossListings {
+				key := crossListingKey(subject.Season, subject.Year, listing.SubjectNumber, listing.CourseNumber, listing.SectionNumber)
+				index.listedBy[key] = append(index.listedBy[key], &CrossListing{
+					SubjectNumber: subject.Number,
+					CourseNumber:  course.Number,
+					SectionNumber: section.Number,
+					TopicName:     section.TopicName,
+				})
+				keys = append(keys, key)
+			}
+		}
+	}
+	return
+}
+
+// link links the cross-listings of the sections of the subject to the sections in the index, and adds the
+// listings back from the sections that list them. It returns the keys of the listed sections that are not in
+// the index.
+func (index *crossListingIndex) link(subject *Subject) (missing []string) {
+	for _, course := range subject.Courses {
+		for _, section := range course.Sections {
+			for _, listing := range section.CrossListings {
+				key := crossListingKey(subject.Season, subject.Year, listing.SubjectNumber, listing.CourseNumber, listing.SectionNumber)
+				topicName, ok := index.topicNames[key]
+				if !ok {
+					missing = append(missing, key)
+				}
+				if topicName == section.TopicName {
+					topicName = ""
+				}
+				listing.TopicName = topicName
+			}
+
+			// Only the section a key resolves to is listed back
+			key := crossListingKey(subject.Season, subject.Year, subject.Number, course.Number, section.Number)
+			if index.topicNames[key] != section.TopicName {
+				continue
+			}
+			for _, listing := range index.listedBy[key] {
+				if listing.TopicName != section.TopicName {
+					section.addCrossListing(listing)
+				}
+			}
+		}
+	}
+	return
+}
+
+// addCrossListing adds the listing unless the section already lists the same section.
+func (section *Section) addCrossListing(listing *CrossListing) {
+	for _, existing := range section.CrossListings {
+		if existing.SubjectNumber == listing.SubjectNumber &&
+			existing.CourseNumber == listing.CourseNumber &&
+			existing.SectionNumber == listing.SectionNumber {
+			return
+		}
+	}
+	section.CrossListings = append(section.CrossListings, listing)
+}
+
+func crossListingKey(season, year, subjectNumber, courseNumber, sectionNumber string) string {
+	return strings.Join([]string{season, year, subjectNumber, courseNumber, sectionNumber}, ":")
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveCrossListings(t *testing.T) {
+	linguistics := &Section{Number: "01", TopicName: "ling", CrossListings: []*CrossListing{
+		{SubjectNumber: "730", CourseNumber: "107", SectionNumber: "01"},
+		{SubjectNumber: "730", CourseNumber: "107", SectionNumber: "01"},
+		{SubjectNumber: "615", CourseNumber: "201", SectionNumber: "01"},
+		{SubjectNumber: "940", CourseNumber: "101", SectionNumber: "05"},
+	}}
+	philosophy := &Section{Number: "01", TopicName: "phil"}
+	unrelated := &Section{Number: "02", TopicName: "phil-02"}
+
+	university := &University{Subjects: []*Subject{
+		{Number: "615", Season: Fall, Year: "2017", Courses: []*Course{{Number: "201", Sections: []*Section{linguistics}}}},
+		{Number: "730", Season: Fall, Year: "2017", Courses: []*Course{{Number: "107", Sections: []*Section{philosophy, unrelated}}}},
+	}}
+
+	resolveCrossListings(university)
+
+	assert.Len(t, linguistics.CrossListings, 3)
+	assert.Equal(t, []string{"phil"}, linguistics.CrossListedTopicNames())
+	assert.Equal(t, []*CrossListing{{SubjectNumber: "615", CourseNumber: "201", SectionNumber: "01", TopicName: "ling"}}, philosophy.CrossListings)
+	assert.Empty(t, unrelated.CrossListings)
+}
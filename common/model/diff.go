@@ -1,26 +1,219 @@
 package model
 
 import (
+	"strings"
+
 	log "github.com/Sirupsen/logrus"
 )
 
+type (
+	// UniversityDiff is the structural difference between two snapshots of a university.
+	// Subjects, courses and sections are matched by topic name, meetings and instructors
+	// by their position in the section.
+	UniversityDiff struct {
+		Old      University
+		New      University
+		Added    []*Subject
+		Removed  []*Subject
+		Modified []*SubjectDiff
+	}
+
+	SubjectDiff struct {
+		Old      *Subject
+		New      *Subject
+		Added    []*Course
+		Removed  []*Course
+		Modified []*CourseDiff
+	}
+
+	CourseDiff struct {
+		Old      *Course
+		New      *Course
+		Added    []*Section
+		Removed  []*Section
+		Modified []*SectionDiff
+	}
+
+	SectionDiff struct {
+		Old                 *Section
+		New                 *Section
+		AddedMeetings       []*Meeting
+		RemovedMeetings     []*Meeting
+		ModifiedMeetings    []*MeetingDiff
+		AddedInstructors    []*Instructor
+		RemovedInstructors  []*Instructor
+		ModifiedInstructors []*InstructorDiff
+	}
+
+	MeetingDiff struct {
+		Old *Meeting
+		New *Meeting
+	}
+
+	InstructorDiff struct {
+		Old *Instructor
+		New *Instructor
+	}
+)
+
+// DiffAndFilter returns the newer university containing only the subjects, courses and sections
+// that were added or modified since the older university.
 func DiffAndFilter(oldUni, newUni University) (filteredUniversity University) {
-	filteredUniversity = newUni
-	oldSubjects := oldUni.Subjects
-	newSubjects := newUni.Subjects
+	return DiffUniversity(oldUni, newUni).Filter()
+}
 
-	var filteredSubjects []*Subject
-	// For each newer subject
-	for s := range newSubjects {
-		// If current index is out of range of the old subjects[] break and add every subject
-		if s >= len(oldSubjects) {
-			filteredSubjects = newSubjects
-			break
+// DiffUniversity matches every node of the older university to the node with the same key
+// in the newer university. Nodes without a match are reported as added or removed, matched
+// nodes that are not equal are reported as modified.
+func DiffUniversity(oldUni, newUni University) *UniversityDiff {
+	diff := &UniversityDiff{Old: oldUni, New: newUni}
+
+	oldSubjects := map[string]*Subject{}
+	for _, subject := range oldUni.Subjects {
+		if key := subjectKey(subject); oldSubjects[key] == nil {
+			oldSubjects[key] = subject
+		}
+	}
+
+	for _, newSubject := range newUni.Subjects {
+		key := subjectKey(newSubject)
+		oldSubject, ok := oldSubjects[key]
+		if !ok {
+			diff.Added = append(diff.Added, newSubject)
+			continue
+		}
+		delete(oldSubjects, key)
+
+		if changed(oldSubject.ContentHash, newSubject.ContentHash, func() bool { return newSubject.Equal(oldSubject) }) {
+			diff.Modified = append(diff.Modified, diffSubject(oldSubject, newSubject))
+		}
+	}
+
+	for _, subject := range oldUni.Subjects {
+		if oldSubjects[subjectKey(subject)] == subject {
+			diff.Removed = append(diff.Removed, subject)
+		}
+	}
+
+	return diff
+}
+
+func diffSubject(oldSubject, newSubject *Subject) *SubjectDiff {
+	diff := &SubjectDiff{Old: oldSubject, New: newSubject}
+
+	oldCourses := map[string]*Course{}
+	for _, course := range oldSubject.Courses {
+		if key := courseKey(course); oldCourses[key] == nil {
+			oldCourses[key] = course
 		}
+	}
+
+	for _, newCourse := range newSubject.Courses {
+		key := courseKey(newCourse)
+		oldCourse, ok := oldCourses[key]
+		if !ok {
+			diff.Added = append(diff.Added, newCourse)
+			continue
+		}
+		delete(oldCourses, key)
+
+		if changed(oldCourse.ContentHash, newCourse.ContentHash, func() bool { return newCourse.Equal(oldCourse) }) {
+			diff.Modified = append(diff.Modified, diffCourse(oldCourse, newCourse))
+		}
+	}
+
+	for _, course := range oldSubject.Courses {
+		if oldCourses[courseKey(course)] == course {
+			diff.Removed = append(diff.Removed, course)
+		}
+	}
+
+	return diff
+}
+
+func diffCourse(oldCourse, newCourse *Course) *CourseDiff {
+	return diffCourseBy(oldCourse, newCourse, sectionKey)
+}
+
+func diffCourseBy(oldCourse, newCourse *Course, keyOf func(*Section) string) *CourseDiff {
+	diff := &CourseDiff{Old: oldCourse, New: newCourse}
 
-		if !newSubjects[s].Equal(oldSubjects[s]) {
-			newSubjects[s].Courses = diffAndFilterCourses(oldSubjects[s].Courses, newSubjects[s].Courses)
-			filteredSubjects = append(filteredSubjects, newSubjects[s])
+	oldSections := map[string]*Section{}
+	for _, section := range oldCourse.Sections {
+		if key := keyOf(section); oldSections[key] == nil {
+			oldSections[key] = section
+		}
+	}
+
+	for _, newSection := range newCourse.Sections {
+		key := keyOf(newSection)
+		oldSection, ok := oldSections[key]
+		if !ok {
+			diff.Added = append(diff.Added, newSection)
+			continue
+		}
+		delete(oldSections, key)
+
+		if changed(oldSection.ContentHash, newSection.ContentHash, func() bool { return newSection.Equal(oldSection) }) {
+			diff.Modified = append(diff.Modified, diffSection(oldSection, newSection))
+		}
+	}
+
+	for _, section := range oldCourse.Sections {
+		if oldSections[keyOf(section)] == section {
+			diff.Removed = append(diff.Removed, section)
+		}
+	}
+
+	return diff
+}
+
+func diffSection(oldSection, newSection *Section) *SectionDiff {
+	diff := &SectionDiff{Old: oldSection, New: newSection}
+
+	for i, newMeeting := range newSection.Meetings {
+		if i >= len(oldSection.Meetings) {
+			diff.AddedMeetings = append(diff.AddedMeetings, newMeeting)
+		} else if oldMeeting := oldSection.Meetings[i]; changed(oldMeeting.ContentHash, newMeeting.ContentHash, func() bool { return newMeeting.Equal(oldMeeting) }) {
+			diff.ModifiedMeetings = append(diff.ModifiedMeetings, &MeetingDiff{Old: oldMeeting, New: newMeeting})
+		}
+	}
+
+	if len(oldSection.Meetings) > len(newSection.Meetings) {
+		diff.RemovedMeetings = oldSection.Meetings[len(newSection.Meetings):]
+	}
+
+	for i, newInstructor := range newSection.Instructors {
+		if i >= len(oldSection.Instructors) {
+			diff.AddedInstructors = append(diff.AddedInstructors, newInstructor)
+		} else if oldInstructor := oldSection.Instructors[i]; !newInstructor.Equal(oldInstructor) {
+			diff.ModifiedInstructors = append(diff.ModifiedInstructors, &InstructorDiff{Old: oldInstructor, New: newInstructor})
+		}
+	}
+
+	if len(oldSection.Instructors) > len(newSection.Instructors) {
+		diff.RemovedInstructors = oldSection.Instructors[len(newSection.Instructors):]
+	}
+
+	return diff
+}
+
+// Filter returns the newer university with only the added and modified subjects, courses and sections.
+// The subjects and courses of the newer university are copied, not mutated.
+func (diff *UniversityDiff) Filter() (filteredUniversity University) {
+	filteredUniversity = diff.New
+
+	modified := map[*Subject]*SubjectDiff{}
+	for _, subjectDiff := range diff.Modified {
+		modified[subjectDiff.New] = subjectDiff
+	}
+
+	var filteredSubjects []*Subject
+	for _, subject := range diff.New.Subjects {
+		if subjectDiff, ok := modified[subject]; ok {
+			filteredSubjects = append(filteredSubjects, subjectDiff.filter())
+		} else if containsSubject(diff.Added, subject) {
+			filteredSubjects = append(filteredSubjects, subject)
 		}
 	}
 
@@ -28,50 +221,109 @@ func DiffAndFilter(oldUni, newUni University) (filteredUniversity University) {
 	return
 }
 
-func diffAndFilterCourses(oldCourses, newCourses []*Course) []*Course {
+func (diff *SubjectDiff) filter() *Subject {
+	modified := map[*Course]*CourseDiff{}
+	for _, courseDiff := range diff.Modified {
+		modified[courseDiff.New] = courseDiff
+	}
+
 	var filteredCourses []*Course
-	for c := range newCourses {
-		if c >= len(oldCourses) {
-			filteredCourses = newCourses
-			break
+	for _, course := range diff.New.Courses {
+		if courseDiff, ok := modified[course]; ok {
+			filteredCourses = append(filteredCourses, courseDiff.filter())
+		} else if containsCourse(diff.Added, course) {
+			filteredCourses = append(filteredCourses, course)
 		}
+	}
 
-		if !newCourses[c].Equal(oldCourses[c]) {
-			newCourses[c].Sections = diffAndFilterSections(oldCourses[c].Sections, newCourses[c].Sections)
-			filteredCourses = append(filteredCourses, newCourses[c])
+	subject := *diff.New
+	subject.Courses = filteredCourses
+	return &subject
+}
+
+func (diff *CourseDiff) filter() *Course {
+	var oldSections, newSections []*Section
+	for _, sectionDiff := range diff.Modified {
+		oldSections = append(oldSections, sectionDiff.Old)
+		newSections = append(newSections, sectionDiff.New)
+	}
+	logSectionDiff(diff.Old.Sections, diff.New.Sections, oldSections, newSections)
+
+	var filteredSections []*Section
+	for _, section := range diff.New.Sections {
+		if containsSection(newSections, section) || containsSection(diff.Added, section) {
+			filteredSections = append(filteredSections, section)
 		}
 	}
-	return filteredCourses
+
+	course := *diff.New
+	course.Sections = filteredSections
+	return &course
 }
 
-func diffAndFilterSections(oldSections, newSections []*Section) []*Section {
-	oldSectionFields := logSection(oldSections, "old")
-	newSectionFields := logSection(newSections, "new")
-	var filteredSections []*Section
-	for e := range newSections {
-		if e >= len(oldSections) {
-			filteredSections = newSections
-			break
-		}
-		if !newSections[e].Equal(oldSections[e]) {
-			fullSection := log.Fields{
-				"old_full_section": oldSections[e].String(),
-				"new_full_section": newSections[e].String(),
+// Subtree is a subject, course or section that was added, removed or whose content changed.
+type Subtree struct {
+	Kind    string `json:"kind"`
+	Change  string `json:"change"`
+	Key     string `json:"key"`
+	OldHash string `json:"old_hash,omitempty"`
+	NewHash string `json:"new_hash,omitempty"`
+}
+
+// Subtrees returns every changed subject, course and section of the diff, parents before their children. A
+// subtree that was added or removed is reported without its children.
+func (diff *UniversityDiff) Subtrees() (subtrees []Subtree) {
+	for _, subject := range diff.Added {
+		subtrees = append(subtrees, Subtree{Kind: "subject", Change: "added", Key: subjectKey(subject), NewHash: subject.ContentHash})
+	}
+	for _, subject := range diff.Removed {
+		subtrees = append(subtrees, Subtree{Kind: "subject", Change: "removed", Key: subjectKey(subject), OldHash: subject.ContentHash})
+	}
+
+	for _, subjectDiff := range diff.Modified {
+		subtrees = append(subtrees, Subtree{Kind: "subject", Change: "modified", Key: subjectKey(subjectDiff.New), OldHash: subjectDiff.Old.ContentHash, NewHash: subjectDiff.New.ContentHash})
+		for _, course := range subjectDiff.Added {
+			subtrees = append(subtrees, Subtree{Kind: "course", Change: "added", Key: courseKey(course), NewHash: course.ContentHash})
+		}
+		for _, course := range subjectDiff.Removed {
+			subtrees = append(subtrees, Subtree{Kind: "course", Change: "removed", Key: courseKey(course), OldHash: course.ContentHash})
+		}
+
+		for _, courseDiff := range subjectDiff.Modified {
+			subtrees = append(subtrees, Subtree{Kind: "course", Change: "modified", Key: courseKey(courseDiff.New), OldHash: courseDiff.Old.ContentHash, NewHash: courseDiff.New.ContentHash})
+			for _, section := range courseDiff.Added {
+				subtrees = append(subtrees, Subtree{Kind: "section", Change: "added", Key: sectionKey(section), NewHash: section.ContentHash})
+			}
+			for _, section := range courseDiff.Removed {
+				subtrees = append(subtrees, Subtree{Kind: "section", Change: "removed", Key: sectionKey(section), OldHash: section.ContentHash})
+			}
+			for _, sectionDiff := range courseDiff.Modified {
+				subtrees = append(subtrees, Subtree{Kind: "section", Change: "modified", Key: sectionKey(sectionDiff.New), OldHash: sectionDiff.Old.ContentHash, NewHash: sectionDiff.New.ContentHash})
 			}
-			log.WithFields(log.Fields{
-				"old_call_number": oldSections[e].CallNumber,
-				"old_status":      oldSections[e].Status,
-				"new_call_number": newSections[e].CallNumber,
-				"new_status":      newSections[e].Status,
-			}).WithFields(oldSectionFields).WithFields(newSectionFields).WithFields(fullSection).WithFields(
-				log.Fields{
-					"old_section": oldSections[e].TopicName,
-					"new_section": newSections[e].TopicName,
-				}).Infoln("diff")
-			filteredSections = append(filteredSections, newSections[e])
 		}
 	}
-	return filteredSections
+	return
+}
+
+func logSectionDiff(allOldSections, allNewSections, oldSections, newSections []*Section) {
+	oldSectionFields := logSection(allOldSections, "old")
+	newSectionFields := logSection(allNewSections, "new")
+	for e := range newSections {
+		fullSection := log.Fields{
+			"old_full_section": oldSections[e].String(),
+			"new_full_section": newSections[e].String(),
+		}
+		log.WithFields(log.Fields{
+			"old_call_number": oldSections[e].CallNumber,
+			"old_status":      oldSections[e].Status,
+			"new_call_number": newSections[e].CallNumber,
+			"new_status":      newSections[e].Status,
+		}).WithFields(oldSectionFields).WithFields(newSectionFields).WithFields(fullSection).WithFields(
+			log.Fields{
+				"old_section": oldSections[e].TopicName,
+				"new_section": newSections[e].TopicName,
+			}).Infoln("diff")
+	}
 }
 
 func logSection(section []*Section, prepend string) log.Fields {
@@ -89,3 +341,53 @@ func logSection(section []*Section, prepend string) log.Fields {
 		prepend + "_closed_count": closedCount,
 	}
 }
+
+// Topic names are only available after validation, unvalidated nodes fall back to the fields
+// the topic name would have been built from.
+func subjectKey(subject *Subject) string {
+	if subject.TopicName != "" {
+		return subject.TopicName
+	}
+	return strings.Join([]string{subject.Number, subject.Name, subject.Season, subject.Year}, ".")
+}
+
+func courseKey(course *Course) string {
+	if course.TopicName != "" {
+		return course.TopicName
+	}
+	return strings.Join([]string{course.Number, course.Name}, ".")
+}
+
+func sectionKey(section *Section) string {
+	if section.TopicName != "" {
+		return section.TopicName
+	}
+	return strings.Join([]string{section.Number, section.CallNumber}, ".")
+}
+
+func containsSubject(subjects []*Subject, subject *Subject) bool {
+	for i := range subjects {
+		if subjects[i] == subject {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCourse(courses []*Course, course *Course) bool {
+	for i := range courses {
+		if courses[i] == course {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSection(sections []*Section, section *Section) bool {
+	for i := range sections {
+		if sections[i] == section {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func diffTestSection(topicName, status string) *Section {
+	day := "Monday"
+	return &Section{
+		TopicName:   topicName,
+		Status:      status,
+		Meetings:    []*Meeting{{Day: &day}},
+		Instructors: []*Instructor{{Name: "Smith"}},
+	}
+}
+
+func diffTestUniversity() University {
+	return University{
+		Name: "Test University",
+		Subjects: []*Subject{
+			{TopicName: "math", Courses: []*Course{
+				{TopicName: "math.calc", Sections: []*Section{
+					diffTestSection("math.calc.01", "Open"),
+					diffTestSection("math.calc.02", "Open"),
+				}},
+			}},
+			{TopicName: "physics", Courses: []*Course{
+				{TopicName: "physics.mechanics", Sections: []*Section{
+					diffTestSection("physics.mechanics.01", "Closed"),
+				}},
+			}},
+		},
+	}
+}
+
+func TestDiffUniversityUnchanged(t *testing.T) {
+	diff := DiffUniversity(diffTestUniversity(), diffTestUniversity())
+
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Modified)
+	assert.Empty(t, diff.Filter().Subjects)
+}
+
+func TestDiffUniversityInsertedSubject(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	newUni.Subjects = append([]*Subject{{TopicName: "biology"}}, newUni.Subjects...)
+
+	diff := DiffUniversity(oldUni, newUni)
+
+	assert.Len(t, diff.Added, 1)
+	assert.Equal(t, "biology", diff.Added[0].TopicName)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Modified)
+}
+
+func TestDiffUniversityRemovedSubject(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	newUni.Subjects = newUni.Subjects[1:]
+
+	diff := DiffUniversity(oldUni, newUni)
+
+	assert.Empty(t, diff.Added)
+	assert.Len(t, diff.Removed, 1)
+	assert.Equal(t, "math", diff.Removed[0].TopicName)
+	assert.Empty(t, diff.Modified)
+}
+
+func TestDiffUniversityReorderedSections(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	sections := newUni.Subjects[0].Courses[0].Sections
+	sections[0], sections[1] = sections[1], sections[0]
+
+	diff := DiffUniversity(oldUni, newUni)
+
+	assert.Len(t, diff.Modified, 1)
+	assert.Len(t, diff.Modified[0].Modified, 1)
+	assert.Empty(t, diff.Modified[0].Modified[0].Modified)
+	assert.Empty(t, diff.Modified[0].Modified[0].Added)
+	assert.Empty(t, diff.Modified[0].Modified[0].Removed)
+}
+
+func TestDiffUniversityModifiedSection(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	newUni.Subjects[0].Courses[0].Sections[1].Status = "Closed"
+	newUni.Subjects[0].Courses[0].Sections = append(newUni.Subjects[0].Courses[0].Sections, diffTestSection("math.calc.03", "Open"))
+
+	diff := DiffUniversity(oldUni, newUni)
+
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Len(t, diff.Modified, 1)
+
+	courseDiff := diff.Modified[0].Modified[0]
+	assert.Len(t, courseDiff.Added, 1)
+	assert.Equal(t, "math.calc.03", courseDiff.Added[0].TopicName)
+	assert.Len(t, courseDiff.Modified, 1)
+	assert.Equal(t, "Open", courseDiff.Modified[0].Old.Status)
+	assert.Equal(t, "Closed", courseDiff.Modified[0].New.Status)
+
+	filtered := diff.Filter()
+	assert.Len(t, filtered.Subjects, 1)
+	assert.Len(t, filtered.Subjects[0].Courses, 1)
+	assert.Len(t, filtered.Subjects[0].Courses[0].Sections, 2)
+	assert.Len(t, newUni.Subjects[0].Courses[0].Sections, 3, "filter must not mutate the newer university")
+}
+
+func TestDiffSectionMeetingsAndInstructors(t *testing.T) {
+	oldSection := diffTestSection("math.calc.01", "Open")
+	newSection := diffTestSection("math.calc.01", "Open")
+	tuesday := "Tuesday"
+	newSection.Meetings[0].Day = &tuesday
+	newSection.Meetings = append(newSection.Meetings, &Meeting{Day: &tuesday})
+	newSection.Instructors = nil
+
+	diff := diffSection(oldSection, newSection)
+
+	assert.Len(t, diff.ModifiedMeetings, 1)
+	assert.Len(t, diff.AddedMeetings, 1)
+	assert.Empty(t, diff.RemovedMeetings)
+	assert.Len(t, diff.RemovedInstructors, 1)
+	assert.Empty(t, diff.AddedInstructors)
+}
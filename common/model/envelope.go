@@ -0,0 +1,243 @@
+package model
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SchemaVersion is the major.minor version of model.proto. The major version changes when a payload written
+// with the schema can no longer be read by a consumer built with an older one, e.g a field is renumbered.
+const SchemaVersion = "1.0"
+
+// envelopeMagic prefixes a sealed payload, telling it apart from a payload written before envelopes.
+var envelopeMagic = []byte("UCTE")
+
+// envelopeChunkSize is the largest chunk of the payload an EnvelopeWriter writes at once.
+const envelopeChunkSize = 1 << 20
+
+var (
+	// ErrNoEnvelope is returned by OpenEnvelope for a payload that was not sealed in an envelope.
+	ErrNoEnvelope = errors.New("payload is not in an envelope")
+	// ErrContentHash is returned by the payload of an envelope when it does not match the hash it was sealed with.
+	ErrContentHash = errors.New("payload does not match its content hash")
+)
+
+// SchemaVersionError is returned by OpenEnvelope for a payload written with a schema this build cannot read.
+type SchemaVersionError struct {
+	Version string
+}
+
+func (e *SchemaVersionError) Error() string {
+	return fmt.Sprintf("unsupported schema version %q, expected major version of %s", e.Version, SchemaVersion)
+}
+
+// EnvelopeWriter seals a payload as it is written. The envelope is written first, followed by the payload in
+// length prefixed chunks, and the content hash of the payload is written once the writer is closed, so a payload
+// is sealed without being held in memory.
+type EnvelopeWriter struct {
+	w      io.Writer
+	hash   hash.Hash
+	closed bool
+}
+
+// NewEnvelopeWriter writes an envelope for a payload encoded in the format with the current schema version and
+// returns the writer the payload is written to.
+func NewEnvelopeWriter(w io.Writer, producer, format string, scrapedAt time.Time) (*EnvelopeWriter, error) {
+	envelope := Envelope{
+		SchemaVersion: SchemaVersion,
+		Producer:      producer,
+		ScrapedAt:     scrapedAt.Unix(),
+		Format:        format,
+	}
+
+	data, err := envelope.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode envelope")
+	}
+	if _, err := w.Write(envelopeMagic); err != nil {
+		return nil, err
+	}
+	if err := writeRecord(w, data); err != nil {
+		return nil, err
+	}
+	return &EnvelopeWriter{w: w, hash: sha256.New()}, nil
+}
+
+// Write writes p to the payload.
+func (ew *EnvelopeWriter) Write(p []byte) (n int, err error) {
+	if ew.closed {
+		return 0, errors.New("envelope already closed")
+	}
+
+	// An empty chunk ends the payload
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > envelopeChunkSize {
+			chunk = chunk[:envelopeChunkSize]
+		}
+		if err := writeRecord(ew.w, chunk); err != nil {
+			return n, err
+		}
+		ew.hash.Write(chunk)
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// Close ends the payload and writes its content hash. It does not close the underlying writer.
+func (ew *EnvelopeWriter) Close() error {
+	if ew.closed {
+		return nil
+	}
+	ew.closed = true
+
+	trailer := Envelope{ContentHash: hex.EncodeToString(ew.hash.Sum(nil))}
+	data, err := trailer.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode envelope")
+	}
+	if err := writeRecord(ew.w, nil); err != nil {
+		return err
+	}
+	return writeRecord(ew.w, data)
+}
+
+// SealEnvelope wraps a payload encoded in the format with the current schema version and returns the encoded
+// envelope.
+func SealEnvelope(producer, format string, payload []byte, scrapedAt time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	ew, err := NewEnvelopeWriter(&buf, producer, format, scrapedAt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ew.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := ew.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// OpenEnvelope reads the envelope a payload was sealed in and verifies that its schema version is supported. The
+// payload is read from the returned reader, which returns ErrContentHash in place of io.EOF when the payload does
+// not match the hash it was sealed with, so a payload can only be trusted once it was read to the end. The
+// envelope is returned with a *SchemaVersionError so the producer of a rejected payload can be reported. A payload
+// that was not sealed is returned as it is with ErrNoEnvelope.
+func OpenEnvelope(r io.Reader) (*Envelope, io.Reader, error) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(len(envelopeMagic)); err != nil || !bytes.Equal(magic, envelopeMagic) {
+		return nil, br, ErrNoEnvelope
+	}
+	br.Discard(len(envelopeMagic))
+
+	data, err := readRecord(br, nil)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode envelope")
+	}
+
+	envelope := &Envelope{}
+	if err := envelope.Unmarshal(data); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode envelope")
+	}
+
+	if major, err := schemaMajor(envelope.SchemaVersion); err != nil || major != currentSchemaMajor() {
+		return envelope, nil, &SchemaVersionError{Version: envelope.SchemaVersion}
+	}
+
+	return envelope, &payloadReader{r: br, envelope: envelope, hash: sha256.New()}, nil
+}
+
+// payloadReader reads the chunks of a sealed payload and verifies its content hash after the last chunk.
+type payloadReader struct {
+	r         *bufio.Reader
+	envelope  *Envelope
+	hash      hash.Hash
+	remaining uint64
+	err       error
+}
+
+func (pr *payloadReader) Read(p []byte) (int, error) {
+	if pr.err != nil {
+		return 0, pr.err
+	}
+
+	if pr.remaining == 0 {
+		size, err := binary.ReadUvarint(pr.r)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			pr.err = errors.Wrap(err, "failed to read payload")
+			return 0, pr.err
+		}
+		if size == 0 {
+			pr.err = pr.verify()
+			return 0, pr.err
+		}
+		pr.remaining = size
+	}
+
+	if uint64(len(p)) > pr.remaining {
+		p = p[:pr.remaining]
+	}
+	n, err := pr.r.Read(p)
+	pr.hash.Write(p[:n])
+	pr.remaining -= uint64(n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		pr.err = errors.Wrap(err, "failed to read payload")
+	}
+	return n, pr.err
+}
+
+// verify reads the content hash written after the payload and compares it to the hash of the payload read.
+func (pr *payloadReader) verify() error {
+	data, err := readRecord(pr.r, nil)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to decode envelope")
+	}
+
+	trailer := Envelope{}
+	if err := trailer.Unmarshal(data); err != nil {
+		return errors.Wrap(err, "failed to decode envelope")
+	}
+	pr.envelope.ContentHash = trailer.ContentHash
+
+	if hex.EncodeToString(pr.hash.Sum(nil)) != trailer.ContentHash {
+		return ErrContentHash
+	}
+	return io.EOF
+}
+
+func schemaMajor(version string) (int, error) {
+	return strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+}
+
+func currentSchemaMajor() int {
+	major, err := schemaMajor(SchemaVersion)
+	if err != nil {
+		panic(err)
+	}
+	return major
+}
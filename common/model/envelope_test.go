@@ -0,0 +1,111 @@
+package model
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenEnvelope(t *testing.T) {
+	scrapedAt := time.Unix(1500000000, 0)
+	data, err := SealEnvelope("rutgers-NB", Protobuf, []byte("payload"), scrapedAt)
+	assert.NoError(t, err)
+
+	envelope, payload, err := OpenEnvelope(bytes.NewReader(data))
+	if assert.NoError(t, err) {
+		assert.Equal(t, SchemaVersion, envelope.SchemaVersion)
+		assert.Equal(t, "rutgers-NB", envelope.Producer)
+		assert.Equal(t, scrapedAt.Unix(), envelope.ScrapedAt)
+		assert.Equal(t, Protobuf, envelope.Format)
+
+		read, err := ioutil.ReadAll(payload)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("payload"), read)
+		sum := sha256.Sum256([]byte("payload"))
+		assert.Equal(t, hex.EncodeToString(sum[:]), envelope.ContentHash)
+	}
+
+	_, payload, err = OpenEnvelope(bytes.NewReader([]byte("payload")))
+	assert.Equal(t, ErrNoEnvelope, err)
+	read, _ := ioutil.ReadAll(payload)
+	assert.Equal(t, []byte("payload"), read)
+
+	// Flip the last byte of the payload
+	corrupt := append([]byte(nil), data...)
+	corrupt[bytes.Index(corrupt, []byte("payload"))+6] ^= 1
+	_, payload, err = OpenEnvelope(bytes.NewReader(corrupt))
+	if assert.NoError(t, err) {
+		_, err = ioutil.ReadAll(payload)
+		assert.Equal(t, ErrContentHash, err)
+	}
+
+	// Cut off before the content hash
+	_, payload, err = OpenEnvelope(bytes.NewReader(data[:len(data)-10]))
+	if assert.NoError(t, err) {
+		_, err = ioutil.ReadAll(payload)
+		assert.Error(t, err)
+		assert.NotEqual(t, ErrContentHash, err)
+	}
+}
+
+func TestEnvelopeWriter(t *testing.T) {
+	university := streamUniversity()
+
+	var buf bytes.Buffer
+	ew, err := NewEnvelopeWriter(&buf, "rutgers-NB", Stream, time.Now())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, NewStreamEncoder(ew).Encode(&university))
+	assert.NoError(t, ew.Close())
+	_, err = ew.Write([]byte("payload"))
+	assert.Error(t, err)
+
+	envelope, payload, err := OpenEnvelope(&buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	decoder := NewStreamDecoder(payload)
+	var subjects int
+	for {
+		_, err := decoder.Next()
+		if err != nil {
+			assert.Equal(t, io.EOF, err)
+			break
+		}
+		subjects++
+	}
+	assert.Equal(t, len(university.Subjects), subjects)
+	assert.NotEmpty(t, envelope.ContentHash)
+}
+
+func TestOpenEnvelope_SchemaVersion(t *testing.T) {
+	seal := func(version string) io.Reader {
+		envelope := Envelope{SchemaVersion: version, Producer: "njit"}
+		data, err := envelope.Marshal()
+		assert.NoError(t, err)
+
+		var buf bytes.Buffer
+		buf.Write(envelopeMagic)
+		assert.NoError(t, writeRecord(&buf, data))
+		return &buf
+	}
+
+	// Minor versions are compatible
+	_, _, err := OpenEnvelope(seal("1.7"))
+	assert.NoError(t, err)
+
+	for _, version := range []string{"2.0", "0.9", "", "one"} {
+		envelope, _, err := OpenEnvelope(seal(version))
+		if assert.IsType(t, &SchemaVersionError{}, err, version) {
+			assert.Equal(t, "njit", envelope.Producer)
+		}
+	}
+}
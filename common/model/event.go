@@ -0,0 +1,260 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+type (
+	ChangeType int
+
+	// ChangeEvent is a single semantic change between two snapshots of a university.
+	// Old and New hold the value before and after the change, their type depends on the ChangeType.
+	ChangeEvent struct {
+		Type ChangeType `json:"type"`
+		// TopicPath holds the topic names from the university down to the changed node.
+		TopicPath []string    `json:"topic_path"`
+		Old       interface{} `json:"old,omitempty"`
+		New       interface{} `json:"new,omitempty"`
+	}
+
+	SeatCount struct {
+		Now int64 `json:"now"`
+		Max int64 `json:"max"`
+	}
+)
+
+const (
+	// Old and New are *Subject, one of them is nil.
+	SubjectAdded ChangeType = iota
+	SubjectRemoved
+	// Old and New are *Course, one of them is nil.
+	CourseAdded
+	CourseRemoved
+	// Old and New are the course names.
+	CourseRenamed
+	// Old and New are *Section, one of them is nil.
+	SectionAdded
+	SectionRemoved
+	// Old and New are the section statuses.
+	SectionStatusChanged
+	// Old and New are SeatCount.
+	SeatCountChanged
+	// Old and New are the instructor names in order.
+	InstructorChanged
+	// Old and New are *Meeting whose room or time changed.
+	MeetingMoved
+	// Old and New are *Meeting, one of them is nil.
+	MeetingAdded
+	MeetingRemoved
+)
+
+var changeType = [...]string{
+	"subject_added",
+	"subject_removed",
+	"course_added",
+	"course_removed",
+	"course_renamed",
+	"section_added",
+	"section_removed",
+	"section_status_changed",
+	"seat_count_changed",
+	"instructor_changed",
+	"meeting_moved",
+	"meeting_added",
+	"meeting_removed",
+}
+
+func (c ChangeType) String() string {
+	if c < 0 || int(c) >= len(changeType) {
+		return fmt.Sprintf("change_type(%d)", c)
+	}
+	return changeType[c]
+}
+
+func (c ChangeType) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// TopicName is the topic name of the changed node.
+func (event ChangeEvent) TopicName() string {
+	if len(event.TopicPath) == 0 {
+		return ""
+	}
+	return event.TopicPath[len(event.TopicPath)-1]
+}
+
+func (event ChangeEvent) Fields() log.Fields {
+	return log.Fields{
+		"change_type": event.Type.String(),
+		"topic_name":  event.TopicName(),
+		"old":         event.Old,
+		"new":         event.New,
+	}
+}
+
+// Changes returns every semantic change between the older and newer university.
+func Changes(oldUni, newUni University) []*ChangeEvent {
+	return DiffUniversity(oldUni, newUni).Changes()
+}
+
+// Changes flattens the diff into a list of change events. Adding or removing a subject or course
+// produces a single event for that node, not one for each of its children. A course that was
+// removed and added again under the same number is reported as renamed.
+func (diff *UniversityDiff) Changes() (events []*ChangeEvent) {
+	path := []string{diff.New.TopicName}
+
+	for _, subject := range diff.Added {
+		events = append(events, &ChangeEvent{Type: SubjectAdded, TopicPath: withTopic(path, subject.TopicName), New: subject})
+	}
+
+	for _, subject := range diff.Removed {
+		events = append(events, &ChangeEvent{Type: SubjectRemoved, TopicPath: withTopic(path, subject.TopicName), Old: subject})
+	}
+
+	for _, subjectDiff := range diff.Modified {
+		events = append(events, subjectDiff.changes(withTopic(path, subjectDiff.New.TopicName))...)
+	}
+
+	return
+}
+
+func (diff *SubjectDiff) changes(path []string) (events []*ChangeEvent) {
+	added, removed, renamed := matchRenamedCourses(diff.Added, diff.Removed)
+
+	for _, course := range added {
+		events = append(events, &ChangeEvent{Type: CourseAdded, TopicPath: withTopic(path, course.TopicName), New: course})
+	}
+
+	for _, course := range removed {
+		events = append(events, &ChangeEvent{Type: CourseRemoved, TopicPath: withTopic(path, course.TopicName), Old: course})
+	}
+
+	for _, courseDiff := range renamed {
+		coursePath := withTopic(path, courseDiff.New.TopicName)
+		events = append(events, &ChangeEvent{Type: CourseRenamed, TopicPath: coursePath, Old: courseDiff.Old.Name, New: courseDiff.New.Name})
+		events = append(events, courseDiff.changes(coursePath)...)
+	}
+
+	for _, courseDiff := range diff.Modified {
+		events = append(events, courseDiff.changes(withTopic(path, courseDiff.New.TopicName))...)
+	}
+
+	return
+}
+
+func (diff *CourseDiff) changes(path []string) (events []*ChangeEvent) {
+	for _, section := range diff.Added {
+		events = append(events, &ChangeEvent{Type: SectionAdded, TopicPath: withTopic(path, section.TopicName), New: section})
+	}
+
+	for _, section := range diff.Removed {
+		events = append(events, &ChangeEvent{Type: SectionRemoved, TopicPath: withTopic(path, section.TopicName), Old: section})
+	}
+
+	for _, sectionDiff := range diff.Modified {
+		events = append(events, sectionDiff.changes(withTopic(path, sectionDiff.New.TopicName))...)
+	}
+
+	return
+}
+
+func (diff *SectionDiff) changes(path []string) (events []*ChangeEvent) {
+	oldSection, newSection := diff.Old, diff.New
+
+	if oldSection.Status != newSection.Status {
+		events = append(events, &ChangeEvent{Type: SectionStatusChanged, TopicPath: path, Old: oldSection.Status, New: newSection.Status})
+	}
+
+	if oldSection.Now != newSection.Now || oldSection.Max != newSection.Max {
+		events = append(events, &ChangeEvent{
+			Type:      SeatCountChanged,
+			TopicPath: path,
+			Old:       SeatCount{Now: oldSection.Now, Max: oldSection.Max},
+			New:       SeatCount{Now: newSection.Now, Max: newSection.Max},
+		})
+	}
+
+	if len(diff.AddedInstructors) > 0 || len(diff.RemovedInstructors) > 0 || len(diff.ModifiedInstructors) > 0 {
+		events = append(events, &ChangeEvent{Type: InstructorChanged, TopicPath: path, Old: instructorNames(oldSection), New: instructorNames(newSection)})
+	}
+
+	for _, meetingDiff := range diff.ModifiedMeetings {
+		if meetingDiff.Old.isMoved(meetingDiff.New) {
+			events = append(events, &ChangeEvent{Type: MeetingMoved, TopicPath: path, Old: meetingDiff.Old, New: meetingDiff.New})
+		}
+	}
+
+	for _, meeting := range diff.AddedMeetings {
+		events = append(events, &ChangeEvent{Type: MeetingAdded, TopicPath: path, New: meeting})
+	}
+
+	for _, meeting := range diff.RemovedMeetings {
+		events = append(events, &ChangeEvent{Type: MeetingRemoved, TopicPath: path, Old: meeting})
+	}
+
+	return
+}
+
+// A meeting has moved when its room or time changed, changes to its metadata are ignored.
+func (meeting *Meeting) isMoved(other *Meeting) bool {
+	return stringValue(meeting.Room) != stringValue(other.Room) ||
+		stringValue(meeting.Day) != stringValue(other.Day) ||
+		stringValue(meeting.StartTime) != stringValue(other.StartTime) ||
+		stringValue(meeting.EndTime) != stringValue(other.EndTime)
+}
+
+// Topic names of a course include its name, so a renamed course does not match its older self.
+// Courses in the same subject that share a number are paired up and their sections are
+// matched without the course topic name.
+func matchRenamedCourses(addedCourses, removedCourses []*Course) (added, removed []*Course, renamed []*CourseDiff) {
+	removedByNumber := map[string]*Course{}
+	for _, course := range removedCourses {
+		if removedByNumber[course.Number] == nil {
+			removedByNumber[course.Number] = course
+		}
+	}
+
+	for _, newCourse := range addedCourses {
+		if oldCourse, ok := removedByNumber[newCourse.Number]; ok && oldCourse.Name != newCourse.Name {
+			delete(removedByNumber, newCourse.Number)
+			renamed = append(renamed, diffCourseBy(oldCourse, newCourse, sectionNumberKey))
+		} else {
+			added = append(added, newCourse)
+		}
+	}
+
+	for _, course := range removedCourses {
+		if removedByNumber[course.Number] == course {
+			removed = append(removed, course)
+		}
+	}
+
+	return
+}
+
+func sectionNumberKey(section *Section) string {
+	return strings.Join([]string{section.Number, section.CallNumber}, ".")
+}
+
+func instructorNames(section *Section) (names []string) {
+	for _, instructor := range section.Instructors {
+		names = append(names, instructor.Name)
+	}
+	return
+}
+
+func withTopic(path []string, topicName string) []string {
+	topicPath := make([]string, len(path), len(path)+1)
+	copy(topicPath, path)
+	return append(topicPath, topicName)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
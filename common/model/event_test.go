@@ -0,0 +1,120 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func changeTypes(events []*ChangeEvent) (types []ChangeType) {
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return
+}
+
+func TestChangesUnchanged(t *testing.T) {
+	assert.Empty(t, Changes(diffTestUniversity(), diffTestUniversity()))
+}
+
+func TestChangesSection(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	oldUni.TopicName = "test"
+	newUni.TopicName = "test"
+
+	section := newUni.Subjects[0].Courses[0].Sections[0]
+	section.Status = "Closed"
+	section.Now = 5
+	section.Instructors = []*Instructor{{Name: "Jones"}}
+	room := "ARC-103"
+	section.Meetings[0].Room = &room
+
+	events := Changes(oldUni, newUni)
+
+	assert.Equal(t, []ChangeType{SectionStatusChanged, SeatCountChanged, InstructorChanged, MeetingMoved}, changeTypes(events))
+	assert.Equal(t, []string{"test", "math", "math.calc", "math.calc.01"}, events[0].TopicPath)
+	assert.Equal(t, "math.calc.01", events[0].TopicName())
+	assert.Equal(t, "Open", events[0].Old)
+	assert.Equal(t, "Closed", events[0].New)
+	assert.Equal(t, SeatCount{Now: 0}, events[1].Old)
+	assert.Equal(t, SeatCount{Now: 5}, events[1].New)
+	assert.Equal(t, []string{"Smith"}, events[2].Old)
+	assert.Equal(t, []string{"Jones"}, events[2].New)
+}
+
+func TestChangesMeetingMetadataIsNotMoved(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	classType := "LEC"
+	newUni.Subjects[0].Courses[0].Sections[0].Meetings[0].ClassType = &classType
+
+	assert.Empty(t, Changes(oldUni, newUni))
+}
+
+func TestChangesMeetingAddedAndRemoved(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	section := newUni.Subjects[0].Courses[0].Sections[0]
+	section.Meetings = append(section.Meetings, &Meeting{Room: proto.String("HLL-114"), Index: int32(len(section.Meetings))})
+
+	events := Changes(oldUni, newUni)
+	assert.Equal(t, []ChangeType{MeetingAdded}, changeTypes(events))
+	assert.Nil(t, events[0].Old)
+
+	events = Changes(newUni, oldUni)
+	assert.Equal(t, []ChangeType{MeetingRemoved}, changeTypes(events))
+	assert.Nil(t, events[0].New)
+}
+
+func TestChangeType_String(t *testing.T) {
+	assert.Equal(t, "meeting_removed", MeetingRemoved.String())
+	assert.Equal(t, "change_type(99)", ChangeType(99).String())
+	assert.Equal(t, "change_type(-1)", ChangeType(-1).String())
+}
+
+func TestChangesAddedAndRemoved(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	newUni.Subjects = newUni.Subjects[:1]
+	newUni.Subjects = append(newUni.Subjects, &Subject{TopicName: "biology"})
+	course := newUni.Subjects[0].Courses[0]
+	course.Sections = append(course.Sections[1:], diffTestSection("math.calc.03", "Open"))
+
+	events := Changes(oldUni, newUni)
+
+	assert.Equal(t, []ChangeType{SubjectAdded, SubjectRemoved, SectionAdded, SectionRemoved}, changeTypes(events))
+	assert.Equal(t, "biology", events[0].New.(*Subject).TopicName)
+	assert.Equal(t, "physics", events[1].Old.(*Subject).TopicName)
+	assert.Equal(t, "math.calc.03", events[2].TopicName())
+	assert.Equal(t, "math.calc.01", events[3].TopicName())
+}
+
+func TestChangesCourseRenamed(t *testing.T) {
+	oldUni := diffTestUniversity()
+	newUni := diffTestUniversity()
+	for _, uni := range []University{oldUni, newUni} {
+		course := uni.Subjects[0].Courses[0]
+		course.Number = "151"
+		course.Name = "Calculus"
+		for i, section := range course.Sections {
+			section.Number = string('1' + rune(i))
+		}
+	}
+
+	course := newUni.Subjects[0].Courses[0]
+	course.Name = "Calculus I"
+	course.TopicName = "math.calc_i"
+	for _, section := range course.Sections {
+		section.TopicName = "math.calc_i." + section.Number
+	}
+	course.Sections[1].Status = "Closed"
+
+	events := Changes(oldUni, newUni)
+
+	assert.Equal(t, []ChangeType{CourseRenamed, SectionStatusChanged}, changeTypes(events))
+	assert.Equal(t, "Calculus", events[0].Old)
+	assert.Equal(t, "Calculus I", events[0].New)
+	assert.Equal(t, []string{"", "math", "math.calc_i", "math.calc_i.2"}, events[1].TopicPath)
+}
@@ -0,0 +1,200 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// contentHash hashes the fields of an entity, marshaled without its database ids and children, together with
+// the hashes of its children. Children are hashed by kind in sorted order, so the hash does not depend on the
+// order a scraper listed them in.
+func contentHash(entity proto.Message, children map[string][]string) (string, error) {
+	data, err := proto.Marshal(entity)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write(data)
+
+	kinds := make([]string, 0, len(children))
+	for kind := range children {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	for _, kind := range kinds {
+		hashes := append([]string(nil), children[kind]...)
+		sort.Strings(hashes)
+		for _, hash := range hashes {
+			h.Write([]byte(kind))
+			h.Write([]byte(hash))
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// childHashes collects the hashes of children of a kind, stopping at the first child that fails to hash.
+type childHashes map[string][]string
+
+func (children childHashes) add(kind string, hash func() (string, error)) error {
+	h, err := hash()
+	if err != nil {
+		return err
+	}
+	children[kind] = append(children[kind], h)
+	return nil
+}
+
+func (children childHashes) addMetadata(metadata []*Metadata) error {
+	for _, m := range metadata {
+		if err := children.add("metadata", m.Hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Hash returns the content hash of the metadata.
+func (metadata *Metadata) Hash() (string, error) {
+	content := Metadata{Title: metadata.Title, Content: metadata.Content}
+	return contentHash(&content, nil)
+}
+
+// Hash returns the content hash of the instructor, independent of its position in the section.
+func (instructor *Instructor) Hash() (string, error) {
+	content := Instructor{Name: instructor.Name, TopicName: instructor.TopicName}
+	return contentHash(&content, nil)
+}
+
+// Hash returns the content hash of the book.
+func (book *Book) Hash() (string, error) {
+	content := Book{Title: book.Title, Url: book.Url}
+	return contentHash(&content, nil)
+}
+
+// Hash returns the content hash of the meeting and its metadata, independent of its position in the section.
+func (meeting *Meeting) Hash() (string, error) {
+	content := *meeting
+	content.Id, content.SectionId, content.Index, content.ContentHash = 0, 0, 0, ""
+	content.Metadata = nil
+
+	children := childHashes{}
+	if err := children.addMetadata(meeting.Metadata); err != nil {
+		return "", err
+	}
+	return contentHash(&content, children)
+}
+
+// Hash returns the content hash of the section and everything in it.
+func (section *Section) Hash() (string, error) {
+	content := *section
+	content.Id, content.CourseId, content.ContentHash = 0, 0, ""
+	content.Meetings, content.Instructors, content.Books, content.Metadata = nil, nil, nil, nil
+
+	children := childHashes{}
+	if err := children.addMetadata(section.Metadata); err != nil {
+		return "", err
+	}
+	for _, meeting := range section.Meetings {
+		if err := children.add("meeting", meeting.Hash); err != nil {
+			return "", err
+		}
+	}
+	for _, instructor := range section.Instructors {
+		if err := children.add("instructor", instructor.Hash); err != nil {
+			return "", err
+		}
+	}
+	for _, book := range section.Books {
+		if err := children.add("book", book.Hash); err != nil {
+			return "", err
+		}
+	}
+	return contentHash(&content, children)
+}
+
+// Hash returns the content hash of the course and everything in it.
+func (course *Course) Hash() (string, error) {
+	content := *course
+	content.Id, content.SubjectId, content.ContentHash = 0, 0, ""
+	content.Sections, content.Metadata = nil, nil
+
+	children := childHashes{}
+	if err := children.addMetadata(course.Metadata); err != nil {
+		return "", err
+	}
+	for _, section := range course.Sections {
+		if err := children.add("section", section.Hash); err != nil {
+			return "", err
+		}
+	}
+	return contentHash(&content, children)
+}
+
+// Hash returns the content hash of the subject and everything in it.
+func (subject *Subject) Hash() (string, error) {
+	content := *subject
+	content.Id, content.UniversityId, content.ContentHash = 0, 0, ""
+	content.Courses, content.Metadata = nil, nil
+
+	children := childHashes{}
+	if err := children.addMetadata(subject.Metadata); err != nil {
+		return "", err
+	}
+	for _, course := range subject.Courses {
+		if err := children.add("course", course.Hash); err != nil {
+			return "", err
+		}
+	}
+	return contentHash(&content, children)
+}
+
+// SetContentHashes sets the content hash of every subject, course, section and meeting of the university. It
+// stops at the first entity that fails to hash and returns the error along with the path of the entity.
+func SetContentHashes(university *University) error {
+	set := func(path string, contentHash *string, hash func() (string, error)) error {
+		h, err := hash()
+		if err != nil {
+			return ruleError("content_hash", "", "%s: %s", path, err)
+		}
+		*contentHash = h
+		return nil
+	}
+
+	for _, subject := range university.Subjects {
+		for _, course := range subject.Courses {
+			for _, section := range course.Sections {
+				path := sectionPath(subject, course, section)
+				for meetingIndex, meeting := range section.Meetings {
+					if err := set(path+"/meeting:"+strconv.Itoa(meetingIndex), &meeting.ContentHash, meeting.Hash); err != nil {
+						return err
+					}
+				}
+				if err := set(path, &section.ContentHash, section.Hash); err != nil {
+					return err
+				}
+			}
+			if err := set(coursePath(subject, course), &course.ContentHash, course.Hash); err != nil {
+				return err
+			}
+		}
+		if err := set(subjectPath(subject), &subject.ContentHash, subject.Hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changed compares the content hashes of two entities, or the entities themselves when either was not hashed.
+func changed(oldHash, newHash string, equal func() bool) bool {
+	if oldHash != "" && newHash != "" {
+		return oldHash != newHash
+	}
+	return !equal()
+}
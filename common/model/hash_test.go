@@ -0,0 +1,105 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func hashSection() *Section {
+	return &Section{
+		Number: "01", CallNumber: "12345", Status: Open.String(), Max: 30, Now: 10, Credits: "3.0",
+		Meetings: []*Meeting{
+			{Day: proto.String("Monday"), StartTime: proto.String("10:20 AM"), EndTime: proto.String("11:40 AM"), Index: 0},
+			{Day: proto.String("Wednesday"), StartTime: proto.String("10:20 AM"), EndTime: proto.String("11:40 AM"), Index: 1},
+		},
+		Instructors: []*Instructor{{Name: "Smith, John"}, {Name: "Doe, Jane"}},
+		Metadata:    []*Metadata{{Title: "Notes", Content: "Hybrid"}},
+	}
+}
+
+func mustHash(t *testing.T, hash func() (string, error)) string {
+	h, err := hash()
+	assert.NoError(t, err)
+	return h
+}
+
+func TestSection_Hash(t *testing.T) {
+	section := hashSection()
+	hash := mustHash(t, section.Hash)
+	assert.Len(t, hash, 64)
+
+	// Database ids and the order of children are not content
+	reordered := hashSection()
+	reordered.Id, reordered.CourseId = 10, 20
+	reordered.Meetings[0], reordered.Meetings[1] = reordered.Meetings[1], reordered.Meetings[0]
+	reordered.Meetings[0].Index, reordered.Meetings[1].Index = 0, 1
+	reordered.Instructors[0], reordered.Instructors[1] = reordered.Instructors[1], reordered.Instructors[0]
+	assert.Equal(t, hash, mustHash(t, reordered.Hash))
+
+	changed := hashSection()
+	changed.Now = 11
+	assert.NotEqual(t, hash, mustHash(t, changed.Hash))
+
+	changed = hashSection()
+	changed.Meetings[1].Day = proto.String("Thursday")
+	assert.NotEqual(t, hash, mustHash(t, changed.Hash))
+
+	// A set content hash is not content
+	section.ContentHash = hash
+	assert.Equal(t, hash, mustHash(t, section.Hash))
+}
+
+func TestSetContentHashes(t *testing.T) {
+	university := &University{Subjects: []*Subject{
+		{Name: "Mathematics", Number: "640", Season: Fall, Year: "2017", Courses: []*Course{
+			{Name: "Calculus I", Number: "151", Sections: []*Section{hashSection()}},
+		}},
+	}}
+	assert.NoError(t, SetContentHashes(university))
+
+	subject := university.Subjects[0]
+	course := subject.Courses[0]
+	section := course.Sections[0]
+	assert.Equal(t, mustHash(t, subject.Hash), subject.ContentHash)
+	assert.Equal(t, mustHash(t, course.Hash), course.ContentHash)
+	assert.Equal(t, mustHash(t, section.Hash), section.ContentHash)
+	assert.Equal(t, mustHash(t, section.Meetings[0].Hash), section.Meetings[0].ContentHash)
+
+	old := subject.ContentHash
+	section.Status = Closed.String()
+	assert.NoError(t, SetContentHashes(university))
+	assert.NotEqual(t, old, subject.ContentHash)
+}
+
+func TestUniversityDiff_Subtrees(t *testing.T) {
+	university := func() University {
+		u := University{Subjects: []*Subject{
+			{Name: "Mathematics", Number: "640", Season: Fall, Year: "2017", Courses: []*Course{
+				{Name: "Calculus I", Number: "151", Sections: []*Section{hashSection()}},
+				{Name: "Calculus II", Number: "152"},
+			}},
+		}}
+		assert.NoError(t, SetContentHashes(&u))
+		return u
+	}
+
+	oldUniversity, newUniversity := university(), university()
+	newUniversity.Subjects[0].Courses[0].Sections[0].Status = Closed.String()
+	newUniversity.Subjects[0].Courses = newUniversity.Subjects[0].Courses[:1]
+	assert.NoError(t, SetContentHashes(&newUniversity))
+
+	subtrees := DiffUniversity(oldUniversity, newUniversity).Subtrees()
+	if assert.Len(t, subtrees, 4) {
+		assert.Equal(t, Subtree{Kind: "subject", Change: "modified", Key: "640.Mathematics.fall.2017",
+			OldHash: oldUniversity.Subjects[0].ContentHash, NewHash: newUniversity.Subjects[0].ContentHash}, subtrees[0])
+		assert.Equal(t, "152.Calculus II", subtrees[1].Key)
+		assert.Equal(t, "removed", subtrees[1].Change)
+		assert.Equal(t, "course", subtrees[2].Kind)
+		assert.Equal(t, "section", subtrees[3].Kind)
+		assert.Equal(t, "modified", subtrees[3].Change)
+	}
+
+	assert.Empty(t, DiffUniversity(oldUniversity, university()).Subtrees())
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"strings"
+)
+
+// Names registrars use in place of an instructor that has not been assigned
+var placeholderInstructors = map[string]bool{
+	"STAFF":           true,
+	"TBA":             true,
+	"TBD":             true,
+	"TO BE ANNOUNCED": true,
+}
+
+// NormalizeInstructorName normalizes the case, spacing and punctuation of an instructor's name so the same
+// person is recognized across sections, e.g "Smith,  John A." becomes "SMITH, JOHN A". Returns an empty
+// string for placeholder names such as STAFF.
+func NormalizeInstructorName(name string) string {
+	name = strings.ToUpper(strings.Replace(name, ".", " ", -1))
+
+	var parts []string
+	for _, part := range strings.Split(name, ",") {
+		if part = strings.Join(strings.Fields(part), " "); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	name = strings.Join(parts, ", ")
+
+	if placeholderInstructors[name] {
+		return ""
+	}
+	return name
+}
+
+// InstructorTopicName returns the topic name that identifies an instructor of the university, or an empty
+// string for placeholder names.
+func InstructorTopicName(university *University, name string) string {
+	normalized := NormalizeInstructorName(name)
+	if normalized == "" {
+		return ""
+	}
+	return ToTopicName(university.TopicName + "." + normalized)
+}
+
+// resolveInstructors sets the topic name of every instructor of the university.
+func resolveInstructors(university *University) {
+	for _, subject := range university.Subjects {
+		for _, course := range subject.Courses {
+			for _, section := range course.Sections {
+				for _, instructor := range section.Instructors {
+					instructor.TopicName = InstructorTopicName(university, instructor.Name)
+				}
+			}
+		}
+	}
+}
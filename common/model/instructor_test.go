@@ -0,0 +1,41 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeInstructorName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"SMITH, JOHN", "SMITH, JOHN"},
+		{"Smith,  John A.", "SMITH, JOHN A"},
+		{" smith ,john a ", "SMITH, JOHN A"},
+		{"Jane Doe", "JANE DOE"},
+		{"Staff", ""},
+		{"TBA", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, NormalizeInstructorName(tt.name), tt.name)
+	}
+}
+
+func TestResolveInstructors(t *testing.T) {
+	first := &Instructor{Name: "SMITH, JOHN"}
+	second := &Instructor{Name: "Smith, John"}
+	staff := &Instructor{Name: "STAFF"}
+
+	university := &University{TopicName: "rutgers.universitynew.brunswick", Subjects: []*Subject{
+		{Courses: []*Course{{Sections: []*Section{{Instructors: []*Instructor{first, staff}}, {Instructors: []*Instructor{second}}}}}},
+	}}
+
+	resolveInstructors(university)
+
+	assert.Equal(t, "rutgers.universitynew.brunswick.smith.john", first.TopicName)
+	assert.Equal(t, first.TopicName, second.TopicName)
+	assert.Equal(t, "", staff.TopicName)
+}
@@ -0,0 +1,154 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// Interval is the time a meeting occupies in a week. Start and End are minutes since midnight.
+type Interval struct {
+	Day   time.Weekday
+	Start int
+	End   int
+}
+
+func init() {
+	// The generated code registers enums with golang/protobuf, but jsonpb resolves them from gogo/protobuf
+	proto.RegisterEnum("model.Weekday", Weekday_name, Weekday_value)
+}
+
+var clockLayouts = []string{"3:04 PM", "3:04PM", "15:04", "15:04:05"}
+
+// ParseClock parses a meeting time such as "10:20 AM" or "14:00" into minutes since midnight.
+func ParseClock(clock string) (int, bool) {
+	clock = strings.ToUpper(strings.TrimSpace(clock))
+	for _, layout := range clockLayouts {
+		if t, err := time.Parse(layout, clock); err == nil {
+			return t.Hour()*60 + t.Minute(), true
+		}
+	}
+	return 0, false
+}
+
+// FormatClock formats minutes since midnight as a meeting time, e.g "10:20 AM".
+func FormatClock(minutes int) string {
+	return time.Date(0, 1, 1, 0, minutes, 0, 0, time.UTC).Format("3:04 PM")
+}
+
+// ParseWeekday parses the full or three letter name of a day of the week, ignoring case.
+func ParseWeekday(day string) (time.Weekday, bool) {
+	day = strings.TrimSpace(day)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(day, d.String()) || strings.EqualFold(day, d.String()[:3]) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// Interval returns the time the meeting occupies. Meetings without a day, start and end time,
+// such as online or by arrangement meetings, have no interval. The typed fields set during validation
+// are preferred over the day and time strings.
+func (meeting *Meeting) Interval() (Interval, bool) {
+	if meeting.Weekday != nil && meeting.StartMinute != nil && meeting.Duration != nil {
+		start := int(*meeting.StartMinute)
+		return Interval{Day: time.Weekday(*meeting.Weekday), Start: start, End: start + int(*meeting.Duration)}, true
+	}
+
+	if meeting.Day == nil || meeting.StartTime == nil || meeting.EndTime == nil {
+		return Interval{}, false
+	}
+
+	day, ok := ParseWeekday(*meeting.Day)
+	if !ok {
+		return Interval{}, false
+	}
+
+	start, ok := ParseClock(*meeting.StartTime)
+	if !ok {
+		return Interval{}, false
+	}
+
+	end, ok := ParseClock(*meeting.EndTime)
+	if !ok || end <= start {
+		return Interval{}, false
+	}
+
+	return Interval{Day: day, Start: start, End: end}, true
+}
+
+// normalizeTimes parses the day, start and end time of the meeting into its typed fields and rewrites
+// the strings in their canonical form, e.g "Monday" and "10:20 AM".
+func (meeting *Meeting) normalizeTimes() error {
+	meeting.Weekday, meeting.StartMinute, meeting.Duration = nil, nil, nil
+
+	if meeting.Day != nil {
+		day, ok := ParseWeekday(*meeting.Day)
+		if !ok {
+			return ruleError("meeting.day", "day", "Meeting day %q is not a weekday", *meeting.Day)
+		}
+		canonical := day.String()
+		meeting.Day = &canonical
+		meeting.Weekday = Weekday(day).Enum()
+	}
+
+	if meeting.StartTime == nil && meeting.EndTime == nil {
+		return nil
+	} else if meeting.StartTime == nil || meeting.EndTime == nil {
+		return ruleError("meeting.time", "start_time", "Meeting must have both a start and end time")
+	}
+
+	start, ok := ParseClock(*meeting.StartTime)
+	if !ok {
+		return ruleError("meeting.time", "start_time", "Meeting start time %q can not be parsed", *meeting.StartTime)
+	}
+
+	end, ok := ParseClock(*meeting.EndTime)
+	if !ok {
+		return ruleError("meeting.time", "end_time", "Meeting end time %q can not be parsed", *meeting.EndTime)
+	}
+
+	duration := end - start
+	if duration == 0 {
+		return ruleError("meeting.time", "end_time", "Meeting starts and ends at %s", *meeting.StartTime)
+	} else if duration < 0 {
+		// Ends after midnight
+		duration += 24 * 60
+	}
+
+	startTime, endTime := FormatClock(start), FormatClock(end)
+	meeting.StartTime, meeting.EndTime = &startTime, &endTime
+	meeting.StartMinute = proto.Int32(int32(start))
+	meeting.Duration = proto.Int32(int32(duration))
+
+	return nil
+}
+
+// Intervals returns the intervals of every scheduled meeting of the section.
+func (section *Section) Intervals() (intervals []Interval) {
+	for _, meeting := range section.Meetings {
+		if interval, ok := meeting.Interval(); ok {
+			intervals = append(intervals, interval)
+		}
+	}
+	return
+}
+
+// Overlaps reports whether both intervals are on the same day and share any time.
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Day == other.Day && i.Start < other.End && other.Start < i.End
+}
+
+// Gap returns the minutes between two intervals on the same day, or -1 when they are on different days
+// or overlap.
+func (i Interval) Gap(other Interval) int {
+	if i.Day != other.Day || i.Overlaps(other) {
+		return -1
+	}
+	if i.End <= other.Start {
+		return other.Start - i.End
+	}
+	return i.Start - other.End
+}
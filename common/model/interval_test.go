@@ -0,0 +1,103 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		clock   string
+		minutes int
+		ok      bool
+	}{
+		{"10:20 AM", 620, true},
+		{"12:00 PM", 720, true},
+		{"12:30 AM", 30, true},
+		{"1:40 PM", 820, true},
+		{"1:40pm", 820, true},
+		{"14:00", 840, true},
+		{"14:00:00", 840, true},
+		{"", 0, false},
+		{"TBA", 0, false},
+	}
+	for _, tt := range tests {
+		minutes, ok := ParseClock(tt.clock)
+		assert.Equal(t, tt.ok, ok, tt.clock)
+		assert.Equal(t, tt.minutes, minutes, tt.clock)
+	}
+}
+
+func TestMeeting_Interval(t *testing.T) {
+	day, start, end, empty := "Thursday", "10:20 AM", "11:40 AM", ""
+
+	interval, ok := (&Meeting{Day: &day, StartTime: &start, EndTime: &end}).Interval()
+	assert.True(t, ok)
+	assert.Equal(t, Interval{Day: time.Thursday, Start: 620, End: 700}, interval)
+
+	_, ok = (&Meeting{Day: &empty, StartTime: &start, EndTime: &end}).Interval()
+	assert.False(t, ok)
+
+	_, ok = (&Meeting{Day: &day, StartTime: &end, EndTime: &start}).Interval()
+	assert.False(t, ok)
+
+	_, ok = (&Meeting{}).Interval()
+	assert.False(t, ok)
+}
+
+func TestInterval_Gap(t *testing.T) {
+	morning := Interval{Day: time.Monday, Start: 600, End: 680}
+	noon := Interval{Day: time.Monday, Start: 720, End: 800}
+	overlapping := Interval{Day: time.Monday, Start: 660, End: 740}
+	tuesday := Interval{Day: time.Tuesday, Start: 600, End: 680}
+
+	assert.False(t, morning.Overlaps(noon))
+	assert.True(t, morning.Overlaps(overlapping))
+	assert.False(t, morning.Overlaps(tuesday))
+
+	assert.Equal(t, 40, morning.Gap(noon))
+	assert.Equal(t, 40, noon.Gap(morning))
+	assert.Equal(t, -1, morning.Gap(overlapping))
+	assert.Equal(t, -1, morning.Gap(tuesday))
+}
+
+func TestMeeting_Validate(t *testing.T) {
+	day, start, end := " thursday", "6:10PM", "9:00 pm"
+	meeting := &Meeting{Day: &day, StartTime: &start, EndTime: &end}
+
+	assert.NoError(t, meeting.Validate())
+	assert.Equal(t, "Thursday", *meeting.Day)
+	assert.Equal(t, "6:10 PM", *meeting.StartTime)
+	assert.Equal(t, "9:00 PM", *meeting.EndTime)
+	assert.Equal(t, Weekday_THURSDAY, meeting.GetWeekday())
+	assert.Equal(t, int32(1090), meeting.GetStartMinute())
+	assert.Equal(t, int32(170), meeting.GetDuration())
+
+	interval, ok := meeting.Interval()
+	assert.True(t, ok)
+	assert.Equal(t, Interval{Day: time.Thursday, Start: 1090, End: 1260}, interval)
+
+	late, midnight := "11:00 PM", "12:30 AM"
+	meeting = &Meeting{Day: &day, StartTime: &late, EndTime: &midnight}
+	assert.NoError(t, meeting.Validate())
+	assert.Equal(t, int32(90), meeting.GetDuration())
+
+	unscheduled, online := "", "Online"
+	meeting = &Meeting{Day: &unscheduled, StartTime: &unscheduled, EndTime: &unscheduled, ClassType: &online}
+	assert.NoError(t, meeting.Validate())
+	assert.Nil(t, meeting.Day)
+	assert.Nil(t, meeting.Weekday)
+	assert.Nil(t, meeting.StartMinute)
+
+	for _, invalid := range []*Meeting{
+		{Day: proto.String("Someday")},
+		{Day: &day, StartTime: &start},
+		{Day: &day, StartTime: proto.String("TBA"), EndTime: &end},
+		{Day: &day, StartTime: &start, EndTime: &start},
+	} {
+		assert.Error(t, invalid.Validate())
+	}
+}
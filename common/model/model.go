@@ -1,9 +1,8 @@
 package model
 
 import (
+	"fmt"
 	"time"
-
-	log "github.com/Sirupsen/logrus"
 )
 
 type (
@@ -11,22 +10,33 @@ type (
 	Status int
 
 	DBResolvedSemester struct {
-		Id            int64  `db:"id"`
-		UniversityId  int64  `db:"university_id"`
-		CurrentSeason string `db:"current_season"`
-		CurrentYear   string `db:"current_year"`
-		LastSeason    string `db:"last_season"`
-		LastYear      string `db:"last_year"`
-		NextSeason    string `db:"next_season"`
-		NextYear      string `db:"next_year"`
+		Id              int64  `db:"id"`
+		UniversityId    int64  `db:"university_id"`
+		CurrentSeason   string `db:"current_season"`
+		CurrentYear     string `db:"current_year"`
+		CurrentTermCode int32  `db:"current_term_code"`
+		CurrentTermName string `db:"current_term_name"`
+		LastSeason      string `db:"last_season"`
+		LastYear        string `db:"last_year"`
+		LastTermCode    int32  `db:"last_term_code"`
+		LastTermName    string `db:"last_term_name"`
+		NextSeason      string `db:"next_season"`
+		NextYear        string `db:"next_year"`
+		NextTermCode    int32  `db:"next_term_code"`
+		NextTermName    string `db:"next_term_name"`
 	}
 )
 
 const (
 	Protobuf = "protobuf"
 	Json     = "json"
+	// Stream is a university header followed by its subjects, each a length-delimited protobuf record
+	Stream = "stream"
 )
 
+// DefaultTimeZone is assumed for universities that do not declare a time zone
+const DefaultTimeZone = "America/New_York"
+
 const (
 	InFall Period = iota
 	InSpring
@@ -68,6 +78,12 @@ const (
 	Winter = "winter"
 )
 
+// Types of notifications
+const (
+	StatusNotification   = "status"
+	WaitlistNotification = "waitlist"
+)
+
 const (
 	Open Status = 1 + iota
 	Closed
@@ -90,96 +106,58 @@ func (r Registration) day() int {
 	return time.Unix(r.PeriodDate, 0).UTC().Day()
 }
 
-func (r Registration) dayOfYear() int {
-	return time.Unix(r.PeriodDate, 0).UTC().YearDay()
+// The period names of a season follow the legacy periods, e.g fall, start_fall and end_fall, so that a registered
+// term type such as summer_1 has the periods summer_1, start_summer_1 and end_summer_1.
+func inSessionPeriod(season string) string {
+	return season
 }
 
-func (r Registration) season() string {
-	switch r.Period {
-	case InFall.String():
-		return Fall
-	case InSpring.String():
-		return Spring
-	case InSummer.String():
-		return Summer
-	case InWinter.String():
-		return Winter
-	default:
-		return Summer
-	}
+func registrationOpensPeriod(season string) string {
+	return "start_" + season
 }
 
-func ResolveSemesters(t time.Time, registration []*Registration) *ResolvedSemester {
-	month := t.Month()
-	day := t.Day()
-	year := t.Year()
-
-	yearDay := t.YearDay()
-
-	//var springReg = registration[SEM_SPRING];
-	var winterReg = registration[InWinter]
-	//var summerReg = registration[SEM_SUMMER];
-	//var fallReg  = registration[SEM_FALL];
-	var startFallReg = registration[StartFall]
-	var startSpringReg = registration[StartSpring]
-	var endSummerReg = registration[EndSummer]
-	//var startSummerReg  = registration[START_SUMMER];
-
-	fall := &Semester{
-		Year:   int32(year),
-		Season: Fall}
-
-	winter := &Semester{
-		Year:   int32(year),
-		Season: Winter}
-
-	spring := &Semester{
-		Year:   int32(year),
-		Season: Spring}
-
-	summer := &Semester{
-		Year:   int32(year),
-		Season: Summer}
-
-	// Spring: Winter - StartFall
-	log.Println(winterReg.month(), winterReg.day(), startFallReg.month(), startFallReg.day(), month, day)
-	if yearDay >= winterReg.dayOfYear() || yearDay < startFallReg.dayOfYear() {
-		if winterReg.month()-month <= 0 {
-			spring.Year = spring.Year + 1
-			summer.Year = summer.Year + 1
-		} else {
-			winter.Year = winter.Year - 1
-			fall.Year = fall.Year - 1
-		}
-		log.Debugln("Spring: Winter - StartFall ", winterReg.month(), winterReg.day(), "--", startFallReg.month(), startFallReg.day(), "--", month, day)
-		return &ResolvedSemester{
-			Last:    winter,
-			Current: spring,
-			Next:    summer}
-
-	} else if yearDay >= startFallReg.dayOfYear() && yearDay < endSummerReg.dayOfYear() {
-		log.Debugln("StartFall: StartFall -- EndSummer ", startFallReg.dayOfYear(), "--", endSummerReg.dayOfYear(), "--", yearDay)
-		return &ResolvedSemester{
-			Last:    spring,
-			Current: summer,
-			Next:    fall,
-		}
-	} else if yearDay >= endSummerReg.dayOfYear() && yearDay < startSpringReg.dayOfYear() {
-		log.Debugln("Fall: EndSummer -- StartSpring ", endSummerReg.dayOfYear(), "--", yearDay < startSpringReg.dayOfYear(), "--", yearDay)
-		return &ResolvedSemester{
-			Last:    summer,
-			Current: fall,
-			Next:    winter,
-		}
-	} else if yearDay >= startSpringReg.dayOfYear() && yearDay < winterReg.dayOfYear() {
-		spring.Year = spring.Year + 1
-		log.Debugln("StartSpring: StartSpring -- Winter ", startSpringReg.dayOfYear(), "--", winterReg.dayOfYear(), "--", yearDay)
-		return &ResolvedSemester{
-			Last:    fall,
-			Current: winter,
-			Next:    spring,
+func sessionEndsPeriod(season string) string {
+	return "end_" + season
+}
+
+// The season in session after each of the legacy seasons
+var followingSession = map[string]Period{Fall: InWinter, Winter: InSpring, Spring: InSummer, Summer: InFall}
+
+// SemesterDates returns the first and last day of classes of a semester. A semester begins on the day its
+// season is in session and ends on the day its session ends. Universities that only record the day each
+// season is in session end a legacy season the day before the following season is in session.
+func SemesterDates(registrations []*Registration, semester Semester) (start, end time.Time, err error) {
+	find := func(period string) *Registration {
+		for _, registration := range registrations {
+			if registration.Period == period {
+				return registration
+			}
 		}
+		return nil
+	}
+
+	first := find(inSessionPeriod(semester.Season))
+	if first == nil {
+		return start, end, fmt.Errorf("no registration dates for %s %d", semester.Season, semester.Year)
+	}
+	start = time.Date(int(semester.Year), first.month(), first.day(), 0, 0, 0, 0, time.UTC)
+
+	var following *Registration
+	if period, ok := followingSession[semester.Season]; ok {
+		following = find(period.String())
+	}
+
+	if last := find(sessionEndsPeriod(semester.Season)); last != nil {
+		end = time.Date(int(semester.Year), last.month(), last.day(), 0, 0, 0, 0, time.UTC)
+	} else if following != nil {
+		end = time.Date(int(semester.Year), following.month(), following.day()-1, 0, 0, 0, 0, time.UTC)
+	} else {
+		return start, end, fmt.Errorf("no end of session for %s %d", semester.Season, semester.Year)
+	}
+
+	if end.Before(start) {
+		end = end.AddDate(1, 0, 0)
 	}
 
-	return &ResolvedSemester{}
+	return start, end, nil
 }
@@ -5,6 +5,7 @@ package model
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -27,24 +28,84 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Values match time.Weekday
+type Weekday int32
+
+const (
+	Weekday_SUNDAY    Weekday = 0
+	Weekday_MONDAY    Weekday = 1
+	Weekday_TUESDAY   Weekday = 2
+	Weekday_WEDNESDAY Weekday = 3
+	Weekday_THURSDAY  Weekday = 4
+	Weekday_FRIDAY    Weekday = 5
+	Weekday_SATURDAY  Weekday = 6
+)
+
+var Weekday_name = map[int32]string{
+	0: "SUNDAY",
+	1: "MONDAY",
+	2: "TUESDAY",
+	3: "WEDNESDAY",
+	4: "THURSDAY",
+	5: "FRIDAY",
+	6: "SATURDAY",
+}
+
+var Weekday_value = map[string]int32{
+	"SUNDAY":    0,
+	"MONDAY":    1,
+	"TUESDAY":   2,
+	"WEDNESDAY": 3,
+	"THURSDAY":  4,
+	"FRIDAY":    5,
+	"SATURDAY":  6,
+}
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return proto.EnumName(Weekday_name, int32(x))
+}
+
+func (x *Weekday) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(Weekday_value, data, "Weekday")
+	if err != nil {
+		return err
+	}
+	*x = Weekday(value)
+	return nil
+}
+
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{0}
+}
+
 type University struct {
-	Id                   int64             `protobuf:"varint,1,opt,name=id" json:"id" db:"id"`
-	Name                 string            `protobuf:"bytes,2,opt,name=name" json:"name" db:"name"`
-	Abbr                 string            `protobuf:"bytes,3,opt,name=abbr" json:"abbr" db:"abbr"`
-	HomePage             string            `protobuf:"bytes,4,opt,name=home_page,json=homePage" json:"home_page" db:"home_page"`
-	RegistrationPage     string            `protobuf:"bytes,5,opt,name=registration_page,json=registrationPage" json:"registration_page" db:"registration_page"`
-	MainColor            string            `protobuf:"bytes,6,opt,name=main_color,json=mainColor" json:"main_color" db:"main_color"`
-	AccentColor          string            `protobuf:"bytes,7,opt,name=accent_color,json=accentColor" json:"accent_color" db:"accent_color"`
-	TopicName            string            `protobuf:"bytes,8,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
-	TopicId              string            `protobuf:"bytes,9,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
-	ResolvedSemesters    *ResolvedSemester `protobuf:"bytes,10,opt,name=resolved_semesters,json=resolvedSemesters" json:"resolved_semesters,omitempty"`
-	Subjects             []*Subject        `protobuf:"bytes,11,rep,name=subjects" json:"subjects,omitempty"`
-	AvailableSemesters   []*Semester       `protobuf:"bytes,12,rep,name=available_semesters,json=availableSemesters" json:"available_semesters,omitempty"`
-	Registrations        []*Registration   `protobuf:"bytes,13,rep,name=registrations" json:"registrations,omitempty"`
-	Metadata             []*Metadata       `protobuf:"bytes,14,rep,name=metadata" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id                 int64             `protobuf:"varint,1,opt,name=id" json:"id" db:"id"`
+	Name               string            `protobuf:"bytes,2,opt,name=name" json:"name" db:"name"`
+	Abbr               string            `protobuf:"bytes,3,opt,name=abbr" json:"abbr" db:"abbr"`
+	HomePage           string            `protobuf:"bytes,4,opt,name=home_page,json=homePage" json:"home_page" db:"home_page"`
+	RegistrationPage   string            `protobuf:"bytes,5,opt,name=registration_page,json=registrationPage" json:"registration_page" db:"registration_page"`
+	MainColor          string            `protobuf:"bytes,6,opt,name=main_color,json=mainColor" json:"main_color" db:"main_color"`
+	AccentColor        string            `protobuf:"bytes,7,opt,name=accent_color,json=accentColor" json:"accent_color" db:"accent_color"`
+	TopicName          string            `protobuf:"bytes,8,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
+	TopicId            string            `protobuf:"bytes,9,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
+	ResolvedSemesters  *ResolvedSemester `protobuf:"bytes,10,opt,name=resolved_semesters,json=resolvedSemesters" json:"resolved_semesters,omitempty"`
+	Subjects           []*Subject        `protobuf:"bytes,11,rep,name=subjects" json:"subjects,omitempty"`
+	AvailableSemesters []*Semester       `protobuf:"bytes,12,rep,name=available_semesters,json=availableSemesters" json:"available_semesters,omitempty"`
+	Registrations      []*Registration   `protobuf:"bytes,13,rep,name=registrations" json:"registrations,omitempty"`
+	Metadata           []*Metadata       `protobuf:"bytes,14,rep,name=metadata" json:"metadata,omitempty"`
+	// IANA time zone that meeting times are given in, e.g America/New_York
+	TimeZone string `protobuf:"bytes,15,opt,name=time_zone,json=timeZone" json:"time_zone" db:"time_zone"`
+	// Catalog of the buildings that rooms are split against
+	Buildings            []*Building `protobuf:"bytes,16,rep,name=buildings" json:"buildings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *University) Reset()      { *m = University{} }
@@ -177,20 +238,39 @@ func (m *University) GetMetadata() []*Metadata {
 	return nil
 }
 
+func (m *University) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *University) GetBuildings() []*Building {
+	if m != nil {
+		return m.Buildings
+	}
+	return nil
+}
+
 type Subject struct {
-	Id                   int64       `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
-	UniversityId         int64       `protobuf:"varint,2,opt,name=university_id,json=universityId" json:"-" db:"university_id"`
-	Name                 string      `protobuf:"bytes,3,opt,name=name" json:"name" db:"name"`
-	Number               string      `protobuf:"bytes,4,opt,name=number" json:"number" db:"number"`
-	Season               string      `protobuf:"bytes,5,opt,name=season" json:"season" db:"season"`
-	Year                 string      `protobuf:"bytes,6,opt,name=year" json:"year" db:"year"`
-	TopicName            string      `protobuf:"bytes,7,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
-	TopicId              string      `protobuf:"bytes,8,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
-	Courses              []*Course   `protobuf:"bytes,9,rep,name=courses" json:"courses,omitempty"`
-	Metadata             []*Metadata `protobuf:"bytes,10,rep,name=metadata" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Id           int64       `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
+	UniversityId int64       `protobuf:"varint,2,opt,name=university_id,json=universityId" json:"-" db:"university_id"`
+	Name         string      `protobuf:"bytes,3,opt,name=name" json:"name" db:"name"`
+	Number       string      `protobuf:"bytes,4,opt,name=number" json:"number" db:"number"`
+	Season       string      `protobuf:"bytes,5,opt,name=season" json:"season" db:"season"`
+	Year         string      `protobuf:"bytes,6,opt,name=year" json:"year" db:"year"`
+	TopicName    string      `protobuf:"bytes,7,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
+	TopicId      string      `protobuf:"bytes,8,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
+	Courses      []*Course   `protobuf:"bytes,9,rep,name=courses" json:"courses,omitempty"`
+	Metadata     []*Metadata `protobuf:"bytes,10,rep,name=metadata" json:"metadata,omitempty"`
+	// Orders the terms of a year, see TermType
+	TermCode int32  `protobuf:"varint,11,opt,name=term_code,json=termCode" json:"term_code" db:"term_code"`
+	TermName string `protobuf:"bytes,12,opt,name=term_name,json=termName" json:"term_name" db:"term_name"`
+	// Hash of the content of the subject and its children, independent of their order, set during validation
+	ContentHash          string   `protobuf:"bytes,13,opt,name=content_hash,json=contentHash" json:"content_hash" db:"content_hash"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Subject) Reset()      { *m = Subject{} }
@@ -295,19 +375,44 @@ func (m *Subject) GetMetadata() []*Metadata {
 	return nil
 }
 
+func (m *Subject) GetTermCode() int32 {
+	if m != nil {
+		return m.TermCode
+	}
+	return 0
+}
+
+func (m *Subject) GetTermName() string {
+	if m != nil {
+		return m.TermName
+	}
+	return ""
+}
+
+func (m *Subject) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 type Course struct {
-	Id                   int64       `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
-	SubjectId            int64       `protobuf:"varint,2,opt,name=subject_id,json=subjectId" json:"-" db:"subject_id"`
-	Name                 string      `protobuf:"bytes,3,opt,name=name" json:"name" db:"name"`
-	Number               string      `protobuf:"bytes,4,opt,name=number" json:"number" db:"number"`
-	Synopsis             *string     `protobuf:"bytes,5,opt,name=synopsis" json:"synopsis,omitempty" db:"synopsis"`
-	TopicName            string      `protobuf:"bytes,6,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
-	TopicId              string      `protobuf:"bytes,7,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
-	Sections             []*Section  `protobuf:"bytes,8,rep,name=sections" json:"sections,omitempty"`
-	Metadata             []*Metadata `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Id        int64       `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
+	SubjectId int64       `protobuf:"varint,2,opt,name=subject_id,json=subjectId" json:"-" db:"subject_id"`
+	Name      string      `protobuf:"bytes,3,opt,name=name" json:"name" db:"name"`
+	Number    string      `protobuf:"bytes,4,opt,name=number" json:"number" db:"number"`
+	Synopsis  *string     `protobuf:"bytes,5,opt,name=synopsis" json:"synopsis,omitempty" db:"synopsis"`
+	TopicName string      `protobuf:"bytes,6,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
+	TopicId   string      `protobuf:"bytes,7,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
+	Sections  []*Section  `protobuf:"bytes,8,rep,name=sections" json:"sections,omitempty"`
+	Metadata  []*Metadata `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty"`
+	// Parsed from the prerequisite notes of the course, set during validation
+	Prerequisite *Prerequisite `protobuf:"bytes,10,opt,name=prerequisite" json:"prerequisite,omitempty"`
+	// Hash of the content of the course and its children, independent of their order, set during validation
+	ContentHash          string   `protobuf:"bytes,11,opt,name=content_hash,json=contentHash" json:"content_hash" db:"content_hash"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Course) Reset()      { *m = Course{} }
@@ -405,24 +510,45 @@ func (m *Course) GetMetadata() []*Metadata {
 	return nil
 }
 
+func (m *Course) GetPrerequisite() *Prerequisite {
+	if m != nil {
+		return m.Prerequisite
+	}
+	return nil
+}
+
+func (m *Course) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 type Section struct {
-	Id                   int64         `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
-	CourseId             int64         `protobuf:"varint,2,opt,name=course_id,json=courseId" json:"-" db:"course_id"`
-	Number               string        `protobuf:"bytes,3,opt,name=number" json:"number" db:"number"`
-	CallNumber           string        `protobuf:"bytes,4,opt,name=call_number,json=callNumber" json:"call_number" db:"call_number"`
-	Max                  int64         `protobuf:"varint,5,opt,name=max" json:"max" db:"max"`
-	Now                  int64         `protobuf:"varint,6,opt,name=now" json:"now" db:"now"`
-	Status               string        `protobuf:"bytes,7,opt,name=status" json:"status" db:"status"`
-	Credits              string        `protobuf:"bytes,8,opt,name=credits" json:"credits" db:"credits"`
-	TopicName            string        `protobuf:"bytes,9,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
-	TopicId              string        `protobuf:"bytes,10,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
-	Meetings             []*Meeting    `protobuf:"bytes,11,rep,name=meetings" json:"meetings,omitempty"`
-	Instructors          []*Instructor `protobuf:"bytes,12,rep,name=instructors" json:"instructors,omitempty"`
-	Books                []*Book       `protobuf:"bytes,13,rep,name=books" json:"books,omitempty"`
-	Metadata             []*Metadata   `protobuf:"bytes,14,rep,name=metadata" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Id            int64           `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
+	CourseId      int64           `protobuf:"varint,2,opt,name=course_id,json=courseId" json:"-" db:"course_id"`
+	Number        string          `protobuf:"bytes,3,opt,name=number" json:"number" db:"number"`
+	CallNumber    string          `protobuf:"bytes,4,opt,name=call_number,json=callNumber" json:"call_number" db:"call_number"`
+	Max           int64           `protobuf:"varint,5,opt,name=max" json:"max" db:"max"`
+	Now           int64           `protobuf:"varint,6,opt,name=now" json:"now" db:"now"`
+	Status        string          `protobuf:"bytes,7,opt,name=status" json:"status" db:"status"`
+	Credits       string          `protobuf:"bytes,8,opt,name=credits" json:"credits" db:"credits"`
+	TopicName     string          `protobuf:"bytes,9,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
+	TopicId       string          `protobuf:"bytes,10,opt,name=topic_id,json=topicId" json:"topic_id" db:"topic_id"`
+	Meetings      []*Meeting      `protobuf:"bytes,11,rep,name=meetings" json:"meetings,omitempty"`
+	Instructors   []*Instructor   `protobuf:"bytes,12,rep,name=instructors" json:"instructors,omitempty"`
+	Books         []*Book         `protobuf:"bytes,13,rep,name=books" json:"books,omitempty"`
+	Metadata      []*Metadata     `protobuf:"bytes,14,rep,name=metadata" json:"metadata,omitempty"`
+	CrossListings []*CrossListing `protobuf:"bytes,15,rep,name=cross_listings,json=crossListings" json:"cross_listings,omitempty"`
+	// Seats on the waitlist, for universities that publish waitlists
+	WaitlistMax *int64 `protobuf:"varint,16,opt,name=waitlist_max,json=waitlistMax" json:"waitlist_max,omitempty" db:"waitlist_max"`
+	// Students on the waitlist
+	WaitlistNow *int64 `protobuf:"varint,17,opt,name=waitlist_now,json=waitlistNow" json:"waitlist_now,omitempty" db:"waitlist_now"`
+	// Hash of the content of the section and its children, independent of their order, set during validation
+	ContentHash          string   `protobuf:"bytes,18,opt,name=content_hash,json=contentHash" json:"content_hash" db:"content_hash"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Section) Reset()      { *m = Section{} }
@@ -555,19 +681,60 @@ func (m *Section) GetMetadata() []*Metadata {
 	return nil
 }
 
+func (m *Section) GetCrossListings() []*CrossListing {
+	if m != nil {
+		return m.CrossListings
+	}
+	return nil
+}
+
+func (m *Section) GetWaitlistMax() int64 {
+	if m != nil && m.WaitlistMax != nil {
+		return *m.WaitlistMax
+	}
+	return 0
+}
+
+func (m *Section) GetWaitlistNow() int64 {
+	if m != nil && m.WaitlistNow != nil {
+		return *m.WaitlistNow
+	}
+	return 0
+}
+
+func (m *Section) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 type Meeting struct {
-	Id                   int64       `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
-	SectionId            int64       `protobuf:"varint,2,opt,name=section_id,json=sectionId" json:"-" db:"section_id"`
-	Room                 *string     `protobuf:"bytes,3,opt,name=room" json:"room,omitempty" db:"room"`
-	Day                  *string     `protobuf:"bytes,4,opt,name=day" json:"day,omitempty" db:"day"`
-	StartTime            *string     `protobuf:"bytes,5,opt,name=start_time,json=startTime" json:"start_time,omitempty" db:"start_time"`
-	EndTime              *string     `protobuf:"bytes,6,opt,name=end_time,json=endTime" json:"end_time,omitempty" db:"end_time"`
-	ClassType            *string     `protobuf:"bytes,7,opt,name=class_type,json=classType" json:"class_type,omitempty" db:"class_type"`
-	Index                int32       `protobuf:"varint,8,opt,name=index" json:"index" db:"index"`
-	Metadata             []*Metadata `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Id        int64       `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
+	SectionId int64       `protobuf:"varint,2,opt,name=section_id,json=sectionId" json:"-" db:"section_id"`
+	Room      *string     `protobuf:"bytes,3,opt,name=room" json:"room,omitempty" db:"room"`
+	Day       *string     `protobuf:"bytes,4,opt,name=day" json:"day,omitempty" db:"day"`
+	StartTime *string     `protobuf:"bytes,5,opt,name=start_time,json=startTime" json:"start_time,omitempty" db:"start_time"`
+	EndTime   *string     `protobuf:"bytes,6,opt,name=end_time,json=endTime" json:"end_time,omitempty" db:"end_time"`
+	ClassType *string     `protobuf:"bytes,7,opt,name=class_type,json=classType" json:"class_type,omitempty" db:"class_type"`
+	Index     int32       `protobuf:"varint,8,opt,name=index" json:"index" db:"index"`
+	Metadata  []*Metadata `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty"`
+	// Canonical form of day, start_time and end_time, set during validation. Times are in the time zone of the university.
+	Weekday *Weekday `protobuf:"varint,10,opt,name=weekday,enum=model.Weekday" json:"weekday,omitempty" db:"weekday"`
+	// Minutes since midnight
+	StartMinute *int32 `protobuf:"varint,11,opt,name=start_minute,json=startMinute" json:"start_minute,omitempty" db:"start_minute"`
+	// Length of the meeting in minutes
+	Duration *int32 `protobuf:"varint,12,opt,name=duration" json:"duration,omitempty" db:"duration"`
+	// Room split into its building and room number, set during validation
+	BuildingCode *string `protobuf:"bytes,13,opt,name=building_code,json=buildingCode" json:"building_code,omitempty" db:"building_code"`
+	RoomNumber   *string `protobuf:"bytes,14,opt,name=room_number,json=roomNumber" json:"room_number,omitempty" db:"room_number"`
+	// Catalog entry of the building, when the university has one
+	Building *Building `protobuf:"bytes,15,opt,name=building" json:"building,omitempty"`
+	// Hash of the content of the meeting and its children, independent of their order, set during validation
+	ContentHash          string   `protobuf:"bytes,16,opt,name=content_hash,json=contentHash" json:"content_hash" db:"content_hash"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Meeting) Reset()      { *m = Meeting{} }
@@ -665,11 +832,62 @@ func (m *Meeting) GetMetadata() []*Metadata {
 	return nil
 }
 
+func (m *Meeting) GetWeekday() Weekday {
+	if m != nil && m.Weekday != nil {
+		return *m.Weekday
+	}
+	return Weekday_SUNDAY
+}
+
+func (m *Meeting) GetStartMinute() int32 {
+	if m != nil && m.StartMinute != nil {
+		return *m.StartMinute
+	}
+	return 0
+}
+
+func (m *Meeting) GetDuration() int32 {
+	if m != nil && m.Duration != nil {
+		return *m.Duration
+	}
+	return 0
+}
+
+func (m *Meeting) GetBuildingCode() string {
+	if m != nil && m.BuildingCode != nil {
+		return *m.BuildingCode
+	}
+	return ""
+}
+
+func (m *Meeting) GetRoomNumber() string {
+	if m != nil && m.RoomNumber != nil {
+		return *m.RoomNumber
+	}
+	return ""
+}
+
+func (m *Meeting) GetBuilding() *Building {
+	if m != nil {
+		return m.Building
+	}
+	return nil
+}
+
+func (m *Meeting) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
 type Instructor struct {
-	Id                   int64    `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
-	SectionId            int64    `protobuf:"varint,2,opt,name=section_id,json=sectionId" json:"-" db:"section_id"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name" json:"name" db:"name"`
-	Index                int32    `protobuf:"varint,4,opt,name=index" json:"-" db:"index"`
+	Id        int64  `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
+	SectionId int64  `protobuf:"varint,2,opt,name=section_id,json=sectionId" json:"-" db:"section_id"`
+	Name      string `protobuf:"bytes,3,opt,name=name" json:"name" db:"name"`
+	Index     int32  `protobuf:"varint,4,opt,name=index" json:"-" db:"index"`
+	// Identifies the instructor across sections and semesters. Empty for placeholders such as STAFF
+	TopicName            string   `protobuf:"bytes,5,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -735,6 +953,13 @@ func (m *Instructor) GetIndex() int32 {
 	return 0
 }
 
+func (m *Instructor) GetTopicName() string {
+	if m != nil {
+		return m.TopicName
+	}
+	return ""
+}
+
 type Book struct {
 	Id                   int64    `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
 	SectionId            int64    `protobuf:"varint,2,opt,name=section_id,json=sectionId" json:"-" db:"section_id"`
@@ -1040,8 +1265,13 @@ func (m *ResolvedSemester) GetNext() *Semester {
 }
 
 type Semester struct {
-	Year                 int32    `protobuf:"varint,1,opt,name=year" json:"year" db:"year"`
-	Season               string   `protobuf:"bytes,2,opt,name=season" json:"season" db:"season"`
+	Year int32 `protobuf:"varint,1,opt,name=year" json:"year" db:"year"`
+	// Key of the term type, e.g fall or summer_1
+	Season string `protobuf:"bytes,2,opt,name=season" json:"season" db:"season"`
+	// Orders the terms of a year
+	TermCode int32 `protobuf:"varint,3,opt,name=term_code,json=termCode" json:"term_code" db:"term_code"`
+	// Displayed to users, e.g Summer Session I
+	TermName             string   `protobuf:"bytes,4,opt,name=term_name,json=termName" json:"term_name" db:"term_name"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1093,14 +1323,32 @@ func (m *Semester) GetSeason() string {
 	return ""
 }
 
+func (m *Semester) GetTermCode() int32 {
+	if m != nil {
+		return m.TermCode
+	}
+	return 0
+}
+
+func (m *Semester) GetTermName() string {
+	if m != nil {
+		return m.TermName
+	}
+	return ""
+}
+
 type UCTNotification struct {
-	NotificationId       int64      `protobuf:"varint,1,opt,name=notification_id,json=notificationId" json:"notification_id"`
-	TopicName            string     `protobuf:"bytes,2,opt,name=topic_name,json=topicName" json:"topic_name"`
-	Status               string     `protobuf:"bytes,3,opt,name=status" json:"status"`
-	University           University `protobuf:"bytes,4,opt,name=university" json:"university"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	NotificationId int64      `protobuf:"varint,1,opt,name=notification_id,json=notificationId" json:"notification_id"`
+	TopicName      string     `protobuf:"bytes,2,opt,name=topic_name,json=topicName" json:"topic_name"`
+	Status         string     `protobuf:"bytes,3,opt,name=status" json:"status"`
+	University     University `protobuf:"bytes,4,opt,name=university" json:"university"`
+	// Sections cross-listed with the section, which share its seats
+	CrossListedTopicNames []string `protobuf:"bytes,5,rep,name=cross_listed_topic_names,json=crossListedTopicNames" json:"cross_listed_topic_names,omitempty"`
+	// One of status or waitlist. Empty for status changes
+	Type                 string   `protobuf:"bytes,6,opt,name=type" json:"type"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UCTNotification) Reset()      { *m = UCTNotification{} }
@@ -1163,6 +1411,20 @@ func (m *UCTNotification) GetUniversity() University {
 	return University{}
 }
 
+func (m *UCTNotification) GetCrossListedTopicNames() []string {
+	if m != nil {
+		return m.CrossListedTopicNames
+	}
+	return nil
+}
+
+func (m *UCTNotification) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
 type Response struct {
 	Meta                 *Meta    `protobuf:"bytes,1,opt,name=meta" json:"meta,omitempty"`
 	Data                 *Data    `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
@@ -1281,6 +1543,13 @@ type Data struct {
 	Course               *Course             `protobuf:"bytes,7,opt,name=course" json:"course,omitempty"`
 	Section              *Section            `protobuf:"bytes,8,opt,name=section" json:"section,omitempty"`
 	SubscriptionView     []*SubscriptionView `protobuf:"bytes,9,rep,name=subscription_view,json=subscriptionView" json:"subscription_view,omitempty"`
+	SectionHistory       *SectionHistory     `protobuf:"bytes,10,opt,name=section_history,json=sectionHistory" json:"section_history,omitempty"`
+	SearchResults        []*SearchResult     `protobuf:"bytes,11,rep,name=search_results,json=searchResults" json:"search_results,omitempty"`
+	Page                 *Page               `protobuf:"bytes,12,opt,name=page" json:"page,omitempty"`
+	Schedules            []*Schedule         `protobuf:"bytes,13,rep,name=schedules" json:"schedules,omitempty"`
+	Prerequisite         *Prerequisite       `protobuf:"bytes,14,opt,name=prerequisite" json:"prerequisite,omitempty"`
+	InstructorProfile    *InstructorProfile  `protobuf:"bytes,15,opt,name=instructor_profile,json=instructorProfile" json:"instructor_profile,omitempty"`
+	Buildings            []*Building         `protobuf:"bytes,16,rep,name=buildings" json:"buildings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -1381,6 +1650,55 @@ func (m *Data) GetSubscriptionView() []*SubscriptionView {
 	return nil
 }
 
+func (m *Data) GetSectionHistory() *SectionHistory {
+	if m != nil {
+		return m.SectionHistory
+	}
+	return nil
+}
+
+func (m *Data) GetSearchResults() []*SearchResult {
+	if m != nil {
+		return m.SearchResults
+	}
+	return nil
+}
+
+func (m *Data) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *Data) GetSchedules() []*Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *Data) GetPrerequisite() *Prerequisite {
+	if m != nil {
+		return m.Prerequisite
+	}
+	return nil
+}
+
+func (m *Data) GetInstructorProfile() *InstructorProfile {
+	if m != nil {
+		return m.InstructorProfile
+	}
+	return nil
+}
+
+func (m *Data) GetBuildings() []*Building {
+	if m != nil {
+		return m.Buildings
+	}
+	return nil
+}
+
 type Subscription struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id" json:"id" db:"id" firestore:"id"`
 	Os                   string   `protobuf:"bytes,2,opt,name=os" json:"os" db:"os" firestore:"os"`
@@ -1529,456 +1847,1119 @@ func (m *SubscriptionView) GetIsHot() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*University)(nil), "model.University")
-	proto.RegisterType((*Subject)(nil), "model.Subject")
-	proto.RegisterType((*Course)(nil), "model.Course")
-	proto.RegisterType((*Section)(nil), "model.Section")
-	proto.RegisterType((*Meeting)(nil), "model.Meeting")
-	proto.RegisterType((*Instructor)(nil), "model.Instructor")
-	proto.RegisterType((*Book)(nil), "model.Book")
-	proto.RegisterType((*Metadata)(nil), "model.Metadata")
-	proto.RegisterType((*Registration)(nil), "model.Registration")
-	proto.RegisterType((*ResolvedSemester)(nil), "model.ResolvedSemester")
-	proto.RegisterType((*Semester)(nil), "model.Semester")
-	proto.RegisterType((*UCTNotification)(nil), "model.UCTNotification")
-	proto.RegisterType((*Response)(nil), "model.Response")
-	proto.RegisterType((*Meta)(nil), "model.Meta")
-	proto.RegisterType((*Data)(nil), "model.Data")
-	proto.RegisterType((*Subscription)(nil), "model.Subscription")
-	proto.RegisterType((*SubscriptionView)(nil), "model.SubscriptionView")
+type SectionHistory struct {
+	TopicName            string               `protobuf:"bytes,1,opt,name=topic_name,json=topicName" json:"topic_name"`
+	Snapshots            []*SectionSnapshot   `protobuf:"bytes,2,rep,name=snapshots" json:"snapshots,omitempty"`
+	Stats                *SectionHistoryStats `protobuf:"bytes,3,opt,name=stats" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func init() { proto.RegisterFile("common/model/model.proto", fileDescriptor_3ad522f3927c3aa3) }
-
-var fileDescriptor_3ad522f3927c3aa3 = []byte{
-	// 1770 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcb, 0x8f, 0x1b, 0x49,
-	0x19, 0x4f, 0xbb, 0xfd, 0x68, 0x7f, 0xf6, 0x64, 0x66, 0x2a, 0xcb, 0x4e, 0xc3, 0xae, 0x3c, 0xb3,
-	0xb5, 0x0f, 0x0c, 0xc9, 0x4c, 0x96, 0x6c, 0xd8, 0x61, 0x79, 0x48, 0xcb, 0x24, 0x42, 0x8c, 0x20,
-	0x11, 0xaa, 0x64, 0x91, 0xe0, 0x80, 0xd5, 0xee, 0xae, 0x71, 0x8a, 0xb8, 0xbb, 0xac, 0xae, 0xf2,
-	0x3c, 0x6e, 0xdc, 0x38, 0x73, 0xe3, 0xc0, 0x1f, 0xc0, 0x85, 0x03, 0x5c, 0xe0, 0xc0, 0x81, 0x0b,
-	0xd2, 0x1e, 0x39, 0x72, 0x21, 0xca, 0x98, 0x1b, 0x27, 0x84, 0x38, 0x20, 0x71, 0x41, 0xf5, 0xe8,
-	0x97, 0xed, 0xc4, 0x4e, 0xd0, 0xe6, 0x62, 0x55, 0xd5, 0xef, 0xf7, 0x7d, 0xf5, 0xf8, 0x7d, 0xf5,
-	0x7d, 0xd5, 0x06, 0x3f, 0xe4, 0x71, 0xcc, 0x93, 0x9b, 0x31, 0x8f, 0xe8, 0xd8, 0xfc, 0x1e, 0x4c,
-	0x52, 0x2e, 0x39, 0x6a, 0xe8, 0xce, 0x17, 0xf6, 0x47, 0x4c, 0x3e, 0x9a, 0x0e, 0x0f, 0x42, 0x1e,
-	0xdf, 0x1c, 0xf1, 0x11, 0xbf, 0xa9, 0xd1, 0xe1, 0xf4, 0x44, 0xf7, 0x74, 0x47, 0xb7, 0x8c, 0x15,
-	0xfe, 0x6f, 0x03, 0xe0, 0x93, 0x84, 0x9d, 0xd2, 0x54, 0x30, 0x79, 0x81, 0x76, 0xa1, 0xc6, 0x22,
-	0xdf, 0xd9, 0x73, 0xfa, 0xee, 0xd1, 0xe6, 0xa7, 0x4f, 0x76, 0xaf, 0xfc, 0xeb, 0xc9, 0x6e, 0x2b,
-	0x1a, 0x7e, 0x1d, 0xb3, 0x08, 0x93, 0x1a, 0x8b, 0xd0, 0xbb, 0x50, 0x4f, 0x82, 0x98, 0xfa, 0xb5,
-	0x3d, 0xa7, 0xdf, 0x3e, 0xda, 0xb6, 0x94, 0xb6, 0xa2, 0xa8, 0x71, 0x4c, 0x34, 0xac, 0x68, 0xc1,
-	0x70, 0x98, 0xfa, 0xee, 0x22, 0x4d, 0x8d, 0x63, 0xa2, 0x61, 0xf4, 0x01, 0xb4, 0x1f, 0xf1, 0x98,
-	0x0e, 0x26, 0xc1, 0x88, 0xfa, 0x75, 0xcd, 0x7d, 0xdd, 0x72, 0xaf, 0x2a, 0x6e, 0x0e, 0x62, 0xe2,
-	0xa9, 0xf6, 0x0f, 0x82, 0x11, 0x45, 0xdf, 0x83, 0xed, 0x94, 0x8e, 0x98, 0x90, 0x69, 0x20, 0x19,
-	0x4f, 0x8c, 0x71, 0x43, 0x1b, 0xf7, 0xac, 0xf1, 0xeb, 0xca, 0x78, 0x81, 0x84, 0xc9, 0x56, 0x79,
-	0x4c, 0x3b, 0xfb, 0x10, 0x20, 0x0e, 0x58, 0x32, 0x08, 0xf9, 0x98, 0xa7, 0x7e, 0x53, 0x7b, 0xd9,
-	0xb1, 0x5e, 0x36, 0x95, 0x97, 0x02, 0xc5, 0xa4, 0xad, 0x3a, 0x77, 0x54, 0x1b, 0x7d, 0x13, 0xba,
-	0x41, 0x18, 0xd2, 0x44, 0x5a, 0xcb, 0x96, 0xb6, 0xfc, 0xbc, 0xb5, 0xdc, 0xd6, 0x1b, 0x2d, 0xe1,
-	0x98, 0x74, 0x4c, 0xd7, 0x58, 0x7f, 0x08, 0x20, 0xf9, 0x84, 0x85, 0x03, 0x7d, 0x96, 0xde, 0xe2,
-	0xac, 0x05, 0x8a, 0x49, 0x5b, 0x77, 0xee, 0xab, 0x63, 0x7d, 0x1f, 0x3c, 0x83, 0xb0, 0xc8, 0x6f,
-	0x6b, 0xab, 0xcf, 0x59, 0xab, 0x8d, 0xc2, 0x4a, 0x49, 0xd5, 0xd2, 0xcd, 0xe3, 0x08, 0x7d, 0x07,
-	0x50, 0x4a, 0x05, 0x1f, 0x9f, 0xd2, 0x68, 0x20, 0x68, 0x4c, 0x85, 0xa4, 0xa9, 0xf0, 0x61, 0xcf,
-	0xe9, 0x77, 0x6e, 0xed, 0x1c, 0x98, 0xf8, 0x21, 0x96, 0xf0, 0xc0, 0xe2, 0x64, 0x3b, 0x9d, 0x1b,
-	0x11, 0xe8, 0xcb, 0xe0, 0x89, 0xe9, 0xf0, 0xa7, 0x34, 0x94, 0xc2, 0xef, 0xec, 0xb9, 0xfd, 0xce,
-	0xad, 0xab, 0xd6, 0xfa, 0x81, 0x19, 0x26, 0x39, 0x8e, 0x3e, 0x86, 0x6b, 0xc1, 0x69, 0xc0, 0xc6,
-	0xc1, 0x70, 0x4c, 0x4b, 0x93, 0x76, 0xb5, 0xd9, 0x66, 0x66, 0x96, 0x4d, 0x86, 0x72, 0x6e, 0x31,
-	0xdb, 0x47, 0xb0, 0x51, 0x56, 0x4a, 0xf8, 0x1b, 0xda, 0xf6, 0x5a, 0xbe, 0xe0, 0x02, 0x23, 0x55,
-	0x26, 0xba, 0x0e, 0x5e, 0x4c, 0x65, 0x10, 0x05, 0x32, 0xf0, 0xaf, 0x56, 0x66, 0xbc, 0x67, 0x87,
-	0x49, 0x4e, 0xc0, 0x7f, 0x73, 0xa1, 0x65, 0xd7, 0x8f, 0xde, 0x29, 0x85, 0xfe, 0x6b, 0xea, 0x54,
-	0xff, 0xf1, 0x64, 0xd7, 0xd9, 0x9f, 0x8f, 0xff, 0xbb, 0xb0, 0x31, 0xcd, 0xaf, 0x8b, 0x92, 0xa1,
-	0xa6, 0x0d, 0x76, 0xcb, 0x06, 0x48, 0x19, 0x54, 0x58, 0x98, 0x74, 0x8b, 0xfe, 0x71, 0x71, 0x8b,
-	0xdc, 0xe7, 0xdf, 0xa2, 0xeb, 0xd0, 0x4c, 0xa6, 0xf1, 0x90, 0xa6, 0xf6, 0x6e, 0x5c, 0xb3, 0xc4,
-	0x8e, 0x26, 0x6a, 0x04, 0x13, 0x4b, 0x51, 0x64, 0x41, 0x03, 0xc1, 0x13, 0x7b, 0x17, 0x2a, 0x64,
-	0x83, 0x60, 0x62, 0x29, 0x6a, 0x01, 0x17, 0x34, 0xc8, 0x02, 0xbe, 0xb2, 0x00, 0x35, 0x8e, 0x89,
-	0x86, 0xe7, 0xe2, 0xb4, 0xf5, 0x52, 0x71, 0xea, 0xad, 0x15, 0xa7, 0x5f, 0x84, 0x56, 0xc8, 0xa7,
-	0xa9, 0xa0, 0xc2, 0x6f, 0x6b, 0xd5, 0x36, 0xac, 0x6a, 0x77, 0xf4, 0x28, 0xc9, 0xd0, 0x8a, 0xbe,
-	0xb0, 0x4a, 0xdf, 0xdf, 0xba, 0xd0, 0x34, 0x0e, 0xd6, 0x94, 0xf7, 0x1b, 0x00, 0x36, 0x8c, 0x0b,
-	0x6d, 0xdf, 0x2c, 0xb3, 0xf5, 0xae, 0x0b, 0x0a, 0x26, 0x6d, 0xdb, 0xf9, 0x8c, 0x54, 0xdd, 0x07,
-	0x4f, 0x5c, 0x24, 0x7c, 0x22, 0x98, 0xb0, 0xba, 0x6e, 0x67, 0xa7, 0x98, 0x8d, 0x63, 0x92, 0x53,
-	0xe6, 0x04, 0x6b, 0xbe, 0x94, 0x60, 0xad, 0xb5, 0x04, 0x53, 0x09, 0x81, 0x86, 0xe6, 0x76, 0x7a,
-	0xd5, 0x84, 0x60, 0x86, 0x49, 0x8e, 0x57, 0x34, 0x6b, 0xaf, 0xd2, 0xec, 0x17, 0x0d, 0x68, 0x59,
-	0x17, 0x6b, 0x8a, 0xf6, 0x35, 0x68, 0x9b, 0xe8, 0x28, 0x34, 0x7b, 0xa3, 0x4c, 0xd6, 0xa5, 0x24,
-	0x67, 0x60, 0xe2, 0x99, 0xf6, 0x71, 0x54, 0x92, 0xc2, 0x5d, 0x2d, 0xc5, 0x47, 0xd0, 0x09, 0x83,
-	0xf1, 0x78, 0x50, 0x11, 0xcf, 0xb7, 0x16, 0x5b, 0x7a, 0x8e, 0x02, 0xc6, 0x04, 0x54, 0xef, 0xbe,
-	0x31, 0xc5, 0xe0, 0xc6, 0xc1, 0xb9, 0x16, 0xd0, 0x3d, 0xda, 0xb2, 0x26, 0x9e, 0x29, 0x2f, 0xe7,
-	0x98, 0x28, 0x50, 0x71, 0x12, 0x7e, 0xa6, 0x35, 0x9b, 0xe3, 0x24, 0xfc, 0x0c, 0x13, 0x05, 0xea,
-	0x3b, 0x2e, 0x03, 0x39, 0x15, 0x56, 0xa4, 0xea, 0x1d, 0xd7, 0x88, 0xba, 0xe3, 0xba, 0x81, 0x0e,
-	0xa0, 0x15, 0xa6, 0x34, 0x62, 0x52, 0xd8, 0x3b, 0xf8, 0x9a, 0x65, 0x77, 0xf5, 0x5a, 0x0d, 0x84,
-	0x49, 0x46, 0x9a, 0x8b, 0x9d, 0xf6, 0x4b, 0xc5, 0x0e, 0xac, 0x1b, 0x3b, 0x31, 0xa5, 0x92, 0x25,
-	0xa3, 0xf9, 0x62, 0x72, 0xcf, 0x0c, 0x93, 0x1c, 0x47, 0x1f, 0x40, 0x87, 0x25, 0x42, 0xa6, 0xd3,
-	0x50, 0xf2, 0xbc, 0x88, 0x6c, 0x5b, 0xfa, 0x71, 0x8e, 0x90, 0x32, 0x0b, 0xbd, 0x05, 0x8d, 0x21,
-	0xe7, 0x8f, 0xb3, 0xba, 0xd1, 0xb1, 0xf4, 0x23, 0xce, 0x1f, 0x13, 0x83, 0xbc, 0x58, 0x9d, 0xf8,
-	0x95, 0x0b, 0x2d, 0xbb, 0xb4, 0x17, 0x48, 0x24, 0x26, 0x88, 0x9f, 0x9b, 0x48, 0x72, 0x8a, 0x4a,
-	0x24, 0xa6, 0x73, 0x1c, 0xa1, 0xb7, 0xa0, 0x9e, 0x72, 0x1e, 0xdb, 0xa0, 0xdc, 0xc8, 0x92, 0x88,
-	0x1a, 0xc3, 0x44, 0x43, 0xa8, 0x07, 0x6e, 0x14, 0x5c, 0xd8, 0x20, 0xec, 0x66, 0x91, 0x12, 0x05,
-	0x17, 0x98, 0x28, 0x00, 0xdd, 0x02, 0x10, 0x32, 0x48, 0xe5, 0x40, 0xb2, 0x38, 0x7b, 0x1d, 0x5d,
-	0xcb, 0xa7, 0xcd, 0x11, 0x35, 0xad, 0xea, 0x3c, 0x64, 0x31, 0x45, 0x37, 0xc0, 0xa3, 0x49, 0x64,
-	0x2c, 0x9a, 0xd5, 0x5c, 0x93, 0x8d, 0x63, 0xd2, 0xa2, 0x49, 0xa4, 0xd9, 0xb7, 0x00, 0xc2, 0x71,
-	0x20, 0xc4, 0x40, 0x5e, 0x4c, 0xb2, 0xda, 0x90, 0xcf, 0x50, 0x20, 0x98, 0xb4, 0x75, 0xe7, 0xe1,
-	0xc5, 0x84, 0xa2, 0x3e, 0x34, 0x58, 0x12, 0xd1, 0x73, 0x1d, 0x90, 0x8d, 0x23, 0x64, 0xe3, 0x04,
-	0xf4, 0xc9, 0x29, 0x00, 0x13, 0x43, 0x78, 0xb1, 0x94, 0xf1, 0x67, 0x07, 0xa0, 0x08, 0x85, 0x57,
-	0xa1, 0xd0, 0x9a, 0xa9, 0x7e, 0x3f, 0xdb, 0x6f, 0x5d, 0xef, 0x77, 0xa7, 0xec, 0x7e, 0x71, 0xd3,
-	0xf8, 0xf7, 0x0e, 0xd4, 0x55, 0x8c, 0xbe, 0x8a, 0x1d, 0xf4, 0xa1, 0x21, 0x99, 0x1c, 0x67, 0x5b,
-	0xa8, 0x48, 0xa1, 0x01, 0x4c, 0x0c, 0x41, 0x25, 0xa6, 0x69, 0x3a, 0xb6, 0xa1, 0x56, 0x49, 0x4c,
-	0xd3, 0x74, 0x8c, 0x89, 0x02, 0xf1, 0x6f, 0x5c, 0xf0, 0x32, 0x61, 0xd6, 0x5c, 0xfd, 0xc7, 0xcb,
-	0x5f, 0x52, 0x6f, 0xac, 0xff, 0x8a, 0x3a, 0xac, 0x14, 0x6b, 0x57, 0x9b, 0xfb, 0xeb, 0x14, 0xea,
-	0xdb, 0xe5, 0x82, 0x51, 0xd7, 0x76, 0x3b, 0xab, 0x8b, 0xc5, 0x61, 0xe5, 0xb8, 0x1b, 0xcb, 0xa6,
-	0x5b, 0x7e, 0xd4, 0x87, 0x00, 0x36, 0x9d, 0x29, 0xc3, 0xe6, 0x12, 0xc3, 0x02, 0x56, 0x1f, 0x19,
-	0xa6, 0x53, 0xd6, 0xa8, 0xb5, 0x4a, 0x23, 0x95, 0xeb, 0x79, 0x22, 0x69, 0x22, 0x97, 0xe6, 0x7a,
-	0x03, 0xa9, 0x5c, 0x6f, 0x5b, 0x33, 0x07, 0xba, 0xe5, 0x57, 0xf4, 0x2b, 0x7d, 0xfd, 0x5e, 0x87,
-	0xe6, 0x84, 0xa6, 0x8c, 0x47, 0xcb, 0xaa, 0xae, 0x41, 0x30, 0xb1, 0x14, 0x55, 0x75, 0x4d, 0x6b,
-	0x10, 0x05, 0x92, 0x5a, 0xb5, 0x2a, 0x55, 0xb7, 0x04, 0x63, 0x02, 0xa6, 0x77, 0x57, 0x75, 0x7e,
-	0xee, 0xc0, 0xd6, 0xfc, 0xb7, 0x0d, 0xfa, 0x12, 0xb4, 0xc2, 0x69, 0x9a, 0xaa, 0x93, 0x72, 0xf4,
-	0x57, 0xd0, 0xc2, 0x07, 0x49, 0x86, 0xa3, 0xb7, 0xa1, 0x3e, 0x0e, 0x84, 0xd4, 0x9b, 0x5c, 0xc2,
-	0xd3, 0xa0, 0x22, 0x25, 0xf4, 0x5c, 0xea, 0xad, 0x2c, 0x23, 0x29, 0x10, 0xff, 0x04, 0xbc, 0x7c,
-	0x01, 0xd9, 0xd3, 0xdb, 0xd1, 0x29, 0xe1, 0x99, 0x4f, 0xef, 0xe2, 0x39, 0x5f, 0x5b, 0xf9, 0x9c,
-	0xc7, 0x7f, 0x74, 0x60, 0xf3, 0x93, 0x3b, 0x0f, 0xef, 0x73, 0xc9, 0x4e, 0x58, 0x68, 0x14, 0xdd,
-	0x87, 0xcd, 0xa4, 0xd4, 0x1f, 0xe4, 0xf2, 0xd6, 0x95, 0x27, 0x72, 0xb5, 0x0c, 0x1e, 0x47, 0xe8,
-	0xed, 0x4a, 0xf5, 0x37, 0x73, 0x1a, 0x66, 0xa9, 0xd4, 0xbf, 0x99, 0xbf, 0x3f, 0xdc, 0x12, 0x21,
-	0x7b, 0x70, 0x1c, 0x02, 0x14, 0x3a, 0x6b, 0xa5, 0x8a, 0x4a, 0x5d, 0xfc, 0xc7, 0x60, 0x8d, 0x4a,
-	0x54, 0xfc, 0x7d, 0xf0, 0x08, 0x15, 0x13, 0x9e, 0x08, 0x8a, 0x76, 0xa1, 0xae, 0xf2, 0xba, 0x15,
-	0xa7, 0x53, 0x4a, 0xfa, 0x44, 0x03, 0x8a, 0xa0, 0xab, 0x42, 0xad, 0x42, 0xb8, 0xab, 0x2a, 0x82,
-	0x06, 0xf0, 0x6d, 0xa8, 0x2b, 0x3a, 0x42, 0x50, 0x0f, 0x79, 0x44, 0xcd, 0x41, 0x13, 0xdd, 0x46,
-	0x3e, 0xb4, 0x62, 0x2a, 0x44, 0x30, 0xb2, 0x5b, 0x24, 0x59, 0x17, 0xff, 0xce, 0x85, 0xba, 0x72,
-	0x82, 0xbe, 0x0a, 0x45, 0xb4, 0x32, 0x2a, 0x7c, 0xa7, 0xf2, 0xe2, 0x28, 0xf6, 0x41, 0x2a, 0xb4,
-	0xca, 0x07, 0x72, 0x6d, 0xc5, 0x07, 0x72, 0xe9, 0x63, 0xc7, 0x7d, 0xee, 0xc7, 0x4e, 0xf9, 0x91,
-	0x5d, 0x5f, 0xf1, 0xc8, 0xfe, 0x4a, 0xe5, 0xf4, 0x1b, 0xcf, 0x38, 0xfd, 0xf2, 0xb9, 0xa3, 0x3e,
-	0xb4, 0xec, 0x9a, 0x74, 0x56, 0x5a, 0x5c, 0x72, 0x06, 0xa3, 0x77, 0xa1, 0x69, 0xd6, 0xa4, 0x53,
-	0xd1, 0xc2, 0x82, 0x2d, 0xa8, 0x1d, 0x9a, 0xf5, 0xe8, 0x34, 0xb4, 0xb8, 0xdc, 0x0c, 0x46, 0x77,
-	0x61, 0x5b, 0x4c, 0x87, 0x22, 0x4c, 0xd9, 0x44, 0x47, 0xe7, 0x29, 0xa3, 0x67, 0xb6, 0xd0, 0xef,
-	0x14, 0x8b, 0xc8, 0xf1, 0x1f, 0x32, 0x7a, 0x46, 0xb6, 0xc4, 0xdc, 0x88, 0x12, 0xad, 0x5b, 0xa6,
-	0xa1, 0x83, 0x3c, 0x8d, 0xcd, 0xfd, 0x19, 0xc4, 0x22, 0xbc, 0x77, 0xc2, 0x52, 0x2a, 0x24, 0x4f,
-	0x69, 0x91, 0xd0, 0x0e, 0xa0, 0xc6, 0x85, 0x8d, 0xf6, 0x0a, 0x9f, 0x8b, 0x0a, 0x9f, 0x0b, 0x4c,
-	0x6a, 0x5c, 0xa0, 0x1f, 0xc1, 0x06, 0x13, 0x03, 0xbb, 0x8e, 0x21, 0xcd, 0x32, 0xd8, 0x6d, 0x6b,
-	0x7a, 0x43, 0x4f, 0x55, 0x26, 0x54, 0x67, 0xad, 0x20, 0xa4, 0xcb, 0xc4, 0x83, 0xbc, 0x8b, 0xee,
-	0x55, 0x2e, 0xa0, 0xa9, 0xb6, 0x07, 0xd6, 0xef, 0x7b, 0x73, 0xcf, 0xef, 0xb2, 0xd3, 0x67, 0xbc,
-	0xca, 0x8f, 0xa1, 0x7d, 0x12, 0xc6, 0x03, 0xc9, 0x1f, 0xd3, 0xec, 0x1f, 0x81, 0x1b, 0xd6, 0xdb,
-	0x3b, 0xca, 0x5b, 0x0e, 0x56, 0x9c, 0x15, 0xa3, 0xc4, 0x3b, 0x09, 0xe3, 0x87, 0xaa, 0xa9, 0x56,
-	0x16, 0xa6, 0x34, 0x90, 0x34, 0x1a, 0x04, 0xd2, 0xbe, 0x0c, 0x2b, 0x2b, 0x2b, 0xd0, 0x8a, 0xb3,
-	0xd2, 0x30, 0x69, 0xdb, 0xce, 0xb7, 0x25, 0xfe, 0xb7, 0x03, 0x5b, 0xf3, 0xda, 0xce, 0xed, 0xde,
-	0xf9, 0x7f, 0x77, 0x4f, 0xa0, 0x93, 0x9f, 0x74, 0x2a, 0x6c, 0x99, 0x7a, 0xdf, 0xfa, 0xeb, 0xdb,
-	0xa7, 0x41, 0x06, 0x57, 0x1c, 0x96, 0xc7, 0x49, 0xd9, 0x09, 0xfa, 0x16, 0x34, 0x99, 0x18, 0x3c,
-	0xe2, 0x26, 0xd7, 0x7b, 0x47, 0xef, 0x59, 0x77, 0x3d, 0x2b, 0xfa, 0x23, 0x2e, 0xe7, 0xd5, 0x56,
-	0x43, 0xa4, 0xc1, 0xc4, 0x77, 0xb9, 0x3c, 0x3a, 0xfc, 0xeb, 0x65, 0xef, 0xca, 0xd3, 0xcb, 0x9e,
-	0xf3, 0xcf, 0xcb, 0x9e, 0xf3, 0x9f, 0xcb, 0x9e, 0xf3, 0xb3, 0x59, 0xcf, 0xf9, 0xf5, 0xac, 0xe7,
-	0xfc, 0x61, 0xd6, 0x73, 0xfe, 0x34, 0xeb, 0x39, 0x9f, 0xce, 0x7a, 0xce, 0x5f, 0x66, 0x3d, 0xe7,
-	0xe9, 0xac, 0xe7, 0xfc, 0xf2, 0xef, 0xbd, 0x2b, 0x3f, 0x36, 0xff, 0xe8, 0xfe, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x20, 0x98, 0x4c, 0xf3, 0x15, 0x00, 0x00,
+func (m *SectionHistory) Reset()      { *m = SectionHistory{} }
+func (*SectionHistory) ProtoMessage() {}
+func (*SectionHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{17}
 }
-
-func (this *University) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *SectionHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SectionHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SectionHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *SectionHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SectionHistory.Merge(m, src)
+}
+func (m *SectionHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *SectionHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_SectionHistory.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*University)
-	if !ok {
-		that2, ok := that.(University)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *University")
-		}
+var xxx_messageInfo_SectionHistory proto.InternalMessageInfo
+
+func (m *SectionHistory) GetTopicName() string {
+	if m != nil {
+		return m.TopicName
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *University but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *University but is not nil && this == nil")
+	return ""
+}
+
+func (m *SectionHistory) GetSnapshots() []*SectionSnapshot {
+	if m != nil {
+		return m.Snapshots
 	}
-	if this.Id != that1.Id {
-		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
-	}
-	if this.Name != that1.Name {
-		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
-	}
-	if this.Abbr != that1.Abbr {
-		return fmt.Errorf("Abbr this(%v) Not Equal that(%v)", this.Abbr, that1.Abbr)
-	}
-	if this.HomePage != that1.HomePage {
-		return fmt.Errorf("HomePage this(%v) Not Equal that(%v)", this.HomePage, that1.HomePage)
+	return nil
+}
+
+func (m *SectionHistory) GetStats() *SectionHistoryStats {
+	if m != nil {
+		return m.Stats
 	}
-	if this.RegistrationPage != that1.RegistrationPage {
-		return fmt.Errorf("RegistrationPage this(%v) Not Equal that(%v)", this.RegistrationPage, that1.RegistrationPage)
+	return nil
+}
+
+// The enrollment of a section from the time it was recorded until the next snapshot.
+type SectionSnapshot struct {
+	Now    int64  `protobuf:"varint,1,opt,name=now" json:"now" db:"now"`
+	Max    int64  `protobuf:"varint,2,opt,name=max" json:"max" db:"max"`
+	Status string `protobuf:"bytes,3,opt,name=status" json:"status" db:"status"`
+	// Unix time in seconds
+	CreatedAt            int64    `protobuf:"varint,4,opt,name=created_at,json=createdAt" json:"created_at" db:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SectionSnapshot) Reset()      { *m = SectionSnapshot{} }
+func (*SectionSnapshot) ProtoMessage() {}
+func (*SectionSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{18}
+}
+func (m *SectionSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SectionSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SectionSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.MainColor != that1.MainColor {
-		return fmt.Errorf("MainColor this(%v) Not Equal that(%v)", this.MainColor, that1.MainColor)
+}
+func (m *SectionSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SectionSnapshot.Merge(m, src)
+}
+func (m *SectionSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SectionSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SectionSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SectionSnapshot proto.InternalMessageInfo
+
+func (m *SectionSnapshot) GetNow() int64 {
+	if m != nil {
+		return m.Now
 	}
-	if this.AccentColor != that1.AccentColor {
-		return fmt.Errorf("AccentColor this(%v) Not Equal that(%v)", this.AccentColor, that1.AccentColor)
+	return 0
+}
+
+func (m *SectionSnapshot) GetMax() int64 {
+	if m != nil {
+		return m.Max
 	}
-	if this.TopicName != that1.TopicName {
-		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+	return 0
+}
+
+func (m *SectionSnapshot) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if this.TopicId != that1.TopicId {
-		return fmt.Errorf("TopicId this(%v) Not Equal that(%v)", this.TopicId, that1.TopicId)
+	return ""
+}
+
+func (m *SectionSnapshot) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if !this.ResolvedSemesters.Equal(that1.ResolvedSemesters) {
-		return fmt.Errorf("ResolvedSemesters this(%v) Not Equal that(%v)", this.ResolvedSemesters, that1.ResolvedSemesters)
+	return 0
+}
+
+type SectionHistoryStats struct {
+	// Seconds from the first open snapshot until the section first closed, 0 if it never filled.
+	TimeToFill int64 `protobuf:"varint,1,opt,name=time_to_fill,json=timeToFill" json:"time_to_fill"`
+	// Number of times the section went from closed to open.
+	Reopenings int64 `protobuf:"varint,2,opt,name=reopenings" json:"reopenings"`
+	// Hour of the day in the time zone of the university that the section most often reopened, -1 if it never reopened.
+	TypicalOpenHour      int32    `protobuf:"varint,3,opt,name=typical_open_hour,json=typicalOpenHour" json:"typical_open_hour"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SectionHistoryStats) Reset()      { *m = SectionHistoryStats{} }
+func (*SectionHistoryStats) ProtoMessage() {}
+func (*SectionHistoryStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{19}
+}
+func (m *SectionHistoryStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SectionHistoryStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SectionHistoryStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(this.Subjects) != len(that1.Subjects) {
-		return fmt.Errorf("Subjects this(%v) Not Equal that(%v)", len(this.Subjects), len(that1.Subjects))
+}
+func (m *SectionHistoryStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SectionHistoryStats.Merge(m, src)
+}
+func (m *SectionHistoryStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SectionHistoryStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SectionHistoryStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SectionHistoryStats proto.InternalMessageInfo
+
+func (m *SectionHistoryStats) GetTimeToFill() int64 {
+	if m != nil {
+		return m.TimeToFill
 	}
-	for i := range this.Subjects {
-		if !this.Subjects[i].Equal(that1.Subjects[i]) {
-			return fmt.Errorf("Subjects this[%v](%v) Not Equal that[%v](%v)", i, this.Subjects[i], i, that1.Subjects[i])
-		}
+	return 0
+}
+
+func (m *SectionHistoryStats) GetReopenings() int64 {
+	if m != nil {
+		return m.Reopenings
 	}
-	if len(this.AvailableSemesters) != len(that1.AvailableSemesters) {
-		return fmt.Errorf("AvailableSemesters this(%v) Not Equal that(%v)", len(this.AvailableSemesters), len(that1.AvailableSemesters))
+	return 0
+}
+
+func (m *SectionHistoryStats) GetTypicalOpenHour() int32 {
+	if m != nil {
+		return m.TypicalOpenHour
 	}
-	for i := range this.AvailableSemesters {
-		if !this.AvailableSemesters[i].Equal(that1.AvailableSemesters[i]) {
-			return fmt.Errorf("AvailableSemesters this[%v](%v) Not Equal that[%v](%v)", i, this.AvailableSemesters[i], i, that1.AvailableSemesters[i])
+	return 0
+}
+
+type SearchResult struct {
+	// One of course, section or instructor
+	Kind string `protobuf:"bytes,1,opt,name=kind" json:"kind" db:"kind"`
+	// Empty for instructors
+	TopicName            string   `protobuf:"bytes,2,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name" json:"name" db:"name"`
+	Rank                 float64  `protobuf:"fixed64,4,opt,name=rank" json:"rank" db:"rank"`
+	Course               *Course  `protobuf:"bytes,5,opt,name=course" json:"course,omitempty"`
+	Section              *Section `protobuf:"bytes,6,opt,name=section" json:"section,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResult) Reset()      { *m = SearchResult{} }
+func (*SearchResult) ProtoMessage() {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{20}
+}
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.Registrations) != len(that1.Registrations) {
-		return fmt.Errorf("Registrations this(%v) Not Equal that(%v)", len(this.Registrations), len(that1.Registrations))
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+func (m *SearchResult) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	for i := range this.Registrations {
-		if !this.Registrations[i].Equal(that1.Registrations[i]) {
-			return fmt.Errorf("Registrations this[%v](%v) Not Equal that[%v](%v)", i, this.Registrations[i], i, that1.Registrations[i])
-		}
+	return ""
+}
+
+func (m *SearchResult) GetTopicName() string {
+	if m != nil {
+		return m.TopicName
 	}
-	if len(this.Metadata) != len(that1.Metadata) {
-		return fmt.Errorf("Metadata this(%v) Not Equal that(%v)", len(this.Metadata), len(that1.Metadata))
+	return ""
+}
+
+func (m *SearchResult) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	for i := range this.Metadata {
-		if !this.Metadata[i].Equal(that1.Metadata[i]) {
-			return fmt.Errorf("Metadata this[%v](%v) Not Equal that[%v](%v)", i, this.Metadata[i], i, that1.Metadata[i])
-		}
+	return ""
+}
+
+func (m *SearchResult) GetRank() float64 {
+	if m != nil {
+		return m.Rank
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	return 0
+}
+
+func (m *SearchResult) GetCourse() *Course {
+	if m != nil {
+		return m.Course
 	}
 	return nil
 }
-func (this *University) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SearchResult) GetSection() *Section {
+	if m != nil {
+		return m.Section
 	}
+	return nil
+}
 
-	that1, ok := that.(*University)
-	if !ok {
-		that2, ok := that.(University)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Page struct {
+	Offset int64 `protobuf:"varint,1,opt,name=offset" json:"offset"`
+	Limit  int64 `protobuf:"varint,2,opt,name=limit" json:"limit"`
+	// Number of results across all pages
+	Total                int64    `protobuf:"varint,3,opt,name=total" json:"total"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Page) Reset()      { *m = Page{} }
+func (*Page) ProtoMessage() {}
+func (*Page) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{21}
+}
+func (m *Page) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Page) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Page.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
+}
+func (m *Page) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Page.Merge(m, src)
+}
+func (m *Page) XXX_Size() int {
+	return m.Size()
+}
+func (m *Page) XXX_DiscardUnknown() {
+	xxx_messageInfo_Page.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Page proto.InternalMessageInfo
+
+func (m *Page) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	if this.Abbr != that1.Abbr {
-		return false
+	return 0
+}
+
+func (m *Page) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	if this.HomePage != that1.HomePage {
-		return false
+	return 0
+}
+
+func (m *Page) GetTotal() int64 {
+	if m != nil {
+		return m.Total
 	}
-	if this.RegistrationPage != that1.RegistrationPage {
-		return false
+	return 0
+}
+
+type Schedule struct {
+	// One section of each requested course, in the order the courses were requested
+	Sections []*Section `protobuf:"bytes,1,rep,name=sections" json:"sections,omitempty"`
+	// Number of days with at least one meeting
+	Days int32 `protobuf:"varint,2,opt,name=days" json:"days"`
+	// Minutes between consecutive meetings on the same day, summed over the week
+	GapMinutes int32 `protobuf:"varint,3,opt,name=gap_minutes,json=gapMinutes" json:"gap_minutes"`
+	// Earliest start time of any meeting, in minutes since midnight
+	EarliestStart        int32    `protobuf:"varint,4,opt,name=earliest_start,json=earliestStart" json:"earliest_start"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Schedule) Reset()      { *m = Schedule{} }
+func (*Schedule) ProtoMessage() {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{22}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.MainColor != that1.MainColor {
-		return false
-	}
-	if this.AccentColor != that1.AccentColor {
-		return false
-	}
-	if this.TopicName != that1.TopicName {
-		return false
-	}
-	if this.TopicId != that1.TopicId {
-		return false
-	}
-	if !this.ResolvedSemesters.Equal(that1.ResolvedSemesters) {
-		return false
-	}
-	if len(this.Subjects) != len(that1.Subjects) {
-		return false
-	}
-	for i := range this.Subjects {
-		if !this.Subjects[i].Equal(that1.Subjects[i]) {
-			return false
-		}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetSections() []*Section {
+	if m != nil {
+		return m.Sections
 	}
-	if len(this.AvailableSemesters) != len(that1.AvailableSemesters) {
-		return false
+	return nil
+}
+
+func (m *Schedule) GetDays() int32 {
+	if m != nil {
+		return m.Days
 	}
-	for i := range this.AvailableSemesters {
-		if !this.AvailableSemesters[i].Equal(that1.AvailableSemesters[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *Schedule) GetGapMinutes() int32 {
+	if m != nil {
+		return m.GapMinutes
 	}
-	if len(this.Registrations) != len(that1.Registrations) {
-		return false
+	return 0
+}
+
+func (m *Schedule) GetEarliestStart() int32 {
+	if m != nil {
+		return m.EarliestStart
 	}
-	for i := range this.Registrations {
-		if !this.Registrations[i].Equal(that1.Registrations[i]) {
-			return false
+	return 0
+}
+
+// An expression of the courses required before taking a course. Either an operator over its operands
+// or a reference to a single course.
+type Prerequisite struct {
+	// One of and, or. Empty for course references
+	Operator      string          `protobuf:"bytes,1,opt,name=operator" json:"operator"`
+	Operands      []*Prerequisite `protobuf:"bytes,2,rep,name=operands" json:"operands,omitempty"`
+	SubjectNumber string          `protobuf:"bytes,3,opt,name=subject_number,json=subjectNumber" json:"subject_number"`
+	CourseNumber  string          `protobuf:"bytes,4,opt,name=course_number,json=courseNumber" json:"course_number"`
+	// Topic name of the referenced course when it is offered in the same semester
+	TopicName            string   `protobuf:"bytes,5,opt,name=topic_name,json=topicName" json:"topic_name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Prerequisite) Reset()      { *m = Prerequisite{} }
+func (*Prerequisite) ProtoMessage() {}
+func (*Prerequisite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{23}
+}
+func (m *Prerequisite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Prerequisite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Prerequisite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.Metadata) != len(that1.Metadata) {
-		return false
+}
+func (m *Prerequisite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Prerequisite.Merge(m, src)
+}
+func (m *Prerequisite) XXX_Size() int {
+	return m.Size()
+}
+func (m *Prerequisite) XXX_DiscardUnknown() {
+	xxx_messageInfo_Prerequisite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Prerequisite proto.InternalMessageInfo
+
+func (m *Prerequisite) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	for i := range this.Metadata {
-		if !this.Metadata[i].Equal(that1.Metadata[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *Prerequisite) GetOperands() []*Prerequisite {
+	if m != nil {
+		return m.Operands
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return nil
+}
+
+func (m *Prerequisite) GetSubjectNumber() string {
+	if m != nil {
+		return m.SubjectNumber
 	}
-	return true
+	return ""
 }
-func (this *Subject) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+
+func (m *Prerequisite) GetCourseNumber() string {
+	if m != nil {
+		return m.CourseNumber
 	}
+	return ""
+}
 
-	that1, ok := that.(*Subject)
-	if !ok {
-		that2, ok := that.(Subject)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Subject")
-		}
+func (m *Prerequisite) GetTopicName() string {
+	if m != nil {
+		return m.TopicName
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
+	return ""
+}
+
+// A section of another course that shares its seats and meetings with a section.
+type CrossListing struct {
+	SubjectNumber string `protobuf:"bytes,1,opt,name=subject_number,json=subjectNumber" json:"subject_number"`
+	CourseNumber  string `protobuf:"bytes,2,opt,name=course_number,json=courseNumber" json:"course_number"`
+	SectionNumber string `protobuf:"bytes,3,opt,name=section_number,json=sectionNumber" json:"section_number"`
+	// Topic name of the linked section when it is offered by the same university in the same semester
+	TopicName            string   `protobuf:"bytes,4,opt,name=topic_name,json=topicName" json:"topic_name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CrossListing) Reset()      { *m = CrossListing{} }
+func (*CrossListing) ProtoMessage() {}
+func (*CrossListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{24}
+}
+func (m *CrossListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that is type *Subject but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Subject but is not nil && this == nil")
-	}
-	if this.Id != that1.Id {
-		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
-	}
-	if this.UniversityId != that1.UniversityId {
-		return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", this.UniversityId, that1.UniversityId)
-	}
-	if this.Name != that1.Name {
-		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
-	}
-	if this.Number != that1.Number {
-		return fmt.Errorf("Number this(%v) Not Equal that(%v)", this.Number, that1.Number)
-	}
-	if this.Season != that1.Season {
-		return fmt.Errorf("Season this(%v) Not Equal that(%v)", this.Season, that1.Season)
+		return b[:n], nil
 	}
-	if this.Year != that1.Year {
-		return fmt.Errorf("Year this(%v) Not Equal that(%v)", this.Year, that1.Year)
+}
+func (m *CrossListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossListing.Merge(m, src)
+}
+func (m *CrossListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossListing proto.InternalMessageInfo
+
+func (m *CrossListing) GetSubjectNumber() string {
+	if m != nil {
+		return m.SubjectNumber
 	}
-	if this.TopicName != that1.TopicName {
-		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+	return ""
+}
+
+func (m *CrossListing) GetCourseNumber() string {
+	if m != nil {
+		return m.CourseNumber
 	}
-	if this.TopicId != that1.TopicId {
-		return fmt.Errorf("TopicId this(%v) Not Equal that(%v)", this.TopicId, that1.TopicId)
+	return ""
+}
+
+func (m *CrossListing) GetSectionNumber() string {
+	if m != nil {
+		return m.SectionNumber
 	}
-	if len(this.Courses) != len(that1.Courses) {
-		return fmt.Errorf("Courses this(%v) Not Equal that(%v)", len(this.Courses), len(that1.Courses))
+	return ""
+}
+
+func (m *CrossListing) GetTopicName() string {
+	if m != nil {
+		return m.TopicName
 	}
-	for i := range this.Courses {
-		if !this.Courses[i].Equal(that1.Courses[i]) {
-			return fmt.Errorf("Courses this[%v](%v) Not Equal that[%v](%v)", i, this.Courses[i], i, that1.Courses[i])
+	return ""
+}
+
+// An instructor of a university and the courses they have taught.
+type InstructorProfile struct {
+	Name      string `protobuf:"bytes,1,opt,name=name" json:"name" db:"name"`
+	TopicName string `protobuf:"bytes,2,opt,name=topic_name,json=topicName" json:"topic_name" db:"topic_name"`
+	// Newest semester first
+	Teaching             []*Teaching `protobuf:"bytes,3,rep,name=teaching" json:"teaching,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *InstructorProfile) Reset()      { *m = InstructorProfile{} }
+func (*InstructorProfile) ProtoMessage() {}
+func (*InstructorProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{25}
+}
+func (m *InstructorProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstructorProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstructorProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.Metadata) != len(that1.Metadata) {
-		return fmt.Errorf("Metadata this(%v) Not Equal that(%v)", len(this.Metadata), len(that1.Metadata))
+}
+func (m *InstructorProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstructorProfile.Merge(m, src)
+}
+func (m *InstructorProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstructorProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstructorProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstructorProfile proto.InternalMessageInfo
+
+func (m *InstructorProfile) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	for i := range this.Metadata {
-		if !this.Metadata[i].Equal(that1.Metadata[i]) {
-			return fmt.Errorf("Metadata this[%v](%v) Not Equal that[%v](%v)", i, this.Metadata[i], i, that1.Metadata[i])
-		}
+	return ""
+}
+
+func (m *InstructorProfile) GetTopicName() string {
+	if m != nil {
+		return m.TopicName
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	return ""
+}
+
+func (m *InstructorProfile) GetTeaching() []*Teaching {
+	if m != nil {
+		return m.Teaching
 	}
 	return nil
 }
-func (this *Subject) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Subject)
-	if !ok {
-		that2, ok := that.(Subject)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.UniversityId != that1.UniversityId {
-		return false
-	}
-	if this.Name != that1.Name {
-		return false
+// A course an instructor taught in a semester.
+type Teaching struct {
+	Season string `protobuf:"bytes,1,opt,name=season" json:"season"`
+	Year   string `protobuf:"bytes,2,opt,name=year" json:"year"`
+	// Only includes the sections taught by the instructor
+	Course               *Course  `protobuf:"bytes,3,opt,name=course" json:"course,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Teaching) Reset()      { *m = Teaching{} }
+func (*Teaching) ProtoMessage() {}
+func (*Teaching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{26}
+}
+func (m *Teaching) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Teaching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Teaching.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Number != that1.Number {
-		return false
+}
+func (m *Teaching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Teaching.Merge(m, src)
+}
+func (m *Teaching) XXX_Size() int {
+	return m.Size()
+}
+func (m *Teaching) XXX_DiscardUnknown() {
+	xxx_messageInfo_Teaching.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Teaching proto.InternalMessageInfo
+
+func (m *Teaching) GetSeason() string {
+	if m != nil {
+		return m.Season
 	}
-	if this.Season != that1.Season {
-		return false
+	return ""
+}
+
+func (m *Teaching) GetYear() string {
+	if m != nil {
+		return m.Year
 	}
-	if this.Year != that1.Year {
-		return false
+	return ""
+}
+
+func (m *Teaching) GetCourse() *Course {
+	if m != nil {
+		return m.Course
 	}
-	if this.TopicName != that1.TopicName {
-		return false
+	return nil
+}
+
+// A building on a campus of a university. Code is the prefix rooms in the building are written with, e.g HLL in HLL-116.
+type Building struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id" json:"-" db:"id"`
+	UniversityId         int64    `protobuf:"varint,2,opt,name=university_id,json=universityId" json:"-" db:"university_id"`
+	Code                 string   `protobuf:"bytes,3,opt,name=code" json:"code" db:"code"`
+	Name                 *string  `protobuf:"bytes,4,opt,name=name" json:"name,omitempty" db:"name"`
+	Campus               *string  `protobuf:"bytes,5,opt,name=campus" json:"campus,omitempty" db:"campus"`
+	Latitude             *float64 `protobuf:"fixed64,6,opt,name=latitude" json:"latitude,omitempty" db:"latitude"`
+	Longitude            *float64 `protobuf:"fixed64,7,opt,name=longitude" json:"longitude,omitempty" db:"longitude"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Building) Reset()      { *m = Building{} }
+func (*Building) ProtoMessage() {}
+func (*Building) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{27}
+}
+func (m *Building) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Building) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Building.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.TopicId != that1.TopicId {
-		return false
+}
+func (m *Building) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Building.Merge(m, src)
+}
+func (m *Building) XXX_Size() int {
+	return m.Size()
+}
+func (m *Building) XXX_DiscardUnknown() {
+	xxx_messageInfo_Building.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Building proto.InternalMessageInfo
+
+func (m *Building) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(this.Courses) != len(that1.Courses) {
-		return false
+	return 0
+}
+
+func (m *Building) GetUniversityId() int64 {
+	if m != nil {
+		return m.UniversityId
 	}
-	for i := range this.Courses {
-		if !this.Courses[i].Equal(that1.Courses[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *Building) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	if len(this.Metadata) != len(that1.Metadata) {
-		return false
+	return ""
+}
+
+func (m *Building) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	for i := range this.Metadata {
-		if !this.Metadata[i].Equal(that1.Metadata[i]) {
-			return false
+	return ""
+}
+
+func (m *Building) GetCampus() string {
+	if m != nil && m.Campus != nil {
+		return *m.Campus
+	}
+	return ""
+}
+
+func (m *Building) GetLatitude() float64 {
+	if m != nil && m.Latitude != nil {
+		return *m.Latitude
+	}
+	return 0
+}
+
+func (m *Building) GetLongitude() float64 {
+	if m != nil && m.Longitude != nil {
+		return *m.Longitude
+	}
+	return 0
+}
+
+// Wraps a scraped university on its way from a scraper to ein, so that a payload written with an incompatible
+// schema is never ingested. The envelope is written before the payload and the content hash after it, so a
+// payload can be sealed and verified as it is streamed.
+type Envelope struct {
+	// major.minor version of this schema the payload was written with, e.g 1.0
+	SchemaVersion string `protobuf:"bytes,1,opt,name=schema_version,json=schemaVersion" json:"schema_version"`
+	// Name of the scraper that produced the payload, e.g rutgers-NB
+	Producer string `protobuf:"bytes,2,opt,name=producer" json:"producer"`
+	// Unix time in seconds the envelope was written, before the payload
+	ScrapedAt int64 `protobuf:"varint,3,opt,name=scraped_at,json=scrapedAt" json:"scraped_at"`
+	// Hex encoded sha256 of the payload, only set in the envelope written after the payload
+	ContentHash string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash" json:"content_hash"`
+	// One of protobuf, json or stream
+	Format               string   `protobuf:"bytes,5,opt,name=format" json:"format"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Envelope) Reset()      { *m = Envelope{} }
+func (*Envelope) ProtoMessage() {}
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad522f3927c3aa3, []int{28}
+}
+func (m *Envelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Envelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Envelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+}
+func (m *Envelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Envelope.Merge(m, src)
+}
+func (m *Envelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *Envelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_Envelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Envelope proto.InternalMessageInfo
+
+func (m *Envelope) GetSchemaVersion() string {
+	if m != nil {
+		return m.SchemaVersion
 	}
-	return true
+	return ""
 }
-func (this *Course) VerboseEqual(that interface{}) error {
+
+func (m *Envelope) GetProducer() string {
+	if m != nil {
+		return m.Producer
+	}
+	return ""
+}
+
+func (m *Envelope) GetScrapedAt() int64 {
+	if m != nil {
+		return m.ScrapedAt
+	}
+	return 0
+}
+
+func (m *Envelope) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *Envelope) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("model.Weekday", Weekday_name, Weekday_value)
+	proto.RegisterType((*University)(nil), "model.University")
+	proto.RegisterType((*Subject)(nil), "model.Subject")
+	proto.RegisterType((*Course)(nil), "model.Course")
+	proto.RegisterType((*Section)(nil), "model.Section")
+	proto.RegisterType((*Meeting)(nil), "model.Meeting")
+	proto.RegisterType((*Instructor)(nil), "model.Instructor")
+	proto.RegisterType((*Book)(nil), "model.Book")
+	proto.RegisterType((*Metadata)(nil), "model.Metadata")
+	proto.RegisterType((*Registration)(nil), "model.Registration")
+	proto.RegisterType((*ResolvedSemester)(nil), "model.ResolvedSemester")
+	proto.RegisterType((*Semester)(nil), "model.Semester")
+	proto.RegisterType((*UCTNotification)(nil), "model.UCTNotification")
+	proto.RegisterType((*Response)(nil), "model.Response")
+	proto.RegisterType((*Meta)(nil), "model.Meta")
+	proto.RegisterType((*Data)(nil), "model.Data")
+	proto.RegisterType((*Subscription)(nil), "model.Subscription")
+	proto.RegisterType((*SubscriptionView)(nil), "model.SubscriptionView")
+	proto.RegisterType((*SectionHistory)(nil), "model.SectionHistory")
+	proto.RegisterType((*SectionSnapshot)(nil), "model.SectionSnapshot")
+	proto.RegisterType((*SectionHistoryStats)(nil), "model.SectionHistoryStats")
+	proto.RegisterType((*SearchResult)(nil), "model.SearchResult")
+	proto.RegisterType((*Page)(nil), "model.Page")
+	proto.RegisterType((*Schedule)(nil), "model.Schedule")
+	proto.RegisterType((*Prerequisite)(nil), "model.Prerequisite")
+	proto.RegisterType((*CrossListing)(nil), "model.CrossListing")
+	proto.RegisterType((*InstructorProfile)(nil), "model.InstructorProfile")
+	proto.RegisterType((*Teaching)(nil), "model.Teaching")
+	proto.RegisterType((*Building)(nil), "model.Building")
+	proto.RegisterType((*Envelope)(nil), "model.Envelope")
+}
+
+func init() { proto.RegisterFile("common/model/model.proto", fileDescriptor_3ad522f3927c3aa3) }
+
+var fileDescriptor_3ad522f3927c3aa3 = []byte{
+	// 2963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x3d, 0x6c, 0x1c, 0xc7,
+	0xf5, 0xd7, 0xde, 0xf7, 0xbd, 0xbb, 0xe3, 0xc7, 0xc8, 0x92, 0xf6, 0x6f, 0x1b, 0x27, 0x7a, 0x64,
+	0xd9, 0xb4, 0x65, 0x51, 0xfa, 0xcb, 0xb2, 0xe5, 0xaf, 0xff, 0x1f, 0x16, 0x25, 0x3b, 0x62, 0x62,
+	0xd1, 0xc6, 0x90, 0xb2, 0x61, 0x23, 0xc0, 0x61, 0xb9, 0x3b, 0xe4, 0x6d, 0xb8, 0xb7, 0x73, 0xd9,
+	0xd9, 0x13, 0xc5, 0x54, 0x69, 0x82, 0x34, 0x01, 0x52, 0x05, 0x48, 0x13, 0x24, 0x45, 0x0a, 0x37,
+	0x41, 0x80, 0x34, 0x71, 0x15, 0xa4, 0x34, 0x92, 0x26, 0x65, 0x2a, 0xc5, 0x62, 0xba, 0x54, 0x41,
+	0x90, 0x22, 0x4d, 0x90, 0x60, 0xbe, 0x76, 0x77, 0xee, 0x8e, 0xe4, 0x51, 0x41, 0xd4, 0x10, 0xfb,
+	0xde, 0xef, 0xf7, 0x66, 0x67, 0x67, 0xde, 0x7b, 0xf3, 0xe6, 0x1d, 0xc1, 0xf5, 0xd9, 0x60, 0xc0,
+	0xe2, 0x2b, 0x03, 0x16, 0xd0, 0x48, 0xfd, 0x5d, 0x19, 0x26, 0x2c, 0x65, 0xa8, 0x2a, 0x85, 0xa7,
+	0x2f, 0xef, 0x84, 0x69, 0x7f, 0xb4, 0xb5, 0xe2, 0xb3, 0xc1, 0x95, 0x1d, 0xb6, 0xc3, 0xae, 0x48,
+	0x74, 0x6b, 0xb4, 0x2d, 0x25, 0x29, 0xc8, 0x27, 0x65, 0x85, 0xff, 0x54, 0x03, 0xb8, 0x17, 0x87,
+	0xf7, 0x69, 0xc2, 0xc3, 0x74, 0x1f, 0x9d, 0x87, 0x52, 0x18, 0xb8, 0xce, 0x92, 0xb3, 0x5c, 0x5e,
+	0x9d, 0xff, 0xf2, 0xe1, 0xf9, 0x53, 0x7f, 0x7b, 0x78, 0xbe, 0x1e, 0x6c, 0xbd, 0x85, 0xc3, 0x00,
+	0x93, 0x52, 0x18, 0xa0, 0x8b, 0x50, 0x89, 0xbd, 0x01, 0x75, 0x4b, 0x4b, 0xce, 0x72, 0x73, 0x75,
+	0x51, 0x53, 0x9a, 0x82, 0x22, 0xf4, 0x98, 0x48, 0x58, 0xd0, 0xbc, 0xad, 0xad, 0xc4, 0x2d, 0x4f,
+	0xd2, 0x84, 0x1e, 0x13, 0x09, 0xa3, 0x57, 0xa1, 0xd9, 0x67, 0x03, 0xda, 0x1b, 0x7a, 0x3b, 0xd4,
+	0xad, 0x48, 0xee, 0x59, 0xcd, 0x9d, 0x13, 0xdc, 0x0c, 0xc4, 0xa4, 0x21, 0x9e, 0x3f, 0xf2, 0x76,
+	0x28, 0xfa, 0x06, 0x2c, 0x26, 0x74, 0x27, 0xe4, 0x69, 0xe2, 0xa5, 0x21, 0x8b, 0x95, 0x71, 0x55,
+	0x1a, 0x77, 0xb5, 0xf1, 0x59, 0x61, 0x3c, 0x41, 0xc2, 0x64, 0xa1, 0xa8, 0x93, 0x83, 0xbd, 0x0e,
+	0x30, 0xf0, 0xc2, 0xb8, 0xe7, 0xb3, 0x88, 0x25, 0x6e, 0x4d, 0x8e, 0x72, 0x4e, 0x8f, 0x32, 0x2f,
+	0x46, 0xc9, 0x51, 0x4c, 0x9a, 0x42, 0xb8, 0x25, 0x9e, 0xd1, 0x3b, 0xd0, 0xf6, 0x7c, 0x9f, 0xc6,
+	0xa9, 0xb6, 0xac, 0x4b, 0xcb, 0xff, 0xd1, 0x96, 0x8b, 0xf2, 0x43, 0x0b, 0x38, 0x26, 0x2d, 0x25,
+	0x2a, 0xeb, 0xd7, 0x01, 0x52, 0x36, 0x0c, 0xfd, 0x9e, 0x5c, 0xcb, 0xc6, 0xe4, 0x5b, 0x73, 0x14,
+	0x93, 0xa6, 0x14, 0xd6, 0xc5, 0xb2, 0x5e, 0x85, 0x86, 0x42, 0xc2, 0xc0, 0x6d, 0x4a, 0xab, 0x33,
+	0xda, 0xaa, 0x93, 0x5b, 0x89, 0xad, 0xaa, 0xcb, 0xc7, 0xb5, 0x00, 0xbd, 0x0f, 0x28, 0xa1, 0x9c,
+	0x45, 0xf7, 0x69, 0xd0, 0xe3, 0x74, 0x40, 0x79, 0x4a, 0x13, 0xee, 0xc2, 0x92, 0xb3, 0xdc, 0xba,
+	0x76, 0x6e, 0x45, 0xf9, 0x0f, 0xd1, 0x84, 0x0d, 0x8d, 0x93, 0xc5, 0x64, 0x4c, 0xc3, 0xd1, 0xcb,
+	0xd0, 0xe0, 0xa3, 0xad, 0x6f, 0x51, 0x3f, 0xe5, 0x6e, 0x6b, 0xa9, 0xbc, 0xdc, 0xba, 0x36, 0xa7,
+	0xad, 0x37, 0x94, 0x9a, 0x64, 0x38, 0x7a, 0x17, 0x4e, 0x7b, 0xf7, 0xbd, 0x30, 0xf2, 0xb6, 0x22,
+	0x5a, 0x78, 0x69, 0x5b, 0x9a, 0xcd, 0x1b, 0x33, 0xf3, 0x32, 0x94, 0x71, 0xf3, 0xb7, 0xbd, 0x09,
+	0x9d, 0xe2, 0x4e, 0x71, 0xb7, 0x23, 0x6d, 0x4f, 0x67, 0x13, 0xce, 0x31, 0x62, 0x33, 0xd1, 0x25,
+	0x68, 0x0c, 0x68, 0xea, 0x05, 0x5e, 0xea, 0xb9, 0x73, 0xd6, 0x1b, 0xef, 0x6a, 0x35, 0xc9, 0x08,
+	0xc2, 0xff, 0xd2, 0x70, 0x40, 0x7b, 0xdf, 0x61, 0x31, 0x75, 0xe7, 0x27, 0xfd, 0x2f, 0x03, 0x31,
+	0x69, 0x88, 0xe7, 0xcf, 0x58, 0x4c, 0xd1, 0x65, 0x68, 0x6e, 0x8d, 0xc2, 0x28, 0x08, 0xe3, 0x1d,
+	0xee, 0x2e, 0x58, 0xaf, 0x58, 0xd5, 0x7a, 0x92, 0x33, 0xf0, 0xf7, 0xaa, 0x50, 0xd7, 0x6b, 0x84,
+	0x9e, 0x2f, 0x84, 0xd7, 0x53, 0xe2, 0x45, 0x7f, 0x79, 0x78, 0xde, 0xb9, 0x3c, 0x1e, 0x63, 0xb7,
+	0xa1, 0x33, 0xca, 0x42, 0x52, 0x6c, 0x75, 0x49, 0x1a, 0x9c, 0x2f, 0x1a, 0x20, 0x61, 0x60, 0xb1,
+	0x30, 0x69, 0xe7, 0xf2, 0x5a, 0x1e, 0xa9, 0xe5, 0xa3, 0x23, 0xf5, 0x12, 0xd4, 0xe2, 0xd1, 0x60,
+	0x8b, 0x26, 0x3a, 0xfe, 0x4e, 0x6b, 0x62, 0x4b, 0x12, 0x25, 0x82, 0x89, 0xa6, 0x08, 0x32, 0xa7,
+	0x1e, 0x67, 0xb1, 0x5b, 0x9d, 0x24, 0x2b, 0x04, 0x13, 0x4d, 0x11, 0x13, 0xd8, 0xa7, 0x9e, 0x09,
+	0x2a, 0x6b, 0x02, 0x42, 0x8f, 0x89, 0x84, 0xc7, 0x62, 0xa1, 0xfe, 0x58, 0xb1, 0xd0, 0x98, 0x29,
+	0x16, 0x5e, 0x84, 0xba, 0xcf, 0x46, 0x09, 0xa7, 0xdc, 0x6d, 0xca, 0x6d, 0xeb, 0xe8, 0x6d, 0xbb,
+	0x25, 0xb5, 0xc4, 0xa0, 0x96, 0x0f, 0xc1, 0x2c, 0x3e, 0x44, 0x93, 0x41, 0xcf, 0x67, 0x01, 0x75,
+	0x5b, 0x4b, 0xce, 0x72, 0x75, 0xcc, 0x87, 0x0c, 0x28, 0x7c, 0x88, 0x26, 0x83, 0x5b, 0x2c, 0xa0,
+	0x99, 0x91, 0xfc, 0xe6, 0xf6, 0x14, 0xc7, 0x33, 0xa0, 0x36, 0x92, 0x5f, 0xfc, 0x0e, 0xb4, 0x7d,
+	0x16, 0xa7, 0x22, 0xa9, 0xf4, 0x3d, 0xde, 0x77, 0x3b, 0x93, 0x39, 0xa7, 0x88, 0x63, 0xd2, 0xd2,
+	0xe2, 0x1d, 0x21, 0x7d, 0x5e, 0x81, 0x9a, 0xfa, 0xd0, 0x19, 0xdd, 0xf0, 0x6d, 0x00, 0x1d, 0xd2,
+	0xb9, 0x0f, 0x3e, 0x5b, 0x64, 0xcb, 0xdd, 0xc9, 0x29, 0x98, 0x34, 0xb5, 0xf0, 0x5f, 0xf2, 0xbe,
+	0xcb, 0xd0, 0xe0, 0xfb, 0x31, 0x1b, 0xf2, 0x90, 0x6b, 0xff, 0x5b, 0x34, 0xbb, 0x6d, 0xf4, 0x98,
+	0x64, 0x94, 0x31, 0xc7, 0xaa, 0x3d, 0x96, 0x63, 0xd5, 0x67, 0x72, 0x2c, 0x91, 0x1c, 0xa9, 0xaf,
+	0x32, 0x55, 0xc3, 0x4e, 0x8e, 0x4a, 0x4d, 0x32, 0xdc, 0xf2, 0xad, 0xe6, 0x71, 0xbe, 0x75, 0x03,
+	0xda, 0xc3, 0x84, 0x26, 0xf4, 0xdb, 0xa3, 0x90, 0x87, 0x29, 0xd5, 0x79, 0xdb, 0xa4, 0xc1, 0x8f,
+	0x0a, 0x10, 0xb1, 0x88, 0x13, 0xae, 0xd2, 0x3a, 0x91, 0xab, 0xfc, 0xab, 0x06, 0x75, 0x3d, 0xf3,
+	0x19, 0x7d, 0xe5, 0x0d, 0x68, 0xaa, 0xe0, 0xc9, 0x5d, 0xe5, 0x99, 0x22, 0x79, 0x4e, 0xbd, 0x51,
+	0x33, 0x30, 0x69, 0xa8, 0xe7, 0xb5, 0xa0, 0xe0, 0x01, 0xe5, 0xe3, 0x3d, 0xe0, 0x4d, 0x68, 0xf9,
+	0x5e, 0x14, 0xf5, 0x2c, 0x9f, 0x71, 0xb5, 0xc5, 0x82, 0x7c, 0x47, 0x0e, 0x63, 0x02, 0x42, 0x5a,
+	0x57, 0xa6, 0x18, 0xca, 0x03, 0xef, 0x81, 0xf4, 0x9b, 0xf2, 0xea, 0x82, 0x36, 0x69, 0xa8, 0x13,
+	0xfe, 0x01, 0x26, 0x02, 0x14, 0x9c, 0x98, 0xed, 0xb9, 0xb5, 0x49, 0x4e, 0xcc, 0xf6, 0x30, 0x11,
+	0xa0, 0x4c, 0x81, 0xa9, 0x97, 0x8e, 0xb8, 0x5b, 0x9f, 0x9c, 0xaf, 0x42, 0x44, 0x0a, 0x94, 0x0f,
+	0x68, 0x05, 0xea, 0x7e, 0x42, 0x83, 0x30, 0xe5, 0x3a, 0x45, 0x3d, 0xa5, 0xd9, 0x6d, 0x39, 0x57,
+	0x05, 0x61, 0x62, 0x48, 0x63, 0x2e, 0xdb, 0x7c, 0x2c, 0x97, 0x85, 0x59, 0x5d, 0x76, 0x40, 0x69,
+	0x2a, 0xcf, 0x30, 0xfb, 0x3c, 0xbf, 0xab, 0xd4, 0x24, 0xc3, 0xd1, 0xab, 0xd0, 0x0a, 0x63, 0x9e,
+	0x26, 0x23, 0x3f, 0x65, 0xd9, 0x39, 0xbe, 0xa8, 0xe9, 0x6b, 0x19, 0x42, 0x8a, 0x2c, 0xf4, 0x1c,
+	0x54, 0xb7, 0x18, 0xdb, 0x35, 0x47, 0x77, 0xcb, 0x9c, 0x90, 0x8c, 0xed, 0x12, 0x85, 0x9c, 0xec,
+	0xa8, 0x7e, 0x0b, 0xe6, 0xfc, 0x84, 0x71, 0xde, 0x8b, 0x42, 0xae, 0xa6, 0x3d, 0x6f, 0xd5, 0x04,
+	0xb7, 0x04, 0xf8, 0x81, 0xc2, 0x48, 0xc7, 0x2f, 0x48, 0x1c, 0xbd, 0x01, 0xed, 0x3d, 0x2f, 0x4c,
+	0x85, 0x65, 0x4f, 0x38, 0xc1, 0x82, 0xdc, 0xe0, 0x33, 0x26, 0x12, 0x8a, 0x18, 0x26, 0x2d, 0x23,
+	0xde, 0xf5, 0x1e, 0x58, 0x96, 0xc2, 0x35, 0x16, 0x0f, 0xb1, 0x94, 0xfe, 0x91, 0x59, 0xae, 0xb3,
+	0xbd, 0x89, 0x08, 0x44, 0x27, 0x8a, 0xc0, 0x9f, 0xd5, 0xa0, 0xae, 0x37, 0xe2, 0x04, 0xd9, 0x5a,
+	0x85, 0xec, 0x91, 0xd9, 0x3a, 0xa3, 0x88, 0x6c, 0xad, 0x84, 0xb5, 0x00, 0x3d, 0x07, 0x95, 0x84,
+	0xb1, 0x81, 0x0e, 0xc1, 0x8e, 0xc9, 0xd4, 0x42, 0x87, 0x89, 0x84, 0x50, 0x17, 0xca, 0x81, 0xb7,
+	0xaf, 0x43, 0xae, 0x6d, 0xe2, 0x22, 0xf0, 0xf6, 0x31, 0x11, 0x00, 0xba, 0x06, 0xc0, 0x53, 0x2f,
+	0x49, 0x7b, 0xa2, 0x4e, 0x32, 0xe5, 0x41, 0xf6, 0xda, 0x0c, 0x11, 0xaf, 0x15, 0xc2, 0x66, 0x38,
+	0xa0, 0xe8, 0x15, 0x68, 0xd0, 0x38, 0x50, 0x16, 0x35, 0x3b, 0xa1, 0x1b, 0x3d, 0x26, 0x75, 0x1a,
+	0x07, 0x92, 0x7d, 0x0d, 0xc0, 0x8f, 0x3c, 0xce, 0x7b, 0xe9, 0xfe, 0xd0, 0x14, 0x0a, 0xd9, 0x1b,
+	0x72, 0x04, 0x93, 0xa6, 0x14, 0x36, 0xf7, 0x87, 0x14, 0x2d, 0x43, 0x35, 0x8c, 0x03, 0xfa, 0x40,
+	0x86, 0x5f, 0x75, 0x15, 0xe9, 0xe5, 0x07, 0xb9, 0x72, 0x02, 0xc0, 0x44, 0x11, 0x4e, 0x96, 0x97,
+	0xdf, 0x82, 0xfa, 0x1e, 0xa5, 0xbb, 0x62, 0x41, 0x44, 0xb8, 0xcd, 0x65, 0xc1, 0xf3, 0x89, 0xd2,
+	0xae, 0x2e, 0x98, 0x18, 0xd7, 0x34, 0x4c, 0x8c, 0x81, 0x70, 0x29, 0xb5, 0x1c, 0x83, 0x30, 0x1e,
+	0xa5, 0xa6, 0x64, 0xc8, 0x5c, 0xaa, 0x88, 0x61, 0xd2, 0x92, 0xe2, 0x5d, 0x29, 0x89, 0xf3, 0x2f,
+	0x18, 0xa9, 0x3a, 0x57, 0xd6, 0x0c, 0xd5, 0x7c, 0xb9, 0x8c, 0x1e, 0x93, 0x8c, 0x82, 0xde, 0x86,
+	0x8e, 0xa9, 0x42, 0x55, 0x71, 0xa2, 0xea, 0x85, 0xb3, 0xa6, 0x7a, 0xb4, 0x40, 0x4c, 0xda, 0x46,
+	0x96, 0x05, 0xca, 0x6b, 0xd0, 0x12, 0xdb, 0x6e, 0x32, 0xed, 0x9c, 0xca, 0x5e, 0x26, 0xcb, 0x16,
+	0x20, 0x4c, 0x40, 0x48, 0xeb, 0xa6, 0x40, 0x6c, 0x98, 0x61, 0x64, 0x3d, 0x3d, 0xa5, 0x34, 0xce,
+	0x08, 0x13, 0x21, 0xb2, 0x70, 0xa2, 0x10, 0xf9, 0xa7, 0x03, 0x90, 0x27, 0x9f, 0x27, 0x11, 0x25,
+	0x33, 0xd6, 0x34, 0x97, 0x8d, 0xcf, 0x55, 0xe4, 0x1e, 0x9d, 0x2b, 0x0e, 0x3f, 0xc5, 0xf1, 0xec,
+	0x9c, 0x5f, 0x9d, 0x35, 0xe7, 0xe3, 0x5f, 0x3b, 0x50, 0x11, 0xd9, 0xf4, 0x49, 0x7c, 0xf9, 0x32,
+	0x54, 0xd3, 0x30, 0x8d, 0xcc, 0xa7, 0x5b, 0x61, 0x24, 0x01, 0x4c, 0x14, 0x41, 0x1c, 0xa1, 0xa3,
+	0x24, 0xd2, 0x69, 0xc2, 0x3a, 0x42, 0x47, 0x49, 0x84, 0x89, 0x00, 0xf1, 0x2f, 0xca, 0xd0, 0x30,
+	0x41, 0x35, 0xe3, 0xec, 0xdf, 0x9d, 0x7e, 0x25, 0x7a, 0x66, 0xf6, 0xeb, 0xd0, 0x0d, 0xab, 0x9a,
+	0x2d, 0x4b, 0x73, 0x77, 0x96, 0x4a, 0xf6, 0x7a, 0xb1, 0xb4, 0xa9, 0x48, 0xbb, 0x73, 0xc7, 0x97,
+	0x35, 0x37, 0xac, 0xe5, 0xae, 0x4e, 0x7b, 0xdd, 0xf4, 0xa5, 0xbe, 0x01, 0xa0, 0x0f, 0x5e, 0x61,
+	0x58, 0x9b, 0x62, 0x98, 0xc3, 0xa2, 0x23, 0xa1, 0x84, 0xe2, 0x1e, 0xd5, 0x8f, 0xdb, 0x23, 0x51,
+	0x95, 0xa8, 0x40, 0x9a, 0x5a, 0x95, 0x28, 0x48, 0x54, 0x25, 0xfa, 0xe9, 0xc0, 0x81, 0x76, 0xf1,
+	0xca, 0xfd, 0x44, 0xaf, 0xb1, 0x97, 0xa0, 0x36, 0xa4, 0x49, 0xc8, 0x82, 0x69, 0xf5, 0xa1, 0x42,
+	0x30, 0xd1, 0x14, 0x51, 0x1f, 0xaa, 0xa7, 0x5e, 0xe0, 0xa5, 0x54, 0xef, 0x96, 0x55, 0x1f, 0x16,
+	0x60, 0x4c, 0x40, 0x49, 0xb7, 0x85, 0xf0, 0x7d, 0x07, 0x16, 0xc6, 0x1b, 0x21, 0xe8, 0x25, 0xa8,
+	0xfb, 0xa3, 0x24, 0x11, 0x2b, 0xe5, 0x58, 0xd9, 0xcc, 0x30, 0x88, 0xc1, 0xd1, 0x05, 0xa8, 0x44,
+	0x1e, 0x4f, 0xdd, 0xd2, 0x74, 0x9e, 0x04, 0x05, 0x29, 0xa6, 0x0f, 0x52, 0xb7, 0x7c, 0x08, 0x49,
+	0x80, 0xf8, 0x4b, 0x07, 0x1a, 0xd9, 0x0c, 0xcc, 0x25, 0xda, 0x51, 0xf9, 0xfe, 0xb0, 0x4b, 0x74,
+	0x7e, 0x31, 0x2f, 0x1d, 0x7f, 0x31, 0xb7, 0x6e, 0xac, 0xe5, 0xc7, 0xb9, 0xb1, 0x56, 0x66, 0xbb,
+	0xb1, 0xe2, 0x1f, 0x96, 0x60, 0xfe, 0xde, 0xad, 0xcd, 0x75, 0x96, 0x86, 0xdb, 0xa1, 0xaf, 0x9c,
+	0xe7, 0x32, 0xcc, 0xc7, 0x05, 0xb9, 0x97, 0x79, 0x52, 0x45, 0x0c, 0x47, 0xe6, 0x8a, 0xe0, 0x5a,
+	0x80, 0x2e, 0x58, 0xe9, 0x51, 0x7d, 0x9d, 0x62, 0x16, 0xea, 0xdf, 0x67, 0xb3, 0xa2, 0xbc, 0x5c,
+	0x20, 0x68, 0x9d, 0x08, 0xa9, 0xdc, 0xa5, 0xe4, 0xdc, 0xf3, 0xf2, 0x35, 0xef, 0x7d, 0x6a, 0xa3,
+	0x02, 0x15, 0xdd, 0x00, 0x37, 0xaf, 0x39, 0x69, 0xd0, 0xcb, 0x27, 0x22, 0x2e, 0xa0, 0xe5, 0xe5,
+	0x26, 0x39, 0x93, 0x15, 0x9a, 0x34, 0xd8, 0x34, 0xd3, 0xe1, 0xc8, 0x85, 0x8a, 0x2c, 0x52, 0x6a,
+	0x85, 0xd9, 0x48, 0x0d, 0xfe, 0x00, 0x1a, 0x84, 0xf2, 0x21, 0x8b, 0x39, 0x45, 0xe7, 0xa1, 0x22,
+	0x2a, 0x0a, 0xed, 0x5a, 0xad, 0x42, 0xb9, 0x41, 0x24, 0x20, 0x08, 0xb2, 0x1e, 0x29, 0x59, 0x84,
+	0xdb, 0xa2, 0x16, 0x91, 0x00, 0xbe, 0x0e, 0x15, 0x41, 0x47, 0x08, 0x2a, 0x72, 0x33, 0xa5, 0x97,
+	0x10, 0xf9, 0x8c, 0x5c, 0xa8, 0x0f, 0x28, 0xe7, 0xa2, 0x39, 0x2a, 0x57, 0x8d, 0x18, 0x11, 0xff,
+	0xb2, 0x06, 0x15, 0x31, 0x08, 0x7a, 0x0d, 0xf2, 0x58, 0x0b, 0x29, 0x77, 0x9d, 0xa5, 0xf2, 0xd4,
+	0xa5, 0x21, 0x16, 0xcd, 0xea, 0x05, 0x96, 0x8e, 0xe9, 0x05, 0x16, 0x7a, 0x2e, 0xe5, 0x23, 0x7b,
+	0x2e, 0xc5, 0x3b, 0x74, 0xe5, 0x98, 0x3b, 0xf4, 0xff, 0x5a, 0x1b, 0x5a, 0x3d, 0x64, 0x43, 0xad,
+	0xad, 0x5c, 0x86, 0xba, 0x9e, 0x93, 0xdc, 0x94, 0xc9, 0x29, 0x1b, 0x18, 0x5d, 0x84, 0x9a, 0x9a,
+	0x93, 0x4c, 0xa4, 0x13, 0x13, 0xd6, 0xa0, 0x1c, 0x50, 0xcd, 0xc7, 0x6d, 0xd8, 0x03, 0xea, 0xe9,
+	0x1a, 0x18, 0xdd, 0x86, 0x45, 0x3e, 0xda, 0xe2, 0x7e, 0x12, 0x0e, 0xa5, 0xc3, 0xdf, 0x0f, 0xe9,
+	0x9e, 0x2e, 0x31, 0xcf, 0xe5, 0x93, 0xc8, 0xf0, 0x8f, 0x43, 0xba, 0x47, 0x16, 0xf8, 0x98, 0x06,
+	0xfd, 0x3f, 0xcc, 0x9b, 0x13, 0xa3, 0x1f, 0xf2, 0x94, 0x25, 0xfb, 0xba, 0x1b, 0x70, 0xc6, 0x7e,
+	0xef, 0x1d, 0x05, 0x92, 0x39, 0x6e, 0xc9, 0xe2, 0xfe, 0xc4, 0xa9, 0x97, 0xf8, 0xfd, 0x5e, 0x42,
+	0xf9, 0x28, 0xca, 0xda, 0xb8, 0xa7, 0x33, 0x73, 0x01, 0x12, 0x89, 0x91, 0x0e, 0x2f, 0x48, 0x5c,
+	0xf8, 0xa1, 0x6c, 0xb2, 0xb7, 0x2d, 0x3f, 0x14, 0xfd, 0x73, 0x22, 0x01, 0xd1, 0x12, 0xe5, 0x7e,
+	0x9f, 0x06, 0xa3, 0x88, 0x9a, 0x0b, 0x5f, 0x96, 0xdc, 0xb4, 0x9e, 0xe4, 0x8c, 0x89, 0xb6, 0xc6,
+	0xdc, 0xac, 0x6d, 0x8d, 0xaf, 0x01, 0xca, 0xef, 0x98, 0xbd, 0x61, 0xc2, 0xb6, 0xc3, 0x88, 0xea,
+	0x42, 0xd3, 0x9d, 0xb8, 0x90, 0x7e, 0xa4, 0x70, 0xb2, 0x18, 0x8e, 0xab, 0x4e, 0xda, 0xc3, 0xfd,
+	0x55, 0x19, 0xda, 0xc5, 0x3d, 0x42, 0x2b, 0xd9, 0x09, 0x38, 0xf6, 0xa3, 0x43, 0x18, 0xe0, 0xa5,
+	0xed, 0x30, 0xa1, 0x62, 0xd1, 0x69, 0x7e, 0x16, 0xae, 0x40, 0x89, 0x71, 0xb7, 0x34, 0xc9, 0x67,
+	0xdc, 0xe2, 0x33, 0x8e, 0x49, 0x89, 0x71, 0xf4, 0x29, 0x74, 0x42, 0xde, 0xd3, 0x4e, 0xb0, 0x45,
+	0xcd, 0xe1, 0x77, 0x5d, 0x9b, 0xbe, 0x22, 0x5f, 0x55, 0x24, 0xd8, 0x6f, 0xb5, 0x10, 0xd2, 0x0e,
+	0xf9, 0x46, 0x26, 0xa2, 0xbb, 0x56, 0x42, 0x55, 0x99, 0x7c, 0x45, 0x8f, 0xfb, 0xc2, 0x58, 0xbd,
+	0x59, 0x1c, 0xf4, 0x90, 0xd6, 0xc3, 0x1a, 0x34, 0xb7, 0xfd, 0x41, 0x2f, 0x65, 0xbb, 0xd4, 0x74,
+	0x85, 0x5f, 0xd1, 0xa3, 0x3d, 0x2f, 0x46, 0xcb, 0x40, 0x6b, 0xb0, 0x5c, 0x4b, 0x1a, 0xdb, 0xfe,
+	0x60, 0x53, 0x3c, 0x8a, 0x99, 0xf9, 0x09, 0xf5, 0x44, 0xa6, 0xf5, 0x52, 0xb7, 0x36, 0x39, 0xb3,
+	0x1c, 0xb5, 0x06, 0x2b, 0xa8, 0x49, 0x53, 0x0b, 0x37, 0x53, 0xfc, 0x77, 0x07, 0x16, 0xc6, 0x03,
+	0x6b, 0xec, 0xeb, 0x9d, 0xff, 0xf4, 0xeb, 0x09, 0xb4, 0xb2, 0x95, 0x4e, 0xb8, 0xae, 0x70, 0xae,
+	0xea, 0xf1, 0x96, 0x75, 0x55, 0x69, 0x60, 0x6b, 0xc0, 0xa2, 0x9e, 0x14, 0x07, 0x41, 0xff, 0x07,
+	0xb5, 0x90, 0xf7, 0xfa, 0x4c, 0x95, 0x09, 0x8d, 0xd5, 0x17, 0xf4, 0x70, 0x5d, 0xbd, 0xe9, 0x7d,
+	0x96, 0x8e, 0xef, 0xb6, 0x50, 0x91, 0x6a, 0xc8, 0xef, 0xb0, 0x14, 0xff, 0xc4, 0x81, 0x39, 0x3b,
+	0x17, 0xa0, 0x0b, 0x53, 0x3e, 0x7a, 0xe2, 0x0c, 0xbd, 0x0e, 0x4d, 0x1e, 0x7b, 0x43, 0xde, 0x67,
+	0x59, 0x5a, 0x3f, 0x6b, 0xa7, 0x96, 0x0d, 0x0d, 0x93, 0x9c, 0x88, 0xae, 0x42, 0x55, 0x9c, 0xb2,
+	0x5c, 0x97, 0x34, 0x4f, 0x4f, 0x4d, 0x46, 0x1b, 0x82, 0x41, 0x14, 0x11, 0xff, 0xc6, 0x81, 0xf9,
+	0xb1, 0x01, 0x4d, 0xe3, 0xcd, 0x39, 0xaa, 0xf1, 0xa6, 0x1b, 0x78, 0xa5, 0xa3, 0x1a, 0x78, 0x97,
+	0xc6, 0xea, 0x80, 0x23, 0x9b, 0x73, 0xaf, 0x5b, 0xee, 0xa6, 0x2b, 0xfb, 0xe2, 0xc5, 0xeb, 0x10,
+	0xbf, 0xfa, 0x91, 0x03, 0xa7, 0xa7, 0x7c, 0x1f, 0x7a, 0x01, 0xda, 0xf2, 0xf7, 0xa2, 0x94, 0xf5,
+	0xb6, 0xc3, 0x28, 0xb2, 0xaa, 0x1a, 0x10, 0xc8, 0x26, 0x7b, 0x3f, 0x8c, 0x22, 0xf4, 0x3c, 0x40,
+	0x42, 0xd9, 0x90, 0xc6, 0x32, 0xf9, 0x94, 0x8a, 0xac, 0x5c, 0x8f, 0xae, 0xc2, 0x62, 0xba, 0x3f,
+	0x0c, 0x7d, 0x2f, 0xea, 0x09, 0x5d, 0xaf, 0xcf, 0x46, 0x89, 0x2e, 0xd6, 0x14, 0x79, 0x5e, 0xc3,
+	0x1f, 0x0e, 0x69, 0x7c, 0x87, 0x8d, 0x12, 0xfc, 0x83, 0x12, 0xb4, 0x8b, 0x59, 0x5c, 0xd4, 0x8e,
+	0xbb, 0x61, 0x6c, 0xd2, 0x94, 0x55, 0x3b, 0x0a, 0x3d, 0x26, 0x12, 0x1e, 0xbb, 0x80, 0x96, 0x66,
+	0x6e, 0x3a, 0xce, 0x78, 0x1d, 0xbe, 0x08, 0x95, 0xc4, 0x8b, 0x77, 0xe5, 0x02, 0x3b, 0x36, 0x4d,
+	0xe8, 0x45, 0x7f, 0xc9, 0x8b, 0x77, 0x0b, 0xc7, 0x6e, 0x75, 0xc6, 0x63, 0xb7, 0x76, 0xe4, 0xb1,
+	0x8b, 0xbf, 0x09, 0x15, 0xf9, 0x0b, 0xef, 0xb3, 0x50, 0x63, 0xdb, 0xdb, 0x9c, 0xa6, 0xd6, 0x86,
+	0x68, 0x1d, 0x7a, 0x1a, 0xaa, 0x51, 0x38, 0x08, 0x53, 0x6b, 0x1f, 0x94, 0x4a, 0x60, 0x29, 0x4b,
+	0xbd, 0xc8, 0x2d, 0x17, 0x31, 0xa9, 0xc2, 0x3f, 0x17, 0x45, 0xba, 0x3e, 0xd0, 0xac, 0xda, 0xc5,
+	0x39, 0xa6, 0x76, 0x71, 0x45, 0x4d, 0xb7, 0xaf, 0xf6, 0xdd, 0x6c, 0xa5, 0xd4, 0xa0, 0x8b, 0xd0,
+	0xda, 0xf1, 0x86, 0xba, 0xf5, 0xc3, 0xad, 0xbd, 0x86, 0x1d, 0x6f, 0xa8, 0x9a, 0x40, 0xa2, 0x6b,
+	0x3a, 0x47, 0xbd, 0x24, 0x0a, 0x29, 0x4f, 0x7b, 0xb2, 0x3b, 0xe4, 0x56, 0x0a, 0xcc, 0x8e, 0xc1,
+	0x36, 0x04, 0x84, 0x1f, 0x3a, 0xd0, 0x2e, 0x9e, 0xa7, 0x68, 0x09, 0x1a, 0x6c, 0x48, 0x13, 0x2f,
+	0x65, 0x89, 0x95, 0x08, 0x32, 0x2d, 0xba, 0xa2, 0x19, 0x71, 0x60, 0xd2, 0xc0, 0xd4, 0x83, 0x39,
+	0x23, 0x89, 0x09, 0x99, 0xab, 0xb3, 0xd5, 0xc9, 0xd7, 0x13, 0xd2, 0x98, 0x6e, 0x10, 0xbd, 0x04,
+	0x1d, 0x7d, 0x5f, 0xb6, 0x7a, 0xf8, 0x8a, 0xdb, 0x56, 0x90, 0xa6, 0x5e, 0x98, 0xd2, 0x18, 0x19,
+	0xcf, 0x5a, 0xf8, 0x0b, 0x07, 0xda, 0xc5, 0xd6, 0xef, 0x94, 0xd9, 0x38, 0x27, 0x98, 0x4d, 0xe9,
+	0xd0, 0xd9, 0x88, 0x71, 0x75, 0xfd, 0x35, 0xf5, 0x2b, 0x15, 0x36, 0x75, 0xea, 0x95, 0xe9, 0x53,
+	0xff, 0xa9, 0x03, 0x8b, 0x13, 0xc5, 0x4a, 0x16, 0x55, 0xce, 0xd1, 0x51, 0xf5, 0xb8, 0x41, 0x7b,
+	0x09, 0x1a, 0x29, 0xf5, 0xfc, 0xbe, 0x68, 0xd0, 0x95, 0xad, 0xba, 0x67, 0x53, 0xab, 0x49, 0x46,
+	0xc0, 0x21, 0x34, 0x8c, 0x56, 0x5e, 0xb1, 0xd4, 0x0d, 0xd3, 0xb1, 0xae, 0x58, 0x52, 0x27, 0xbc,
+	0x5a, 0x5e, 0x53, 0x8b, 0xeb, 0x27, 0x35, 0x85, 0xb8, 0x2e, 0x1f, 0x11, 0xd7, 0xf8, 0x77, 0x25,
+	0x68, 0x98, 0xca, 0xeb, 0x49, 0xff, 0x4c, 0x9e, 0xdd, 0x83, 0xc7, 0xd6, 0x5b, 0x5d, 0x81, 0x25,
+	0x2c, 0x3a, 0xe4, 0x85, 0xbd, 0xec, 0x4c, 0xdb, 0x92, 0x17, 0xa1, 0xe6, 0x7b, 0x83, 0xe1, 0xc8,
+	0xfc, 0x38, 0x39, 0x6f, 0x0e, 0x1e, 0xa5, 0xc5, 0x44, 0xc3, 0xa2, 0x8f, 0x1b, 0x79, 0x69, 0x98,
+	0x8e, 0x02, 0x75, 0x43, 0x74, 0xf2, 0x3e, 0xae, 0xd1, 0x63, 0x92, 0x51, 0xd0, 0x55, 0x68, 0x46,
+	0x2c, 0xde, 0x51, 0xfc, 0xba, 0xe4, 0x23, 0x73, 0xeb, 0xce, 0x00, 0x4c, 0x72, 0x12, 0xfe, 0xbd,
+	0x03, 0x8d, 0xf7, 0xe2, 0xfb, 0x34, 0x62, 0x43, 0x2a, 0x1d, 0xd7, 0xef, 0xd3, 0x81, 0xd7, 0x93,
+	0x1f, 0x3d, 0xb6, 0x81, 0x1d, 0x85, 0x7d, 0xac, 0x20, 0x91, 0x1e, 0x86, 0x09, 0x0b, 0x46, 0xfe,
+	0x58, 0x2c, 0x64, 0x5a, 0xe1, 0xda, 0xdc, 0x4f, 0xbc, 0xa1, 0x3a, 0x35, 0x8b, 0x99, 0xb1, 0xa9,
+	0xf5, 0x37, 0x53, 0xf4, 0xe2, 0x58, 0x67, 0xb7, 0x18, 0x01, 0xc5, 0x26, 0xae, 0xf0, 0xaa, 0x6d,
+	0x96, 0x0c, 0xbc, 0xd4, 0x8a, 0x6f, 0xad, 0xfb, 0x7a, 0xa5, 0x51, 0x5b, 0xa8, 0xbf, 0x4c, 0xa1,
+	0xae, 0xdb, 0xea, 0x08, 0xa0, 0xb6, 0x71, 0x6f, 0xfd, 0xf6, 0xcd, 0x4f, 0x17, 0x4e, 0x89, 0xe7,
+	0xbb, 0x1f, 0xca, 0x67, 0x07, 0xb5, 0xa0, 0xbe, 0x79, 0xef, 0xbd, 0x0d, 0x21, 0x94, 0x50, 0x07,
+	0x9a, 0x9f, 0xbc, 0x77, 0x7b, 0x5d, 0x89, 0x65, 0xd4, 0x86, 0xc6, 0xe6, 0x9d, 0x7b, 0x44, 0x4a,
+	0x15, 0x61, 0xf5, 0x3e, 0x59, 0x13, 0xcf, 0x55, 0x81, 0x6c, 0xdc, 0xdc, 0xbc, 0x47, 0x84, 0x54,
+	0x5b, 0xbd, 0xf1, 0xc7, 0x47, 0xdd, 0x53, 0x5f, 0x3d, 0xea, 0x3a, 0x7f, 0x7d, 0xd4, 0x75, 0xfe,
+	0xf1, 0xa8, 0xeb, 0x7c, 0xf7, 0xa0, 0xeb, 0x7c, 0x7e, 0xd0, 0x75, 0xbe, 0x38, 0xe8, 0x3a, 0xbf,
+	0x3d, 0xe8, 0x3a, 0x5f, 0x1e, 0x74, 0x9d, 0x3f, 0x1c, 0x74, 0x9d, 0xaf, 0x0e, 0xba, 0xce, 0x8f,
+	0xff, 0xdc, 0x3d, 0xf5, 0x99, 0xfa, 0x8f, 0xab, 0x7f, 0x0f, 0x00, 0x52, 0x46, 0xc5, 0x07, 0x93,
+	0x25, 0x00, 0x00,
+}
+
+func (this *University) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1986,43 +2967,43 @@ func (this *Course) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Course)
+	that1, ok := that.(*University)
 	if !ok {
-		that2, ok := that.(Course)
+		that2, ok := that.(University)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Course")
+			return fmt.Errorf("that is not of type *University")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Course but is nil && this != nil")
+		return fmt.Errorf("that is type *University but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Course but is not nil && this == nil")
+		return fmt.Errorf("that is type *University but is not nil && this == nil")
 	}
 	if this.Id != that1.Id {
 		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.SubjectId != that1.SubjectId {
-		return fmt.Errorf("SubjectId this(%v) Not Equal that(%v)", this.SubjectId, that1.SubjectId)
-	}
 	if this.Name != that1.Name {
 		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
 	}
-	if this.Number != that1.Number {
-		return fmt.Errorf("Number this(%v) Not Equal that(%v)", this.Number, that1.Number)
+	if this.Abbr != that1.Abbr {
+		return fmt.Errorf("Abbr this(%v) Not Equal that(%v)", this.Abbr, that1.Abbr)
 	}
-	if this.Synopsis != nil && that1.Synopsis != nil {
-		if *this.Synopsis != *that1.Synopsis {
-			return fmt.Errorf("Synopsis this(%v) Not Equal that(%v)", *this.Synopsis, *that1.Synopsis)
-		}
-	} else if this.Synopsis != nil {
-		return fmt.Errorf("this.Synopsis == nil && that.Synopsis != nil")
-	} else if that1.Synopsis != nil {
-		return fmt.Errorf("Synopsis this(%v) Not Equal that(%v)", this.Synopsis, that1.Synopsis)
+	if this.HomePage != that1.HomePage {
+		return fmt.Errorf("HomePage this(%v) Not Equal that(%v)", this.HomePage, that1.HomePage)
+	}
+	if this.RegistrationPage != that1.RegistrationPage {
+		return fmt.Errorf("RegistrationPage this(%v) Not Equal that(%v)", this.RegistrationPage, that1.RegistrationPage)
+	}
+	if this.MainColor != that1.MainColor {
+		return fmt.Errorf("MainColor this(%v) Not Equal that(%v)", this.MainColor, that1.MainColor)
+	}
+	if this.AccentColor != that1.AccentColor {
+		return fmt.Errorf("AccentColor this(%v) Not Equal that(%v)", this.AccentColor, that1.AccentColor)
 	}
 	if this.TopicName != that1.TopicName {
 		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
@@ -2030,12 +3011,31 @@ func (this *Course) VerboseEqual(that interface{}) error {
 	if this.TopicId != that1.TopicId {
 		return fmt.Errorf("TopicId this(%v) Not Equal that(%v)", this.TopicId, that1.TopicId)
 	}
-	if len(this.Sections) != len(that1.Sections) {
-		return fmt.Errorf("Sections this(%v) Not Equal that(%v)", len(this.Sections), len(that1.Sections))
+	if !this.ResolvedSemesters.Equal(that1.ResolvedSemesters) {
+		return fmt.Errorf("ResolvedSemesters this(%v) Not Equal that(%v)", this.ResolvedSemesters, that1.ResolvedSemesters)
 	}
-	for i := range this.Sections {
-		if !this.Sections[i].Equal(that1.Sections[i]) {
-			return fmt.Errorf("Sections this[%v](%v) Not Equal that[%v](%v)", i, this.Sections[i], i, that1.Sections[i])
+	if len(this.Subjects) != len(that1.Subjects) {
+		return fmt.Errorf("Subjects this(%v) Not Equal that(%v)", len(this.Subjects), len(that1.Subjects))
+	}
+	for i := range this.Subjects {
+		if !this.Subjects[i].Equal(that1.Subjects[i]) {
+			return fmt.Errorf("Subjects this[%v](%v) Not Equal that[%v](%v)", i, this.Subjects[i], i, that1.Subjects[i])
+		}
+	}
+	if len(this.AvailableSemesters) != len(that1.AvailableSemesters) {
+		return fmt.Errorf("AvailableSemesters this(%v) Not Equal that(%v)", len(this.AvailableSemesters), len(that1.AvailableSemesters))
+	}
+	for i := range this.AvailableSemesters {
+		if !this.AvailableSemesters[i].Equal(that1.AvailableSemesters[i]) {
+			return fmt.Errorf("AvailableSemesters this[%v](%v) Not Equal that[%v](%v)", i, this.AvailableSemesters[i], i, that1.AvailableSemesters[i])
+		}
+	}
+	if len(this.Registrations) != len(that1.Registrations) {
+		return fmt.Errorf("Registrations this(%v) Not Equal that(%v)", len(this.Registrations), len(that1.Registrations))
+	}
+	for i := range this.Registrations {
+		if !this.Registrations[i].Equal(that1.Registrations[i]) {
+			return fmt.Errorf("Registrations this[%v](%v) Not Equal that[%v](%v)", i, this.Registrations[i], i, that1.Registrations[i])
 		}
 	}
 	if len(this.Metadata) != len(that1.Metadata) {
@@ -2046,19 +3046,30 @@ func (this *Course) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("Metadata this[%v](%v) Not Equal that[%v](%v)", i, this.Metadata[i], i, that1.Metadata[i])
 		}
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if this.TimeZone != that1.TimeZone {
+		return fmt.Errorf("TimeZone this(%v) Not Equal that(%v)", this.TimeZone, that1.TimeZone)
+	}
+	if len(this.Buildings) != len(that1.Buildings) {
+		return fmt.Errorf("Buildings this(%v) Not Equal that(%v)", len(this.Buildings), len(that1.Buildings))
+	}
+	for i := range this.Buildings {
+		if !this.Buildings[i].Equal(that1.Buildings[i]) {
+			return fmt.Errorf("Buildings this[%v](%v) Not Equal that[%v](%v)", i, this.Buildings[i], i, that1.Buildings[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Course) Equal(that interface{}) bool {
+func (this *University) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Course)
+	that1, ok := that.(*University)
 	if !ok {
-		that2, ok := that.(Course)
+		that2, ok := that.(University)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2073,22 +3084,22 @@ func (this *Course) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.SubjectId != that1.SubjectId {
+	if this.Name != that1.Name {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.Abbr != that1.Abbr {
 		return false
 	}
-	if this.Number != that1.Number {
+	if this.HomePage != that1.HomePage {
 		return false
 	}
-	if this.Synopsis != nil && that1.Synopsis != nil {
-		if *this.Synopsis != *that1.Synopsis {
-			return false
-		}
-	} else if this.Synopsis != nil {
+	if this.RegistrationPage != that1.RegistrationPage {
 		return false
-	} else if that1.Synopsis != nil {
+	}
+	if this.MainColor != that1.MainColor {
+		return false
+	}
+	if this.AccentColor != that1.AccentColor {
 		return false
 	}
 	if this.TopicName != that1.TopicName {
@@ -2097,11 +3108,30 @@ func (this *Course) Equal(that interface{}) bool {
 	if this.TopicId != that1.TopicId {
 		return false
 	}
-	if len(this.Sections) != len(that1.Sections) {
+	if !this.ResolvedSemesters.Equal(that1.ResolvedSemesters) {
 		return false
 	}
-	for i := range this.Sections {
-		if !this.Sections[i].Equal(that1.Sections[i]) {
+	if len(this.Subjects) != len(that1.Subjects) {
+		return false
+	}
+	for i := range this.Subjects {
+		if !this.Subjects[i].Equal(that1.Subjects[i]) {
+			return false
+		}
+	}
+	if len(this.AvailableSemesters) != len(that1.AvailableSemesters) {
+		return false
+	}
+	for i := range this.AvailableSemesters {
+		if !this.AvailableSemesters[i].Equal(that1.AvailableSemesters[i]) {
+			return false
+		}
+	}
+	if len(this.Registrations) != len(that1.Registrations) {
+		return false
+	}
+	for i := range this.Registrations {
+		if !this.Registrations[i].Equal(that1.Registrations[i]) {
 			return false
 		}
 	}
@@ -2113,12 +3143,23 @@ func (this *Course) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if len(this.Buildings) != len(that1.Buildings) {
+		return false
+	}
+	for i := range this.Buildings {
+		if !this.Buildings[i].Equal(that1.Buildings[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Section) VerboseEqual(that interface{}) error {
+func (this *Subject) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2126,46 +3167,40 @@ func (this *Section) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Section)
+	that1, ok := that.(*Subject)
 	if !ok {
-		that2, ok := that.(Section)
+		that2, ok := that.(Subject)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Section")
+			return fmt.Errorf("that is not of type *Subject")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Section but is nil && this != nil")
+		return fmt.Errorf("that is type *Subject but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Section but is not nil && this == nil")
+		return fmt.Errorf("that is type *Subject but is not nil && this == nil")
 	}
 	if this.Id != that1.Id {
 		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.CourseId != that1.CourseId {
-		return fmt.Errorf("CourseId this(%v) Not Equal that(%v)", this.CourseId, that1.CourseId)
+	if this.UniversityId != that1.UniversityId {
+		return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", this.UniversityId, that1.UniversityId)
+	}
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
 	}
 	if this.Number != that1.Number {
 		return fmt.Errorf("Number this(%v) Not Equal that(%v)", this.Number, that1.Number)
 	}
-	if this.CallNumber != that1.CallNumber {
-		return fmt.Errorf("CallNumber this(%v) Not Equal that(%v)", this.CallNumber, that1.CallNumber)
-	}
-	if this.Max != that1.Max {
-		return fmt.Errorf("Max this(%v) Not Equal that(%v)", this.Max, that1.Max)
-	}
-	if this.Now != that1.Now {
-		return fmt.Errorf("Now this(%v) Not Equal that(%v)", this.Now, that1.Now)
-	}
-	if this.Status != that1.Status {
-		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
+	if this.Season != that1.Season {
+		return fmt.Errorf("Season this(%v) Not Equal that(%v)", this.Season, that1.Season)
 	}
-	if this.Credits != that1.Credits {
-		return fmt.Errorf("Credits this(%v) Not Equal that(%v)", this.Credits, that1.Credits)
+	if this.Year != that1.Year {
+		return fmt.Errorf("Year this(%v) Not Equal that(%v)", this.Year, that1.Year)
 	}
 	if this.TopicName != that1.TopicName {
 		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
@@ -2173,28 +3208,12 @@ func (this *Section) VerboseEqual(that interface{}) error {
 	if this.TopicId != that1.TopicId {
 		return fmt.Errorf("TopicId this(%v) Not Equal that(%v)", this.TopicId, that1.TopicId)
 	}
-	if len(this.Meetings) != len(that1.Meetings) {
-		return fmt.Errorf("Meetings this(%v) Not Equal that(%v)", len(this.Meetings), len(that1.Meetings))
-	}
-	for i := range this.Meetings {
-		if !this.Meetings[i].Equal(that1.Meetings[i]) {
-			return fmt.Errorf("Meetings this[%v](%v) Not Equal that[%v](%v)", i, this.Meetings[i], i, that1.Meetings[i])
-		}
-	}
-	if len(this.Instructors) != len(that1.Instructors) {
-		return fmt.Errorf("Instructors this(%v) Not Equal that(%v)", len(this.Instructors), len(that1.Instructors))
-	}
-	for i := range this.Instructors {
-		if !this.Instructors[i].Equal(that1.Instructors[i]) {
-			return fmt.Errorf("Instructors this[%v](%v) Not Equal that[%v](%v)", i, this.Instructors[i], i, that1.Instructors[i])
-		}
-	}
-	if len(this.Books) != len(that1.Books) {
-		return fmt.Errorf("Books this(%v) Not Equal that(%v)", len(this.Books), len(that1.Books))
+	if len(this.Courses) != len(that1.Courses) {
+		return fmt.Errorf("Courses this(%v) Not Equal that(%v)", len(this.Courses), len(that1.Courses))
 	}
-	for i := range this.Books {
-		if !this.Books[i].Equal(that1.Books[i]) {
-			return fmt.Errorf("Books this[%v](%v) Not Equal that[%v](%v)", i, this.Books[i], i, that1.Books[i])
+	for i := range this.Courses {
+		if !this.Courses[i].Equal(that1.Courses[i]) {
+			return fmt.Errorf("Courses this[%v](%v) Not Equal that[%v](%v)", i, this.Courses[i], i, that1.Courses[i])
 		}
 	}
 	if len(this.Metadata) != len(that1.Metadata) {
@@ -2205,19 +3224,28 @@ func (this *Section) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("Metadata this[%v](%v) Not Equal that[%v](%v)", i, this.Metadata[i], i, that1.Metadata[i])
 		}
 	}
+	if this.TermCode != that1.TermCode {
+		return fmt.Errorf("TermCode this(%v) Not Equal that(%v)", this.TermCode, that1.TermCode)
+	}
+	if this.TermName != that1.TermName {
+		return fmt.Errorf("TermName this(%v) Not Equal that(%v)", this.TermName, that1.TermName)
+	}
+	if this.ContentHash != that1.ContentHash {
+		return fmt.Errorf("ContentHash this(%v) Not Equal that(%v)", this.ContentHash, that1.ContentHash)
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Section) Equal(that interface{}) bool {
+func (this *Subject) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Section)
+	that1, ok := that.(*Subject)
 	if !ok {
-		that2, ok := that.(Section)
+		that2, ok := that.(Subject)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2232,25 +3260,19 @@ func (this *Section) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.CourseId != that1.CourseId {
-		return false
-	}
-	if this.Number != that1.Number {
-		return false
-	}
-	if this.CallNumber != that1.CallNumber {
+	if this.UniversityId != that1.UniversityId {
 		return false
 	}
-	if this.Max != that1.Max {
+	if this.Name != that1.Name {
 		return false
 	}
-	if this.Now != that1.Now {
+	if this.Number != that1.Number {
 		return false
 	}
-	if this.Status != that1.Status {
+	if this.Season != that1.Season {
 		return false
 	}
-	if this.Credits != that1.Credits {
+	if this.Year != that1.Year {
 		return false
 	}
 	if this.TopicName != that1.TopicName {
@@ -2259,44 +3281,37 @@ func (this *Section) Equal(that interface{}) bool {
 	if this.TopicId != that1.TopicId {
 		return false
 	}
-	if len(this.Meetings) != len(that1.Meetings) {
+	if len(this.Courses) != len(that1.Courses) {
 		return false
 	}
-	for i := range this.Meetings {
-		if !this.Meetings[i].Equal(that1.Meetings[i]) {
+	for i := range this.Courses {
+		if !this.Courses[i].Equal(that1.Courses[i]) {
 			return false
 		}
 	}
-	if len(this.Instructors) != len(that1.Instructors) {
+	if len(this.Metadata) != len(that1.Metadata) {
 		return false
 	}
-	for i := range this.Instructors {
-		if !this.Instructors[i].Equal(that1.Instructors[i]) {
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
 			return false
 		}
 	}
-	if len(this.Books) != len(that1.Books) {
+	if this.TermCode != that1.TermCode {
 		return false
 	}
-	for i := range this.Books {
-		if !this.Books[i].Equal(that1.Books[i]) {
-			return false
-		}
-	}
-	if len(this.Metadata) != len(that1.Metadata) {
+	if this.TermName != that1.TermName {
 		return false
 	}
-	for i := range this.Metadata {
-		if !this.Metadata[i].Equal(that1.Metadata[i]) {
-			return false
-		}
+	if this.ContentHash != that1.ContentHash {
+		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Meeting) VerboseEqual(that interface{}) error {
+func (this *Course) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2304,76 +3319,57 @@ func (this *Meeting) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Meeting)
+	that1, ok := that.(*Course)
 	if !ok {
-		that2, ok := that.(Meeting)
+		that2, ok := that.(Course)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Meeting")
+			return fmt.Errorf("that is not of type *Course")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Meeting but is nil && this != nil")
+		return fmt.Errorf("that is type *Course but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Meeting but is not nil && this == nil")
+		return fmt.Errorf("that is type *Course but is not nil && this == nil")
 	}
 	if this.Id != that1.Id {
 		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.SectionId != that1.SectionId {
-		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
+	if this.SubjectId != that1.SubjectId {
+		return fmt.Errorf("SubjectId this(%v) Not Equal that(%v)", this.SubjectId, that1.SubjectId)
 	}
-	if this.Room != nil && that1.Room != nil {
-		if *this.Room != *that1.Room {
-			return fmt.Errorf("Room this(%v) Not Equal that(%v)", *this.Room, *that1.Room)
-		}
-	} else if this.Room != nil {
-		return fmt.Errorf("this.Room == nil && that.Room != nil")
-	} else if that1.Room != nil {
-		return fmt.Errorf("Room this(%v) Not Equal that(%v)", this.Room, that1.Room)
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
 	}
-	if this.Day != nil && that1.Day != nil {
-		if *this.Day != *that1.Day {
-			return fmt.Errorf("Day this(%v) Not Equal that(%v)", *this.Day, *that1.Day)
-		}
-	} else if this.Day != nil {
-		return fmt.Errorf("this.Day == nil && that.Day != nil")
-	} else if that1.Day != nil {
-		return fmt.Errorf("Day this(%v) Not Equal that(%v)", this.Day, that1.Day)
+	if this.Number != that1.Number {
+		return fmt.Errorf("Number this(%v) Not Equal that(%v)", this.Number, that1.Number)
 	}
-	if this.StartTime != nil && that1.StartTime != nil {
-		if *this.StartTime != *that1.StartTime {
-			return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", *this.StartTime, *that1.StartTime)
+	if this.Synopsis != nil && that1.Synopsis != nil {
+		if *this.Synopsis != *that1.Synopsis {
+			return fmt.Errorf("Synopsis this(%v) Not Equal that(%v)", *this.Synopsis, *that1.Synopsis)
 		}
-	} else if this.StartTime != nil {
-		return fmt.Errorf("this.StartTime == nil && that.StartTime != nil")
-	} else if that1.StartTime != nil {
-		return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", this.StartTime, that1.StartTime)
+	} else if this.Synopsis != nil {
+		return fmt.Errorf("this.Synopsis == nil && that.Synopsis != nil")
+	} else if that1.Synopsis != nil {
+		return fmt.Errorf("Synopsis this(%v) Not Equal that(%v)", this.Synopsis, that1.Synopsis)
 	}
-	if this.EndTime != nil && that1.EndTime != nil {
-		if *this.EndTime != *that1.EndTime {
-			return fmt.Errorf("EndTime this(%v) Not Equal that(%v)", *this.EndTime, *that1.EndTime)
-		}
-	} else if this.EndTime != nil {
-		return fmt.Errorf("this.EndTime == nil && that.EndTime != nil")
-	} else if that1.EndTime != nil {
-		return fmt.Errorf("EndTime this(%v) Not Equal that(%v)", this.EndTime, that1.EndTime)
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
-	if this.ClassType != nil && that1.ClassType != nil {
-		if *this.ClassType != *that1.ClassType {
-			return fmt.Errorf("ClassType this(%v) Not Equal that(%v)", *this.ClassType, *that1.ClassType)
-		}
-	} else if this.ClassType != nil {
-		return fmt.Errorf("this.ClassType == nil && that.ClassType != nil")
-	} else if that1.ClassType != nil {
-		return fmt.Errorf("ClassType this(%v) Not Equal that(%v)", this.ClassType, that1.ClassType)
+	if this.TopicId != that1.TopicId {
+		return fmt.Errorf("TopicId this(%v) Not Equal that(%v)", this.TopicId, that1.TopicId)
 	}
-	if this.Index != that1.Index {
-		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	if len(this.Sections) != len(that1.Sections) {
+		return fmt.Errorf("Sections this(%v) Not Equal that(%v)", len(this.Sections), len(that1.Sections))
+	}
+	for i := range this.Sections {
+		if !this.Sections[i].Equal(that1.Sections[i]) {
+			return fmt.Errorf("Sections this[%v](%v) Not Equal that[%v](%v)", i, this.Sections[i], i, that1.Sections[i])
+		}
 	}
 	if len(this.Metadata) != len(that1.Metadata) {
 		return fmt.Errorf("Metadata this(%v) Not Equal that(%v)", len(this.Metadata), len(that1.Metadata))
@@ -2383,19 +3379,25 @@ func (this *Meeting) VerboseEqual(that interface{}) error {
 			return fmt.Errorf("Metadata this[%v](%v) Not Equal that[%v](%v)", i, this.Metadata[i], i, that1.Metadata[i])
 		}
 	}
+	if !this.Prerequisite.Equal(that1.Prerequisite) {
+		return fmt.Errorf("Prerequisite this(%v) Not Equal that(%v)", this.Prerequisite, that1.Prerequisite)
+	}
+	if this.ContentHash != that1.ContentHash {
+		return fmt.Errorf("ContentHash this(%v) Not Equal that(%v)", this.ContentHash, that1.ContentHash)
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Meeting) Equal(that interface{}) bool {
+func (this *Course) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Meeting)
+	that1, ok := that.(*Course)
 	if !ok {
-		that2, ok := that.(Meeting)
+		that2, ok := that.(Course)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2410,56 +3412,37 @@ func (this *Meeting) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.SectionId != that1.SectionId {
+	if this.SubjectId != that1.SubjectId {
 		return false
 	}
-	if this.Room != nil && that1.Room != nil {
-		if *this.Room != *that1.Room {
-			return false
-		}
-	} else if this.Room != nil {
+	if this.Name != that1.Name {
 		return false
-	} else if that1.Room != nil {
+	}
+	if this.Number != that1.Number {
 		return false
 	}
-	if this.Day != nil && that1.Day != nil {
-		if *this.Day != *that1.Day {
+	if this.Synopsis != nil && that1.Synopsis != nil {
+		if *this.Synopsis != *that1.Synopsis {
 			return false
 		}
-	} else if this.Day != nil {
+	} else if this.Synopsis != nil {
 		return false
-	} else if that1.Day != nil {
+	} else if that1.Synopsis != nil {
 		return false
 	}
-	if this.StartTime != nil && that1.StartTime != nil {
-		if *this.StartTime != *that1.StartTime {
-			return false
-		}
-	} else if this.StartTime != nil {
-		return false
-	} else if that1.StartTime != nil {
+	if this.TopicName != that1.TopicName {
 		return false
 	}
-	if this.EndTime != nil && that1.EndTime != nil {
-		if *this.EndTime != *that1.EndTime {
-			return false
-		}
-	} else if this.EndTime != nil {
+	if this.TopicId != that1.TopicId {
 		return false
-	} else if that1.EndTime != nil {
+	}
+	if len(this.Sections) != len(that1.Sections) {
 		return false
 	}
-	if this.ClassType != nil && that1.ClassType != nil {
-		if *this.ClassType != *that1.ClassType {
+	for i := range this.Sections {
+		if !this.Sections[i].Equal(that1.Sections[i]) {
 			return false
 		}
-	} else if this.ClassType != nil {
-		return false
-	} else if that1.ClassType != nil {
-		return false
-	}
-	if this.Index != that1.Index {
-		return false
 	}
 	if len(this.Metadata) != len(that1.Metadata) {
 		return false
@@ -2469,12 +3452,18 @@ func (this *Meeting) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Prerequisite.Equal(that1.Prerequisite) {
+		return false
+	}
+	if this.ContentHash != that1.ContentHash {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Instructor) VerboseEqual(that interface{}) error {
+func (this *Section) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2482,48 +3471,127 @@ func (this *Instructor) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Instructor)
+	that1, ok := that.(*Section)
 	if !ok {
-		that2, ok := that.(Instructor)
+		that2, ok := that.(Section)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Instructor")
+			return fmt.Errorf("that is not of type *Section")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Instructor but is nil && this != nil")
+		return fmt.Errorf("that is type *Section but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Instructor but is not nil && this == nil")
+		return fmt.Errorf("that is type *Section but is not nil && this == nil")
 	}
 	if this.Id != that1.Id {
 		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.SectionId != that1.SectionId {
-		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
+	if this.CourseId != that1.CourseId {
+		return fmt.Errorf("CourseId this(%v) Not Equal that(%v)", this.CourseId, that1.CourseId)
 	}
-	if this.Name != that1.Name {
-		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
+	if this.Number != that1.Number {
+		return fmt.Errorf("Number this(%v) Not Equal that(%v)", this.Number, that1.Number)
 	}
-	if this.Index != that1.Index {
-		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	if this.CallNumber != that1.CallNumber {
+		return fmt.Errorf("CallNumber this(%v) Not Equal that(%v)", this.CallNumber, that1.CallNumber)
+	}
+	if this.Max != that1.Max {
+		return fmt.Errorf("Max this(%v) Not Equal that(%v)", this.Max, that1.Max)
+	}
+	if this.Now != that1.Now {
+		return fmt.Errorf("Now this(%v) Not Equal that(%v)", this.Now, that1.Now)
+	}
+	if this.Status != that1.Status {
+		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
+	}
+	if this.Credits != that1.Credits {
+		return fmt.Errorf("Credits this(%v) Not Equal that(%v)", this.Credits, that1.Credits)
+	}
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+	}
+	if this.TopicId != that1.TopicId {
+		return fmt.Errorf("TopicId this(%v) Not Equal that(%v)", this.TopicId, that1.TopicId)
+	}
+	if len(this.Meetings) != len(that1.Meetings) {
+		return fmt.Errorf("Meetings this(%v) Not Equal that(%v)", len(this.Meetings), len(that1.Meetings))
+	}
+	for i := range this.Meetings {
+		if !this.Meetings[i].Equal(that1.Meetings[i]) {
+			return fmt.Errorf("Meetings this[%v](%v) Not Equal that[%v](%v)", i, this.Meetings[i], i, that1.Meetings[i])
+		}
+	}
+	if len(this.Instructors) != len(that1.Instructors) {
+		return fmt.Errorf("Instructors this(%v) Not Equal that(%v)", len(this.Instructors), len(that1.Instructors))
+	}
+	for i := range this.Instructors {
+		if !this.Instructors[i].Equal(that1.Instructors[i]) {
+			return fmt.Errorf("Instructors this[%v](%v) Not Equal that[%v](%v)", i, this.Instructors[i], i, that1.Instructors[i])
+		}
+	}
+	if len(this.Books) != len(that1.Books) {
+		return fmt.Errorf("Books this(%v) Not Equal that(%v)", len(this.Books), len(that1.Books))
+	}
+	for i := range this.Books {
+		if !this.Books[i].Equal(that1.Books[i]) {
+			return fmt.Errorf("Books this[%v](%v) Not Equal that[%v](%v)", i, this.Books[i], i, that1.Books[i])
+		}
+	}
+	if len(this.Metadata) != len(that1.Metadata) {
+		return fmt.Errorf("Metadata this(%v) Not Equal that(%v)", len(this.Metadata), len(that1.Metadata))
+	}
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return fmt.Errorf("Metadata this[%v](%v) Not Equal that[%v](%v)", i, this.Metadata[i], i, that1.Metadata[i])
+		}
+	}
+	if len(this.CrossListings) != len(that1.CrossListings) {
+		return fmt.Errorf("CrossListings this(%v) Not Equal that(%v)", len(this.CrossListings), len(that1.CrossListings))
+	}
+	for i := range this.CrossListings {
+		if !this.CrossListings[i].Equal(that1.CrossListings[i]) {
+			return fmt.Errorf("CrossListings this[%v](%v) Not Equal that[%v](%v)", i, this.CrossListings[i], i, that1.CrossListings[i])
+		}
+	}
+	if this.WaitlistMax != nil && that1.WaitlistMax != nil {
+		if *this.WaitlistMax != *that1.WaitlistMax {
+			return fmt.Errorf("WaitlistMax this(%v) Not Equal that(%v)", *this.WaitlistMax, *that1.WaitlistMax)
+		}
+	} else if this.WaitlistMax != nil {
+		return fmt.Errorf("this.WaitlistMax == nil && that.WaitlistMax != nil")
+	} else if that1.WaitlistMax != nil {
+		return fmt.Errorf("WaitlistMax this(%v) Not Equal that(%v)", this.WaitlistMax, that1.WaitlistMax)
+	}
+	if this.WaitlistNow != nil && that1.WaitlistNow != nil {
+		if *this.WaitlistNow != *that1.WaitlistNow {
+			return fmt.Errorf("WaitlistNow this(%v) Not Equal that(%v)", *this.WaitlistNow, *that1.WaitlistNow)
+		}
+	} else if this.WaitlistNow != nil {
+		return fmt.Errorf("this.WaitlistNow == nil && that.WaitlistNow != nil")
+	} else if that1.WaitlistNow != nil {
+		return fmt.Errorf("WaitlistNow this(%v) Not Equal that(%v)", this.WaitlistNow, that1.WaitlistNow)
+	}
+	if this.ContentHash != that1.ContentHash {
+		return fmt.Errorf("ContentHash this(%v) Not Equal that(%v)", this.ContentHash, that1.ContentHash)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Instructor) Equal(that interface{}) bool {
+func (this *Section) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Instructor)
+	that1, ok := that.(*Section)
 	if !ok {
-		that2, ok := that.(Instructor)
+		that2, ok := that.(Section)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2538,91 +3606,92 @@ func (this *Instructor) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.SectionId != that1.SectionId {
+	if this.CourseId != that1.CourseId {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.Number != that1.Number {
 		return false
 	}
-	if this.Index != that1.Index {
+	if this.CallNumber != that1.CallNumber {
 		return false
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+	if this.Max != that1.Max {
 		return false
 	}
-	return true
-}
-func (this *Book) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that == nil && this != nil")
+	if this.Now != that1.Now {
+		return false
 	}
-
-	that1, ok := that.(*Book)
-	if !ok {
-		that2, ok := that.(Book)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *Book")
-		}
+	if this.Status != that1.Status {
+		return false
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *Book but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *Book but is not nil && this == nil")
+	if this.Credits != that1.Credits {
+		return false
 	}
-	if this.Id != that1.Id {
-		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
+	if this.TopicName != that1.TopicName {
+		return false
 	}
-	if this.SectionId != that1.SectionId {
-		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
+	if this.TopicId != that1.TopicId {
+		return false
 	}
-	if this.Title != that1.Title {
-		return fmt.Errorf("Title this(%v) Not Equal that(%v)", this.Title, that1.Title)
+	if len(this.Meetings) != len(that1.Meetings) {
+		return false
 	}
-	if this.Url != that1.Url {
-		return fmt.Errorf("Url this(%v) Not Equal that(%v)", this.Url, that1.Url)
+	for i := range this.Meetings {
+		if !this.Meetings[i].Equal(that1.Meetings[i]) {
+			return false
+		}
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	if len(this.Instructors) != len(that1.Instructors) {
+		return false
 	}
-	return nil
-}
-func (this *Book) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	for i := range this.Instructors {
+		if !this.Instructors[i].Equal(that1.Instructors[i]) {
+			return false
+		}
 	}
-
-	that1, ok := that.(*Book)
-	if !ok {
-		that2, ok := that.(Book)
-		if ok {
-			that1 = &that2
-		} else {
+	if len(this.Books) != len(that1.Books) {
+		return false
+	}
+	for i := range this.Books {
+		if !this.Books[i].Equal(that1.Books[i]) {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if len(this.Metadata) != len(that1.Metadata) {
 		return false
 	}
-	if this.Id != that1.Id {
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return false
+		}
+	}
+	if len(this.CrossListings) != len(that1.CrossListings) {
 		return false
 	}
-	if this.SectionId != that1.SectionId {
+	for i := range this.CrossListings {
+		if !this.CrossListings[i].Equal(that1.CrossListings[i]) {
+			return false
+		}
+	}
+	if this.WaitlistMax != nil && that1.WaitlistMax != nil {
+		if *this.WaitlistMax != *that1.WaitlistMax {
+			return false
+		}
+	} else if this.WaitlistMax != nil {
+		return false
+	} else if that1.WaitlistMax != nil {
 		return false
 	}
-	if this.Title != that1.Title {
+	if this.WaitlistNow != nil && that1.WaitlistNow != nil {
+		if *this.WaitlistNow != *that1.WaitlistNow {
+			return false
+		}
+	} else if this.WaitlistNow != nil {
+		return false
+	} else if that1.WaitlistNow != nil {
 		return false
 	}
-	if this.Url != that1.Url {
+	if this.ContentHash != that1.ContentHash {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2630,7 +3699,7 @@ func (this *Book) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Metadata) VerboseEqual(that interface{}) error {
+func (this *Meeting) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2638,90 +3707,149 @@ func (this *Metadata) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Metadata)
+	that1, ok := that.(*Meeting)
 	if !ok {
-		that2, ok := that.(Metadata)
+		that2, ok := that.(Meeting)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Metadata")
+			return fmt.Errorf("that is not of type *Meeting")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Metadata but is nil && this != nil")
+		return fmt.Errorf("that is type *Meeting but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Metadata but is not nil && this == nil")
+		return fmt.Errorf("that is type *Meeting but is not nil && this == nil")
 	}
 	if this.Id != that1.Id {
 		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.UniversityId != nil && that1.UniversityId != nil {
-		if *this.UniversityId != *that1.UniversityId {
-			return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", *this.UniversityId, *that1.UniversityId)
+	if this.SectionId != that1.SectionId {
+		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
+	}
+	if this.Room != nil && that1.Room != nil {
+		if *this.Room != *that1.Room {
+			return fmt.Errorf("Room this(%v) Not Equal that(%v)", *this.Room, *that1.Room)
 		}
-	} else if this.UniversityId != nil {
-		return fmt.Errorf("this.UniversityId == nil && that.UniversityId != nil")
-	} else if that1.UniversityId != nil {
-		return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", this.UniversityId, that1.UniversityId)
+	} else if this.Room != nil {
+		return fmt.Errorf("this.Room == nil && that.Room != nil")
+	} else if that1.Room != nil {
+		return fmt.Errorf("Room this(%v) Not Equal that(%v)", this.Room, that1.Room)
 	}
-	if this.SubjectId != nil && that1.SubjectId != nil {
-		if *this.SubjectId != *that1.SubjectId {
-			return fmt.Errorf("SubjectId this(%v) Not Equal that(%v)", *this.SubjectId, *that1.SubjectId)
+	if this.Day != nil && that1.Day != nil {
+		if *this.Day != *that1.Day {
+			return fmt.Errorf("Day this(%v) Not Equal that(%v)", *this.Day, *that1.Day)
 		}
-	} else if this.SubjectId != nil {
-		return fmt.Errorf("this.SubjectId == nil && that.SubjectId != nil")
-	} else if that1.SubjectId != nil {
-		return fmt.Errorf("SubjectId this(%v) Not Equal that(%v)", this.SubjectId, that1.SubjectId)
+	} else if this.Day != nil {
+		return fmt.Errorf("this.Day == nil && that.Day != nil")
+	} else if that1.Day != nil {
+		return fmt.Errorf("Day this(%v) Not Equal that(%v)", this.Day, that1.Day)
 	}
-	if this.CourseId != nil && that1.CourseId != nil {
-		if *this.CourseId != *that1.CourseId {
-			return fmt.Errorf("CourseId this(%v) Not Equal that(%v)", *this.CourseId, *that1.CourseId)
+	if this.StartTime != nil && that1.StartTime != nil {
+		if *this.StartTime != *that1.StartTime {
+			return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", *this.StartTime, *that1.StartTime)
 		}
-	} else if this.CourseId != nil {
-		return fmt.Errorf("this.CourseId == nil && that.CourseId != nil")
-	} else if that1.CourseId != nil {
-		return fmt.Errorf("CourseId this(%v) Not Equal that(%v)", this.CourseId, that1.CourseId)
+	} else if this.StartTime != nil {
+		return fmt.Errorf("this.StartTime == nil && that.StartTime != nil")
+	} else if that1.StartTime != nil {
+		return fmt.Errorf("StartTime this(%v) Not Equal that(%v)", this.StartTime, that1.StartTime)
 	}
-	if this.SectionId != nil && that1.SectionId != nil {
-		if *this.SectionId != *that1.SectionId {
-			return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", *this.SectionId, *that1.SectionId)
+	if this.EndTime != nil && that1.EndTime != nil {
+		if *this.EndTime != *that1.EndTime {
+			return fmt.Errorf("EndTime this(%v) Not Equal that(%v)", *this.EndTime, *that1.EndTime)
 		}
-	} else if this.SectionId != nil {
-		return fmt.Errorf("this.SectionId == nil && that.SectionId != nil")
-	} else if that1.SectionId != nil {
-		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
+	} else if this.EndTime != nil {
+		return fmt.Errorf("this.EndTime == nil && that.EndTime != nil")
+	} else if that1.EndTime != nil {
+		return fmt.Errorf("EndTime this(%v) Not Equal that(%v)", this.EndTime, that1.EndTime)
 	}
-	if this.MeetingId != nil && that1.MeetingId != nil {
-		if *this.MeetingId != *that1.MeetingId {
-			return fmt.Errorf("MeetingId this(%v) Not Equal that(%v)", *this.MeetingId, *that1.MeetingId)
+	if this.ClassType != nil && that1.ClassType != nil {
+		if *this.ClassType != *that1.ClassType {
+			return fmt.Errorf("ClassType this(%v) Not Equal that(%v)", *this.ClassType, *that1.ClassType)
 		}
-	} else if this.MeetingId != nil {
-		return fmt.Errorf("this.MeetingId == nil && that.MeetingId != nil")
-	} else if that1.MeetingId != nil {
-		return fmt.Errorf("MeetingId this(%v) Not Equal that(%v)", this.MeetingId, that1.MeetingId)
+	} else if this.ClassType != nil {
+		return fmt.Errorf("this.ClassType == nil && that.ClassType != nil")
+	} else if that1.ClassType != nil {
+		return fmt.Errorf("ClassType this(%v) Not Equal that(%v)", this.ClassType, that1.ClassType)
 	}
-	if this.Title != that1.Title {
-		return fmt.Errorf("Title this(%v) Not Equal that(%v)", this.Title, that1.Title)
+	if this.Index != that1.Index {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
 	}
-	if this.Content != that1.Content {
-		return fmt.Errorf("Content this(%v) Not Equal that(%v)", this.Content, that1.Content)
+	if len(this.Metadata) != len(that1.Metadata) {
+		return fmt.Errorf("Metadata this(%v) Not Equal that(%v)", len(this.Metadata), len(that1.Metadata))
+	}
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return fmt.Errorf("Metadata this[%v](%v) Not Equal that[%v](%v)", i, this.Metadata[i], i, that1.Metadata[i])
+		}
+	}
+	if this.Weekday != nil && that1.Weekday != nil {
+		if *this.Weekday != *that1.Weekday {
+			return fmt.Errorf("Weekday this(%v) Not Equal that(%v)", *this.Weekday, *that1.Weekday)
+		}
+	} else if this.Weekday != nil {
+		return fmt.Errorf("this.Weekday == nil && that.Weekday != nil")
+	} else if that1.Weekday != nil {
+		return fmt.Errorf("Weekday this(%v) Not Equal that(%v)", this.Weekday, that1.Weekday)
+	}
+	if this.StartMinute != nil && that1.StartMinute != nil {
+		if *this.StartMinute != *that1.StartMinute {
+			return fmt.Errorf("StartMinute this(%v) Not Equal that(%v)", *this.StartMinute, *that1.StartMinute)
+		}
+	} else if this.StartMinute != nil {
+		return fmt.Errorf("this.StartMinute == nil && that.StartMinute != nil")
+	} else if that1.StartMinute != nil {
+		return fmt.Errorf("StartMinute this(%v) Not Equal that(%v)", this.StartMinute, that1.StartMinute)
+	}
+	if this.Duration != nil && that1.Duration != nil {
+		if *this.Duration != *that1.Duration {
+			return fmt.Errorf("Duration this(%v) Not Equal that(%v)", *this.Duration, *that1.Duration)
+		}
+	} else if this.Duration != nil {
+		return fmt.Errorf("this.Duration == nil && that.Duration != nil")
+	} else if that1.Duration != nil {
+		return fmt.Errorf("Duration this(%v) Not Equal that(%v)", this.Duration, that1.Duration)
+	}
+	if this.BuildingCode != nil && that1.BuildingCode != nil {
+		if *this.BuildingCode != *that1.BuildingCode {
+			return fmt.Errorf("BuildingCode this(%v) Not Equal that(%v)", *this.BuildingCode, *that1.BuildingCode)
+		}
+	} else if this.BuildingCode != nil {
+		return fmt.Errorf("this.BuildingCode == nil && that.BuildingCode != nil")
+	} else if that1.BuildingCode != nil {
+		return fmt.Errorf("BuildingCode this(%v) Not Equal that(%v)", this.BuildingCode, that1.BuildingCode)
+	}
+	if this.RoomNumber != nil && that1.RoomNumber != nil {
+		if *this.RoomNumber != *that1.RoomNumber {
+			return fmt.Errorf("RoomNumber this(%v) Not Equal that(%v)", *this.RoomNumber, *that1.RoomNumber)
+		}
+	} else if this.RoomNumber != nil {
+		return fmt.Errorf("this.RoomNumber == nil && that.RoomNumber != nil")
+	} else if that1.RoomNumber != nil {
+		return fmt.Errorf("RoomNumber this(%v) Not Equal that(%v)", this.RoomNumber, that1.RoomNumber)
+	}
+	if !this.Building.Equal(that1.Building) {
+		return fmt.Errorf("Building this(%v) Not Equal that(%v)", this.Building, that1.Building)
+	}
+	if this.ContentHash != that1.ContentHash {
+		return fmt.Errorf("ContentHash this(%v) Not Equal that(%v)", this.ContentHash, that1.ContentHash)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Metadata) Equal(that interface{}) bool {
+func (this *Meeting) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Metadata)
+	that1, ok := that.(*Meeting)
 	if !ok {
-		that2, ok := that.(Metadata)
+		that2, ok := that.(Meeting)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2736,55 +3864,114 @@ func (this *Metadata) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.UniversityId != nil && that1.UniversityId != nil {
-		if *this.UniversityId != *that1.UniversityId {
+	if this.SectionId != that1.SectionId {
+		return false
+	}
+	if this.Room != nil && that1.Room != nil {
+		if *this.Room != *that1.Room {
 			return false
 		}
-	} else if this.UniversityId != nil {
+	} else if this.Room != nil {
 		return false
-	} else if that1.UniversityId != nil {
+	} else if that1.Room != nil {
 		return false
 	}
-	if this.SubjectId != nil && that1.SubjectId != nil {
-		if *this.SubjectId != *that1.SubjectId {
+	if this.Day != nil && that1.Day != nil {
+		if *this.Day != *that1.Day {
 			return false
 		}
-	} else if this.SubjectId != nil {
+	} else if this.Day != nil {
 		return false
-	} else if that1.SubjectId != nil {
+	} else if that1.Day != nil {
 		return false
 	}
-	if this.CourseId != nil && that1.CourseId != nil {
-		if *this.CourseId != *that1.CourseId {
+	if this.StartTime != nil && that1.StartTime != nil {
+		if *this.StartTime != *that1.StartTime {
 			return false
 		}
-	} else if this.CourseId != nil {
+	} else if this.StartTime != nil {
 		return false
-	} else if that1.CourseId != nil {
+	} else if that1.StartTime != nil {
 		return false
 	}
-	if this.SectionId != nil && that1.SectionId != nil {
-		if *this.SectionId != *that1.SectionId {
+	if this.EndTime != nil && that1.EndTime != nil {
+		if *this.EndTime != *that1.EndTime {
 			return false
 		}
-	} else if this.SectionId != nil {
+	} else if this.EndTime != nil {
 		return false
-	} else if that1.SectionId != nil {
+	} else if that1.EndTime != nil {
 		return false
 	}
-	if this.MeetingId != nil && that1.MeetingId != nil {
-		if *this.MeetingId != *that1.MeetingId {
+	if this.ClassType != nil && that1.ClassType != nil {
+		if *this.ClassType != *that1.ClassType {
 			return false
 		}
-	} else if this.MeetingId != nil {
+	} else if this.ClassType != nil {
 		return false
-	} else if that1.MeetingId != nil {
+	} else if that1.ClassType != nil {
 		return false
 	}
-	if this.Title != that1.Title {
+	if this.Index != that1.Index {
 		return false
 	}
-	if this.Content != that1.Content {
+	if len(this.Metadata) != len(that1.Metadata) {
+		return false
+	}
+	for i := range this.Metadata {
+		if !this.Metadata[i].Equal(that1.Metadata[i]) {
+			return false
+		}
+	}
+	if this.Weekday != nil && that1.Weekday != nil {
+		if *this.Weekday != *that1.Weekday {
+			return false
+		}
+	} else if this.Weekday != nil {
+		return false
+	} else if that1.Weekday != nil {
+		return false
+	}
+	if this.StartMinute != nil && that1.StartMinute != nil {
+		if *this.StartMinute != *that1.StartMinute {
+			return false
+		}
+	} else if this.StartMinute != nil {
+		return false
+	} else if that1.StartMinute != nil {
+		return false
+	}
+	if this.Duration != nil && that1.Duration != nil {
+		if *this.Duration != *that1.Duration {
+			return false
+		}
+	} else if this.Duration != nil {
+		return false
+	} else if that1.Duration != nil {
+		return false
+	}
+	if this.BuildingCode != nil && that1.BuildingCode != nil {
+		if *this.BuildingCode != *that1.BuildingCode {
+			return false
+		}
+	} else if this.BuildingCode != nil {
+		return false
+	} else if that1.BuildingCode != nil {
+		return false
+	}
+	if this.RoomNumber != nil && that1.RoomNumber != nil {
+		if *this.RoomNumber != *that1.RoomNumber {
+			return false
+		}
+	} else if this.RoomNumber != nil {
+		return false
+	} else if that1.RoomNumber != nil {
+		return false
+	}
+	if !this.Building.Equal(that1.Building) {
+		return false
+	}
+	if this.ContentHash != that1.ContentHash {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2792,7 +3979,7 @@ func (this *Metadata) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Registration) VerboseEqual(that interface{}) error {
+func (this *Instructor) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2800,48 +3987,51 @@ func (this *Registration) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Registration)
+	that1, ok := that.(*Instructor)
 	if !ok {
-		that2, ok := that.(Registration)
+		that2, ok := that.(Instructor)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Registration")
+			return fmt.Errorf("that is not of type *Instructor")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Registration but is nil && this != nil")
+		return fmt.Errorf("that is type *Instructor but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Registration but is not nil && this == nil")
+		return fmt.Errorf("that is type *Instructor but is not nil && this == nil")
 	}
 	if this.Id != that1.Id {
 		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.UniversityId != that1.UniversityId {
-		return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", this.UniversityId, that1.UniversityId)
+	if this.SectionId != that1.SectionId {
+		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
 	}
-	if this.Period != that1.Period {
-		return fmt.Errorf("Period this(%v) Not Equal that(%v)", this.Period, that1.Period)
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
 	}
-	if this.PeriodDate != that1.PeriodDate {
-		return fmt.Errorf("PeriodDate this(%v) Not Equal that(%v)", this.PeriodDate, that1.PeriodDate)
+	if this.Index != that1.Index {
+		return fmt.Errorf("Index this(%v) Not Equal that(%v)", this.Index, that1.Index)
+	}
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Registration) Equal(that interface{}) bool {
+func (this *Instructor) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Registration)
+	that1, ok := that.(*Instructor)
 	if !ok {
-		that2, ok := that.(Registration)
+		that2, ok := that.(Instructor)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2856,13 +4046,16 @@ func (this *Registration) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.UniversityId != that1.UniversityId {
+	if this.SectionId != that1.SectionId {
 		return false
 	}
-	if this.Period != that1.Period {
+	if this.Name != that1.Name {
 		return false
 	}
-	if this.PeriodDate != that1.PeriodDate {
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.TopicName != that1.TopicName {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2870,7 +4063,7 @@ func (this *Registration) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ResolvedSemester) VerboseEqual(that interface{}) error {
+func (this *Book) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2878,45 +4071,48 @@ func (this *ResolvedSemester) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*ResolvedSemester)
+	that1, ok := that.(*Book)
 	if !ok {
-		that2, ok := that.(ResolvedSemester)
+		that2, ok := that.(Book)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *ResolvedSemester")
+			return fmt.Errorf("that is not of type *Book")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *ResolvedSemester but is nil && this != nil")
+		return fmt.Errorf("that is type *Book but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *ResolvedSemester but is not nil && this == nil")
+		return fmt.Errorf("that is type *Book but is not nil && this == nil")
 	}
-	if !this.Current.Equal(that1.Current) {
-		return fmt.Errorf("Current this(%v) Not Equal that(%v)", this.Current, that1.Current)
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if !this.Last.Equal(that1.Last) {
-		return fmt.Errorf("Last this(%v) Not Equal that(%v)", this.Last, that1.Last)
+	if this.SectionId != that1.SectionId {
+		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
 	}
-	if !this.Next.Equal(that1.Next) {
-		return fmt.Errorf("Next this(%v) Not Equal that(%v)", this.Next, that1.Next)
+	if this.Title != that1.Title {
+		return fmt.Errorf("Title this(%v) Not Equal that(%v)", this.Title, that1.Title)
+	}
+	if this.Url != that1.Url {
+		return fmt.Errorf("Url this(%v) Not Equal that(%v)", this.Url, that1.Url)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *ResolvedSemester) Equal(that interface{}) bool {
+func (this *Book) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResolvedSemester)
+	that1, ok := that.(*Book)
 	if !ok {
-		that2, ok := that.(ResolvedSemester)
+		that2, ok := that.(Book)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2928,13 +4124,16 @@ func (this *ResolvedSemester) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Current.Equal(that1.Current) {
+	if this.Id != that1.Id {
 		return false
 	}
-	if !this.Last.Equal(that1.Last) {
+	if this.SectionId != that1.SectionId {
 		return false
 	}
-	if !this.Next.Equal(that1.Next) {
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Url != that1.Url {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2942,7 +4141,7 @@ func (this *ResolvedSemester) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Semester) VerboseEqual(that interface{}) error {
+func (this *Metadata) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -2950,42 +4149,90 @@ func (this *Semester) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Semester)
+	that1, ok := that.(*Metadata)
 	if !ok {
-		that2, ok := that.(Semester)
+		that2, ok := that.(Metadata)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Semester")
+			return fmt.Errorf("that is not of type *Metadata")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Semester but is nil && this != nil")
+		return fmt.Errorf("that is type *Metadata but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Semester but is not nil && this == nil")
+		return fmt.Errorf("that is type *Metadata but is not nil && this == nil")
 	}
-	if this.Year != that1.Year {
-		return fmt.Errorf("Year this(%v) Not Equal that(%v)", this.Year, that1.Year)
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.Season != that1.Season {
-		return fmt.Errorf("Season this(%v) Not Equal that(%v)", this.Season, that1.Season)
+	if this.UniversityId != nil && that1.UniversityId != nil {
+		if *this.UniversityId != *that1.UniversityId {
+			return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", *this.UniversityId, *that1.UniversityId)
+		}
+	} else if this.UniversityId != nil {
+		return fmt.Errorf("this.UniversityId == nil && that.UniversityId != nil")
+	} else if that1.UniversityId != nil {
+		return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", this.UniversityId, that1.UniversityId)
+	}
+	if this.SubjectId != nil && that1.SubjectId != nil {
+		if *this.SubjectId != *that1.SubjectId {
+			return fmt.Errorf("SubjectId this(%v) Not Equal that(%v)", *this.SubjectId, *that1.SubjectId)
+		}
+	} else if this.SubjectId != nil {
+		return fmt.Errorf("this.SubjectId == nil && that.SubjectId != nil")
+	} else if that1.SubjectId != nil {
+		return fmt.Errorf("SubjectId this(%v) Not Equal that(%v)", this.SubjectId, that1.SubjectId)
+	}
+	if this.CourseId != nil && that1.CourseId != nil {
+		if *this.CourseId != *that1.CourseId {
+			return fmt.Errorf("CourseId this(%v) Not Equal that(%v)", *this.CourseId, *that1.CourseId)
+		}
+	} else if this.CourseId != nil {
+		return fmt.Errorf("this.CourseId == nil && that.CourseId != nil")
+	} else if that1.CourseId != nil {
+		return fmt.Errorf("CourseId this(%v) Not Equal that(%v)", this.CourseId, that1.CourseId)
+	}
+	if this.SectionId != nil && that1.SectionId != nil {
+		if *this.SectionId != *that1.SectionId {
+			return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", *this.SectionId, *that1.SectionId)
+		}
+	} else if this.SectionId != nil {
+		return fmt.Errorf("this.SectionId == nil && that.SectionId != nil")
+	} else if that1.SectionId != nil {
+		return fmt.Errorf("SectionId this(%v) Not Equal that(%v)", this.SectionId, that1.SectionId)
+	}
+	if this.MeetingId != nil && that1.MeetingId != nil {
+		if *this.MeetingId != *that1.MeetingId {
+			return fmt.Errorf("MeetingId this(%v) Not Equal that(%v)", *this.MeetingId, *that1.MeetingId)
+		}
+	} else if this.MeetingId != nil {
+		return fmt.Errorf("this.MeetingId == nil && that.MeetingId != nil")
+	} else if that1.MeetingId != nil {
+		return fmt.Errorf("MeetingId this(%v) Not Equal that(%v)", this.MeetingId, that1.MeetingId)
+	}
+	if this.Title != that1.Title {
+		return fmt.Errorf("Title this(%v) Not Equal that(%v)", this.Title, that1.Title)
+	}
+	if this.Content != that1.Content {
+		return fmt.Errorf("Content this(%v) Not Equal that(%v)", this.Content, that1.Content)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Semester) Equal(that interface{}) bool {
+func (this *Metadata) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Semester)
+	that1, ok := that.(*Metadata)
 	if !ok {
-		that2, ok := that.(Semester)
+		that2, ok := that.(Metadata)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2997,10 +4244,58 @@ func (this *Semester) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Year != that1.Year {
+	if this.Id != that1.Id {
 		return false
 	}
-	if this.Season != that1.Season {
+	if this.UniversityId != nil && that1.UniversityId != nil {
+		if *this.UniversityId != *that1.UniversityId {
+			return false
+		}
+	} else if this.UniversityId != nil {
+		return false
+	} else if that1.UniversityId != nil {
+		return false
+	}
+	if this.SubjectId != nil && that1.SubjectId != nil {
+		if *this.SubjectId != *that1.SubjectId {
+			return false
+		}
+	} else if this.SubjectId != nil {
+		return false
+	} else if that1.SubjectId != nil {
+		return false
+	}
+	if this.CourseId != nil && that1.CourseId != nil {
+		if *this.CourseId != *that1.CourseId {
+			return false
+		}
+	} else if this.CourseId != nil {
+		return false
+	} else if that1.CourseId != nil {
+		return false
+	}
+	if this.SectionId != nil && that1.SectionId != nil {
+		if *this.SectionId != *that1.SectionId {
+			return false
+		}
+	} else if this.SectionId != nil {
+		return false
+	} else if that1.SectionId != nil {
+		return false
+	}
+	if this.MeetingId != nil && that1.MeetingId != nil {
+		if *this.MeetingId != *that1.MeetingId {
+			return false
+		}
+	} else if this.MeetingId != nil {
+		return false
+	} else if that1.MeetingId != nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Content != that1.Content {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3008,7 +4303,7 @@ func (this *Semester) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *UCTNotification) VerboseEqual(that interface{}) error {
+func (this *Registration) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -3016,48 +4311,48 @@ func (this *UCTNotification) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*UCTNotification)
+	that1, ok := that.(*Registration)
 	if !ok {
-		that2, ok := that.(UCTNotification)
+		that2, ok := that.(Registration)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *UCTNotification")
+			return fmt.Errorf("that is not of type *Registration")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *UCTNotification but is nil && this != nil")
+		return fmt.Errorf("that is type *Registration but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *UCTNotification but is not nil && this == nil")
+		return fmt.Errorf("that is type *Registration but is not nil && this == nil")
 	}
-	if this.NotificationId != that1.NotificationId {
-		return fmt.Errorf("NotificationId this(%v) Not Equal that(%v)", this.NotificationId, that1.NotificationId)
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	if this.TopicName != that1.TopicName {
-		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+	if this.UniversityId != that1.UniversityId {
+		return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", this.UniversityId, that1.UniversityId)
 	}
-	if this.Status != that1.Status {
-		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
+	if this.Period != that1.Period {
+		return fmt.Errorf("Period this(%v) Not Equal that(%v)", this.Period, that1.Period)
 	}
-	if !this.University.Equal(&that1.University) {
-		return fmt.Errorf("University this(%v) Not Equal that(%v)", this.University, that1.University)
+	if this.PeriodDate != that1.PeriodDate {
+		return fmt.Errorf("PeriodDate this(%v) Not Equal that(%v)", this.PeriodDate, that1.PeriodDate)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *UCTNotification) Equal(that interface{}) bool {
+func (this *Registration) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UCTNotification)
+	that1, ok := that.(*Registration)
 	if !ok {
-		that2, ok := that.(UCTNotification)
+		that2, ok := that.(Registration)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3069,16 +4364,16 @@ func (this *UCTNotification) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NotificationId != that1.NotificationId {
+	if this.Id != that1.Id {
 		return false
 	}
-	if this.TopicName != that1.TopicName {
+	if this.UniversityId != that1.UniversityId {
 		return false
 	}
-	if this.Status != that1.Status {
+	if this.Period != that1.Period {
 		return false
 	}
-	if !this.University.Equal(&that1.University) {
+	if this.PeriodDate != that1.PeriodDate {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3086,7 +4381,7 @@ func (this *UCTNotification) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Response) VerboseEqual(that interface{}) error {
+func (this *ResolvedSemester) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -3094,42 +4389,45 @@ func (this *Response) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Response)
+	that1, ok := that.(*ResolvedSemester)
 	if !ok {
-		that2, ok := that.(Response)
+		that2, ok := that.(ResolvedSemester)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Response")
+			return fmt.Errorf("that is not of type *ResolvedSemester")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Response but is nil && this != nil")
+		return fmt.Errorf("that is type *ResolvedSemester but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Response but is not nil && this == nil")
+		return fmt.Errorf("that is type *ResolvedSemester but is not nil && this == nil")
 	}
-	if !this.Meta.Equal(that1.Meta) {
-		return fmt.Errorf("Meta this(%v) Not Equal that(%v)", this.Meta, that1.Meta)
+	if !this.Current.Equal(that1.Current) {
+		return fmt.Errorf("Current this(%v) Not Equal that(%v)", this.Current, that1.Current)
 	}
-	if !this.Data.Equal(that1.Data) {
-		return fmt.Errorf("Data this(%v) Not Equal that(%v)", this.Data, that1.Data)
+	if !this.Last.Equal(that1.Last) {
+		return fmt.Errorf("Last this(%v) Not Equal that(%v)", this.Last, that1.Last)
+	}
+	if !this.Next.Equal(that1.Next) {
+		return fmt.Errorf("Next this(%v) Not Equal that(%v)", this.Next, that1.Next)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Response) Equal(that interface{}) bool {
+func (this *ResolvedSemester) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Response)
+	that1, ok := that.(*ResolvedSemester)
 	if !ok {
-		that2, ok := that.(Response)
+		that2, ok := that.(ResolvedSemester)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3141,10 +4439,13 @@ func (this *Response) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Meta.Equal(that1.Meta) {
+	if !this.Current.Equal(that1.Current) {
 		return false
 	}
-	if !this.Data.Equal(that1.Data) {
+	if !this.Last.Equal(that1.Last) {
+		return false
+	}
+	if !this.Next.Equal(that1.Next) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3152,7 +4453,7 @@ func (this *Response) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Meta) VerboseEqual(that interface{}) error {
+func (this *Semester) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -3160,54 +4461,48 @@ func (this *Meta) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Meta)
+	that1, ok := that.(*Semester)
 	if !ok {
-		that2, ok := that.(Meta)
+		that2, ok := that.(Semester)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Meta")
+			return fmt.Errorf("that is not of type *Semester")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Meta but is nil && this != nil")
+		return fmt.Errorf("that is type *Semester but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Meta but is not nil && this == nil")
+		return fmt.Errorf("that is type *Semester but is not nil && this == nil")
 	}
-	if this.Code != nil && that1.Code != nil {
-		if *this.Code != *that1.Code {
-			return fmt.Errorf("Code this(%v) Not Equal that(%v)", *this.Code, *that1.Code)
-		}
-	} else if this.Code != nil {
-		return fmt.Errorf("this.Code == nil && that.Code != nil")
-	} else if that1.Code != nil {
-		return fmt.Errorf("Code this(%v) Not Equal that(%v)", this.Code, that1.Code)
+	if this.Year != that1.Year {
+		return fmt.Errorf("Year this(%v) Not Equal that(%v)", this.Year, that1.Year)
 	}
-	if this.Message != nil && that1.Message != nil {
-		if *this.Message != *that1.Message {
-			return fmt.Errorf("Message this(%v) Not Equal that(%v)", *this.Message, *that1.Message)
-		}
-	} else if this.Message != nil {
-		return fmt.Errorf("this.Message == nil && that.Message != nil")
-	} else if that1.Message != nil {
-		return fmt.Errorf("Message this(%v) Not Equal that(%v)", this.Message, that1.Message)
+	if this.Season != that1.Season {
+		return fmt.Errorf("Season this(%v) Not Equal that(%v)", this.Season, that1.Season)
+	}
+	if this.TermCode != that1.TermCode {
+		return fmt.Errorf("TermCode this(%v) Not Equal that(%v)", this.TermCode, that1.TermCode)
+	}
+	if this.TermName != that1.TermName {
+		return fmt.Errorf("TermName this(%v) Not Equal that(%v)", this.TermName, that1.TermName)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Meta) Equal(that interface{}) bool {
+func (this *Semester) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Meta)
+	that1, ok := that.(*Semester)
 	if !ok {
-		that2, ok := that.(Meta)
+		that2, ok := that.(Semester)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3219,22 +4514,16 @@ func (this *Meta) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Code != nil && that1.Code != nil {
-		if *this.Code != *that1.Code {
-			return false
-		}
-	} else if this.Code != nil {
+	if this.Year != that1.Year {
 		return false
-	} else if that1.Code != nil {
+	}
+	if this.Season != that1.Season {
 		return false
 	}
-	if this.Message != nil && that1.Message != nil {
-		if *this.Message != *that1.Message {
-			return false
-		}
-	} else if this.Message != nil {
+	if this.TermCode != that1.TermCode {
 		return false
-	} else if that1.Message != nil {
+	}
+	if this.TermName != that1.TermName {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3242,7 +4531,7 @@ func (this *Meta) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Data) VerboseEqual(that interface{}) error {
+func (this *UCTNotification) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -3250,88 +4539,59 @@ func (this *Data) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Data)
+	that1, ok := that.(*UCTNotification)
 	if !ok {
-		that2, ok := that.(Data)
+		that2, ok := that.(UCTNotification)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Data")
+			return fmt.Errorf("that is not of type *UCTNotification")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Data but is nil && this != nil")
+		return fmt.Errorf("that is type *UCTNotification but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Data but is not nil && this == nil")
-	}
-	if len(this.Universities) != len(that1.Universities) {
-		return fmt.Errorf("Universities this(%v) Not Equal that(%v)", len(this.Universities), len(that1.Universities))
-	}
-	for i := range this.Universities {
-		if !this.Universities[i].Equal(that1.Universities[i]) {
-			return fmt.Errorf("Universities this[%v](%v) Not Equal that[%v](%v)", i, this.Universities[i], i, that1.Universities[i])
-		}
-	}
-	if len(this.Subjects) != len(that1.Subjects) {
-		return fmt.Errorf("Subjects this(%v) Not Equal that(%v)", len(this.Subjects), len(that1.Subjects))
-	}
-	for i := range this.Subjects {
-		if !this.Subjects[i].Equal(that1.Subjects[i]) {
-			return fmt.Errorf("Subjects this[%v](%v) Not Equal that[%v](%v)", i, this.Subjects[i], i, that1.Subjects[i])
-		}
-	}
-	if len(this.Courses) != len(that1.Courses) {
-		return fmt.Errorf("Courses this(%v) Not Equal that(%v)", len(this.Courses), len(that1.Courses))
+		return fmt.Errorf("that is type *UCTNotification but is not nil && this == nil")
 	}
-	for i := range this.Courses {
-		if !this.Courses[i].Equal(that1.Courses[i]) {
-			return fmt.Errorf("Courses this[%v](%v) Not Equal that[%v](%v)", i, this.Courses[i], i, that1.Courses[i])
-		}
+	if this.NotificationId != that1.NotificationId {
+		return fmt.Errorf("NotificationId this(%v) Not Equal that(%v)", this.NotificationId, that1.NotificationId)
 	}
-	if len(this.Sections) != len(that1.Sections) {
-		return fmt.Errorf("Sections this(%v) Not Equal that(%v)", len(this.Sections), len(that1.Sections))
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
-	for i := range this.Sections {
-		if !this.Sections[i].Equal(that1.Sections[i]) {
-			return fmt.Errorf("Sections this[%v](%v) Not Equal that[%v](%v)", i, this.Sections[i], i, that1.Sections[i])
-		}
+	if this.Status != that1.Status {
+		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
 	}
-	if !this.University.Equal(that1.University) {
+	if !this.University.Equal(&that1.University) {
 		return fmt.Errorf("University this(%v) Not Equal that(%v)", this.University, that1.University)
 	}
-	if !this.Subject.Equal(that1.Subject) {
-		return fmt.Errorf("Subject this(%v) Not Equal that(%v)", this.Subject, that1.Subject)
-	}
-	if !this.Course.Equal(that1.Course) {
-		return fmt.Errorf("Course this(%v) Not Equal that(%v)", this.Course, that1.Course)
-	}
-	if !this.Section.Equal(that1.Section) {
-		return fmt.Errorf("Section this(%v) Not Equal that(%v)", this.Section, that1.Section)
-	}
-	if len(this.SubscriptionView) != len(that1.SubscriptionView) {
-		return fmt.Errorf("SubscriptionView this(%v) Not Equal that(%v)", len(this.SubscriptionView), len(that1.SubscriptionView))
+	if len(this.CrossListedTopicNames) != len(that1.CrossListedTopicNames) {
+		return fmt.Errorf("CrossListedTopicNames this(%v) Not Equal that(%v)", len(this.CrossListedTopicNames), len(that1.CrossListedTopicNames))
 	}
-	for i := range this.SubscriptionView {
-		if !this.SubscriptionView[i].Equal(that1.SubscriptionView[i]) {
-			return fmt.Errorf("SubscriptionView this[%v](%v) Not Equal that[%v](%v)", i, this.SubscriptionView[i], i, that1.SubscriptionView[i])
+	for i := range this.CrossListedTopicNames {
+		if this.CrossListedTopicNames[i] != that1.CrossListedTopicNames[i] {
+			return fmt.Errorf("CrossListedTopicNames this[%v](%v) Not Equal that[%v](%v)", i, this.CrossListedTopicNames[i], i, that1.CrossListedTopicNames[i])
 		}
 	}
+	if this.Type != that1.Type {
+		return fmt.Errorf("Type this(%v) Not Equal that(%v)", this.Type, that1.Type)
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Data) Equal(that interface{}) bool {
+func (this *UCTNotification) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Data)
+	that1, ok := that.(*UCTNotification)
 	if !ok {
-		that2, ok := that.(Data)
+		that2, ok := that.(UCTNotification)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3343,64 +4603,35 @@ func (this *Data) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Universities) != len(that1.Universities) {
+	if this.NotificationId != that1.NotificationId {
 		return false
 	}
-	for i := range this.Universities {
-		if !this.Universities[i].Equal(that1.Universities[i]) {
-			return false
-		}
-	}
-	if len(this.Subjects) != len(that1.Subjects) {
+	if this.TopicName != that1.TopicName {
 		return false
 	}
-	for i := range this.Subjects {
-		if !this.Subjects[i].Equal(that1.Subjects[i]) {
-			return false
-		}
-	}
-	if len(this.Courses) != len(that1.Courses) {
+	if this.Status != that1.Status {
 		return false
 	}
-	for i := range this.Courses {
-		if !this.Courses[i].Equal(that1.Courses[i]) {
-			return false
-		}
+	if !this.University.Equal(&that1.University) {
+		return false
 	}
-	if len(this.Sections) != len(that1.Sections) {
+	if len(this.CrossListedTopicNames) != len(that1.CrossListedTopicNames) {
 		return false
 	}
-	for i := range this.Sections {
-		if !this.Sections[i].Equal(that1.Sections[i]) {
+	for i := range this.CrossListedTopicNames {
+		if this.CrossListedTopicNames[i] != that1.CrossListedTopicNames[i] {
 			return false
 		}
 	}
-	if !this.University.Equal(that1.University) {
-		return false
-	}
-	if !this.Subject.Equal(that1.Subject) {
-		return false
-	}
-	if !this.Course.Equal(that1.Course) {
-		return false
-	}
-	if !this.Section.Equal(that1.Section) {
-		return false
-	}
-	if len(this.SubscriptionView) != len(that1.SubscriptionView) {
+	if this.Type != that1.Type {
 		return false
 	}
-	for i := range this.SubscriptionView {
-		if !this.SubscriptionView[i].Equal(that1.SubscriptionView[i]) {
-			return false
-		}
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *Subscription) VerboseEqual(that interface{}) error {
+func (this *Response) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -3408,54 +4639,42 @@ func (this *Subscription) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*Subscription)
+	that1, ok := that.(*Response)
 	if !ok {
-		that2, ok := that.(Subscription)
+		that2, ok := that.(Response)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *Subscription")
+			return fmt.Errorf("that is not of type *Response")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *Subscription but is nil && this != nil")
+		return fmt.Errorf("that is type *Response but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *Subscription but is not nil && this == nil")
-	}
-	if this.Id != that1.Id {
-		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
-	}
-	if this.Os != that1.Os {
-		return fmt.Errorf("Os this(%v) Not Equal that(%v)", this.Os, that1.Os)
-	}
-	if this.IsSubscribed != that1.IsSubscribed {
-		return fmt.Errorf("IsSubscribed this(%v) Not Equal that(%v)", this.IsSubscribed, that1.IsSubscribed)
-	}
-	if this.TopicName != that1.TopicName {
-		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+		return fmt.Errorf("that is type *Response but is not nil && this == nil")
 	}
-	if this.FcmToken != that1.FcmToken {
-		return fmt.Errorf("FcmToken this(%v) Not Equal that(%v)", this.FcmToken, that1.FcmToken)
+	if !this.Meta.Equal(that1.Meta) {
+		return fmt.Errorf("Meta this(%v) Not Equal that(%v)", this.Meta, that1.Meta)
 	}
-	if this.CreatedAt != that1.CreatedAt {
-		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
+	if !this.Data.Equal(that1.Data) {
+		return fmt.Errorf("Data this(%v) Not Equal that(%v)", this.Data, that1.Data)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *Subscription) Equal(that interface{}) bool {
+func (this *Response) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Subscription)
+	that1, ok := that.(*Response)
 	if !ok {
-		that2, ok := that.(Subscription)
+		that2, ok := that.(Response)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3467,22 +4686,10 @@ func (this *Subscription) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Os != that1.Os {
-		return false
-	}
-	if this.IsSubscribed != that1.IsSubscribed {
-		return false
-	}
-	if this.TopicName != that1.TopicName {
-		return false
-	}
-	if this.FcmToken != that1.FcmToken {
+	if !this.Meta.Equal(that1.Meta) {
 		return false
 	}
-	if this.CreatedAt != that1.CreatedAt {
+	if !this.Data.Equal(that1.Data) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3490,7 +4697,7 @@ func (this *Subscription) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SubscriptionView) VerboseEqual(that interface{}) error {
+func (this *Meta) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -3498,45 +4705,54 @@ func (this *SubscriptionView) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*SubscriptionView)
+	that1, ok := that.(*Meta)
 	if !ok {
-		that2, ok := that.(SubscriptionView)
+		that2, ok := that.(Meta)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *SubscriptionView")
+			return fmt.Errorf("that is not of type *Meta")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *SubscriptionView but is nil && this != nil")
+		return fmt.Errorf("that is type *Meta but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *SubscriptionView but is not nil && this == nil")
-	}
-	if this.TopicName != that1.TopicName {
-		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+		return fmt.Errorf("that is type *Meta but is not nil && this == nil")
 	}
-	if this.Subscribers != that1.Subscribers {
-		return fmt.Errorf("Subscribers this(%v) Not Equal that(%v)", this.Subscribers, that1.Subscribers)
+	if this.Code != nil && that1.Code != nil {
+		if *this.Code != *that1.Code {
+			return fmt.Errorf("Code this(%v) Not Equal that(%v)", *this.Code, *that1.Code)
+		}
+	} else if this.Code != nil {
+		return fmt.Errorf("this.Code == nil && that.Code != nil")
+	} else if that1.Code != nil {
+		return fmt.Errorf("Code this(%v) Not Equal that(%v)", this.Code, that1.Code)
 	}
-	if this.IsHot != that1.IsHot {
-		return fmt.Errorf("IsHot this(%v) Not Equal that(%v)", this.IsHot, that1.IsHot)
+	if this.Message != nil && that1.Message != nil {
+		if *this.Message != *that1.Message {
+			return fmt.Errorf("Message this(%v) Not Equal that(%v)", *this.Message, *that1.Message)
+		}
+	} else if this.Message != nil {
+		return fmt.Errorf("this.Message == nil && that.Message != nil")
+	} else if that1.Message != nil {
+		return fmt.Errorf("Message this(%v) Not Equal that(%v)", this.Message, that1.Message)
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
 	return nil
 }
-func (this *SubscriptionView) Equal(that interface{}) bool {
+func (this *Meta) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SubscriptionView)
+	that1, ok := that.(*Meta)
 	if !ok {
-		that2, ok := that.(SubscriptionView)
+		that2, ok := that.(Meta)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3548,13 +4764,22 @@ func (this *SubscriptionView) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.TopicName != that1.TopicName {
+	if this.Code != nil && that1.Code != nil {
+		if *this.Code != *that1.Code {
+			return false
+		}
+	} else if this.Code != nil {
 		return false
-	}
-	if this.Subscribers != that1.Subscribers {
+	} else if that1.Code != nil {
 		return false
 	}
-	if this.IsHot != that1.IsHot {
+	if this.Message != nil && that1.Message != nil {
+		if *this.Message != *that1.Message {
+			return false
+		}
+	} else if this.Message != nil {
+		return false
+	} else if that1.Message != nil {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3562,3078 +4787,9415 @@ func (this *SubscriptionView) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *University) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Data) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&model.University{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "Abbr: "+fmt.Sprintf("%#v", this.Abbr)+",\n")
-	s = append(s, "HomePage: "+fmt.Sprintf("%#v", this.HomePage)+",\n")
-	s = append(s, "RegistrationPage: "+fmt.Sprintf("%#v", this.RegistrationPage)+",\n")
-	s = append(s, "MainColor: "+fmt.Sprintf("%#v", this.MainColor)+",\n")
-	s = append(s, "AccentColor: "+fmt.Sprintf("%#v", this.AccentColor)+",\n")
-	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
-	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
-	if this.ResolvedSemesters != nil {
-		s = append(s, "ResolvedSemesters: "+fmt.Sprintf("%#v", this.ResolvedSemesters)+",\n")
-	}
-	if this.Subjects != nil {
-		s = append(s, "Subjects: "+fmt.Sprintf("%#v", this.Subjects)+",\n")
-	}
-	if this.AvailableSemesters != nil {
-		s = append(s, "AvailableSemesters: "+fmt.Sprintf("%#v", this.AvailableSemesters)+",\n")
+
+	that1, ok := that.(*Data)
+	if !ok {
+		that2, ok := that.(Data)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Data")
+		}
 	}
-	if this.Registrations != nil {
-		s = append(s, "Registrations: "+fmt.Sprintf("%#v", this.Registrations)+",\n")
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Data but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Data but is not nil && this == nil")
 	}
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if len(this.Universities) != len(that1.Universities) {
+		return fmt.Errorf("Universities this(%v) Not Equal that(%v)", len(this.Universities), len(that1.Universities))
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	for i := range this.Universities {
+		if !this.Universities[i].Equal(that1.Universities[i]) {
+			return fmt.Errorf("Universities this[%v](%v) Not Equal that[%v](%v)", i, this.Universities[i], i, that1.Universities[i])
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Subject) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Subjects) != len(that1.Subjects) {
+		return fmt.Errorf("Subjects this(%v) Not Equal that(%v)", len(this.Subjects), len(that1.Subjects))
 	}
-	s := make([]string, 0, 14)
-	s = append(s, "&model.Subject{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "UniversityId: "+fmt.Sprintf("%#v", this.UniversityId)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
-	s = append(s, "Season: "+fmt.Sprintf("%#v", this.Season)+",\n")
-	s = append(s, "Year: "+fmt.Sprintf("%#v", this.Year)+",\n")
-	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
-	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
-	if this.Courses != nil {
-		s = append(s, "Courses: "+fmt.Sprintf("%#v", this.Courses)+",\n")
+	for i := range this.Subjects {
+		if !this.Subjects[i].Equal(that1.Subjects[i]) {
+			return fmt.Errorf("Subjects this[%v](%v) Not Equal that[%v](%v)", i, this.Subjects[i], i, that1.Subjects[i])
+		}
 	}
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if len(this.Courses) != len(that1.Courses) {
+		return fmt.Errorf("Courses this(%v) Not Equal that(%v)", len(this.Courses), len(that1.Courses))
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	for i := range this.Courses {
+		if !this.Courses[i].Equal(that1.Courses[i]) {
+			return fmt.Errorf("Courses this[%v](%v) Not Equal that[%v](%v)", i, this.Courses[i], i, that1.Courses[i])
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Course) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Sections) != len(that1.Sections) {
+		return fmt.Errorf("Sections this(%v) Not Equal that(%v)", len(this.Sections), len(that1.Sections))
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&model.Course{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "SubjectId: "+fmt.Sprintf("%#v", this.SubjectId)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
-	if this.Synopsis != nil {
-		s = append(s, "Synopsis: "+valueToGoStringModel(this.Synopsis, "string")+",\n")
+	for i := range this.Sections {
+		if !this.Sections[i].Equal(that1.Sections[i]) {
+			return fmt.Errorf("Sections this[%v](%v) Not Equal that[%v](%v)", i, this.Sections[i], i, that1.Sections[i])
+		}
 	}
-	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
-	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
-	if this.Sections != nil {
-		s = append(s, "Sections: "+fmt.Sprintf("%#v", this.Sections)+",\n")
+	if !this.University.Equal(that1.University) {
+		return fmt.Errorf("University this(%v) Not Equal that(%v)", this.University, that1.University)
 	}
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if !this.Subject.Equal(that1.Subject) {
+		return fmt.Errorf("Subject this(%v) Not Equal that(%v)", this.Subject, that1.Subject)
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !this.Course.Equal(that1.Course) {
+		return fmt.Errorf("Course this(%v) Not Equal that(%v)", this.Course, that1.Course)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Section) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Section.Equal(that1.Section) {
+		return fmt.Errorf("Section this(%v) Not Equal that(%v)", this.Section, that1.Section)
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&model.Section{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "CourseId: "+fmt.Sprintf("%#v", this.CourseId)+",\n")
-	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
-	s = append(s, "CallNumber: "+fmt.Sprintf("%#v", this.CallNumber)+",\n")
-	s = append(s, "Max: "+fmt.Sprintf("%#v", this.Max)+",\n")
-	s = append(s, "Now: "+fmt.Sprintf("%#v", this.Now)+",\n")
-	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
-	s = append(s, "Credits: "+fmt.Sprintf("%#v", this.Credits)+",\n")
-	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
-	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
-	if this.Meetings != nil {
-		s = append(s, "Meetings: "+fmt.Sprintf("%#v", this.Meetings)+",\n")
+	if len(this.SubscriptionView) != len(that1.SubscriptionView) {
+		return fmt.Errorf("SubscriptionView this(%v) Not Equal that(%v)", len(this.SubscriptionView), len(that1.SubscriptionView))
 	}
-	if this.Instructors != nil {
-		s = append(s, "Instructors: "+fmt.Sprintf("%#v", this.Instructors)+",\n")
+	for i := range this.SubscriptionView {
+		if !this.SubscriptionView[i].Equal(that1.SubscriptionView[i]) {
+			return fmt.Errorf("SubscriptionView this[%v](%v) Not Equal that[%v](%v)", i, this.SubscriptionView[i], i, that1.SubscriptionView[i])
+		}
 	}
-	if this.Books != nil {
-		s = append(s, "Books: "+fmt.Sprintf("%#v", this.Books)+",\n")
+	if !this.SectionHistory.Equal(that1.SectionHistory) {
+		return fmt.Errorf("SectionHistory this(%v) Not Equal that(%v)", this.SectionHistory, that1.SectionHistory)
 	}
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	if len(this.SearchResults) != len(that1.SearchResults) {
+		return fmt.Errorf("SearchResults this(%v) Not Equal that(%v)", len(this.SearchResults), len(that1.SearchResults))
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	for i := range this.SearchResults {
+		if !this.SearchResults[i].Equal(that1.SearchResults[i]) {
+			return fmt.Errorf("SearchResults this[%v](%v) Not Equal that[%v](%v)", i, this.SearchResults[i], i, that1.SearchResults[i])
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Meeting) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Page.Equal(that1.Page) {
+		return fmt.Errorf("Page this(%v) Not Equal that(%v)", this.Page, that1.Page)
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&model.Meeting{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "SectionId: "+fmt.Sprintf("%#v", this.SectionId)+",\n")
-	if this.Room != nil {
-		s = append(s, "Room: "+valueToGoStringModel(this.Room, "string")+",\n")
+	if len(this.Schedules) != len(that1.Schedules) {
+		return fmt.Errorf("Schedules this(%v) Not Equal that(%v)", len(this.Schedules), len(that1.Schedules))
 	}
-	if this.Day != nil {
-		s = append(s, "Day: "+valueToGoStringModel(this.Day, "string")+",\n")
+	for i := range this.Schedules {
+		if !this.Schedules[i].Equal(that1.Schedules[i]) {
+			return fmt.Errorf("Schedules this[%v](%v) Not Equal that[%v](%v)", i, this.Schedules[i], i, that1.Schedules[i])
+		}
 	}
-	if this.StartTime != nil {
-		s = append(s, "StartTime: "+valueToGoStringModel(this.StartTime, "string")+",\n")
+	if !this.Prerequisite.Equal(that1.Prerequisite) {
+		return fmt.Errorf("Prerequisite this(%v) Not Equal that(%v)", this.Prerequisite, that1.Prerequisite)
 	}
-	if this.EndTime != nil {
-		s = append(s, "EndTime: "+valueToGoStringModel(this.EndTime, "string")+",\n")
+	if !this.InstructorProfile.Equal(that1.InstructorProfile) {
+		return fmt.Errorf("InstructorProfile this(%v) Not Equal that(%v)", this.InstructorProfile, that1.InstructorProfile)
 	}
-	if this.ClassType != nil {
-		s = append(s, "ClassType: "+valueToGoStringModel(this.ClassType, "string")+",\n")
+	if len(this.Buildings) != len(that1.Buildings) {
+		return fmt.Errorf("Buildings this(%v) Not Equal that(%v)", len(this.Buildings), len(that1.Buildings))
 	}
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	if this.Metadata != nil {
-		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	for i := range this.Buildings {
+		if !this.Buildings[i].Equal(that1.Buildings[i]) {
+			return fmt.Errorf("Buildings this[%v](%v) Not Equal that[%v](%v)", i, this.Buildings[i], i, that1.Buildings[i])
+		}
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *Instructor) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Data) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&model.Instructor{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "SectionId: "+fmt.Sprintf("%#v", this.SectionId)+",\n")
-	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*Data)
+	if !ok {
+		that2, ok := that.(Data)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Book) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&model.Book{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "SectionId: "+fmt.Sprintf("%#v", this.SectionId)+",\n")
-	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
-	s = append(s, "Url: "+fmt.Sprintf("%#v", this.Url)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if len(this.Universities) != len(that1.Universities) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Metadata) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Universities {
+		if !this.Universities[i].Equal(that1.Universities[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&model.Metadata{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	if this.UniversityId != nil {
-		s = append(s, "UniversityId: "+valueToGoStringModel(this.UniversityId, "int64")+",\n")
+	if len(this.Subjects) != len(that1.Subjects) {
+		return false
 	}
-	if this.SubjectId != nil {
-		s = append(s, "SubjectId: "+valueToGoStringModel(this.SubjectId, "int64")+",\n")
+	for i := range this.Subjects {
+		if !this.Subjects[i].Equal(that1.Subjects[i]) {
+			return false
+		}
 	}
-	if this.CourseId != nil {
-		s = append(s, "CourseId: "+valueToGoStringModel(this.CourseId, "int64")+",\n")
+	if len(this.Courses) != len(that1.Courses) {
+		return false
 	}
-	if this.SectionId != nil {
-		s = append(s, "SectionId: "+valueToGoStringModel(this.SectionId, "int64")+",\n")
+	for i := range this.Courses {
+		if !this.Courses[i].Equal(that1.Courses[i]) {
+			return false
+		}
 	}
-	if this.MeetingId != nil {
-		s = append(s, "MeetingId: "+valueToGoStringModel(this.MeetingId, "int64")+",\n")
+	if len(this.Sections) != len(that1.Sections) {
+		return false
 	}
-	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
-	s = append(s, "Content: "+fmt.Sprintf("%#v", this.Content)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	for i := range this.Sections {
+		if !this.Sections[i].Equal(that1.Sections[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Registration) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.University.Equal(that1.University) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&model.Registration{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "UniversityId: "+fmt.Sprintf("%#v", this.UniversityId)+",\n")
-	s = append(s, "Period: "+fmt.Sprintf("%#v", this.Period)+",\n")
-	s = append(s, "PeriodDate: "+fmt.Sprintf("%#v", this.PeriodDate)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !this.Subject.Equal(that1.Subject) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResolvedSemester) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Course.Equal(that1.Course) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&model.ResolvedSemester{")
-	if this.Current != nil {
-		s = append(s, "Current: "+fmt.Sprintf("%#v", this.Current)+",\n")
+	if !this.Section.Equal(that1.Section) {
+		return false
 	}
-	if this.Last != nil {
-		s = append(s, "Last: "+fmt.Sprintf("%#v", this.Last)+",\n")
+	if len(this.SubscriptionView) != len(that1.SubscriptionView) {
+		return false
 	}
-	if this.Next != nil {
-		s = append(s, "Next: "+fmt.Sprintf("%#v", this.Next)+",\n")
+	for i := range this.SubscriptionView {
+		if !this.SubscriptionView[i].Equal(that1.SubscriptionView[i]) {
+			return false
+		}
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !this.SectionHistory.Equal(that1.SectionHistory) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Semester) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.SearchResults) != len(that1.SearchResults) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&model.Semester{")
-	s = append(s, "Year: "+fmt.Sprintf("%#v", this.Year)+",\n")
-	s = append(s, "Season: "+fmt.Sprintf("%#v", this.Season)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	for i := range this.SearchResults {
+		if !this.SearchResults[i].Equal(that1.SearchResults[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *UCTNotification) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Page.Equal(that1.Page) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&model.UCTNotification{")
-	s = append(s, "NotificationId: "+fmt.Sprintf("%#v", this.NotificationId)+",\n")
-	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
-	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
-	s = append(s, "University: "+strings.Replace(this.University.GoString(), `&`, ``, 1)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if len(this.Schedules) != len(that1.Schedules) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Response) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Schedules {
+		if !this.Schedules[i].Equal(that1.Schedules[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&model.Response{")
-	if this.Meta != nil {
-		s = append(s, "Meta: "+fmt.Sprintf("%#v", this.Meta)+",\n")
+	if !this.Prerequisite.Equal(that1.Prerequisite) {
+		return false
 	}
-	if this.Data != nil {
-		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	if !this.InstructorProfile.Equal(that1.InstructorProfile) {
+		return false
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if len(this.Buildings) != len(that1.Buildings) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	for i := range this.Buildings {
+		if !this.Buildings[i].Equal(that1.Buildings[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-func (this *Meta) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Subscription) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&model.Meta{")
-	if this.Code != nil {
-		s = append(s, "Code: "+valueToGoStringModel(this.Code, "int32")+",\n")
+
+	that1, ok := that.(*Subscription)
+	if !ok {
+		that2, ok := that.(Subscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Subscription")
+		}
 	}
-	if this.Message != nil {
-		s = append(s, "Message: "+valueToGoStringModel(this.Message, "string")+",\n")
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Subscription but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Subscription but is not nil && this == nil")
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Data) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Os != that1.Os {
+		return fmt.Errorf("Os this(%v) Not Equal that(%v)", this.Os, that1.Os)
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&model.Data{")
-	if this.Universities != nil {
-		s = append(s, "Universities: "+fmt.Sprintf("%#v", this.Universities)+",\n")
+	if this.IsSubscribed != that1.IsSubscribed {
+		return fmt.Errorf("IsSubscribed this(%v) Not Equal that(%v)", this.IsSubscribed, that1.IsSubscribed)
 	}
-	if this.Subjects != nil {
-		s = append(s, "Subjects: "+fmt.Sprintf("%#v", this.Subjects)+",\n")
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
-	if this.Courses != nil {
-		s = append(s, "Courses: "+fmt.Sprintf("%#v", this.Courses)+",\n")
+	if this.FcmToken != that1.FcmToken {
+		return fmt.Errorf("FcmToken this(%v) Not Equal that(%v)", this.FcmToken, that1.FcmToken)
 	}
-	if this.Sections != nil {
-		s = append(s, "Sections: "+fmt.Sprintf("%#v", this.Sections)+",\n")
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
 	}
-	if this.University != nil {
-		s = append(s, "University: "+fmt.Sprintf("%#v", this.University)+",\n")
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	if this.Subject != nil {
-		s = append(s, "Subject: "+fmt.Sprintf("%#v", this.Subject)+",\n")
+	return nil
+}
+func (this *Subscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.Course != nil {
-		s = append(s, "Course: "+fmt.Sprintf("%#v", this.Course)+",\n")
+
+	that1, ok := that.(*Subscription)
+	if !ok {
+		that2, ok := that.(Subscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.Section != nil {
-		s = append(s, "Section: "+fmt.Sprintf("%#v", this.Section)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.SubscriptionView != nil {
-		s = append(s, "SubscriptionView: "+fmt.Sprintf("%#v", this.SubscriptionView)+",\n")
+	if this.Id != that1.Id {
+		return false
 	}
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.Os != that1.Os {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Subscription) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.IsSubscribed != that1.IsSubscribed {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&model.Subscription{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Os: "+fmt.Sprintf("%#v", this.Os)+",\n")
-	s = append(s, "IsSubscribed: "+fmt.Sprintf("%#v", this.IsSubscribed)+",\n")
-	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
-	s = append(s, "FcmToken: "+fmt.Sprintf("%#v", this.FcmToken)+",\n")
-	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.TopicName != that1.TopicName {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SubscriptionView) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.FcmToken != that1.FcmToken {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&model.SubscriptionView{")
-	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
-	s = append(s, "Subscribers: "+fmt.Sprintf("%#v", this.Subscribers)+",\n")
-	s = append(s, "IsHot: "+fmt.Sprintf("%#v", this.IsHot)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.CreatedAt != that1.CreatedAt {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringModel(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
-func (m *University) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SubscriptionView) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *University) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *University) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
+	that1, ok := that.(*SubscriptionView)
+	if !ok {
+		that2, ok := that.(SubscriptionView)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SubscriptionView")
 		}
 	}
-	if len(m.Registrations) > 0 {
-		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *SubscriptionView but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SubscriptionView but is not nil && this == nil")
 	}
-	if len(m.AvailableSemesters) > 0 {
-		for iNdEx := len(m.AvailableSemesters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AvailableSemesters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
-	if len(m.Subjects) > 0 {
-		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	if this.Subscribers != that1.Subscribers {
+		return fmt.Errorf("Subscribers this(%v) Not Equal that(%v)", this.Subscribers, that1.Subscribers)
 	}
-	if m.ResolvedSemesters != nil {
-		{
-			size, err := m.ResolvedSemesters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	if this.IsHot != that1.IsHot {
+		return fmt.Errorf("IsHot this(%v) Not Equal that(%v)", this.IsHot, that1.IsHot)
 	}
-	i -= len(m.TopicId)
-	copy(dAtA[i:], m.TopicId)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
-	i--
-	dAtA[i] = 0x4a
-	i -= len(m.TopicName)
-	copy(dAtA[i:], m.TopicName)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.AccentColor)
-	copy(dAtA[i:], m.AccentColor)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.AccentColor)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.MainColor)
-	copy(dAtA[i:], m.MainColor)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.MainColor)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.RegistrationPage)
-	copy(dAtA[i:], m.RegistrationPage)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.RegistrationPage)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.HomePage)
-	copy(dAtA[i:], m.HomePage)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.HomePage)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Abbr)
-	copy(dAtA[i:], m.Abbr)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Abbr)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x12
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Subject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return dAtA[:n], nil
+	return nil
 }
+func (this *SubscriptionView) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Subject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*SubscriptionView)
+	if !ok {
+		that2, ok := that.(SubscriptionView)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TopicName != that1.TopicName {
+		return false
+	}
+	if this.Subscribers != that1.Subscribers {
+		return false
+	}
+	if this.IsHot != that1.IsHot {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *SectionHistory) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Subject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*SectionHistory)
+	if !ok {
+		that2, ok := that.(SectionHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SectionHistory")
+		}
 	}
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *SectionHistory but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SectionHistory but is not nil && this == nil")
 	}
-	if len(m.Courses) > 0 {
-		for iNdEx := len(m.Courses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Courses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+	}
+	if len(this.Snapshots) != len(that1.Snapshots) {
+		return fmt.Errorf("Snapshots this(%v) Not Equal that(%v)", len(this.Snapshots), len(that1.Snapshots))
+	}
+	for i := range this.Snapshots {
+		if !this.Snapshots[i].Equal(that1.Snapshots[i]) {
+			return fmt.Errorf("Snapshots this[%v](%v) Not Equal that[%v](%v)", i, this.Snapshots[i], i, that1.Snapshots[i])
 		}
 	}
-	i -= len(m.TopicId)
-	copy(dAtA[i:], m.TopicId)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.TopicName)
-	copy(dAtA[i:], m.TopicName)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.Year)
-	copy(dAtA[i:], m.Year)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Year)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Season)
-	copy(dAtA[i:], m.Season)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Season)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Number)
-	copy(dAtA[i:], m.Number)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Number)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x1a
-	i = encodeVarintModel(dAtA, i, uint64(m.UniversityId))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Course) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Stats.Equal(that1.Stats) {
+		return fmt.Errorf("Stats this(%v) Not Equal that(%v)", this.Stats, that1.Stats)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Course) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
-
-func (m *Course) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *SectionHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+
+	that1, ok := that.(*SectionHistory)
+	if !ok {
+		that2, ok := that.(SectionHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Sections) > 0 {
-		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TopicName != that1.TopicName {
+		return false
+	}
+	if len(this.Snapshots) != len(that1.Snapshots) {
+		return false
+	}
+	for i := range this.Snapshots {
+		if !this.Snapshots[i].Equal(that1.Snapshots[i]) {
+			return false
 		}
 	}
-	i -= len(m.TopicId)
-	copy(dAtA[i:], m.TopicId)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.TopicName)
-	copy(dAtA[i:], m.TopicName)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
-	i--
-	dAtA[i] = 0x32
-	if m.Synopsis != nil {
-		i -= len(*m.Synopsis)
-		copy(dAtA[i:], *m.Synopsis)
-		i = encodeVarintModel(dAtA, i, uint64(len(*m.Synopsis)))
-		i--
-		dAtA[i] = 0x2a
+	if !this.Stats.Equal(that1.Stats) {
+		return false
 	}
-	i -= len(m.Number)
-	copy(dAtA[i:], m.Number)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Number)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x1a
-	i = encodeVarintModel(dAtA, i, uint64(m.SubjectId))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Section) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Section) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *Section) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
+func (this *SectionSnapshot) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if len(m.Books) > 0 {
-		for iNdEx := len(m.Books) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Books[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
+
+	that1, ok := that.(*SectionSnapshot)
+	if !ok {
+		that2, ok := that.(SectionSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SectionSnapshot")
 		}
 	}
-	if len(m.Instructors) > 0 {
-		for iNdEx := len(m.Instructors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Instructors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *SectionSnapshot but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SectionSnapshot but is not nil && this == nil")
 	}
-	if len(m.Meetings) > 0 {
-		for iNdEx := len(m.Meetings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Meetings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+	if this.Now != that1.Now {
+		return fmt.Errorf("Now this(%v) Not Equal that(%v)", this.Now, that1.Now)
+	}
+	if this.Max != that1.Max {
+		return fmt.Errorf("Max this(%v) Not Equal that(%v)", this.Max, that1.Max)
+	}
+	if this.Status != that1.Status {
+		return fmt.Errorf("Status this(%v) Not Equal that(%v)", this.Status, that1.Status)
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return fmt.Errorf("CreatedAt this(%v) Not Equal that(%v)", this.CreatedAt, that1.CreatedAt)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *SectionSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SectionSnapshot)
+	if !ok {
+		that2, ok := that.(SectionSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	i -= len(m.TopicId)
-	copy(dAtA[i:], m.TopicId)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
-	i--
-	dAtA[i] = 0x52
-	i -= len(m.TopicName)
-	copy(dAtA[i:], m.TopicName)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
-	i--
-	dAtA[i] = 0x4a
-	i -= len(m.Credits)
-	copy(dAtA[i:], m.Credits)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Credits)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Status)))
-	i--
-	dAtA[i] = 0x3a
-	i = encodeVarintModel(dAtA, i, uint64(m.Now))
-	i--
-	dAtA[i] = 0x30
-	i = encodeVarintModel(dAtA, i, uint64(m.Max))
-	i--
-	dAtA[i] = 0x28
-	i -= len(m.CallNumber)
-	copy(dAtA[i:], m.CallNumber)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.CallNumber)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Number)
-	copy(dAtA[i:], m.Number)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Number)))
-	i--
-	dAtA[i] = 0x1a
-	i = encodeVarintModel(dAtA, i, uint64(m.CourseId))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Now != that1.Now {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *SectionHistoryStats) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Meeting) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SectionHistoryStats)
+	if !ok {
+		that2, ok := that.(SectionHistoryStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SectionHistoryStats")
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *SectionHistoryStats but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SectionHistoryStats but is not nil && this == nil")
+	}
+	if this.TimeToFill != that1.TimeToFill {
+		return fmt.Errorf("TimeToFill this(%v) Not Equal that(%v)", this.TimeToFill, that1.TimeToFill)
+	}
+	if this.Reopenings != that1.Reopenings {
+		return fmt.Errorf("Reopenings this(%v) Not Equal that(%v)", this.Reopenings, that1.Reopenings)
+	}
+	if this.TypicalOpenHour != that1.TypicalOpenHour {
+		return fmt.Errorf("TypicalOpenHour this(%v) Not Equal that(%v)", this.TypicalOpenHour, that1.TypicalOpenHour)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *SectionHistoryStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Meeting) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*SectionHistoryStats)
+	if !ok {
+		that2, ok := that.(SectionHistoryStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TimeToFill != that1.TimeToFill {
+		return false
+	}
+	if this.Reopenings != that1.Reopenings {
+		return false
+	}
+	if this.TypicalOpenHour != that1.TypicalOpenHour {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *SearchResult) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *Meeting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*SearchResult)
+	if !ok {
+		that2, ok := that.(SearchResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *SearchResult")
+		}
 	}
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *SearchResult but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *SearchResult but is not nil && this == nil")
 	}
-	i = encodeVarintModel(dAtA, i, uint64(m.Index))
-	i--
-	dAtA[i] = 0x40
-	if m.ClassType != nil {
-		i -= len(*m.ClassType)
-		copy(dAtA[i:], *m.ClassType)
-		i = encodeVarintModel(dAtA, i, uint64(len(*m.ClassType)))
-		i--
-		dAtA[i] = 0x3a
+	if this.Kind != that1.Kind {
+		return fmt.Errorf("Kind this(%v) Not Equal that(%v)", this.Kind, that1.Kind)
 	}
-	if m.EndTime != nil {
-		i -= len(*m.EndTime)
-		copy(dAtA[i:], *m.EndTime)
-		i = encodeVarintModel(dAtA, i, uint64(len(*m.EndTime)))
-		i--
-		dAtA[i] = 0x32
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
-	if m.StartTime != nil {
-		i -= len(*m.StartTime)
-		copy(dAtA[i:], *m.StartTime)
-		i = encodeVarintModel(dAtA, i, uint64(len(*m.StartTime)))
-		i--
-		dAtA[i] = 0x2a
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
 	}
-	if m.Day != nil {
-		i -= len(*m.Day)
-		copy(dAtA[i:], *m.Day)
-		i = encodeVarintModel(dAtA, i, uint64(len(*m.Day)))
-		i--
-		dAtA[i] = 0x22
+	if this.Rank != that1.Rank {
+		return fmt.Errorf("Rank this(%v) Not Equal that(%v)", this.Rank, that1.Rank)
 	}
-	if m.Room != nil {
-		i -= len(*m.Room)
-		copy(dAtA[i:], *m.Room)
-		i = encodeVarintModel(dAtA, i, uint64(len(*m.Room)))
-		i--
-		dAtA[i] = 0x1a
+	if !this.Course.Equal(that1.Course) {
+		return fmt.Errorf("Course this(%v) Not Equal that(%v)", this.Course, that1.Course)
 	}
-	i = encodeVarintModel(dAtA, i, uint64(m.SectionId))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Instructor) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Section.Equal(that1.Section) {
+		return fmt.Errorf("Section this(%v) Not Equal that(%v)", this.Section, that1.Section)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Instructor) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Instructor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	i = encodeVarintModel(dAtA, i, uint64(m.Index))
-	i--
-	dAtA[i] = 0x20
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x1a
-	i = encodeVarintModel(dAtA, i, uint64(m.SectionId))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+	return nil
 }
-
-func (m *Book) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SearchResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Book) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Book) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*SearchResult)
+	if !ok {
+		that2, ok := that.(SearchResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i -= len(m.Url)
-	copy(dAtA[i:], m.Url)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Url)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Title)
-	copy(dAtA[i:], m.Title)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Title)))
-	i--
-	dAtA[i] = 0x1a
-	i = encodeVarintModel(dAtA, i, uint64(m.SectionId))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.Kind != that1.Kind {
+		return false
 	}
-	i -= len(m.Content)
-	copy(dAtA[i:], m.Content)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Content)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Title)
-	copy(dAtA[i:], m.Title)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Title)))
-	i--
-	dAtA[i] = 0x3a
-	if m.MeetingId != nil {
-		i = encodeVarintModel(dAtA, i, uint64(*m.MeetingId))
-		i--
-		dAtA[i] = 0x30
+	if this.TopicName != that1.TopicName {
+		return false
 	}
-	if m.SectionId != nil {
-		i = encodeVarintModel(dAtA, i, uint64(*m.SectionId))
-		i--
-		dAtA[i] = 0x28
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.CourseId != nil {
-		i = encodeVarintModel(dAtA, i, uint64(*m.CourseId))
-		i--
-		dAtA[i] = 0x20
+	if this.Rank != that1.Rank {
+		return false
 	}
-	if m.SubjectId != nil {
-		i = encodeVarintModel(dAtA, i, uint64(*m.SubjectId))
-		i--
-		dAtA[i] = 0x18
+	if !this.Course.Equal(that1.Course) {
+		return false
 	}
-	if m.UniversityId != nil {
-		i = encodeVarintModel(dAtA, i, uint64(*m.UniversityId))
-		i--
-		dAtA[i] = 0x10
+	if !this.Section.Equal(that1.Section) {
+		return false
 	}
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Registration) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Registration) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *Registration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *Page) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	i = encodeVarintModel(dAtA, i, uint64(m.PeriodDate))
-	i--
-	dAtA[i] = 0x20
-	i -= len(m.Period)
-	copy(dAtA[i:], m.Period)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Period)))
-	i--
-	dAtA[i] = 0x1a
-	i = encodeVarintModel(dAtA, i, uint64(m.UniversityId))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintModel(dAtA, i, uint64(m.Id))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
 
-func (m *ResolvedSemester) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Page)
+	if !ok {
+		that2, ok := that.(Page)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Page")
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResolvedSemester) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Page but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Page but is not nil && this == nil")
+	}
+	if this.Offset != that1.Offset {
+		return fmt.Errorf("Offset this(%v) Not Equal that(%v)", this.Offset, that1.Offset)
+	}
+	if this.Limit != that1.Limit {
+		return fmt.Errorf("Limit this(%v) Not Equal that(%v)", this.Limit, that1.Limit)
+	}
+	if this.Total != that1.Total {
+		return fmt.Errorf("Total this(%v) Not Equal that(%v)", this.Total, that1.Total)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *Page) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ResolvedSemester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*Page)
+	if !ok {
+		that2, ok := that.(Page)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Next != nil {
-		{
-			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Schedule) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
-		i--
-		dAtA[i] = 0x1a
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if m.Last != nil {
-		{
-			size, err := m.Last.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
+
+	that1, ok := that.(*Schedule)
+	if !ok {
+		that2, ok := that.(Schedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Schedule")
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Current != nil {
-		{
-			size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
-		i--
-		dAtA[i] = 0xa
+		return fmt.Errorf("that is type *Schedule but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Schedule but is not nil && this == nil")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Semester) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Sections) != len(that1.Sections) {
+		return fmt.Errorf("Sections this(%v) Not Equal that(%v)", len(this.Sections), len(that1.Sections))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Semester) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Semester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	for i := range this.Sections {
+		if !this.Sections[i].Equal(that1.Sections[i]) {
+			return fmt.Errorf("Sections this[%v](%v) Not Equal that[%v](%v)", i, this.Sections[i], i, that1.Sections[i])
+		}
 	}
-	i -= len(m.Season)
-	copy(dAtA[i:], m.Season)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Season)))
-	i--
-	dAtA[i] = 0x12
-	i = encodeVarintModel(dAtA, i, uint64(m.Year))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *UCTNotification) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Days != that1.Days {
+		return fmt.Errorf("Days this(%v) Not Equal that(%v)", this.Days, that1.Days)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UCTNotification) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UCTNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.GapMinutes != that1.GapMinutes {
+		return fmt.Errorf("GapMinutes this(%v) Not Equal that(%v)", this.GapMinutes, that1.GapMinutes)
 	}
-	{
-		size, err := m.University.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintModel(dAtA, i, uint64(size))
+	if this.EarliestStart != that1.EarliestStart {
+		return fmt.Errorf("EarliestStart this(%v) Not Equal that(%v)", this.EarliestStart, that1.EarliestStart)
 	}
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Status)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.TopicName)
-	copy(dAtA[i:], m.TopicName)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
-	i--
-	dAtA[i] = 0x12
-	i = encodeVarintModel(dAtA, i, uint64(m.NotificationId))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func (m *Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *Schedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
+
+	that1, ok := that.(*Schedule)
+	if !ok {
+		that2, ok := that.(Schedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Meta != nil {
-		{
-			size, err := m.Meta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Sections) != len(that1.Sections) {
+		return false
+	}
+	for i := range this.Sections {
+		if !this.Sections[i].Equal(that1.Sections[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Meta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Days != that1.Days {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Meta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.GapMinutes != that1.GapMinutes {
+		return false
 	}
-	if m.Message != nil {
-		i -= len(*m.Message)
-		copy(dAtA[i:], *m.Message)
-		i = encodeVarintModel(dAtA, i, uint64(len(*m.Message)))
-		i--
-		dAtA[i] = 0x12
+	if this.EarliestStart != that1.EarliestStart {
+		return false
 	}
-	if m.Code != nil {
-		i = encodeVarintModel(dAtA, i, uint64(*m.Code))
-		i--
-		dAtA[i] = 0x8
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *Data) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Prerequisite) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Data) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*Prerequisite)
+	if !ok {
+		that2, ok := that.(Prerequisite)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Prerequisite")
+		}
 	}
-	if len(m.SubscriptionView) > 0 {
-		for iNdEx := len(m.SubscriptionView) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubscriptionView[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *Prerequisite but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Prerequisite but is not nil && this == nil")
 	}
-	if m.Section != nil {
-		{
-			size, err := m.Section.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
+	if this.Operator != that1.Operator {
+		return fmt.Errorf("Operator this(%v) Not Equal that(%v)", this.Operator, that1.Operator)
+	}
+	if len(this.Operands) != len(that1.Operands) {
+		return fmt.Errorf("Operands this(%v) Not Equal that(%v)", len(this.Operands), len(that1.Operands))
+	}
+	for i := range this.Operands {
+		if !this.Operands[i].Equal(that1.Operands[i]) {
+			return fmt.Errorf("Operands this[%v](%v) Not Equal that[%v](%v)", i, this.Operands[i], i, that1.Operands[i])
 		}
-		i--
-		dAtA[i] = 0x42
 	}
-	if m.Course != nil {
-		{
-			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if this.SubjectNumber != that1.SubjectNumber {
+		return fmt.Errorf("SubjectNumber this(%v) Not Equal that(%v)", this.SubjectNumber, that1.SubjectNumber)
 	}
-	if m.Subject != nil {
-		{
-			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	if this.CourseNumber != that1.CourseNumber {
+		return fmt.Errorf("CourseNumber this(%v) Not Equal that(%v)", this.CourseNumber, that1.CourseNumber)
 	}
-	if m.University != nil {
-		{
-			size, err := m.University.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintModel(dAtA, i, uint64(size))
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *Prerequisite) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Prerequisite)
+	if !ok {
+		that2, ok := that.(Prerequisite)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Sections) > 0 {
-		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if len(this.Operands) != len(that1.Operands) {
+		return false
+	}
+	for i := range this.Operands {
+		if !this.Operands[i].Equal(that1.Operands[i]) {
+			return false
 		}
 	}
-	if len(m.Courses) > 0 {
-		for iNdEx := len(m.Courses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Courses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	if this.SubjectNumber != that1.SubjectNumber {
+		return false
+	}
+	if this.CourseNumber != that1.CourseNumber {
+		return false
+	}
+	if this.TopicName != that1.TopicName {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *CrossListing) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if len(m.Subjects) > 0 {
-		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+
+	that1, ok := that.(*CrossListing)
+	if !ok {
+		that2, ok := that.(CrossListing)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *CrossListing")
 		}
 	}
-	if len(m.Universities) > 0 {
-		for iNdEx := len(m.Universities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Universities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintModel(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *CrossListing but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *CrossListing but is not nil && this == nil")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Subscription) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SubjectNumber != that1.SubjectNumber {
+		return fmt.Errorf("SubjectNumber this(%v) Not Equal that(%v)", this.SubjectNumber, that1.SubjectNumber)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.CourseNumber != that1.CourseNumber {
+		return fmt.Errorf("CourseNumber this(%v) Not Equal that(%v)", this.CourseNumber, that1.CourseNumber)
 	}
-	i -= len(m.CreatedAt)
-	copy(dAtA[i:], m.CreatedAt)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.CreatedAt)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.FcmToken)
-	copy(dAtA[i:], m.FcmToken)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.FcmToken)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.TopicName)
-	copy(dAtA[i:], m.TopicName)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.IsSubscribed)
-	copy(dAtA[i:], m.IsSubscribed)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.IsSubscribed)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Os)
-	copy(dAtA[i:], m.Os)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Os)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Id)
-	copy(dAtA[i:], m.Id)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.Id)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *SubscriptionView) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SectionNumber != that1.SectionNumber {
+		return fmt.Errorf("SectionNumber this(%v) Not Equal that(%v)", this.SectionNumber, that1.SectionNumber)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SubscriptionView) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubscriptionView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
-	i--
-	if m.IsHot {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	i--
-	dAtA[i] = 0x18
-	i = encodeVarintModel(dAtA, i, uint64(m.Subscribers))
-	i--
-	dAtA[i] = 0x10
-	i -= len(m.TopicName)
-	copy(dAtA[i:], m.TopicName)
-	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
-
-func encodeVarintModel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *CrossListing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedUniversity(r randyModel, easy bool) *University {
-	this := &University{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
+
+	that1, ok := that.(*CrossListing)
+	if !ok {
+		that2, ok := that.(CrossListing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.Name = string(randStringModel(r))
-	this.Abbr = string(randStringModel(r))
-	this.HomePage = string(randStringModel(r))
-	this.RegistrationPage = string(randStringModel(r))
-	this.MainColor = string(randStringModel(r))
-	this.AccentColor = string(randStringModel(r))
-	this.TopicName = string(randStringModel(r))
-	this.TopicId = string(randStringModel(r))
-	if r.Intn(5) != 0 {
-		this.ResolvedSemesters = NewPopulatedResolvedSemester(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v1 := r.Intn(5)
-		this.Subjects = make([]*Subject, v1)
-		for i := 0; i < v1; i++ {
-			this.Subjects[i] = NewPopulatedSubject(r, easy)
-		}
+	if this.SubjectNumber != that1.SubjectNumber {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v2 := r.Intn(5)
-		this.AvailableSemesters = make([]*Semester, v2)
-		for i := 0; i < v2; i++ {
-			this.AvailableSemesters[i] = NewPopulatedSemester(r, easy)
-		}
+	if this.CourseNumber != that1.CourseNumber {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v3 := r.Intn(5)
-		this.Registrations = make([]*Registration, v3)
-		for i := 0; i < v3; i++ {
-			this.Registrations[i] = NewPopulatedRegistration(r, easy)
-		}
+	if this.SectionNumber != that1.SectionNumber {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.Metadata = make([]*Metadata, v4)
-		for i := 0; i < v4; i++ {
-			this.Metadata[i] = NewPopulatedMetadata(r, easy)
-		}
+	if this.TopicName != that1.TopicName {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 15)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedSubject(r randyModel, easy bool) *Subject {
-	this := &Subject{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
-	}
-	this.UniversityId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.UniversityId *= -1
-	}
-	this.Name = string(randStringModel(r))
-	this.Number = string(randStringModel(r))
-	this.Season = string(randStringModel(r))
-	this.Year = string(randStringModel(r))
-	this.TopicName = string(randStringModel(r))
-	this.TopicId = string(randStringModel(r))
-	if r.Intn(5) != 0 {
-		v5 := r.Intn(5)
-		this.Courses = make([]*Course, v5)
-		for i := 0; i < v5; i++ {
-			this.Courses[i] = NewPopulatedCourse(r, easy)
+func (this *InstructorProfile) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.Metadata = make([]*Metadata, v6)
-		for i := 0; i < v6; i++ {
-			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+
+	that1, ok := that.(*InstructorProfile)
+	if !ok {
+		that2, ok := that.(InstructorProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *InstructorProfile")
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 11)
-	}
-	return this
-}
-
-func NewPopulatedCourse(r randyModel, easy bool) *Course {
-	this := &Course{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *InstructorProfile but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *InstructorProfile but is not nil && this == nil")
 	}
-	this.SubjectId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.SubjectId *= -1
+	if this.Name != that1.Name {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
 	}
-	this.Name = string(randStringModel(r))
-	this.Number = string(randStringModel(r))
-	if r.Intn(5) != 0 {
-		v7 := string(randStringModel(r))
-		this.Synopsis = &v7
+	if this.TopicName != that1.TopicName {
+		return fmt.Errorf("TopicName this(%v) Not Equal that(%v)", this.TopicName, that1.TopicName)
 	}
-	this.TopicName = string(randStringModel(r))
-	this.TopicId = string(randStringModel(r))
-	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Sections = make([]*Section, v8)
-		for i := 0; i < v8; i++ {
-			this.Sections[i] = NewPopulatedSection(r, easy)
-		}
+	if len(this.Teaching) != len(that1.Teaching) {
+		return fmt.Errorf("Teaching this(%v) Not Equal that(%v)", len(this.Teaching), len(that1.Teaching))
 	}
-	if r.Intn(5) != 0 {
-		v9 := r.Intn(5)
-		this.Metadata = make([]*Metadata, v9)
-		for i := 0; i < v9; i++ {
-			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+	for i := range this.Teaching {
+		if !this.Teaching[i].Equal(that1.Teaching[i]) {
+			return fmt.Errorf("Teaching this[%v](%v) Not Equal that[%v](%v)", i, this.Teaching[i], i, that1.Teaching[i])
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 10)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return this
+	return nil
 }
+func (this *InstructorProfile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedSection(r randyModel, easy bool) *Section {
-	this := &Section{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
+	that1, ok := that.(*InstructorProfile)
+	if !ok {
+		that2, ok := that.(InstructorProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.CourseId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.CourseId *= -1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	this.Number = string(randStringModel(r))
-	this.CallNumber = string(randStringModel(r))
-	this.Max = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Max *= -1
+	if this.Name != that1.Name {
+		return false
 	}
-	this.Now = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Now *= -1
+	if this.TopicName != that1.TopicName {
+		return false
 	}
-	this.Status = string(randStringModel(r))
-	this.Credits = string(randStringModel(r))
-	this.TopicName = string(randStringModel(r))
-	this.TopicId = string(randStringModel(r))
-	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.Meetings = make([]*Meeting, v10)
-		for i := 0; i < v10; i++ {
-			this.Meetings[i] = NewPopulatedMeeting(r, easy)
+	if len(this.Teaching) != len(that1.Teaching) {
+		return false
+	}
+	for i := range this.Teaching {
+		if !this.Teaching[i].Equal(that1.Teaching[i]) {
+			return false
 		}
 	}
-	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Instructors = make([]*Instructor, v11)
-		for i := 0; i < v11; i++ {
-			this.Instructors[i] = NewPopulatedInstructor(r, easy)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Teaching) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if r.Intn(5) != 0 {
-		v12 := r.Intn(5)
-		this.Books = make([]*Book, v12)
-		for i := 0; i < v12; i++ {
-			this.Books[i] = NewPopulatedBook(r, easy)
+
+	that1, ok := that.(*Teaching)
+	if !ok {
+		that2, ok := that.(Teaching)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Teaching")
 		}
 	}
-	if r.Intn(5) != 0 {
-		v13 := r.Intn(5)
-		this.Metadata = make([]*Metadata, v13)
-		for i := 0; i < v13; i++ {
-			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *Teaching but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Teaching but is not nil && this == nil")
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 15)
+	if this.Season != that1.Season {
+		return fmt.Errorf("Season this(%v) Not Equal that(%v)", this.Season, that1.Season)
 	}
-	return this
-}
-
-func NewPopulatedMeeting(r randyModel, easy bool) *Meeting {
-	this := &Meeting{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
+	if this.Year != that1.Year {
+		return fmt.Errorf("Year this(%v) Not Equal that(%v)", this.Year, that1.Year)
 	}
-	this.SectionId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.SectionId *= -1
+	if !this.Course.Equal(that1.Course) {
+		return fmt.Errorf("Course this(%v) Not Equal that(%v)", this.Course, that1.Course)
 	}
-	if r.Intn(5) != 0 {
-		v14 := string(randStringModel(r))
-		this.Room = &v14
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	if r.Intn(5) != 0 {
-		v15 := string(randStringModel(r))
-		this.Day = &v15
+	return nil
+}
+func (this *Teaching) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if r.Intn(5) != 0 {
-		v16 := string(randStringModel(r))
-		this.StartTime = &v16
+
+	that1, ok := that.(*Teaching)
+	if !ok {
+		that2, ok := that.(Teaching)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if r.Intn(5) != 0 {
-		v17 := string(randStringModel(r))
-		this.EndTime = &v17
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v18 := string(randStringModel(r))
-		this.ClassType = &v18
+	if this.Season != that1.Season {
+		return false
 	}
-	this.Index = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Index *= -1
+	if this.Year != that1.Year {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v19 := r.Intn(5)
-		this.Metadata = make([]*Metadata, v19)
-		for i := 0; i < v19; i++ {
-			this.Metadata[i] = NewPopulatedMetadata(r, easy)
-		}
+	if !this.Course.Equal(that1.Course) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 10)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedInstructor(r randyModel, easy bool) *Instructor {
-	this := &Instructor{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
-	}
-	this.SectionId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.SectionId *= -1
-	}
-	this.Name = string(randStringModel(r))
-	this.Index = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Index *= -1
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+func (this *Building) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return this
-}
 
-func NewPopulatedBook(r randyModel, easy bool) *Book {
-	this := &Book{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
+	that1, ok := that.(*Building)
+	if !ok {
+		that2, ok := that.(Building)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Building")
+		}
 	}
-	this.SectionId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.SectionId *= -1
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Building but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Building but is not nil && this == nil")
 	}
-	this.Title = string(randStringModel(r))
-	this.Url = string(randStringModel(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	if this.Id != that1.Id {
+		return fmt.Errorf("Id this(%v) Not Equal that(%v)", this.Id, that1.Id)
 	}
-	return this
-}
-
-func NewPopulatedMetadata(r randyModel, easy bool) *Metadata {
-	this := &Metadata{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
+	if this.UniversityId != that1.UniversityId {
+		return fmt.Errorf("UniversityId this(%v) Not Equal that(%v)", this.UniversityId, that1.UniversityId)
 	}
-	if r.Intn(5) != 0 {
-		v20 := int64(r.Int63())
-		if r.Intn(2) == 0 {
-			v20 *= -1
-		}
-		this.UniversityId = &v20
+	if this.Code != that1.Code {
+		return fmt.Errorf("Code this(%v) Not Equal that(%v)", this.Code, that1.Code)
 	}
-	if r.Intn(5) != 0 {
-		v21 := int64(r.Int63())
-		if r.Intn(2) == 0 {
-			v21 *= -1
+	if this.Name != nil && that1.Name != nil {
+		if *this.Name != *that1.Name {
+			return fmt.Errorf("Name this(%v) Not Equal that(%v)", *this.Name, *that1.Name)
 		}
-		this.SubjectId = &v21
+	} else if this.Name != nil {
+		return fmt.Errorf("this.Name == nil && that.Name != nil")
+	} else if that1.Name != nil {
+		return fmt.Errorf("Name this(%v) Not Equal that(%v)", this.Name, that1.Name)
 	}
-	if r.Intn(5) != 0 {
-		v22 := int64(r.Int63())
-		if r.Intn(2) == 0 {
-			v22 *= -1
+	if this.Campus != nil && that1.Campus != nil {
+		if *this.Campus != *that1.Campus {
+			return fmt.Errorf("Campus this(%v) Not Equal that(%v)", *this.Campus, *that1.Campus)
 		}
-		this.CourseId = &v22
+	} else if this.Campus != nil {
+		return fmt.Errorf("this.Campus == nil && that.Campus != nil")
+	} else if that1.Campus != nil {
+		return fmt.Errorf("Campus this(%v) Not Equal that(%v)", this.Campus, that1.Campus)
 	}
-	if r.Intn(5) != 0 {
-		v23 := int64(r.Int63())
-		if r.Intn(2) == 0 {
-			v23 *= -1
+	if this.Latitude != nil && that1.Latitude != nil {
+		if *this.Latitude != *that1.Latitude {
+			return fmt.Errorf("Latitude this(%v) Not Equal that(%v)", *this.Latitude, *that1.Latitude)
 		}
-		this.SectionId = &v23
+	} else if this.Latitude != nil {
+		return fmt.Errorf("this.Latitude == nil && that.Latitude != nil")
+	} else if that1.Latitude != nil {
+		return fmt.Errorf("Latitude this(%v) Not Equal that(%v)", this.Latitude, that1.Latitude)
 	}
-	if r.Intn(5) != 0 {
-		v24 := int64(r.Int63())
-		if r.Intn(2) == 0 {
-			v24 *= -1
+	if this.Longitude != nil && that1.Longitude != nil {
+		if *this.Longitude != *that1.Longitude {
+			return fmt.Errorf("Longitude this(%v) Not Equal that(%v)", *this.Longitude, *that1.Longitude)
 		}
-		this.MeetingId = &v24
+	} else if this.Longitude != nil {
+		return fmt.Errorf("this.Longitude == nil && that.Longitude != nil")
+	} else if that1.Longitude != nil {
+		return fmt.Errorf("Longitude this(%v) Not Equal that(%v)", this.Longitude, that1.Longitude)
 	}
-	this.Title = string(randStringModel(r))
-	this.Content = string(randStringModel(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 9)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return this
+	return nil
 }
+func (this *Building) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedRegistration(r randyModel, easy bool) *Registration {
-	this := &Registration{}
-	this.Id = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Id *= -1
+	that1, ok := that.(*Building)
+	if !ok {
+		that2, ok := that.(Building)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	this.UniversityId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.UniversityId *= -1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	this.Period = string(randStringModel(r))
-	this.PeriodDate = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.PeriodDate *= -1
+	if this.Id != that1.Id {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	if this.UniversityId != that1.UniversityId {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedResolvedSemester(r randyModel, easy bool) *ResolvedSemester {
-	this := &ResolvedSemester{}
-	if r.Intn(5) != 0 {
-		this.Current = NewPopulatedSemester(r, easy)
+	if this.Code != that1.Code {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.Last = NewPopulatedSemester(r, easy)
+	if this.Name != nil && that1.Name != nil {
+		if *this.Name != *that1.Name {
+			return false
+		}
+	} else if this.Name != nil {
+		return false
+	} else if that1.Name != nil {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.Next = NewPopulatedSemester(r, easy)
+	if this.Campus != nil && that1.Campus != nil {
+		if *this.Campus != *that1.Campus {
+			return false
+		}
+	} else if this.Campus != nil {
+		return false
+	} else if that1.Campus != nil {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	if this.Latitude != nil && that1.Latitude != nil {
+		if *this.Latitude != *that1.Latitude {
+			return false
+		}
+	} else if this.Latitude != nil {
+		return false
+	} else if that1.Latitude != nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedSemester(r randyModel, easy bool) *Semester {
-	this := &Semester{}
-	this.Year = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Year *= -1
+	if this.Longitude != nil && that1.Longitude != nil {
+		if *this.Longitude != *that1.Longitude {
+			return false
+		}
+	} else if this.Longitude != nil {
+		return false
+	} else if that1.Longitude != nil {
+		return false
 	}
-	this.Season = string(randStringModel(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 3)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
+func (this *Envelope) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func NewPopulatedUCTNotification(r randyModel, easy bool) *UCTNotification {
-	this := &UCTNotification{}
-	this.NotificationId = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.NotificationId *= -1
+	that1, ok := that.(*Envelope)
+	if !ok {
+		that2, ok := that.(Envelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Envelope")
+		}
 	}
-	this.TopicName = string(randStringModel(r))
-	this.Status = string(randStringModel(r))
-	v25 := NewPopulatedUniversity(r, easy)
-	this.University = *v25
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Envelope but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Envelope but is not nil && this == nil")
 	}
-	return this
-}
-
-func NewPopulatedResponse(r randyModel, easy bool) *Response {
-	this := &Response{}
-	if r.Intn(5) != 0 {
-		this.Meta = NewPopulatedMeta(r, easy)
+	if this.SchemaVersion != that1.SchemaVersion {
+		return fmt.Errorf("SchemaVersion this(%v) Not Equal that(%v)", this.SchemaVersion, that1.SchemaVersion)
 	}
-	if r.Intn(5) != 0 {
-		this.Data = NewPopulatedData(r, easy)
+	if this.Producer != that1.Producer {
+		return fmt.Errorf("Producer this(%v) Not Equal that(%v)", this.Producer, that1.Producer)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 3)
+	if this.ScrapedAt != that1.ScrapedAt {
+		return fmt.Errorf("ScrapedAt this(%v) Not Equal that(%v)", this.ScrapedAt, that1.ScrapedAt)
 	}
-	return this
-}
-
-func NewPopulatedMeta(r randyModel, easy bool) *Meta {
-	this := &Meta{}
-	if r.Intn(5) != 0 {
-		v26 := int32(r.Int31())
-		if r.Intn(2) == 0 {
-			v26 *= -1
-		}
-		this.Code = &v26
+	if this.ContentHash != that1.ContentHash {
+		return fmt.Errorf("ContentHash this(%v) Not Equal that(%v)", this.ContentHash, that1.ContentHash)
 	}
-	if r.Intn(5) != 0 {
-		v27 := string(randStringModel(r))
-		this.Message = &v27
+	if this.Format != that1.Format {
+		return fmt.Errorf("Format this(%v) Not Equal that(%v)", this.Format, that1.Format)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 3)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return this
+	return nil
 }
-
-func NewPopulatedData(r randyModel, easy bool) *Data {
-	this := &Data{}
-	if r.Intn(5) != 0 {
-		v28 := r.Intn(5)
-		this.Universities = make([]*University, v28)
-		for i := 0; i < v28; i++ {
-			this.Universities[i] = NewPopulatedUniversity(r, easy)
-		}
-	}
-	if r.Intn(5) != 0 {
-		v29 := r.Intn(5)
-		this.Subjects = make([]*Subject, v29)
-		for i := 0; i < v29; i++ {
-			this.Subjects[i] = NewPopulatedSubject(r, easy)
-		}
+func (this *Envelope) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if r.Intn(5) != 0 {
-		v30 := r.Intn(5)
-		this.Courses = make([]*Course, v30)
-		for i := 0; i < v30; i++ {
-			this.Courses[i] = NewPopulatedCourse(r, easy)
+
+	that1, ok := that.(*Envelope)
+	if !ok {
+		that2, ok := that.(Envelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if r.Intn(5) != 0 {
-		v31 := r.Intn(5)
-		this.Sections = make([]*Section, v31)
-		for i := 0; i < v31; i++ {
-			this.Sections[i] = NewPopulatedSection(r, easy)
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.University = NewPopulatedUniversity(r, easy)
+	if this.SchemaVersion != that1.SchemaVersion {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.Subject = NewPopulatedSubject(r, easy)
+	if this.Producer != that1.Producer {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.Course = NewPopulatedCourse(r, easy)
+	if this.ScrapedAt != that1.ScrapedAt {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.Section = NewPopulatedSection(r, easy)
+	if this.ContentHash != that1.ContentHash {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v32 := r.Intn(5)
-		this.SubscriptionView = make([]*SubscriptionView, v32)
-		for i := 0; i < v32; i++ {
-			this.SubscriptionView[i] = NewPopulatedSubscriptionView(r, easy)
-		}
+	if this.Format != that1.Format {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 10)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedSubscription(r randyModel, easy bool) *Subscription {
-	this := &Subscription{}
-	this.Id = string(randStringModel(r))
-	this.Os = string(randStringModel(r))
-	this.IsSubscribed = string(randStringModel(r))
-	this.TopicName = string(randStringModel(r))
-	this.FcmToken = string(randStringModel(r))
-	this.CreatedAt = string(randStringModel(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 7)
+func (this *University) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return this
-}
-
-func NewPopulatedSubscriptionView(r randyModel, easy bool) *SubscriptionView {
-	this := &SubscriptionView{}
-	this.TopicName = string(randStringModel(r))
-	this.Subscribers = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Subscribers *= -1
+	s := make([]string, 0, 20)
+	s = append(s, "&model.University{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Abbr: "+fmt.Sprintf("%#v", this.Abbr)+",\n")
+	s = append(s, "HomePage: "+fmt.Sprintf("%#v", this.HomePage)+",\n")
+	s = append(s, "RegistrationPage: "+fmt.Sprintf("%#v", this.RegistrationPage)+",\n")
+	s = append(s, "MainColor: "+fmt.Sprintf("%#v", this.MainColor)+",\n")
+	s = append(s, "AccentColor: "+fmt.Sprintf("%#v", this.AccentColor)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
+	if this.ResolvedSemesters != nil {
+		s = append(s, "ResolvedSemesters: "+fmt.Sprintf("%#v", this.ResolvedSemesters)+",\n")
 	}
-	this.IsHot = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	if this.Subjects != nil {
+		s = append(s, "Subjects: "+fmt.Sprintf("%#v", this.Subjects)+",\n")
 	}
-	return this
-}
-
-type randyModel interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneModel(r randyModel) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if this.AvailableSemesters != nil {
+		s = append(s, "AvailableSemesters: "+fmt.Sprintf("%#v", this.AvailableSemesters)+",\n")
 	}
-	return rune(ru + 61)
-}
-func randStringModel(r randyModel) string {
-	v33 := r.Intn(100)
-	tmps := make([]rune, v33)
-	for i := 0; i < v33; i++ {
-		tmps[i] = randUTF8RuneModel(r)
+	if this.Registrations != nil {
+		s = append(s, "Registrations: "+fmt.Sprintf("%#v", this.Registrations)+",\n")
 	}
-	return string(tmps)
-}
-func randUnrecognizedModel(r randyModel, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldModel(dAtA, r, fieldNumber, wire)
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	return dAtA
-}
-func randFieldModel(dAtA []byte, r randyModel, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
-		v34 := r.Int63()
-		if r.Intn(2) == 0 {
-			v34 *= -1
-		}
-		dAtA = encodeVarintPopulateModel(dAtA, uint64(v34))
-	case 1:
-		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateModel(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	if this.Buildings != nil {
+		s = append(s, "Buildings: "+fmt.Sprintf("%#v", this.Buildings)+",\n")
 	}
-	return dAtA
-}
-func encodeVarintPopulateModel(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *University) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	l = len(m.Name)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Abbr)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.HomePage)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.RegistrationPage)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.MainColor)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.AccentColor)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicName)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicId)
-	n += 1 + l + sovModel(uint64(l))
-	if m.ResolvedSemesters != nil {
-		l = m.ResolvedSemesters.Size()
-		n += 1 + l + sovModel(uint64(l))
+func (this *Subject) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Subjects) > 0 {
-		for _, e := range m.Subjects {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	s := make([]string, 0, 17)
+	s = append(s, "&model.Subject{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "UniversityId: "+fmt.Sprintf("%#v", this.UniversityId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
+	s = append(s, "Season: "+fmt.Sprintf("%#v", this.Season)+",\n")
+	s = append(s, "Year: "+fmt.Sprintf("%#v", this.Year)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
+	if this.Courses != nil {
+		s = append(s, "Courses: "+fmt.Sprintf("%#v", this.Courses)+",\n")
 	}
-	if len(m.AvailableSemesters) > 0 {
-		for _, e := range m.AvailableSemesters {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	if len(m.Registrations) > 0 {
-		for _, e := range m.Registrations {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	s = append(s, "TermCode: "+fmt.Sprintf("%#v", this.TermCode)+",\n")
+	s = append(s, "TermName: "+fmt.Sprintf("%#v", this.TermName)+",\n")
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Course) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 15)
+	s = append(s, "&model.Course{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "SubjectId: "+fmt.Sprintf("%#v", this.SubjectId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
+	if this.Synopsis != nil {
+		s = append(s, "Synopsis: "+valueToGoStringModel(this.Synopsis, "string")+",\n")
 	}
-	return n
-}
-
-func (m *Subject) Size() (n int) {
-	if m == nil {
-		return 0
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
+	if this.Sections != nil {
+		s = append(s, "Sections: "+fmt.Sprintf("%#v", this.Sections)+",\n")
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	n += 1 + sovModel(uint64(m.UniversityId))
-	l = len(m.Name)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Number)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Season)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Year)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicName)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicId)
-	n += 1 + l + sovModel(uint64(l))
-	if len(m.Courses) > 0 {
-		for _, e := range m.Courses {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Prerequisite != nil {
+		s = append(s, "Prerequisite: "+fmt.Sprintf("%#v", this.Prerequisite)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Course) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	n += 1 + sovModel(uint64(m.SubjectId))
-	l = len(m.Name)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Number)
-	n += 1 + l + sovModel(uint64(l))
-	if m.Synopsis != nil {
-		l = len(*m.Synopsis)
-		n += 1 + l + sovModel(uint64(l))
+func (this *Section) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.TopicName)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicId)
-	n += 1 + l + sovModel(uint64(l))
-	if len(m.Sections) > 0 {
-		for _, e := range m.Sections {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	s := make([]string, 0, 22)
+	s = append(s, "&model.Section{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CourseId: "+fmt.Sprintf("%#v", this.CourseId)+",\n")
+	s = append(s, "Number: "+fmt.Sprintf("%#v", this.Number)+",\n")
+	s = append(s, "CallNumber: "+fmt.Sprintf("%#v", this.CallNumber)+",\n")
+	s = append(s, "Max: "+fmt.Sprintf("%#v", this.Max)+",\n")
+	s = append(s, "Now: "+fmt.Sprintf("%#v", this.Now)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Credits: "+fmt.Sprintf("%#v", this.Credits)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "TopicId: "+fmt.Sprintf("%#v", this.TopicId)+",\n")
+	if this.Meetings != nil {
+		s = append(s, "Meetings: "+fmt.Sprintf("%#v", this.Meetings)+",\n")
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Instructors != nil {
+		s = append(s, "Instructors: "+fmt.Sprintf("%#v", this.Instructors)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Books != nil {
+		s = append(s, "Books: "+fmt.Sprintf("%#v", this.Books)+",\n")
 	}
-	return n
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
+	}
+	if this.CrossListings != nil {
+		s = append(s, "CrossListings: "+fmt.Sprintf("%#v", this.CrossListings)+",\n")
+	}
+	if this.WaitlistMax != nil {
+		s = append(s, "WaitlistMax: "+valueToGoStringModel(this.WaitlistMax, "int64")+",\n")
+	}
+	if this.WaitlistNow != nil {
+		s = append(s, "WaitlistNow: "+valueToGoStringModel(this.WaitlistNow, "int64")+",\n")
+	}
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Section) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Meeting) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	n += 1 + sovModel(uint64(m.CourseId))
-	l = len(m.Number)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.CallNumber)
-	n += 1 + l + sovModel(uint64(l))
-	n += 1 + sovModel(uint64(m.Max))
-	n += 1 + sovModel(uint64(m.Now))
-	l = len(m.Status)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Credits)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicName)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicId)
-	n += 1 + l + sovModel(uint64(l))
-	if len(m.Meetings) > 0 {
-		for _, e := range m.Meetings {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	s := make([]string, 0, 20)
+	s = append(s, "&model.Meeting{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "SectionId: "+fmt.Sprintf("%#v", this.SectionId)+",\n")
+	if this.Room != nil {
+		s = append(s, "Room: "+valueToGoStringModel(this.Room, "string")+",\n")
 	}
-	if len(m.Instructors) > 0 {
-		for _, e := range m.Instructors {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Day != nil {
+		s = append(s, "Day: "+valueToGoStringModel(this.Day, "string")+",\n")
 	}
-	if len(m.Books) > 0 {
-		for _, e := range m.Books {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.StartTime != nil {
+		s = append(s, "StartTime: "+valueToGoStringModel(this.StartTime, "string")+",\n")
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.EndTime != nil {
+		s = append(s, "EndTime: "+valueToGoStringModel(this.EndTime, "string")+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.ClassType != nil {
+		s = append(s, "ClassType: "+valueToGoStringModel(this.ClassType, "string")+",\n")
 	}
-	return n
-}
-
-func (m *Meeting) Size() (n int) {
-	if m == nil {
-		return 0
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	if this.Metadata != nil {
+		s = append(s, "Metadata: "+fmt.Sprintf("%#v", this.Metadata)+",\n")
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	n += 1 + sovModel(uint64(m.SectionId))
-	if m.Room != nil {
-		l = len(*m.Room)
-		n += 1 + l + sovModel(uint64(l))
+	if this.Weekday != nil {
+		s = append(s, "Weekday: "+valueToGoStringModel(this.Weekday, "Weekday")+",\n")
 	}
-	if m.Day != nil {
-		l = len(*m.Day)
-		n += 1 + l + sovModel(uint64(l))
+	if this.StartMinute != nil {
+		s = append(s, "StartMinute: "+valueToGoStringModel(this.StartMinute, "int32")+",\n")
 	}
-	if m.StartTime != nil {
-		l = len(*m.StartTime)
-		n += 1 + l + sovModel(uint64(l))
+	if this.Duration != nil {
+		s = append(s, "Duration: "+valueToGoStringModel(this.Duration, "int32")+",\n")
 	}
-	if m.EndTime != nil {
-		l = len(*m.EndTime)
-		n += 1 + l + sovModel(uint64(l))
+	if this.BuildingCode != nil {
+		s = append(s, "BuildingCode: "+valueToGoStringModel(this.BuildingCode, "string")+",\n")
 	}
-	if m.ClassType != nil {
-		l = len(*m.ClassType)
-		n += 1 + l + sovModel(uint64(l))
+	if this.RoomNumber != nil {
+		s = append(s, "RoomNumber: "+valueToGoStringModel(this.RoomNumber, "string")+",\n")
 	}
-	n += 1 + sovModel(uint64(m.Index))
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Building != nil {
+		s = append(s, "Building: "+fmt.Sprintf("%#v", this.Building)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Instructor) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Instructor) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	n += 1 + sovModel(uint64(m.SectionId))
-	l = len(m.Name)
-	n += 1 + l + sovModel(uint64(l))
-	n += 1 + sovModel(uint64(m.Index))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 9)
+	s = append(s, "&model.Instructor{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "SectionId: "+fmt.Sprintf("%#v", this.SectionId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Book) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Book) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	n += 1 + sovModel(uint64(m.SectionId))
-	l = len(m.Title)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Url)
-	n += 1 + l + sovModel(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 8)
+	s = append(s, "&model.Book{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "SectionId: "+fmt.Sprintf("%#v", this.SectionId)+",\n")
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "Url: "+fmt.Sprintf("%#v", this.Url)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Metadata) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	if m.UniversityId != nil {
-		n += 1 + sovModel(uint64(*m.UniversityId))
+	s := make([]string, 0, 12)
+	s = append(s, "&model.Metadata{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	if this.UniversityId != nil {
+		s = append(s, "UniversityId: "+valueToGoStringModel(this.UniversityId, "int64")+",\n")
 	}
-	if m.SubjectId != nil {
-		n += 1 + sovModel(uint64(*m.SubjectId))
+	if this.SubjectId != nil {
+		s = append(s, "SubjectId: "+valueToGoStringModel(this.SubjectId, "int64")+",\n")
 	}
-	if m.CourseId != nil {
-		n += 1 + sovModel(uint64(*m.CourseId))
+	if this.CourseId != nil {
+		s = append(s, "CourseId: "+valueToGoStringModel(this.CourseId, "int64")+",\n")
 	}
-	if m.SectionId != nil {
-		n += 1 + sovModel(uint64(*m.SectionId))
+	if this.SectionId != nil {
+		s = append(s, "SectionId: "+valueToGoStringModel(this.SectionId, "int64")+",\n")
 	}
-	if m.MeetingId != nil {
-		n += 1 + sovModel(uint64(*m.MeetingId))
+	if this.MeetingId != nil {
+		s = append(s, "MeetingId: "+valueToGoStringModel(this.MeetingId, "int64")+",\n")
 	}
-	l = len(m.Title)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Content)
-	n += 1 + l + sovModel(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s = append(s, "Title: "+fmt.Sprintf("%#v", this.Title)+",\n")
+	s = append(s, "Content: "+fmt.Sprintf("%#v", this.Content)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Registration) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Registration) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Id))
-	n += 1 + sovModel(uint64(m.UniversityId))
-	l = len(m.Period)
-	n += 1 + l + sovModel(uint64(l))
-	n += 1 + sovModel(uint64(m.PeriodDate))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 8)
+	s = append(s, "&model.Registration{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "UniversityId: "+fmt.Sprintf("%#v", this.UniversityId)+",\n")
+	s = append(s, "Period: "+fmt.Sprintf("%#v", this.Period)+",\n")
+	s = append(s, "PeriodDate: "+fmt.Sprintf("%#v", this.PeriodDate)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResolvedSemester) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ResolvedSemester) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Current != nil {
-		l = m.Current.Size()
-		n += 1 + l + sovModel(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&model.ResolvedSemester{")
+	if this.Current != nil {
+		s = append(s, "Current: "+fmt.Sprintf("%#v", this.Current)+",\n")
 	}
-	if m.Last != nil {
-		l = m.Last.Size()
-		n += 1 + l + sovModel(uint64(l))
+	if this.Last != nil {
+		s = append(s, "Last: "+fmt.Sprintf("%#v", this.Last)+",\n")
 	}
-	if m.Next != nil {
-		l = m.Next.Size()
-		n += 1 + l + sovModel(uint64(l))
+	if this.Next != nil {
+		s = append(s, "Next: "+fmt.Sprintf("%#v", this.Next)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Semester) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Semester) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.Year))
-	l = len(m.Season)
-	n += 1 + l + sovModel(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 8)
+	s = append(s, "&model.Semester{")
+	s = append(s, "Year: "+fmt.Sprintf("%#v", this.Year)+",\n")
+	s = append(s, "Season: "+fmt.Sprintf("%#v", this.Season)+",\n")
+	s = append(s, "TermCode: "+fmt.Sprintf("%#v", this.TermCode)+",\n")
+	s = append(s, "TermName: "+fmt.Sprintf("%#v", this.TermName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *UCTNotification) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *UCTNotification) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	n += 1 + sovModel(uint64(m.NotificationId))
-	l = len(m.TopicName)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Status)
-	n += 1 + l + sovModel(uint64(l))
-	l = m.University.Size()
-	n += 1 + l + sovModel(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 10)
+	s = append(s, "&model.UCTNotification{")
+	s = append(s, "NotificationId: "+fmt.Sprintf("%#v", this.NotificationId)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "University: "+strings.Replace(this.University.GoString(), `&`, ``, 1)+",\n")
+	if this.CrossListedTopicNames != nil {
+		s = append(s, "CrossListedTopicNames: "+fmt.Sprintf("%#v", this.CrossListedTopicNames)+",\n")
 	}
-	return n
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Response) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Meta != nil {
-		l = m.Meta.Size()
-		n += 1 + l + sovModel(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&model.Response{")
+	if this.Meta != nil {
+		s = append(s, "Meta: "+fmt.Sprintf("%#v", this.Meta)+",\n")
 	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovModel(uint64(l))
+	if this.Data != nil {
+		s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Meta) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Meta) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Code != nil {
-		n += 1 + sovModel(uint64(*m.Code))
+	s := make([]string, 0, 6)
+	s = append(s, "&model.Meta{")
+	if this.Code != nil {
+		s = append(s, "Code: "+valueToGoStringModel(this.Code, "int32")+",\n")
 	}
-	if m.Message != nil {
-		l = len(*m.Message)
-		n += 1 + l + sovModel(uint64(l))
+	if this.Message != nil {
+		s = append(s, "Message: "+valueToGoStringModel(this.Message, "string")+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Data) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Data) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.Universities) > 0 {
-		for _, e := range m.Universities {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	s := make([]string, 0, 20)
+	s = append(s, "&model.Data{")
+	if this.Universities != nil {
+		s = append(s, "Universities: "+fmt.Sprintf("%#v", this.Universities)+",\n")
 	}
-	if len(m.Subjects) > 0 {
-		for _, e := range m.Subjects {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Subjects != nil {
+		s = append(s, "Subjects: "+fmt.Sprintf("%#v", this.Subjects)+",\n")
 	}
-	if len(m.Courses) > 0 {
-		for _, e := range m.Courses {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Courses != nil {
+		s = append(s, "Courses: "+fmt.Sprintf("%#v", this.Courses)+",\n")
 	}
-	if len(m.Sections) > 0 {
-		for _, e := range m.Sections {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.Sections != nil {
+		s = append(s, "Sections: "+fmt.Sprintf("%#v", this.Sections)+",\n")
 	}
-	if m.University != nil {
-		l = m.University.Size()
-		n += 1 + l + sovModel(uint64(l))
+	if this.University != nil {
+		s = append(s, "University: "+fmt.Sprintf("%#v", this.University)+",\n")
 	}
-	if m.Subject != nil {
-		l = m.Subject.Size()
-		n += 1 + l + sovModel(uint64(l))
+	if this.Subject != nil {
+		s = append(s, "Subject: "+fmt.Sprintf("%#v", this.Subject)+",\n")
 	}
-	if m.Course != nil {
-		l = m.Course.Size()
-		n += 1 + l + sovModel(uint64(l))
+	if this.Course != nil {
+		s = append(s, "Course: "+fmt.Sprintf("%#v", this.Course)+",\n")
 	}
-	if m.Section != nil {
-		l = m.Section.Size()
-		n += 1 + l + sovModel(uint64(l))
+	if this.Section != nil {
+		s = append(s, "Section: "+fmt.Sprintf("%#v", this.Section)+",\n")
 	}
-	if len(m.SubscriptionView) > 0 {
-		for _, e := range m.SubscriptionView {
-			l = e.Size()
-			n += 1 + l + sovModel(uint64(l))
-		}
+	if this.SubscriptionView != nil {
+		s = append(s, "SubscriptionView: "+fmt.Sprintf("%#v", this.SubscriptionView)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.SectionHistory != nil {
+		s = append(s, "SectionHistory: "+fmt.Sprintf("%#v", this.SectionHistory)+",\n")
 	}
-	return n
-}
-
-func (m *Subscription) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.SearchResults != nil {
+		s = append(s, "SearchResults: "+fmt.Sprintf("%#v", this.SearchResults)+",\n")
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.Os)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.IsSubscribed)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.TopicName)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.FcmToken)
-	n += 1 + l + sovModel(uint64(l))
-	l = len(m.CreatedAt)
-	n += 1 + l + sovModel(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Page != nil {
+		s = append(s, "Page: "+fmt.Sprintf("%#v", this.Page)+",\n")
 	}
-	return n
-}
-
-func (m *SubscriptionView) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Schedules != nil {
+		s = append(s, "Schedules: "+fmt.Sprintf("%#v", this.Schedules)+",\n")
 	}
-	var l int
-	_ = l
-	l = len(m.TopicName)
-	n += 1 + l + sovModel(uint64(l))
-	n += 1 + sovModel(uint64(m.Subscribers))
-	n += 2
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.Prerequisite != nil {
+		s = append(s, "Prerequisite: "+fmt.Sprintf("%#v", this.Prerequisite)+",\n")
 	}
-	return n
-}
-
-func sovModel(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModel(x uint64) (n int) {
-	return sovModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if this.InstructorProfile != nil {
+		s = append(s, "InstructorProfile: "+fmt.Sprintf("%#v", this.InstructorProfile)+",\n")
+	}
+	if this.Buildings != nil {
+		s = append(s, "Buildings: "+fmt.Sprintf("%#v", this.Buildings)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *University) String() string {
+func (this *Subscription) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForSubjects := "[]*Subject{"
-	for _, f := range this.Subjects {
-		repeatedStringForSubjects += strings.Replace(f.String(), "Subject", "Subject", 1) + ","
-	}
-	repeatedStringForSubjects += "}"
-	repeatedStringForAvailableSemesters := "[]*Semester{"
-	for _, f := range this.AvailableSemesters {
-		repeatedStringForAvailableSemesters += strings.Replace(f.String(), "Semester", "Semester", 1) + ","
+	s := make([]string, 0, 10)
+	s = append(s, "&model.Subscription{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Os: "+fmt.Sprintf("%#v", this.Os)+",\n")
+	s = append(s, "IsSubscribed: "+fmt.Sprintf("%#v", this.IsSubscribed)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "FcmToken: "+fmt.Sprintf("%#v", this.FcmToken)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	repeatedStringForAvailableSemesters += "}"
-	repeatedStringForRegistrations := "[]*Registration{"
-	for _, f := range this.Registrations {
-		repeatedStringForRegistrations += strings.Replace(f.String(), "Registration", "Registration", 1) + ","
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SubscriptionView) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForRegistrations += "}"
-	repeatedStringForMetadata := "[]*Metadata{"
-	for _, f := range this.Metadata {
-		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	s := make([]string, 0, 7)
+	s = append(s, "&model.SubscriptionView{")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "Subscribers: "+fmt.Sprintf("%#v", this.Subscribers)+",\n")
+	s = append(s, "IsHot: "+fmt.Sprintf("%#v", this.IsHot)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	repeatedStringForMetadata += "}"
-	s := strings.Join([]string{`&University{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Abbr:` + fmt.Sprintf("%v", this.Abbr) + `,`,
-		`HomePage:` + fmt.Sprintf("%v", this.HomePage) + `,`,
-		`RegistrationPage:` + fmt.Sprintf("%v", this.RegistrationPage) + `,`,
-		`MainColor:` + fmt.Sprintf("%v", this.MainColor) + `,`,
-		`AccentColor:` + fmt.Sprintf("%v", this.AccentColor) + `,`,
-		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
-		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
-		`ResolvedSemesters:` + strings.Replace(this.ResolvedSemesters.String(), "ResolvedSemester", "ResolvedSemester", 1) + `,`,
-		`Subjects:` + repeatedStringForSubjects + `,`,
-		`AvailableSemesters:` + repeatedStringForAvailableSemesters + `,`,
-		`Registrations:` + repeatedStringForRegistrations + `,`,
-		`Metadata:` + repeatedStringForMetadata + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Subject) String() string {
+func (this *SectionHistory) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForCourses := "[]*Course{"
-	for _, f := range this.Courses {
-		repeatedStringForCourses += strings.Replace(f.String(), "Course", "Course", 1) + ","
+	s := make([]string, 0, 7)
+	s = append(s, "&model.SectionHistory{")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	if this.Snapshots != nil {
+		s = append(s, "Snapshots: "+fmt.Sprintf("%#v", this.Snapshots)+",\n")
 	}
-	repeatedStringForCourses += "}"
-	repeatedStringForMetadata := "[]*Metadata{"
-	for _, f := range this.Metadata {
-		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	if this.Stats != nil {
+		s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
 	}
-	repeatedStringForMetadata += "}"
-	s := strings.Join([]string{`&Subject{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`UniversityId:` + fmt.Sprintf("%v", this.UniversityId) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
-		`Season:` + fmt.Sprintf("%v", this.Season) + `,`,
-		`Year:` + fmt.Sprintf("%v", this.Year) + `,`,
-		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
-		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
-		`Courses:` + repeatedStringForCourses + `,`,
-		`Metadata:` + repeatedStringForMetadata + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Course) String() string {
+func (this *SectionSnapshot) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForSections := "[]*Section{"
-	for _, f := range this.Sections {
-		repeatedStringForSections += strings.Replace(f.String(), "Section", "Section", 1) + ","
+	s := make([]string, 0, 8)
+	s = append(s, "&model.SectionSnapshot{")
+	s = append(s, "Now: "+fmt.Sprintf("%#v", this.Now)+",\n")
+	s = append(s, "Max: "+fmt.Sprintf("%#v", this.Max)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	repeatedStringForSections += "}"
-	repeatedStringForMetadata := "[]*Metadata{"
-	for _, f := range this.Metadata {
-		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SectionHistoryStats) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForMetadata += "}"
-	s := strings.Join([]string{`&Course{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`SubjectId:` + fmt.Sprintf("%v", this.SubjectId) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
-		`Synopsis:` + valueToStringModel(this.Synopsis) + `,`,
-		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
-		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
-		`Sections:` + repeatedStringForSections + `,`,
-		`Metadata:` + repeatedStringForMetadata + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 7)
+	s = append(s, "&model.SectionHistoryStats{")
+	s = append(s, "TimeToFill: "+fmt.Sprintf("%#v", this.TimeToFill)+",\n")
+	s = append(s, "Reopenings: "+fmt.Sprintf("%#v", this.Reopenings)+",\n")
+	s = append(s, "TypicalOpenHour: "+fmt.Sprintf("%#v", this.TypicalOpenHour)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Section) String() string {
+func (this *SearchResult) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForMeetings := "[]*Meeting{"
-	for _, f := range this.Meetings {
-		repeatedStringForMeetings += strings.Replace(f.String(), "Meeting", "Meeting", 1) + ","
+	s := make([]string, 0, 10)
+	s = append(s, "&model.SearchResult{")
+	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Rank: "+fmt.Sprintf("%#v", this.Rank)+",\n")
+	if this.Course != nil {
+		s = append(s, "Course: "+fmt.Sprintf("%#v", this.Course)+",\n")
 	}
-	repeatedStringForMeetings += "}"
-	repeatedStringForInstructors := "[]*Instructor{"
-	for _, f := range this.Instructors {
-		repeatedStringForInstructors += strings.Replace(f.String(), "Instructor", "Instructor", 1) + ","
+	if this.Section != nil {
+		s = append(s, "Section: "+fmt.Sprintf("%#v", this.Section)+",\n")
 	}
-	repeatedStringForInstructors += "}"
-	repeatedStringForBooks := "[]*Book{"
-	for _, f := range this.Books {
-		repeatedStringForBooks += strings.Replace(f.String(), "Book", "Book", 1) + ","
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	repeatedStringForBooks += "}"
-	repeatedStringForMetadata := "[]*Metadata{"
-	for _, f := range this.Metadata {
-		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Page) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForMetadata += "}"
-	s := strings.Join([]string{`&Section{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`CourseId:` + fmt.Sprintf("%v", this.CourseId) + `,`,
-		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
-		`CallNumber:` + fmt.Sprintf("%v", this.CallNumber) + `,`,
-		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
-		`Now:` + fmt.Sprintf("%v", this.Now) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Credits:` + fmt.Sprintf("%v", this.Credits) + `,`,
-		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
-		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
-		`Meetings:` + repeatedStringForMeetings + `,`,
-		`Instructors:` + repeatedStringForInstructors + `,`,
-		`Books:` + repeatedStringForBooks + `,`,
-		`Metadata:` + repeatedStringForMetadata + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 7)
+	s = append(s, "&model.Page{")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Meeting) String() string {
+func (this *Schedule) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForMetadata := "[]*Metadata{"
-	for _, f := range this.Metadata {
-		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	s := make([]string, 0, 8)
+	s = append(s, "&model.Schedule{")
+	if this.Sections != nil {
+		s = append(s, "Sections: "+fmt.Sprintf("%#v", this.Sections)+",\n")
 	}
-	repeatedStringForMetadata += "}"
-	s := strings.Join([]string{`&Meeting{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`SectionId:` + fmt.Sprintf("%v", this.SectionId) + `,`,
-		`Room:` + valueToStringModel(this.Room) + `,`,
-		`Day:` + valueToStringModel(this.Day) + `,`,
-		`StartTime:` + valueToStringModel(this.StartTime) + `,`,
-		`EndTime:` + valueToStringModel(this.EndTime) + `,`,
-		`ClassType:` + valueToStringModel(this.ClassType) + `,`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`Metadata:` + repeatedStringForMetadata + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "Days: "+fmt.Sprintf("%#v", this.Days)+",\n")
+	s = append(s, "GapMinutes: "+fmt.Sprintf("%#v", this.GapMinutes)+",\n")
+	s = append(s, "EarliestStart: "+fmt.Sprintf("%#v", this.EarliestStart)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Instructor) String() string {
+func (this *Prerequisite) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Instructor{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`SectionId:` + fmt.Sprintf("%v", this.SectionId) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 9)
+	s = append(s, "&model.Prerequisite{")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	if this.Operands != nil {
+		s = append(s, "Operands: "+fmt.Sprintf("%#v", this.Operands)+",\n")
+	}
+	s = append(s, "SubjectNumber: "+fmt.Sprintf("%#v", this.SubjectNumber)+",\n")
+	s = append(s, "CourseNumber: "+fmt.Sprintf("%#v", this.CourseNumber)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Book) String() string {
+func (this *CrossListing) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Book{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`SectionId:` + fmt.Sprintf("%v", this.SectionId) + `,`,
-		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
-		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Metadata) String() string {
-	if this == nil {
-		return "nil"
+	s := make([]string, 0, 8)
+	s = append(s, "&model.CrossListing{")
+	s = append(s, "SubjectNumber: "+fmt.Sprintf("%#v", this.SubjectNumber)+",\n")
+	s = append(s, "CourseNumber: "+fmt.Sprintf("%#v", this.CourseNumber)+",\n")
+	s = append(s, "SectionNumber: "+fmt.Sprintf("%#v", this.SectionNumber)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	s := strings.Join([]string{`&Metadata{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`UniversityId:` + valueToStringModel(this.UniversityId) + `,`,
-		`SubjectId:` + valueToStringModel(this.SubjectId) + `,`,
-		`CourseId:` + valueToStringModel(this.CourseId) + `,`,
-		`SectionId:` + valueToStringModel(this.SectionId) + `,`,
-		`MeetingId:` + valueToStringModel(this.MeetingId) + `,`,
-		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
-		`Content:` + fmt.Sprintf("%v", this.Content) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Registration) String() string {
+func (this *InstructorProfile) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Registration{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`UniversityId:` + fmt.Sprintf("%v", this.UniversityId) + `,`,
-		`Period:` + fmt.Sprintf("%v", this.Period) + `,`,
-		`PeriodDate:` + fmt.Sprintf("%v", this.PeriodDate) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResolvedSemester) String() string {
-	if this == nil {
-		return "nil"
+	s := make([]string, 0, 7)
+	s = append(s, "&model.InstructorProfile{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "TopicName: "+fmt.Sprintf("%#v", this.TopicName)+",\n")
+	if this.Teaching != nil {
+		s = append(s, "Teaching: "+fmt.Sprintf("%#v", this.Teaching)+",\n")
 	}
-	s := strings.Join([]string{`&ResolvedSemester{`,
-		`Current:` + strings.Replace(this.Current.String(), "Semester", "Semester", 1) + `,`,
-		`Last:` + strings.Replace(this.Last.String(), "Semester", "Semester", 1) + `,`,
-		`Next:` + strings.Replace(this.Next.String(), "Semester", "Semester", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Semester) String() string {
+func (this *Teaching) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Semester{`,
-		`Year:` + fmt.Sprintf("%v", this.Year) + `,`,
-		`Season:` + fmt.Sprintf("%v", this.Season) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 7)
+	s = append(s, "&model.Teaching{")
+	s = append(s, "Season: "+fmt.Sprintf("%#v", this.Season)+",\n")
+	s = append(s, "Year: "+fmt.Sprintf("%#v", this.Year)+",\n")
+	if this.Course != nil {
+		s = append(s, "Course: "+fmt.Sprintf("%#v", this.Course)+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *UCTNotification) String() string {
+func (this *Building) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UCTNotification{`,
-		`NotificationId:` + fmt.Sprintf("%v", this.NotificationId) + `,`,
-		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`University:` + strings.Replace(strings.Replace(this.University.String(), "University", "University", 1), `&`, ``, 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 11)
+	s = append(s, "&model.Building{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "UniversityId: "+fmt.Sprintf("%#v", this.UniversityId)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	if this.Name != nil {
+		s = append(s, "Name: "+valueToGoStringModel(this.Name, "string")+",\n")
+	}
+	if this.Campus != nil {
+		s = append(s, "Campus: "+valueToGoStringModel(this.Campus, "string")+",\n")
+	}
+	if this.Latitude != nil {
+		s = append(s, "Latitude: "+valueToGoStringModel(this.Latitude, "float64")+",\n")
+	}
+	if this.Longitude != nil {
+		s = append(s, "Longitude: "+valueToGoStringModel(this.Longitude, "float64")+",\n")
+	}
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Response) String() string {
+func (this *Envelope) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Response{`,
-		`Meta:` + strings.Replace(this.Meta.String(), "Meta", "Meta", 1) + `,`,
-		`Data:` + strings.Replace(this.Data.String(), "Data", "Data", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 9)
+	s = append(s, "&model.Envelope{")
+	s = append(s, "SchemaVersion: "+fmt.Sprintf("%#v", this.SchemaVersion)+",\n")
+	s = append(s, "Producer: "+fmt.Sprintf("%#v", this.Producer)+",\n")
+	s = append(s, "ScrapedAt: "+fmt.Sprintf("%#v", this.ScrapedAt)+",\n")
+	s = append(s, "ContentHash: "+fmt.Sprintf("%#v", this.ContentHash)+",\n")
+	s = append(s, "Format: "+fmt.Sprintf("%#v", this.Format)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *Meta) String() string {
-	if this == nil {
+func valueToGoStringModel(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Meta{`,
-		`Code:` + valueToStringModel(this.Code) + `,`,
-		`Message:` + valueToStringModel(this.Message) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
-func (this *Data) String() string {
-	if this == nil {
-		return "nil"
+func (m *University) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForUniversities := "[]*University{"
-	for _, f := range this.Universities {
-		repeatedStringForUniversities += strings.Replace(f.String(), "University", "University", 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *University) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *University) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	repeatedStringForUniversities += "}"
-	repeatedStringForSubjects := "[]*Subject{"
-	for _, f := range this.Subjects {
-		repeatedStringForSubjects += strings.Replace(f.String(), "Subject", "Subject", 1) + ","
+	if len(m.Buildings) > 0 {
+		for iNdEx := len(m.Buildings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buildings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	repeatedStringForSubjects += "}"
-	repeatedStringForCourses := "[]*Course{"
-	for _, f := range this.Courses {
-		repeatedStringForCourses += strings.Replace(f.String(), "Course", "Course", 1) + ","
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x7a
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	repeatedStringForCourses += "}"
-	repeatedStringForSections := "[]*Section{"
-	for _, f := range this.Sections {
-		repeatedStringForSections += strings.Replace(f.String(), "Section", "Section", 1) + ","
+	if len(m.Registrations) > 0 {
+		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	repeatedStringForSections += "}"
-	repeatedStringForSubscriptionView := "[]*SubscriptionView{"
-	for _, f := range this.SubscriptionView {
-		repeatedStringForSubscriptionView += strings.Replace(f.String(), "SubscriptionView", "SubscriptionView", 1) + ","
+	if len(m.AvailableSemesters) > 0 {
+		for iNdEx := len(m.AvailableSemesters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvailableSemesters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	repeatedStringForSubscriptionView += "}"
-	s := strings.Join([]string{`&Data{`,
-		`Universities:` + repeatedStringForUniversities + `,`,
-		`Subjects:` + repeatedStringForSubjects + `,`,
-		`Courses:` + repeatedStringForCourses + `,`,
-		`Sections:` + repeatedStringForSections + `,`,
-		`University:` + strings.Replace(this.University.String(), "University", "University", 1) + `,`,
-		`Subject:` + strings.Replace(this.Subject.String(), "Subject", "Subject", 1) + `,`,
-		`Course:` + strings.Replace(this.Course.String(), "Course", "Course", 1) + `,`,
-		`Section:` + strings.Replace(this.Section.String(), "Section", "Section", 1) + `,`,
-		`SubscriptionView:` + repeatedStringForSubscriptionView + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Subscription) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	s := strings.Join([]string{`&Subscription{`,
+	if m.ResolvedSemesters != nil {
+		{
+			size, err := m.ResolvedSemesters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i -= len(m.TopicId)
+	copy(dAtA[i:], m.TopicId)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.AccentColor)
+	copy(dAtA[i:], m.AccentColor)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.AccentColor)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.MainColor)
+	copy(dAtA[i:], m.MainColor)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.MainColor)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.RegistrationPage)
+	copy(dAtA[i:], m.RegistrationPage)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.RegistrationPage)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.HomePage)
+	copy(dAtA[i:], m.HomePage)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.HomePage)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Abbr)
+	copy(dAtA[i:], m.Abbr)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Abbr)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Subject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.ContentHash)
+	copy(dAtA[i:], m.ContentHash)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.ContentHash)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.TermName)
+	copy(dAtA[i:], m.TermName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TermName)))
+	i--
+	dAtA[i] = 0x62
+	i = encodeVarintModel(dAtA, i, uint64(m.TermCode))
+	i--
+	dAtA[i] = 0x58
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Courses) > 0 {
+		for iNdEx := len(m.Courses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Courses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.TopicId)
+	copy(dAtA[i:], m.TopicId)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Year)
+	copy(dAtA[i:], m.Year)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Year)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Season)
+	copy(dAtA[i:], m.Season)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Season)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Number)
+	copy(dAtA[i:], m.Number)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Number)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.UniversityId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Course) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Course) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Course) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.ContentHash)
+	copy(dAtA[i:], m.ContentHash)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.ContentHash)))
+	i--
+	dAtA[i] = 0x5a
+	if m.Prerequisite != nil {
+		{
+			size, err := m.Prerequisite.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i -= len(m.TopicId)
+	copy(dAtA[i:], m.TopicId)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x32
+	if m.Synopsis != nil {
+		i -= len(*m.Synopsis)
+		copy(dAtA[i:], *m.Synopsis)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.Synopsis)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Number)
+	copy(dAtA[i:], m.Number)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Number)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.SubjectId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Section) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Section) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Section) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.ContentHash)
+	copy(dAtA[i:], m.ContentHash)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.ContentHash)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.WaitlistNow != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.WaitlistNow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.WaitlistMax != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.WaitlistMax))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.CrossListings) > 0 {
+		for iNdEx := len(m.CrossListings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossListings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Books) > 0 {
+		for iNdEx := len(m.Books) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Books[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Instructors) > 0 {
+		for iNdEx := len(m.Instructors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Instructors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Meetings) > 0 {
+		for iNdEx := len(m.Meetings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Meetings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	i -= len(m.TopicId)
+	copy(dAtA[i:], m.TopicId)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicId)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Credits)
+	copy(dAtA[i:], m.Credits)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Credits)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x3a
+	i = encodeVarintModel(dAtA, i, uint64(m.Now))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintModel(dAtA, i, uint64(m.Max))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.CallNumber)
+	copy(dAtA[i:], m.CallNumber)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.CallNumber)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Number)
+	copy(dAtA[i:], m.Number)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Number)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.CourseId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Meeting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Meeting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Meeting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.ContentHash)
+	copy(dAtA[i:], m.ContentHash)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.ContentHash)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.Building != nil {
+		{
+			size, err := m.Building.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RoomNumber != nil {
+		i -= len(*m.RoomNumber)
+		copy(dAtA[i:], *m.RoomNumber)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.RoomNumber)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.BuildingCode != nil {
+		i -= len(*m.BuildingCode)
+		copy(dAtA[i:], *m.BuildingCode)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.BuildingCode)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Duration != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.Duration))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StartMinute != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.StartMinute))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Weekday != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.Weekday))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x40
+	if m.ClassType != nil {
+		i -= len(*m.ClassType)
+		copy(dAtA[i:], *m.ClassType)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.ClassType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndTime != nil {
+		i -= len(*m.EndTime)
+		copy(dAtA[i:], *m.EndTime)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.EndTime)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTime != nil {
+		i -= len(*m.StartTime)
+		copy(dAtA[i:], *m.StartTime)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.StartTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Day != nil {
+		i -= len(*m.Day)
+		copy(dAtA[i:], *m.Day)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.Day)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Room != nil {
+		i -= len(*m.Room)
+		copy(dAtA[i:], *m.Room)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.Room)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.SectionId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Instructor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Instructor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Instructor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintModel(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.SectionId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Book) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Book) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Book) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.Url)
+	copy(dAtA[i:], m.Url)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Url)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Title)
+	copy(dAtA[i:], m.Title)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.SectionId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.Content)
+	copy(dAtA[i:], m.Content)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Content)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Title)
+	copy(dAtA[i:], m.Title)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x3a
+	if m.MeetingId != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.MeetingId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SectionId != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.SectionId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CourseId != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.CourseId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SubjectId != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.SubjectId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UniversityId != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.UniversityId))
+		i--
+		dAtA[i] = 0x10
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Registration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Registration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Registration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.PeriodDate))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Period)
+	copy(dAtA[i:], m.Period)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Period)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.UniversityId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolvedSemester) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolvedSemester) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolvedSemester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Next != nil {
+		{
+			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Last != nil {
+		{
+			size, err := m.Last.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Current != nil {
+		{
+			size, err := m.Current.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Semester) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Semester) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Semester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.TermName)
+	copy(dAtA[i:], m.TermName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TermName)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintModel(dAtA, i, uint64(m.TermCode))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Season)
+	copy(dAtA[i:], m.Season)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Season)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintModel(dAtA, i, uint64(m.Year))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *UCTNotification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UCTNotification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UCTNotification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.CrossListedTopicNames) > 0 {
+		for iNdEx := len(m.CrossListedTopicNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CrossListedTopicNames[iNdEx])
+			copy(dAtA[i:], m.CrossListedTopicNames[iNdEx])
+			i = encodeVarintModel(dAtA, i, uint64(len(m.CrossListedTopicNames[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.University.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintModel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintModel(dAtA, i, uint64(m.NotificationId))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Meta != nil {
+		{
+			size, err := m.Meta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Meta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Meta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Message != nil {
+		i -= len(*m.Message)
+		copy(dAtA[i:], *m.Message)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != nil {
+		i = encodeVarintModel(dAtA, i, uint64(*m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Data) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Data) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Data) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Buildings) > 0 {
+		for iNdEx := len(m.Buildings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buildings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.InstructorProfile != nil {
+		{
+			size, err := m.InstructorProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Prerequisite != nil {
+		{
+			size, err := m.Prerequisite.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Page != nil {
+		{
+			size, err := m.Page.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.SearchResults) > 0 {
+		for iNdEx := len(m.SearchResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SearchResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.SectionHistory != nil {
+		{
+			size, err := m.SectionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SubscriptionView) > 0 {
+		for iNdEx := len(m.SubscriptionView) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionView[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Section != nil {
+		{
+			size, err := m.Section.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Course != nil {
+		{
+			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Subject != nil {
+		{
+			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.University != nil {
+		{
+			size, err := m.University.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Courses) > 0 {
+		for iNdEx := len(m.Courses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Courses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Universities) > 0 {
+		for iNdEx := len(m.Universities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Universities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.CreatedAt)
+	copy(dAtA[i:], m.CreatedAt)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.CreatedAt)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.FcmToken)
+	copy(dAtA[i:], m.FcmToken)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.FcmToken)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.IsSubscribed)
+	copy(dAtA[i:], m.IsSubscribed)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.IsSubscribed)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Os)
+	copy(dAtA[i:], m.Os)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Os)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Id)
+	copy(dAtA[i:], m.Id)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Id)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscriptionView) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i--
+	if m.IsHot {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintModel(dAtA, i, uint64(m.Subscribers))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SectionHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SectionHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SectionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SectionSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SectionSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SectionSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.CreatedAt))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.Max))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Now))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *SectionHistoryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SectionHistoryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SectionHistoryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.TypicalOpenHour))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintModel(dAtA, i, uint64(m.Reopenings))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.TimeToFill))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Section != nil {
+		{
+			size, err := m.Section.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Course != nil {
+		{
+			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rank))))
+	i--
+	dAtA[i] = 0x21
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Page) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Page) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Page) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.Total))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintModel(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Offset))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i = encodeVarintModel(dAtA, i, uint64(m.EarliestStart))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintModel(dAtA, i, uint64(m.GapMinutes))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintModel(dAtA, i, uint64(m.Days))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Prerequisite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Prerequisite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Prerequisite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.CourseNumber)
+	copy(dAtA[i:], m.CourseNumber)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.CourseNumber)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.SubjectNumber)
+	copy(dAtA[i:], m.SubjectNumber)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.SubjectNumber)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Operands) > 0 {
+		for iNdEx := len(m.Operands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Operator)
+	copy(dAtA[i:], m.Operator)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Operator)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.SectionNumber)
+	copy(dAtA[i:], m.SectionNumber)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.SectionNumber)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.CourseNumber)
+	copy(dAtA[i:], m.CourseNumber)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.CourseNumber)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SubjectNumber)
+	copy(dAtA[i:], m.SubjectNumber)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.SubjectNumber)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InstructorProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstructorProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstructorProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Teaching) > 0 {
+		for iNdEx := len(m.Teaching) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Teaching[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.TopicName)
+	copy(dAtA[i:], m.TopicName)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.TopicName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Teaching) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Teaching) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Teaching) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Course != nil {
+		{
+			size, err := m.Course.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModel(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Year)
+	copy(dAtA[i:], m.Year)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Year)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Season)
+	copy(dAtA[i:], m.Season)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Season)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Building) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Building) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Building) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Longitude != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Longitude))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.Latitude != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Latitude))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Campus != nil {
+		i -= len(*m.Campus)
+		copy(dAtA[i:], *m.Campus)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.Campus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Name != nil {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintModel(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Code)
+	copy(dAtA[i:], m.Code)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Code)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintModel(dAtA, i, uint64(m.UniversityId))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintModel(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Envelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Envelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.ContentHash)
+	copy(dAtA[i:], m.ContentHash)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.ContentHash)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintModel(dAtA, i, uint64(m.ScrapedAt))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Producer)
+	copy(dAtA[i:], m.Producer)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.Producer)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SchemaVersion)
+	copy(dAtA[i:], m.SchemaVersion)
+	i = encodeVarintModel(dAtA, i, uint64(len(m.SchemaVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedUniversity(r randyModel, easy bool) *University {
+	this := &University{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.Name = string(randStringModel(r))
+	this.Abbr = string(randStringModel(r))
+	this.HomePage = string(randStringModel(r))
+	this.RegistrationPage = string(randStringModel(r))
+	this.MainColor = string(randStringModel(r))
+	this.AccentColor = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	this.TopicId = string(randStringModel(r))
+	if r.Intn(5) != 0 {
+		this.ResolvedSemesters = NewPopulatedResolvedSemester(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		v1 := r.Intn(5)
+		this.Subjects = make([]*Subject, v1)
+		for i := 0; i < v1; i++ {
+			this.Subjects[i] = NewPopulatedSubject(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(5)
+		this.AvailableSemesters = make([]*Semester, v2)
+		for i := 0; i < v2; i++ {
+			this.AvailableSemesters[i] = NewPopulatedSemester(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Registrations = make([]*Registration, v3)
+		for i := 0; i < v3; i++ {
+			this.Registrations[i] = NewPopulatedRegistration(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(5)
+		this.Metadata = make([]*Metadata, v4)
+		for i := 0; i < v4; i++ {
+			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+		}
+	}
+	this.TimeZone = string(randStringModel(r))
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.Buildings = make([]*Building, v5)
+		for i := 0; i < v5; i++ {
+			this.Buildings[i] = NewPopulatedBuilding(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 17)
+	}
+	return this
+}
+
+func NewPopulatedSubject(r randyModel, easy bool) *Subject {
+	this := &Subject{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.UniversityId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.UniversityId *= -1
+	}
+	this.Name = string(randStringModel(r))
+	this.Number = string(randStringModel(r))
+	this.Season = string(randStringModel(r))
+	this.Year = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	this.TopicId = string(randStringModel(r))
+	if r.Intn(5) == 0 {
+		v6 := r.Intn(5)
+		this.Courses = make([]*Course, v6)
+		for i := 0; i < v6; i++ {
+			this.Courses[i] = NewPopulatedCourse(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v7 := r.Intn(5)
+		this.Metadata = make([]*Metadata, v7)
+		for i := 0; i < v7; i++ {
+			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+		}
+	}
+	this.TermCode = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TermCode *= -1
+	}
+	this.TermName = string(randStringModel(r))
+	this.ContentHash = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 14)
+	}
+	return this
+}
+
+func NewPopulatedCourse(r randyModel, easy bool) *Course {
+	this := &Course{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.SubjectId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.SubjectId *= -1
+	}
+	this.Name = string(randStringModel(r))
+	this.Number = string(randStringModel(r))
+	if r.Intn(5) != 0 {
+		v8 := string(randStringModel(r))
+		this.Synopsis = &v8
+	}
+	this.TopicName = string(randStringModel(r))
+	this.TopicId = string(randStringModel(r))
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(5)
+		this.Sections = make([]*Section, v9)
+		for i := 0; i < v9; i++ {
+			this.Sections[i] = NewPopulatedSection(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v10 := r.Intn(5)
+		this.Metadata = make([]*Metadata, v10)
+		for i := 0; i < v10; i++ {
+			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		this.Prerequisite = NewPopulatedPrerequisite(r, easy)
+	}
+	this.ContentHash = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 12)
+	}
+	return this
+}
+
+func NewPopulatedSection(r randyModel, easy bool) *Section {
+	this := &Section{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.CourseId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CourseId *= -1
+	}
+	this.Number = string(randStringModel(r))
+	this.CallNumber = string(randStringModel(r))
+	this.Max = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Max *= -1
+	}
+	this.Now = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Now *= -1
+	}
+	this.Status = string(randStringModel(r))
+	this.Credits = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	this.TopicId = string(randStringModel(r))
+	if r.Intn(5) != 0 {
+		v11 := r.Intn(5)
+		this.Meetings = make([]*Meeting, v11)
+		for i := 0; i < v11; i++ {
+			this.Meetings[i] = NewPopulatedMeeting(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v12 := r.Intn(5)
+		this.Instructors = make([]*Instructor, v12)
+		for i := 0; i < v12; i++ {
+			this.Instructors[i] = NewPopulatedInstructor(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v13 := r.Intn(5)
+		this.Books = make([]*Book, v13)
+		for i := 0; i < v13; i++ {
+			this.Books[i] = NewPopulatedBook(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v14 := r.Intn(5)
+		this.Metadata = make([]*Metadata, v14)
+		for i := 0; i < v14; i++ {
+			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v15 := r.Intn(5)
+		this.CrossListings = make([]*CrossListing, v15)
+		for i := 0; i < v15; i++ {
+			this.CrossListings[i] = NewPopulatedCrossListing(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v16 := int64(r.Int63())
+		if r.Intn(2) == 0 {
+			v16 *= -1
+		}
+		this.WaitlistMax = &v16
+	}
+	if r.Intn(5) != 0 {
+		v17 := int64(r.Int63())
+		if r.Intn(2) == 0 {
+			v17 *= -1
+		}
+		this.WaitlistNow = &v17
+	}
+	this.ContentHash = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 19)
+	}
+	return this
+}
+
+func NewPopulatedMeeting(r randyModel, easy bool) *Meeting {
+	this := &Meeting{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.SectionId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.SectionId *= -1
+	}
+	if r.Intn(5) != 0 {
+		v18 := string(randStringModel(r))
+		this.Room = &v18
+	}
+	if r.Intn(5) != 0 {
+		v19 := string(randStringModel(r))
+		this.Day = &v19
+	}
+	if r.Intn(5) != 0 {
+		v20 := string(randStringModel(r))
+		this.StartTime = &v20
+	}
+	if r.Intn(5) != 0 {
+		v21 := string(randStringModel(r))
+		this.EndTime = &v21
+	}
+	if r.Intn(5) != 0 {
+		v22 := string(randStringModel(r))
+		this.ClassType = &v22
+	}
+	this.Index = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Index *= -1
+	}
+	if r.Intn(5) != 0 {
+		v23 := r.Intn(5)
+		this.Metadata = make([]*Metadata, v23)
+		for i := 0; i < v23; i++ {
+			this.Metadata[i] = NewPopulatedMetadata(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v24 := Weekday([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+		this.Weekday = &v24
+	}
+	if r.Intn(5) != 0 {
+		v25 := int32(r.Int31())
+		if r.Intn(2) == 0 {
+			v25 *= -1
+		}
+		this.StartMinute = &v25
+	}
+	if r.Intn(5) != 0 {
+		v26 := int32(r.Int31())
+		if r.Intn(2) == 0 {
+			v26 *= -1
+		}
+		this.Duration = &v26
+	}
+	if r.Intn(5) != 0 {
+		v27 := string(randStringModel(r))
+		this.BuildingCode = &v27
+	}
+	if r.Intn(5) != 0 {
+		v28 := string(randStringModel(r))
+		this.RoomNumber = &v28
+	}
+	if r.Intn(5) != 0 {
+		this.Building = NewPopulatedBuilding(r, easy)
+	}
+	this.ContentHash = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 17)
+	}
+	return this
+}
+
+func NewPopulatedInstructor(r randyModel, easy bool) *Instructor {
+	this := &Instructor{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.SectionId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.SectionId *= -1
+	}
+	this.Name = string(randStringModel(r))
+	this.Index = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Index *= -1
+	}
+	this.TopicName = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedBook(r randyModel, easy bool) *Book {
+	this := &Book{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.SectionId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.SectionId *= -1
+	}
+	this.Title = string(randStringModel(r))
+	this.Url = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedMetadata(r randyModel, easy bool) *Metadata {
+	this := &Metadata{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	if r.Intn(5) != 0 {
+		v29 := int64(r.Int63())
+		if r.Intn(2) == 0 {
+			v29 *= -1
+		}
+		this.UniversityId = &v29
+	}
+	if r.Intn(5) != 0 {
+		v30 := int64(r.Int63())
+		if r.Intn(2) == 0 {
+			v30 *= -1
+		}
+		this.SubjectId = &v30
+	}
+	if r.Intn(5) != 0 {
+		v31 := int64(r.Int63())
+		if r.Intn(2) == 0 {
+			v31 *= -1
+		}
+		this.CourseId = &v31
+	}
+	if r.Intn(5) != 0 {
+		v32 := int64(r.Int63())
+		if r.Intn(2) == 0 {
+			v32 *= -1
+		}
+		this.SectionId = &v32
+	}
+	if r.Intn(5) != 0 {
+		v33 := int64(r.Int63())
+		if r.Intn(2) == 0 {
+			v33 *= -1
+		}
+		this.MeetingId = &v33
+	}
+	this.Title = string(randStringModel(r))
+	this.Content = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 9)
+	}
+	return this
+}
+
+func NewPopulatedRegistration(r randyModel, easy bool) *Registration {
+	this := &Registration{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.UniversityId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.UniversityId *= -1
+	}
+	this.Period = string(randStringModel(r))
+	this.PeriodDate = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.PeriodDate *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedResolvedSemester(r randyModel, easy bool) *ResolvedSemester {
+	this := &ResolvedSemester{}
+	if r.Intn(5) != 0 {
+		this.Current = NewPopulatedSemester(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Last = NewPopulatedSemester(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Next = NewPopulatedSemester(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSemester(r randyModel, easy bool) *Semester {
+	this := &Semester{}
+	this.Year = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Year *= -1
+	}
+	this.Season = string(randStringModel(r))
+	this.TermCode = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TermCode *= -1
+	}
+	this.TermName = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedUCTNotification(r randyModel, easy bool) *UCTNotification {
+	this := &UCTNotification{}
+	this.NotificationId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.NotificationId *= -1
+	}
+	this.TopicName = string(randStringModel(r))
+	this.Status = string(randStringModel(r))
+	v34 := NewPopulatedUniversity(r, easy)
+	this.University = *v34
+	if r.Intn(5) != 0 {
+		v35 := r.Intn(10)
+		this.CrossListedTopicNames = make([]string, v35)
+		for i := 0; i < v35; i++ {
+			this.CrossListedTopicNames[i] = string(randStringModel(r))
+		}
+	}
+	this.Type = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedResponse(r randyModel, easy bool) *Response {
+	this := &Response{}
+	if r.Intn(5) != 0 {
+		this.Meta = NewPopulatedMeta(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.Data = NewPopulatedData(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedMeta(r randyModel, easy bool) *Meta {
+	this := &Meta{}
+	if r.Intn(5) != 0 {
+		v36 := int32(r.Int31())
+		if r.Intn(2) == 0 {
+			v36 *= -1
+		}
+		this.Code = &v36
+	}
+	if r.Intn(5) != 0 {
+		v37 := string(randStringModel(r))
+		this.Message = &v37
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedData(r randyModel, easy bool) *Data {
+	this := &Data{}
+	if r.Intn(5) == 0 {
+		v38 := r.Intn(5)
+		this.Universities = make([]*University, v38)
+		for i := 0; i < v38; i++ {
+			this.Universities[i] = NewPopulatedUniversity(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		v39 := r.Intn(5)
+		this.Subjects = make([]*Subject, v39)
+		for i := 0; i < v39; i++ {
+			this.Subjects[i] = NewPopulatedSubject(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		v40 := r.Intn(5)
+		this.Courses = make([]*Course, v40)
+		for i := 0; i < v40; i++ {
+			this.Courses[i] = NewPopulatedCourse(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v41 := r.Intn(5)
+		this.Sections = make([]*Section, v41)
+		for i := 0; i < v41; i++ {
+			this.Sections[i] = NewPopulatedSection(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		this.University = NewPopulatedUniversity(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.Subject = NewPopulatedSubject(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.Course = NewPopulatedCourse(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Section = NewPopulatedSection(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v42 := r.Intn(5)
+		this.SubscriptionView = make([]*SubscriptionView, v42)
+		for i := 0; i < v42; i++ {
+			this.SubscriptionView[i] = NewPopulatedSubscriptionView(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.SectionHistory = NewPopulatedSectionHistory(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		v43 := r.Intn(5)
+		this.SearchResults = make([]*SearchResult, v43)
+		for i := 0; i < v43; i++ {
+			this.SearchResults[i] = NewPopulatedSearchResult(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.Page = NewPopulatedPage(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v44 := r.Intn(5)
+		this.Schedules = make([]*Schedule, v44)
+		for i := 0; i < v44; i++ {
+			this.Schedules[i] = NewPopulatedSchedule(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		this.Prerequisite = NewPopulatedPrerequisite(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.InstructorProfile = NewPopulatedInstructorProfile(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v45 := r.Intn(5)
+		this.Buildings = make([]*Building, v45)
+		for i := 0; i < v45; i++ {
+			this.Buildings[i] = NewPopulatedBuilding(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 17)
+	}
+	return this
+}
+
+func NewPopulatedSubscription(r randyModel, easy bool) *Subscription {
+	this := &Subscription{}
+	this.Id = string(randStringModel(r))
+	this.Os = string(randStringModel(r))
+	this.IsSubscribed = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	this.FcmToken = string(randStringModel(r))
+	this.CreatedAt = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedSubscriptionView(r randyModel, easy bool) *SubscriptionView {
+	this := &SubscriptionView{}
+	this.TopicName = string(randStringModel(r))
+	this.Subscribers = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Subscribers *= -1
+	}
+	this.IsHot = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSectionHistory(r randyModel, easy bool) *SectionHistory {
+	this := &SectionHistory{}
+	this.TopicName = string(randStringModel(r))
+	if r.Intn(5) != 0 {
+		v46 := r.Intn(5)
+		this.Snapshots = make([]*SectionSnapshot, v46)
+		for i := 0; i < v46; i++ {
+			this.Snapshots[i] = NewPopulatedSectionSnapshot(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.Stats = NewPopulatedSectionHistoryStats(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSectionSnapshot(r randyModel, easy bool) *SectionSnapshot {
+	this := &SectionSnapshot{}
+	this.Now = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Now *= -1
+	}
+	this.Max = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Max *= -1
+	}
+	this.Status = string(randStringModel(r))
+	this.CreatedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.CreatedAt *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedSectionHistoryStats(r randyModel, easy bool) *SectionHistoryStats {
+	this := &SectionHistoryStats{}
+	this.TimeToFill = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.TimeToFill *= -1
+	}
+	this.Reopenings = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Reopenings *= -1
+	}
+	this.TypicalOpenHour = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TypicalOpenHour *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSearchResult(r randyModel, easy bool) *SearchResult {
+	this := &SearchResult{}
+	this.Kind = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	this.Name = string(randStringModel(r))
+	this.Rank = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Rank *= -1
+	}
+	if r.Intn(5) == 0 {
+		this.Course = NewPopulatedCourse(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Section = NewPopulatedSection(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedPage(r randyModel, easy bool) *Page {
+	this := &Page{}
+	this.Offset = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Offset *= -1
+	}
+	this.Limit = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Limit *= -1
+	}
+	this.Total = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Total *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSchedule(r randyModel, easy bool) *Schedule {
+	this := &Schedule{}
+	if r.Intn(5) != 0 {
+		v47 := r.Intn(5)
+		this.Sections = make([]*Section, v47)
+		for i := 0; i < v47; i++ {
+			this.Sections[i] = NewPopulatedSection(r, easy)
+		}
+	}
+	this.Days = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Days *= -1
+	}
+	this.GapMinutes = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.GapMinutes *= -1
+	}
+	this.EarliestStart = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.EarliestStart *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedPrerequisite(r randyModel, easy bool) *Prerequisite {
+	this := &Prerequisite{}
+	this.Operator = string(randStringModel(r))
+	if r.Intn(5) == 0 {
+		v48 := r.Intn(5)
+		this.Operands = make([]*Prerequisite, v48)
+		for i := 0; i < v48; i++ {
+			this.Operands[i] = NewPopulatedPrerequisite(r, easy)
+		}
+	}
+	this.SubjectNumber = string(randStringModel(r))
+	this.CourseNumber = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedCrossListing(r randyModel, easy bool) *CrossListing {
+	this := &CrossListing{}
+	this.SubjectNumber = string(randStringModel(r))
+	this.CourseNumber = string(randStringModel(r))
+	this.SectionNumber = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedInstructorProfile(r randyModel, easy bool) *InstructorProfile {
+	this := &InstructorProfile{}
+	this.Name = string(randStringModel(r))
+	this.TopicName = string(randStringModel(r))
+	if r.Intn(5) == 0 {
+		v49 := r.Intn(5)
+		this.Teaching = make([]*Teaching, v49)
+		for i := 0; i < v49; i++ {
+			this.Teaching[i] = NewPopulatedTeaching(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedTeaching(r randyModel, easy bool) *Teaching {
+	this := &Teaching{}
+	this.Season = string(randStringModel(r))
+	this.Year = string(randStringModel(r))
+	if r.Intn(5) == 0 {
+		this.Course = NewPopulatedCourse(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedBuilding(r randyModel, easy bool) *Building {
+	this := &Building{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.UniversityId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.UniversityId *= -1
+	}
+	this.Code = string(randStringModel(r))
+	if r.Intn(5) != 0 {
+		v50 := string(randStringModel(r))
+		this.Name = &v50
+	}
+	if r.Intn(5) != 0 {
+		v51 := string(randStringModel(r))
+		this.Campus = &v51
+	}
+	if r.Intn(5) != 0 {
+		v52 := float64(r.Float64())
+		if r.Intn(2) == 0 {
+			v52 *= -1
+		}
+		this.Latitude = &v52
+	}
+	if r.Intn(5) != 0 {
+		v53 := float64(r.Float64())
+		if r.Intn(2) == 0 {
+			v53 *= -1
+		}
+		this.Longitude = &v53
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 8)
+	}
+	return this
+}
+
+func NewPopulatedEnvelope(r randyModel, easy bool) *Envelope {
+	this := &Envelope{}
+	this.SchemaVersion = string(randStringModel(r))
+	this.Producer = string(randStringModel(r))
+	this.ScrapedAt = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ScrapedAt *= -1
+	}
+	this.ContentHash = string(randStringModel(r))
+	this.Format = string(randStringModel(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedModel(r, 6)
+	}
+	return this
+}
+
+type randyModel interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneModel(r randyModel) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringModel(r randyModel) string {
+	v54 := r.Intn(100)
+	tmps := make([]rune, v54)
+	for i := 0; i < v54; i++ {
+		tmps[i] = randUTF8RuneModel(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedModel(r randyModel, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldModel(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldModel(dAtA []byte, r randyModel, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
+		v55 := r.Int63()
+		if r.Intn(2) == 0 {
+			v55 *= -1
+		}
+		dAtA = encodeVarintPopulateModel(dAtA, uint64(v55))
+	case 1:
+		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateModel(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateModel(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateModel(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *University) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	l = len(m.Name)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Abbr)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.HomePage)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.RegistrationPage)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.MainColor)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.AccentColor)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicId)
+	n += 1 + l + sovModel(uint64(l))
+	if m.ResolvedSemesters != nil {
+		l = m.ResolvedSemesters.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.AvailableSemesters) > 0 {
+		for _, e := range m.AvailableSemesters {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Registrations) > 0 {
+		for _, e := range m.Registrations {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	l = len(m.TimeZone)
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.Buildings) > 0 {
+		for _, e := range m.Buildings {
+			l = e.Size()
+			n += 2 + l + sovModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Subject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.UniversityId))
+	l = len(m.Name)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Number)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Season)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Year)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicId)
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.Courses) > 0 {
+		for _, e := range m.Courses {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	n += 1 + sovModel(uint64(m.TermCode))
+	l = len(m.TermName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.ContentHash)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Course) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.SubjectId))
+	l = len(m.Name)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Number)
+	n += 1 + l + sovModel(uint64(l))
+	if m.Synopsis != nil {
+		l = len(*m.Synopsis)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicId)
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.Sections) > 0 {
+		for _, e := range m.Sections {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.Prerequisite != nil {
+		l = m.Prerequisite.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	l = len(m.ContentHash)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Section) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.CourseId))
+	l = len(m.Number)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.CallNumber)
+	n += 1 + l + sovModel(uint64(l))
+	n += 1 + sovModel(uint64(m.Max))
+	n += 1 + sovModel(uint64(m.Now))
+	l = len(m.Status)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Credits)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicId)
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.Meetings) > 0 {
+		for _, e := range m.Meetings {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Instructors) > 0 {
+		for _, e := range m.Instructors {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Books) > 0 {
+		for _, e := range m.Books {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.CrossListings) > 0 {
+		for _, e := range m.CrossListings {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.WaitlistMax != nil {
+		n += 2 + sovModel(uint64(*m.WaitlistMax))
+	}
+	if m.WaitlistNow != nil {
+		n += 2 + sovModel(uint64(*m.WaitlistNow))
+	}
+	l = len(m.ContentHash)
+	n += 2 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Meeting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.SectionId))
+	if m.Room != nil {
+		l = len(*m.Room)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Day != nil {
+		l = len(*m.Day)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = len(*m.StartTime)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = len(*m.EndTime)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.ClassType != nil {
+		l = len(*m.ClassType)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	n += 1 + sovModel(uint64(m.Index))
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.Weekday != nil {
+		n += 1 + sovModel(uint64(*m.Weekday))
+	}
+	if m.StartMinute != nil {
+		n += 1 + sovModel(uint64(*m.StartMinute))
+	}
+	if m.Duration != nil {
+		n += 1 + sovModel(uint64(*m.Duration))
+	}
+	if m.BuildingCode != nil {
+		l = len(*m.BuildingCode)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.RoomNumber != nil {
+		l = len(*m.RoomNumber)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Building != nil {
+		l = m.Building.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	l = len(m.ContentHash)
+	n += 2 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Instructor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.SectionId))
+	l = len(m.Name)
+	n += 1 + l + sovModel(uint64(l))
+	n += 1 + sovModel(uint64(m.Index))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Book) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.SectionId))
+	l = len(m.Title)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Url)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	if m.UniversityId != nil {
+		n += 1 + sovModel(uint64(*m.UniversityId))
+	}
+	if m.SubjectId != nil {
+		n += 1 + sovModel(uint64(*m.SubjectId))
+	}
+	if m.CourseId != nil {
+		n += 1 + sovModel(uint64(*m.CourseId))
+	}
+	if m.SectionId != nil {
+		n += 1 + sovModel(uint64(*m.SectionId))
+	}
+	if m.MeetingId != nil {
+		n += 1 + sovModel(uint64(*m.MeetingId))
+	}
+	l = len(m.Title)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Content)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Registration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.UniversityId))
+	l = len(m.Period)
+	n += 1 + l + sovModel(uint64(l))
+	n += 1 + sovModel(uint64(m.PeriodDate))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResolvedSemester) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Current != nil {
+		l = m.Current.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Last != nil {
+		l = m.Last.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Next != nil {
+		l = m.Next.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Semester) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Year))
+	l = len(m.Season)
+	n += 1 + l + sovModel(uint64(l))
+	n += 1 + sovModel(uint64(m.TermCode))
+	l = len(m.TermName)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UCTNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.NotificationId))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovModel(uint64(l))
+	l = m.University.Size()
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.CrossListedTopicNames) > 0 {
+		for _, s := range m.CrossListedTopicNames {
+			l = len(s)
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	l = len(m.Type)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Meta != nil {
+		l = m.Meta.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Meta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != nil {
+		n += 1 + sovModel(uint64(*m.Code))
+	}
+	if m.Message != nil {
+		l = len(*m.Message)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Data) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Universities) > 0 {
+		for _, e := range m.Universities {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Courses) > 0 {
+		for _, e := range m.Courses {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if len(m.Sections) > 0 {
+		for _, e := range m.Sections {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.University != nil {
+		l = m.University.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Subject != nil {
+		l = m.Subject.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Course != nil {
+		l = m.Course.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Section != nil {
+		l = m.Section.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if len(m.SubscriptionView) > 0 {
+		for _, e := range m.SubscriptionView {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.SectionHistory != nil {
+		l = m.SectionHistory.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if len(m.SearchResults) > 0 {
+		for _, e := range m.SearchResults {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.Page != nil {
+		l = m.Page.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.Prerequisite != nil {
+		l = m.Prerequisite.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.InstructorProfile != nil {
+		l = m.InstructorProfile.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if len(m.Buildings) > 0 {
+		for _, e := range m.Buildings {
+			l = e.Size()
+			n += 2 + l + sovModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Os)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.IsSubscribed)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.FcmToken)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.CreatedAt)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscriptionView) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	n += 1 + sovModel(uint64(m.Subscribers))
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SectionHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SectionSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Now))
+	n += 1 + sovModel(uint64(m.Max))
+	l = len(m.Status)
+	n += 1 + l + sovModel(uint64(l))
+	n += 1 + sovModel(uint64(m.CreatedAt))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SectionHistoryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.TimeToFill))
+	n += 1 + sovModel(uint64(m.Reopenings))
+	n += 1 + sovModel(uint64(m.TypicalOpenHour))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovModel(uint64(l))
+	n += 9
+	if m.Course != nil {
+		l = m.Course.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Section != nil {
+		l = m.Section.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Page) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Offset))
+	n += 1 + sovModel(uint64(m.Limit))
+	n += 1 + sovModel(uint64(m.Total))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sections) > 0 {
+		for _, e := range m.Sections {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	n += 1 + sovModel(uint64(m.Days))
+	n += 1 + sovModel(uint64(m.GapMinutes))
+	n += 1 + sovModel(uint64(m.EarliestStart))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Prerequisite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.Operands) > 0 {
+		for _, e := range m.Operands {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	l = len(m.SubjectNumber)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.CourseNumber)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CrossListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubjectNumber)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.CourseNumber)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.SectionNumber)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InstructorProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.TopicName)
+	n += 1 + l + sovModel(uint64(l))
+	if len(m.Teaching) > 0 {
+		for _, e := range m.Teaching {
+			l = e.Size()
+			n += 1 + l + sovModel(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Teaching) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Season)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Year)
+	n += 1 + l + sovModel(uint64(l))
+	if m.Course != nil {
+		l = m.Course.Size()
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Building) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovModel(uint64(m.Id))
+	n += 1 + sovModel(uint64(m.UniversityId))
+	l = len(m.Code)
+	n += 1 + l + sovModel(uint64(l))
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Campus != nil {
+		l = len(*m.Campus)
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.Latitude != nil {
+		n += 9
+	}
+	if m.Longitude != nil {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Envelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SchemaVersion)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Producer)
+	n += 1 + l + sovModel(uint64(l))
+	n += 1 + sovModel(uint64(m.ScrapedAt))
+	l = len(m.ContentHash)
+	n += 1 + l + sovModel(uint64(l))
+	l = len(m.Format)
+	n += 1 + l + sovModel(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovModel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModel(x uint64) (n int) {
+	return sovModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *University) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubjects := "[]*Subject{"
+	for _, f := range this.Subjects {
+		repeatedStringForSubjects += strings.Replace(f.String(), "Subject", "Subject", 1) + ","
+	}
+	repeatedStringForSubjects += "}"
+	repeatedStringForAvailableSemesters := "[]*Semester{"
+	for _, f := range this.AvailableSemesters {
+		repeatedStringForAvailableSemesters += strings.Replace(f.String(), "Semester", "Semester", 1) + ","
+	}
+	repeatedStringForAvailableSemesters += "}"
+	repeatedStringForRegistrations := "[]*Registration{"
+	for _, f := range this.Registrations {
+		repeatedStringForRegistrations += strings.Replace(f.String(), "Registration", "Registration", 1) + ","
+	}
+	repeatedStringForRegistrations += "}"
+	repeatedStringForMetadata := "[]*Metadata{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	repeatedStringForBuildings := "[]*Building{"
+	for _, f := range this.Buildings {
+		repeatedStringForBuildings += strings.Replace(f.String(), "Building", "Building", 1) + ","
+	}
+	repeatedStringForBuildings += "}"
+	s := strings.Join([]string{`&University{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Abbr:` + fmt.Sprintf("%v", this.Abbr) + `,`,
+		`HomePage:` + fmt.Sprintf("%v", this.HomePage) + `,`,
+		`RegistrationPage:` + fmt.Sprintf("%v", this.RegistrationPage) + `,`,
+		`MainColor:` + fmt.Sprintf("%v", this.MainColor) + `,`,
+		`AccentColor:` + fmt.Sprintf("%v", this.AccentColor) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
+		`ResolvedSemesters:` + strings.Replace(this.ResolvedSemesters.String(), "ResolvedSemester", "ResolvedSemester", 1) + `,`,
+		`Subjects:` + repeatedStringForSubjects + `,`,
+		`AvailableSemesters:` + repeatedStringForAvailableSemesters + `,`,
+		`Registrations:` + repeatedStringForRegistrations + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Buildings:` + repeatedStringForBuildings + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Subject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCourses := "[]*Course{"
+	for _, f := range this.Courses {
+		repeatedStringForCourses += strings.Replace(f.String(), "Course", "Course", 1) + ","
+	}
+	repeatedStringForCourses += "}"
+	repeatedStringForMetadata := "[]*Metadata{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&Subject{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`UniversityId:` + fmt.Sprintf("%v", this.UniversityId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`Season:` + fmt.Sprintf("%v", this.Season) + `,`,
+		`Year:` + fmt.Sprintf("%v", this.Year) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
+		`Courses:` + repeatedStringForCourses + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`TermCode:` + fmt.Sprintf("%v", this.TermCode) + `,`,
+		`TermName:` + fmt.Sprintf("%v", this.TermName) + `,`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Course) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSections := "[]*Section{"
+	for _, f := range this.Sections {
+		repeatedStringForSections += strings.Replace(f.String(), "Section", "Section", 1) + ","
+	}
+	repeatedStringForSections += "}"
+	repeatedStringForMetadata := "[]*Metadata{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&Course{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`SubjectId:` + fmt.Sprintf("%v", this.SubjectId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`Synopsis:` + valueToStringModel(this.Synopsis) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
+		`Sections:` + repeatedStringForSections + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`Prerequisite:` + strings.Replace(this.Prerequisite.String(), "Prerequisite", "Prerequisite", 1) + `,`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Section) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMeetings := "[]*Meeting{"
+	for _, f := range this.Meetings {
+		repeatedStringForMeetings += strings.Replace(f.String(), "Meeting", "Meeting", 1) + ","
+	}
+	repeatedStringForMeetings += "}"
+	repeatedStringForInstructors := "[]*Instructor{"
+	for _, f := range this.Instructors {
+		repeatedStringForInstructors += strings.Replace(f.String(), "Instructor", "Instructor", 1) + ","
+	}
+	repeatedStringForInstructors += "}"
+	repeatedStringForBooks := "[]*Book{"
+	for _, f := range this.Books {
+		repeatedStringForBooks += strings.Replace(f.String(), "Book", "Book", 1) + ","
+	}
+	repeatedStringForBooks += "}"
+	repeatedStringForMetadata := "[]*Metadata{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	repeatedStringForCrossListings := "[]*CrossListing{"
+	for _, f := range this.CrossListings {
+		repeatedStringForCrossListings += strings.Replace(f.String(), "CrossListing", "CrossListing", 1) + ","
+	}
+	repeatedStringForCrossListings += "}"
+	s := strings.Join([]string{`&Section{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CourseId:` + fmt.Sprintf("%v", this.CourseId) + `,`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`CallNumber:` + fmt.Sprintf("%v", this.CallNumber) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`Now:` + fmt.Sprintf("%v", this.Now) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Credits:` + fmt.Sprintf("%v", this.Credits) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`TopicId:` + fmt.Sprintf("%v", this.TopicId) + `,`,
+		`Meetings:` + repeatedStringForMeetings + `,`,
+		`Instructors:` + repeatedStringForInstructors + `,`,
+		`Books:` + repeatedStringForBooks + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`CrossListings:` + repeatedStringForCrossListings + `,`,
+		`WaitlistMax:` + valueToStringModel(this.WaitlistMax) + `,`,
+		`WaitlistNow:` + valueToStringModel(this.WaitlistNow) + `,`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Meeting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMetadata := "[]*Metadata{"
+	for _, f := range this.Metadata {
+		repeatedStringForMetadata += strings.Replace(f.String(), "Metadata", "Metadata", 1) + ","
+	}
+	repeatedStringForMetadata += "}"
+	s := strings.Join([]string{`&Meeting{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`SectionId:` + fmt.Sprintf("%v", this.SectionId) + `,`,
+		`Room:` + valueToStringModel(this.Room) + `,`,
+		`Day:` + valueToStringModel(this.Day) + `,`,
+		`StartTime:` + valueToStringModel(this.StartTime) + `,`,
+		`EndTime:` + valueToStringModel(this.EndTime) + `,`,
+		`ClassType:` + valueToStringModel(this.ClassType) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Metadata:` + repeatedStringForMetadata + `,`,
+		`Weekday:` + valueToStringModel(this.Weekday) + `,`,
+		`StartMinute:` + valueToStringModel(this.StartMinute) + `,`,
+		`Duration:` + valueToStringModel(this.Duration) + `,`,
+		`BuildingCode:` + valueToStringModel(this.BuildingCode) + `,`,
+		`RoomNumber:` + valueToStringModel(this.RoomNumber) + `,`,
+		`Building:` + strings.Replace(this.Building.String(), "Building", "Building", 1) + `,`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Instructor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Instructor{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`SectionId:` + fmt.Sprintf("%v", this.SectionId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Book) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Book{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`SectionId:` + fmt.Sprintf("%v", this.SectionId) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Url:` + fmt.Sprintf("%v", this.Url) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Metadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Metadata{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`UniversityId:` + valueToStringModel(this.UniversityId) + `,`,
+		`SubjectId:` + valueToStringModel(this.SubjectId) + `,`,
+		`CourseId:` + valueToStringModel(this.CourseId) + `,`,
+		`SectionId:` + valueToStringModel(this.SectionId) + `,`,
+		`MeetingId:` + valueToStringModel(this.MeetingId) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`Content:` + fmt.Sprintf("%v", this.Content) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Registration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Registration{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`UniversityId:` + fmt.Sprintf("%v", this.UniversityId) + `,`,
+		`Period:` + fmt.Sprintf("%v", this.Period) + `,`,
+		`PeriodDate:` + fmt.Sprintf("%v", this.PeriodDate) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResolvedSemester) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResolvedSemester{`,
+		`Current:` + strings.Replace(this.Current.String(), "Semester", "Semester", 1) + `,`,
+		`Last:` + strings.Replace(this.Last.String(), "Semester", "Semester", 1) + `,`,
+		`Next:` + strings.Replace(this.Next.String(), "Semester", "Semester", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Semester) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Semester{`,
+		`Year:` + fmt.Sprintf("%v", this.Year) + `,`,
+		`Season:` + fmt.Sprintf("%v", this.Season) + `,`,
+		`TermCode:` + fmt.Sprintf("%v", this.TermCode) + `,`,
+		`TermName:` + fmt.Sprintf("%v", this.TermName) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UCTNotification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UCTNotification{`,
+		`NotificationId:` + fmt.Sprintf("%v", this.NotificationId) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`University:` + strings.Replace(strings.Replace(this.University.String(), "University", "University", 1), `&`, ``, 1) + `,`,
+		`CrossListedTopicNames:` + fmt.Sprintf("%v", this.CrossListedTopicNames) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Response) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Response{`,
+		`Meta:` + strings.Replace(this.Meta.String(), "Meta", "Meta", 1) + `,`,
+		`Data:` + strings.Replace(this.Data.String(), "Data", "Data", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Meta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Meta{`,
+		`Code:` + valueToStringModel(this.Code) + `,`,
+		`Message:` + valueToStringModel(this.Message) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Data) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUniversities := "[]*University{"
+	for _, f := range this.Universities {
+		repeatedStringForUniversities += strings.Replace(f.String(), "University", "University", 1) + ","
+	}
+	repeatedStringForUniversities += "}"
+	repeatedStringForSubjects := "[]*Subject{"
+	for _, f := range this.Subjects {
+		repeatedStringForSubjects += strings.Replace(f.String(), "Subject", "Subject", 1) + ","
+	}
+	repeatedStringForSubjects += "}"
+	repeatedStringForCourses := "[]*Course{"
+	for _, f := range this.Courses {
+		repeatedStringForCourses += strings.Replace(f.String(), "Course", "Course", 1) + ","
+	}
+	repeatedStringForCourses += "}"
+	repeatedStringForSections := "[]*Section{"
+	for _, f := range this.Sections {
+		repeatedStringForSections += strings.Replace(f.String(), "Section", "Section", 1) + ","
+	}
+	repeatedStringForSections += "}"
+	repeatedStringForSubscriptionView := "[]*SubscriptionView{"
+	for _, f := range this.SubscriptionView {
+		repeatedStringForSubscriptionView += strings.Replace(f.String(), "SubscriptionView", "SubscriptionView", 1) + ","
+	}
+	repeatedStringForSubscriptionView += "}"
+	repeatedStringForSearchResults := "[]*SearchResult{"
+	for _, f := range this.SearchResults {
+		repeatedStringForSearchResults += strings.Replace(f.String(), "SearchResult", "SearchResult", 1) + ","
+	}
+	repeatedStringForSearchResults += "}"
+	repeatedStringForSchedules := "[]*Schedule{"
+	for _, f := range this.Schedules {
+		repeatedStringForSchedules += strings.Replace(f.String(), "Schedule", "Schedule", 1) + ","
+	}
+	repeatedStringForSchedules += "}"
+	repeatedStringForBuildings := "[]*Building{"
+	for _, f := range this.Buildings {
+		repeatedStringForBuildings += strings.Replace(f.String(), "Building", "Building", 1) + ","
+	}
+	repeatedStringForBuildings += "}"
+	s := strings.Join([]string{`&Data{`,
+		`Universities:` + repeatedStringForUniversities + `,`,
+		`Subjects:` + repeatedStringForSubjects + `,`,
+		`Courses:` + repeatedStringForCourses + `,`,
+		`Sections:` + repeatedStringForSections + `,`,
+		`University:` + strings.Replace(this.University.String(), "University", "University", 1) + `,`,
+		`Subject:` + strings.Replace(this.Subject.String(), "Subject", "Subject", 1) + `,`,
+		`Course:` + strings.Replace(this.Course.String(), "Course", "Course", 1) + `,`,
+		`Section:` + strings.Replace(this.Section.String(), "Section", "Section", 1) + `,`,
+		`SubscriptionView:` + repeatedStringForSubscriptionView + `,`,
+		`SectionHistory:` + strings.Replace(this.SectionHistory.String(), "SectionHistory", "SectionHistory", 1) + `,`,
+		`SearchResults:` + repeatedStringForSearchResults + `,`,
+		`Page:` + strings.Replace(this.Page.String(), "Page", "Page", 1) + `,`,
+		`Schedules:` + repeatedStringForSchedules + `,`,
+		`Prerequisite:` + strings.Replace(this.Prerequisite.String(), "Prerequisite", "Prerequisite", 1) + `,`,
+		`InstructorProfile:` + strings.Replace(this.InstructorProfile.String(), "InstructorProfile", "InstructorProfile", 1) + `,`,
+		`Buildings:` + repeatedStringForBuildings + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Subscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Subscription{`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`Os:` + fmt.Sprintf("%v", this.Os) + `,`,
 		`IsSubscribed:` + fmt.Sprintf("%v", this.IsSubscribed) + `,`,
 		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
-		`FcmToken:` + fmt.Sprintf("%v", this.FcmToken) + `,`,
-		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`FcmToken:` + fmt.Sprintf("%v", this.FcmToken) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubscriptionView) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubscriptionView{`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`Subscribers:` + fmt.Sprintf("%v", this.Subscribers) + `,`,
+		`IsHot:` + fmt.Sprintf("%v", this.IsHot) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SectionHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSnapshots := "[]*SectionSnapshot{"
+	for _, f := range this.Snapshots {
+		repeatedStringForSnapshots += strings.Replace(f.String(), "SectionSnapshot", "SectionSnapshot", 1) + ","
+	}
+	repeatedStringForSnapshots += "}"
+	s := strings.Join([]string{`&SectionHistory{`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`Snapshots:` + repeatedStringForSnapshots + `,`,
+		`Stats:` + strings.Replace(this.Stats.String(), "SectionHistoryStats", "SectionHistoryStats", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SectionSnapshot) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SectionSnapshot{`,
+		`Now:` + fmt.Sprintf("%v", this.Now) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SectionHistoryStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SectionHistoryStats{`,
+		`TimeToFill:` + fmt.Sprintf("%v", this.TimeToFill) + `,`,
+		`Reopenings:` + fmt.Sprintf("%v", this.Reopenings) + `,`,
+		`TypicalOpenHour:` + fmt.Sprintf("%v", this.TypicalOpenHour) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchResult{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Rank:` + fmt.Sprintf("%v", this.Rank) + `,`,
+		`Course:` + strings.Replace(this.Course.String(), "Course", "Course", 1) + `,`,
+		`Section:` + strings.Replace(this.Section.String(), "Section", "Section", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Page) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Page{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Schedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSections := "[]*Section{"
+	for _, f := range this.Sections {
+		repeatedStringForSections += strings.Replace(f.String(), "Section", "Section", 1) + ","
+	}
+	repeatedStringForSections += "}"
+	s := strings.Join([]string{`&Schedule{`,
+		`Sections:` + repeatedStringForSections + `,`,
+		`Days:` + fmt.Sprintf("%v", this.Days) + `,`,
+		`GapMinutes:` + fmt.Sprintf("%v", this.GapMinutes) + `,`,
+		`EarliestStart:` + fmt.Sprintf("%v", this.EarliestStart) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Prerequisite) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOperands := "[]*Prerequisite{"
+	for _, f := range this.Operands {
+		repeatedStringForOperands += strings.Replace(f.String(), "Prerequisite", "Prerequisite", 1) + ","
+	}
+	repeatedStringForOperands += "}"
+	s := strings.Join([]string{`&Prerequisite{`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Operands:` + repeatedStringForOperands + `,`,
+		`SubjectNumber:` + fmt.Sprintf("%v", this.SubjectNumber) + `,`,
+		`CourseNumber:` + fmt.Sprintf("%v", this.CourseNumber) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CrossListing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CrossListing{`,
+		`SubjectNumber:` + fmt.Sprintf("%v", this.SubjectNumber) + `,`,
+		`CourseNumber:` + fmt.Sprintf("%v", this.CourseNumber) + `,`,
+		`SectionNumber:` + fmt.Sprintf("%v", this.SectionNumber) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InstructorProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTeaching := "[]*Teaching{"
+	for _, f := range this.Teaching {
+		repeatedStringForTeaching += strings.Replace(f.String(), "Teaching", "Teaching", 1) + ","
+	}
+	repeatedStringForTeaching += "}"
+	s := strings.Join([]string{`&InstructorProfile{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
+		`Teaching:` + repeatedStringForTeaching + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Teaching) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Teaching{`,
+		`Season:` + fmt.Sprintf("%v", this.Season) + `,`,
+		`Year:` + fmt.Sprintf("%v", this.Year) + `,`,
+		`Course:` + strings.Replace(this.Course.String(), "Course", "Course", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Building) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Building{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`UniversityId:` + fmt.Sprintf("%v", this.UniversityId) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Name:` + valueToStringModel(this.Name) + `,`,
+		`Campus:` + valueToStringModel(this.Campus) + `,`,
+		`Latitude:` + valueToStringModel(this.Latitude) + `,`,
+		`Longitude:` + valueToStringModel(this.Longitude) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Envelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Envelope{`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
+		`Producer:` + fmt.Sprintf("%v", this.Producer) + `,`,
+		`ScrapedAt:` + fmt.Sprintf("%v", this.ScrapedAt) + `,`,
+		`ContentHash:` + fmt.Sprintf("%v", this.ContentHash) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SubscriptionView) String() string {
-	if this == nil {
-		return "nil"
+func valueToStringModel(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *University) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: University: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: University: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abbr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abbr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomePage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HomePage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationPage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MainColor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MainColor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccentColor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccentColor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedSemesters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolvedSemesters == nil {
+				m.ResolvedSemesters = &ResolvedSemester{}
+			}
+			if err := m.ResolvedSemesters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, &Subject{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableSemesters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableSemesters = append(m.AvailableSemesters, &Semester{})
+			if err := m.AvailableSemesters[len(m.AvailableSemesters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrations = append(m.Registrations, &Registration{})
+			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buildings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buildings = append(m.Buildings, &Building{})
+			if err := m.Buildings[len(m.Buildings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniversityId", wireType)
+			}
+			m.UniversityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniversityId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Season = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Year = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Courses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Courses = append(m.Courses, &Course{})
+			if err := m.Courses[len(m.Courses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermCode", wireType)
+			}
+			m.TermCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Course) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Course: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Course: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
+			}
+			m.SubjectId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubjectId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synopsis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Synopsis = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sections = append(m.Sections, &Section{})
+			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prerequisite", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prerequisite == nil {
+				m.Prerequisite = &Prerequisite{}
+			}
+			if err := m.Prerequisite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Section) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Section: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Section: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+			}
+			m.CourseId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CourseId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Now", wireType)
+			}
+			m.Now = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Now |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meetings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meetings = append(m.Meetings, &Meeting{})
+			if err := m.Meetings[len(m.Meetings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instructors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instructors = append(m.Instructors, &Instructor{})
+			if err := m.Instructors[len(m.Instructors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Books", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Books = append(m.Books, &Book{})
+			if err := m.Books[len(m.Books)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossListings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossListings = append(m.CrossListings, &CrossListing{})
+			if err := m.CrossListings[len(m.CrossListings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitlistMax", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitlistMax = &v
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitlistNow", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitlistNow = &v
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Meeting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Meeting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Meeting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Room", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Room = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Day = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.StartTime = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.EndTime = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ClassType = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, &Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekday", wireType)
+			}
+			var v Weekday
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= Weekday(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weekday = &v
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMinute", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartMinute = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Duration = &v
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildingCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.BuildingCode = &s
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoomNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RoomNumber = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Building", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Building == nil {
+				m.Building = &Building{}
+			}
+			if err := m.Building.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Instructor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Instructor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Instructor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Book) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Book: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Book: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			}
+			m.SectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SectionId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&SubscriptionView{`,
-		`TopicName:` + fmt.Sprintf("%v", this.TopicName) + `,`,
-		`Subscribers:` + fmt.Sprintf("%v", this.Subscribers) + `,`,
-		`IsHot:` + fmt.Sprintf("%v", this.IsHot) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringModel(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *University) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6656,10 +14218,10 @@ func (m *University) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: University: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: University: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6682,10 +14244,10 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniversityId", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6695,29 +14257,17 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.UniversityId = &v
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abbr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6727,29 +14277,17 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Abbr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SubjectId = &v
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomePage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6759,29 +14297,17 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HomePage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.CourseId = &v
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6791,29 +14317,17 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RegistrationPage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SectionId = &v
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainColor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeetingId", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6823,27 +14337,15 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MainColor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MeetingId = &v
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccentColor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6871,11 +14373,11 @@ func (m *University) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccentColor = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6903,13 +14405,67 @@ func (m *University) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Registration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Registration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Registration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6919,29 +14475,35 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniversityId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UniversityId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UniversityId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.TopicId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedSemesters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6951,33 +14513,29 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ResolvedSemesters == nil {
-				m.ResolvedSemesters = &ResolvedSemester{}
+				return ErrInvalidLengthModel
 			}
-			if err := m.ResolvedSemesters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Period = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodDate", wireType)
 			}
-			var msglen int
+			m.PeriodDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -6987,29 +14545,68 @@ func (m *University) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PeriodDate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthModel
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subjects = append(m.Subjects, &Subject{})
-			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolvedSemester) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
 			}
-			iNdEx = postIndex
-		case 12:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolvedSemester: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolvedSemester: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableSemesters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7036,14 +14633,16 @@ func (m *University) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailableSemesters = append(m.AvailableSemesters, &Semester{})
-			if err := m.AvailableSemesters[len(m.AvailableSemesters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Current == nil {
+				m.Current = &Semester{}
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7070,14 +14669,16 @@ func (m *University) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Registrations = append(m.Registrations, &Registration{})
-			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Last == nil {
+				m.Last = &Semester{}
+			}
+			if err := m.Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7104,8 +14705,10 @@ func (m *University) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, &Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Next == nil {
+				m.Next = &Semester{}
+			}
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7134,7 +14737,7 @@ func (m *University) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Subject) Unmarshal(dAtA []byte) error {
+func (m *Semester) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7157,17 +14760,17 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Subject: wiretype end group for non-group")
+			return fmt.Errorf("proto: Semester: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Semester: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
 			}
-			m.Id = 0
+			m.Year = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7177,16 +14780,16 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.Year |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniversityId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
 			}
-			m.UniversityId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7196,14 +14799,46 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UniversityId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Season = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermCode", wireType)
+			}
+			m.TermCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TermCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7231,11 +14866,84 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TermName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UCTNotification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UCTNotification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UCTNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationId", wireType)
+			}
+			m.NotificationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotificationId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7263,11 +14971,11 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Number = string(dAtA[iNdEx:postIndex])
+			m.TopicName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7295,13 +15003,13 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Season = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field University", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7311,27 +15019,28 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Year = string(dAtA[iNdEx:postIndex])
+			if err := m.University.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossListedTopicNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7359,11 +15068,11 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			m.CrossListedTopicNames = append(m.CrossListedTopicNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7391,11 +15100,65 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicId = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Courses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7422,14 +15185,16 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Courses = append(m.Courses, &Course{})
-			if err := m.Courses[len(m.Courses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Meta == nil {
+				m.Meta = &Meta{}
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7456,8 +15221,10 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, &Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Data == nil {
+				m.Data = &Data{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7486,7 +15253,7 @@ func (m *Subject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Course) Unmarshal(dAtA []byte) error {
+func (m *Meta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7509,17 +15276,17 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Course: wiretype end group for non-group")
+			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Course: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Id = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7529,33 +15296,15 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Code = &v
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
-			}
-			m.SubjectId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubjectId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7583,78 +15332,68 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Message = &s
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthModel
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Number = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synopsis", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Data) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Synopsis = &s
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Universities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7664,29 +15403,31 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			m.Universities = append(m.Universities, &University{})
+			if err := m.Universities[len(m.Universities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7696,27 +15437,29 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicId = string(dAtA[iNdEx:postIndex])
+			m.Subjects = append(m.Subjects, &Subject{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Courses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7743,14 +15486,14 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sections = append(m.Sections, &Section{})
-			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Courses = append(m.Courses, &Course{})
+			if err := m.Courses[len(m.Courses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7777,70 +15520,16 @@ func (m *Course) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, &Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sections = append(m.Sections, &Section{})
+			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModel(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthModel
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthModel
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Section) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModel
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Section: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Section: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field University", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7850,35 +15539,33 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModel
 			}
-			m.CourseId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CourseId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.University == nil {
+				m.University = &University{}
+			}
+			if err := m.University.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7888,29 +15575,33 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Number = string(dAtA[iNdEx:postIndex])
+			if m.Subject == nil {
+				m.Subject = &Subject{}
+			}
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7920,29 +15611,33 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallNumber = string(dAtA[iNdEx:postIndex])
+			if m.Course == nil {
+				m.Course = &Course{}
+			}
+			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
 			}
-			m.Max = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7952,35 +15647,33 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Max |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Now", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModel
 			}
-			m.Now = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Now |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Section == nil {
+				m.Section = &Section{}
+			}
+			if err := m.Section.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionView", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -7990,29 +15683,31 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.SubscriptionView = append(m.SubscriptionView, &SubscriptionView{})
+			if err := m.SubscriptionView[len(m.SubscriptionView)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionHistory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8022,29 +15717,33 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credits = string(dAtA[iNdEx:postIndex])
+			if m.SectionHistory == nil {
+				m.SectionHistory = &SectionHistory{}
+			}
+			if err := m.SectionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchResults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8054,29 +15753,31 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			m.SearchResults = append(m.SearchResults, &SearchResult{})
+			if err := m.SearchResults[len(m.SearchResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8086,27 +15787,31 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicId = string(dAtA[iNdEx:postIndex])
+			if m.Page == nil {
+				m.Page = &Page{}
+			}
+			if err := m.Page.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meetings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8133,14 +15838,14 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meetings = append(m.Meetings, &Meeting{})
-			if err := m.Meetings[len(m.Meetings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, &Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instructors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prerequisite", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8167,14 +15872,16 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instructors = append(m.Instructors, &Instructor{})
-			if err := m.Instructors[len(m.Instructors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Prerequisite == nil {
+				m.Prerequisite = &Prerequisite{}
+			}
+			if err := m.Prerequisite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Books", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstructorProfile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8201,14 +15908,16 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Books = append(m.Books, &Book{})
-			if err := m.Books[len(m.Books)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InstructorProfile == nil {
+				m.InstructorProfile = &InstructorProfile{}
+			}
+			if err := m.InstructorProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buildings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8235,8 +15944,8 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, &Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buildings = append(m.Buildings, &Building{})
+			if err := m.Buildings[len(m.Buildings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8265,7 +15974,7 @@ func (m *Section) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Meeting) Unmarshal(dAtA []byte) error {
+func (m *Subscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8288,53 +15997,15 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Meeting: wiretype end group for non-group")
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Meeting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
-			}
-			m.SectionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Room", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8362,12 +16033,11 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Room = &s
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8395,12 +16065,11 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Day = &s
+			m.Os = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSubscribed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8428,12 +16097,11 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.StartTime = &s
+			m.IsSubscribed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8461,12 +16129,11 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.EndTime = &s
+			m.TopicName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FcmToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8494,33 +16161,13 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ClassType = &s
+			m.FcmToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8530,25 +16177,23 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, &Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8575,7 +16220,7 @@ func (m *Meeting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Instructor) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionView) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8598,17 +16243,17 @@ func (m *Instructor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Instructor: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionView: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Instructor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionView: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8618,16 +16263,29 @@ func (m *Instructor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribers", wireType)
 			}
-			m.SectionId = 0
+			m.Subscribers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8637,48 +16295,16 @@ func (m *Instructor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SectionId |= int64(b&0x7F) << shift
+				m.Subscribers |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHot", wireType)
 			}
-			m.Index = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8688,11 +16314,12 @@ func (m *Instructor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsHot = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -8718,7 +16345,7 @@ func (m *Instructor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Book) Unmarshal(dAtA []byte) error {
+func (m *SectionHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8741,17 +16368,17 @@ func (m *Book) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Book: wiretype end group for non-group")
+			return fmt.Errorf("proto: SectionHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Book: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SectionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8761,35 +16388,29 @@ func (m *Book) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
 			}
-			m.SectionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SectionId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8799,29 +16420,31 @@ func (m *Book) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Snapshots = append(m.Snapshots, &SectionSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8831,23 +16454,27 @@ func (m *Book) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			if m.Stats == nil {
+				m.Stats = &SectionHistoryStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8874,7 +16501,7 @@ func (m *Book) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *SectionSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8897,17 +16524,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: SectionSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SectionSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Now", wireType)
 			}
-			m.Id = 0
+			m.Now = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8917,16 +16544,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.Now |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniversityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			var v int64
+			m.Max = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -8936,95 +16563,14 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.Max |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UniversityId = &v
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubjectId", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SubjectId = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CourseId", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CourseId = &v
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SectionId", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SectionId = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeetingId", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MeetingId = &v
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9052,13 +16598,13 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9068,24 +16614,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Content = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -9111,7 +16644,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Registration) Unmarshal(dAtA []byte) error {
+func (m *SectionHistoryStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9134,17 +16667,17 @@ func (m *Registration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Registration: wiretype end group for non-group")
+			return fmt.Errorf("proto: SectionHistoryStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Registration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SectionHistoryStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToFill", wireType)
 			}
-			m.Id = 0
+			m.TimeToFill = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9154,16 +16687,16 @@ func (m *Registration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.TimeToFill |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniversityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reopenings", wireType)
 			}
-			m.UniversityId = 0
+			m.Reopenings = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9173,48 +16706,16 @@ func (m *Registration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UniversityId |= int64(b&0x7F) << shift
+				m.Reopenings |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Period = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypicalOpenHour", wireType)
 			}
-			m.PeriodDate = 0
+			m.TypicalOpenHour = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9224,7 +16725,7 @@ func (m *Registration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeriodDate |= int64(b&0x7F) << shift
+				m.TypicalOpenHour |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9254,7 +16755,7 @@ func (m *Registration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResolvedSemester) Unmarshal(dAtA []byte) error {
+func (m *SearchResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9264,30 +16765,94 @@ func (m *ResolvedSemester) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowModel
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResolvedSemester: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResolvedSemester: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9297,31 +16862,38 @@ func (m *ResolvedSemester) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Current == nil {
-				m.Current = &Semester{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
 			}
-			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rank = float64(math.Float64frombits(v))
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9348,16 +16920,16 @@ func (m *ResolvedSemester) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Last == nil {
-				m.Last = &Semester{}
+			if m.Course == nil {
+				m.Course = &Course{}
 			}
-			if err := m.Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9384,10 +16956,10 @@ func (m *ResolvedSemester) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Next == nil {
-				m.Next = &Semester{}
+			if m.Section == nil {
+				m.Section = &Section{}
 			}
-			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Section.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9416,7 +16988,7 @@ func (m *ResolvedSemester) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Semester) Unmarshal(dAtA []byte) error {
+func (m *Page) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9439,17 +17011,17 @@ func (m *Semester) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Semester: wiretype end group for non-group")
+			return fmt.Errorf("proto: Page: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Semester: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Page: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.Year = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9459,16 +17031,16 @@ func (m *Semester) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Year |= int32(b&0x7F) << shift
+				m.Offset |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9478,24 +17050,30 @@ func (m *Semester) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Season = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -9521,7 +17099,7 @@ func (m *Semester) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UCTNotification) Unmarshal(dAtA []byte) error {
+func (m *Schedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9544,17 +17122,17 @@ func (m *UCTNotification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UCTNotification: wiretype end group for non-group")
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UCTNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotificationId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
 			}
-			m.NotificationId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9564,16 +17142,31 @@ func (m *UCTNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NotificationId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sections = append(m.Sections, &Section{})
+			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
 			}
-			var stringLen uint64
+			m.Days = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9583,29 +17176,16 @@ func (m *UCTNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Days |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GapMinutes", wireType)
 			}
-			var stringLen uint64
+			m.GapMinutes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9615,29 +17195,16 @@ func (m *UCTNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GapMinutes |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field University", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestStart", wireType)
 			}
-			var msglen int
+			m.EarliestStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9647,25 +17214,11 @@ func (m *UCTNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EarliestStart |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.University.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -9691,7 +17244,7 @@ func (m *UCTNotification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *Prerequisite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9714,17 +17267,17 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: Prerequisite: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Prerequisite: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9734,31 +17287,27 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Meta == nil {
-				m.Meta = &Meta{}
-			}
-			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operands", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9785,72 +17334,48 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &Data{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operands = append(m.Operands, &Prerequisite{})
+			if err := m.Operands[len(m.Operands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModel(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthModel
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthModel
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectNumber", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Meta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModel
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
+			m.SubjectNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseNumber", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9860,15 +17385,27 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Code = &v
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CourseNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9896,8 +17433,7 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Message = &s
+			m.TopicName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9924,7 +17460,7 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Data) Unmarshal(dAtA []byte) error {
+func (m *CrossListing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9947,17 +17483,17 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrossListing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrossListing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Universities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectNumber", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -9967,31 +17503,29 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Universities = append(m.Universities, &University{})
-			if err := m.Universities[len(m.Universities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubjectNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CourseNumber", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10001,31 +17535,29 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subjects = append(m.Subjects, &Subject{})
-			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CourseNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Courses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SectionNumber", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10035,31 +17567,29 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Courses = append(m.Courses, &Course{})
-			if err := m.Courses[len(m.Courses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SectionNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10069,31 +17599,83 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sections = append(m.Sections, &Section{})
-			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstructorProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstructorProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstructorProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field University", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10103,31 +17685,59 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.University == nil {
-				m.University = &University{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
 			}
-			if err := m.University.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TopicName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Teaching", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10154,18 +17764,70 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Subject == nil {
-				m.Subject = &Subject{}
+			m.Teaching = append(m.Teaching, &Teaching{})
+			if err := m.Teaching[len(m.Teaching)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Teaching) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Teaching: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Teaching: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Season", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10175,33 +17837,29 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Course == nil {
-				m.Course = &Course{}
-			}
-			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Season = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Section", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10211,31 +17869,27 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModel
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModel
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Section == nil {
-				m.Section = &Section{}
-			}
-			if err := m.Section.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Year = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionView", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Course", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10262,8 +17916,10 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubscriptionView = append(m.SubscriptionView, &SubscriptionView{})
-			if err := m.SubscriptionView[len(m.SubscriptionView)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Course == nil {
+				m.Course = &Course{}
+			}
+			if err := m.Course.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10292,7 +17948,7 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Subscription) Unmarshal(dAtA []byte) error {
+func (m *Building) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10315,17 +17971,17 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: Building: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Building: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10335,29 +17991,16 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniversityId", wireType)
 			}
-			var stringLen uint64
+			m.UniversityId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10367,27 +18010,14 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UniversityId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Os = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSubscribed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10415,11 +18045,11 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsSubscribed = string(dAtA[iNdEx:postIndex])
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10447,11 +18077,12 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FcmToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Campus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10479,40 +18110,33 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FcmToken = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Campus = &s
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModel
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModel
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModel
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Latitude = &v2
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.Longitude = &v2
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -10538,7 +18162,7 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionView) Unmarshal(dAtA []byte) error {
+func (m *Envelope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10561,15 +18185,15 @@ func (m *SubscriptionView) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionView: wiretype end group for non-group")
+			return fmt.Errorf("proto: Envelope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionView: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Envelope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10597,13 +18221,13 @@ func (m *SubscriptionView) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicName = string(dAtA[iNdEx:postIndex])
+			m.SchemaVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscribers", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Producer", wireType)
 			}
-			m.Subscribers = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10613,16 +18237,29 @@ func (m *SubscriptionView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Subscribers |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Producer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsHot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScrapedAt", wireType)
 			}
-			var v int
+			m.ScrapedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModel
@@ -10632,12 +18269,75 @@ func (m *SubscriptionView) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ScrapedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsHot = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModel(dAtA[iNdEx:])
@@ -10666,6 +18366,7 @@ func (m *SubscriptionView) Unmarshal(dAtA []byte) error {
 func skipModel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
+	depth := 0
 	for iNdEx < l {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -10697,10 +18398,8 @@ func skipModel(dAtA []byte) (n int, err error) {
 					break
 				}
 			}
-			return iNdEx, nil
 		case 1:
 			iNdEx += 8
-			return iNdEx, nil
 		case 2:
 			var length int
 			for shift := uint(0); ; shift += 7 {
@@ -10721,55 +18420,30 @@ func skipModel(dAtA []byte) (n int, err error) {
 				return 0, ErrInvalidLengthModel
 			}
 			iNdEx += length
-			if iNdEx < 0 {
-				return 0, ErrInvalidLengthModel
-			}
-			return iNdEx, nil
 		case 3:
-			for {
-				var innerWire uint64
-				var start int = iNdEx
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return 0, ErrIntOverflowModel
-					}
-					if iNdEx >= l {
-						return 0, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					innerWire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				innerWireType := int(innerWire & 0x7)
-				if innerWireType == 4 {
-					break
-				}
-				next, err := skipModel(dAtA[start:])
-				if err != nil {
-					return 0, err
-				}
-				iNdEx = start + next
-				if iNdEx < 0 {
-					return 0, ErrInvalidLengthModel
-				}
-			}
-			return iNdEx, nil
+			depth++
 		case 4:
-			return iNdEx, nil
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModel
+			}
+			depth--
 		case 5:
 			iNdEx += 4
-			return iNdEx, nil
 		default:
 			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
 		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModel
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	panic("unreachable")
+	return 0, io.ErrUnexpectedEOF
 }
 
 var (
-	ErrInvalidLengthModel = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflowModel   = fmt.Errorf("proto: integer overflow")
+	ErrInvalidLengthModel        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModel          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModel = fmt.Errorf("proto: unexpected end of group")
 )
@@ -255,7 +255,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Course) MarshalJSON() ([]byte, error) {
+func (j *Building) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -269,7 +269,7 @@ func (j *Course) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Course) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Building) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -278,82 +278,36 @@ func (j *Course) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ "name":`)
-	fflib.WriteJsonString(buf, string(j.Name))
-	buf.WriteString(`,"number":`)
-	fflib.WriteJsonString(buf, string(j.Number))
+	buf.WriteString(`{ "code":`)
+	fflib.WriteJsonString(buf, string(j.Code))
 	buf.WriteByte(',')
-	if j.Synopsis != nil {
+	if j.Name != nil {
 		if true {
-			buf.WriteString(`"synopsis":`)
-			fflib.WriteJsonString(buf, string(*j.Synopsis))
+			buf.WriteString(`"name":`)
+			fflib.WriteJsonString(buf, string(*j.Name))
 			buf.WriteByte(',')
 		}
 	}
-	buf.WriteString(`"topic_name":`)
-	fflib.WriteJsonString(buf, string(j.TopicName))
-	buf.WriteString(`,"topic_id":`)
-	fflib.WriteJsonString(buf, string(j.TopicId))
-	buf.WriteByte(',')
-	if len(j.Sections) != 0 {
-		buf.WriteString(`"sections":`)
-		if j.Sections != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Sections {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
+	if j.Campus != nil {
+		if true {
+			buf.WriteString(`"campus":`)
+			fflib.WriteJsonString(buf, string(*j.Campus))
+			buf.WriteByte(',')
 		}
-		buf.WriteByte(',')
 	}
-	if len(j.Metadata) != 0 {
-		buf.WriteString(`"metadata":`)
-		if j.Metadata != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Metadata {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
+	if j.Latitude != nil {
+		if true {
+			buf.WriteString(`"latitude":`)
+			fflib.AppendFloat(buf, float64(*j.Latitude), 'g', -1, 64)
+			buf.WriteByte(',')
+		}
+	}
+	if j.Longitude != nil {
+		if true {
+			buf.WriteString(`"longitude":`)
+			fflib.AppendFloat(buf, float64(*j.Longitude), 'g', -1, 64)
+			buf.WriteByte(',')
 		}
-		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
@@ -361,48 +315,40 @@ func (j *Course) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtCoursebase = iota
-	ffjtCoursenosuchkey
+	ffjtBuildingbase = iota
+	ffjtBuildingnosuchkey
 
-	ffjtCourseName
-
-	ffjtCourseNumber
-
-	ffjtCourseSynopsis
+	ffjtBuildingCode
 
-	ffjtCourseTopicName
+	ffjtBuildingName
 
-	ffjtCourseTopicId
+	ffjtBuildingCampus
 
-	ffjtCourseSections
+	ffjtBuildingLatitude
 
-	ffjtCourseMetadata
+	ffjtBuildingLongitude
 )
 
-var ffjKeyCourseName = []byte("name")
-
-var ffjKeyCourseNumber = []byte("number")
-
-var ffjKeyCourseSynopsis = []byte("synopsis")
+var ffjKeyBuildingCode = []byte("code")
 
-var ffjKeyCourseTopicName = []byte("topic_name")
+var ffjKeyBuildingName = []byte("name")
 
-var ffjKeyCourseTopicId = []byte("topic_id")
+var ffjKeyBuildingCampus = []byte("campus")
 
-var ffjKeyCourseSections = []byte("sections")
+var ffjKeyBuildingLatitude = []byte("latitude")
 
-var ffjKeyCourseMetadata = []byte("metadata")
+var ffjKeyBuildingLongitude = []byte("longitude")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Course) UnmarshalJSON(input []byte) error {
+func (j *Building) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Course) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Building) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtCoursebase
+	currentKey := ffjtBuildingbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -448,104 +394,79 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtCoursenosuchkey
+				currentKey = ffjtBuildingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'm':
-
-					if bytes.Equal(ffjKeyCourseMetadata, kn) {
-						currentKey = ffjtCourseMetadata
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'n':
+				case 'c':
 
-					if bytes.Equal(ffjKeyCourseName, kn) {
-						currentKey = ffjtCourseName
+					if bytes.Equal(ffjKeyBuildingCode, kn) {
+						currentKey = ffjtBuildingCode
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyCourseNumber, kn) {
-						currentKey = ffjtCourseNumber
+					} else if bytes.Equal(ffjKeyBuildingCampus, kn) {
+						currentKey = ffjtBuildingCampus
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 's':
+				case 'l':
 
-					if bytes.Equal(ffjKeyCourseSynopsis, kn) {
-						currentKey = ffjtCourseSynopsis
+					if bytes.Equal(ffjKeyBuildingLatitude, kn) {
+						currentKey = ffjtBuildingLatitude
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyCourseSections, kn) {
-						currentKey = ffjtCourseSections
+					} else if bytes.Equal(ffjKeyBuildingLongitude, kn) {
+						currentKey = ffjtBuildingLongitude
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 't':
-
-					if bytes.Equal(ffjKeyCourseTopicName, kn) {
-						currentKey = ffjtCourseTopicName
-						state = fflib.FFParse_want_colon
-						goto mainparse
+				case 'n':
 
-					} else if bytes.Equal(ffjKeyCourseTopicId, kn) {
-						currentKey = ffjtCourseTopicId
+					if bytes.Equal(ffjKeyBuildingName, kn) {
+						currentKey = ffjtBuildingName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyCourseMetadata, kn) {
-					currentKey = ffjtCourseMetadata
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyCourseSections, kn) {
-					currentKey = ffjtCourseSections
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.AsciiEqualFold(ffjKeyCourseTopicId, kn) {
-					currentKey = ffjtCourseTopicId
+				if fflib.SimpleLetterEqualFold(ffjKeyBuildingLongitude, kn) {
+					currentKey = ffjtBuildingLongitude
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeyCourseTopicName, kn) {
-					currentKey = ffjtCourseTopicName
+				if fflib.SimpleLetterEqualFold(ffjKeyBuildingLatitude, kn) {
+					currentKey = ffjtBuildingLatitude
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyCourseSynopsis, kn) {
-					currentKey = ffjtCourseSynopsis
+				if fflib.EqualFoldRight(ffjKeyBuildingCampus, kn) {
+					currentKey = ffjtBuildingCampus
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyCourseNumber, kn) {
-					currentKey = ffjtCourseNumber
+				if fflib.SimpleLetterEqualFold(ffjKeyBuildingName, kn) {
+					currentKey = ffjtBuildingName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyCourseName, kn) {
-					currentKey = ffjtCourseName
+				if fflib.SimpleLetterEqualFold(ffjKeyBuildingCode, kn) {
+					currentKey = ffjtBuildingCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtCoursenosuchkey
+				currentKey = ffjtBuildingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -562,28 +483,22 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtCourseName:
-					goto handle_Name
-
-				case ffjtCourseNumber:
-					goto handle_Number
-
-				case ffjtCourseSynopsis:
-					goto handle_Synopsis
+				case ffjtBuildingCode:
+					goto handle_Code
 
-				case ffjtCourseTopicName:
-					goto handle_TopicName
+				case ffjtBuildingName:
+					goto handle_Name
 
-				case ffjtCourseTopicId:
-					goto handle_TopicId
+				case ffjtBuildingCampus:
+					goto handle_Campus
 
-				case ffjtCourseSections:
-					goto handle_Sections
+				case ffjtBuildingLatitude:
+					goto handle_Latitude
 
-				case ffjtCourseMetadata:
-					goto handle_Metadata
+				case ffjtBuildingLongitude:
+					goto handle_Longitude
 
-				case ffjtCoursenosuchkey:
+				case ffjtBuildingnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -597,9 +512,9 @@ mainparse:
 		}
 	}
 
-handle_Name:
+handle_Code:
 
-	/* handler: j.Name type=string kind=string quoted=false*/
+	/* handler: j.Code type=string kind=string quoted=false*/
 
 	{
 
@@ -615,7 +530,7 @@ handle_Name:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Name = string(string(outBuf))
+			j.Code = string(string(outBuf))
 
 		}
 	}
@@ -623,9 +538,9 @@ handle_Name:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Number:
+handle_Name:
 
-	/* handler: j.Number type=string kind=string quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
 
@@ -637,11 +552,15 @@ handle_Number:
 
 		if tok == fflib.FFTok_null {
 
+			j.Name = nil
+
 		} else {
 
+			var tval string
 			outBuf := fs.Output.Bytes()
 
-			j.Number = string(string(outBuf))
+			tval = string(string(outBuf))
+			j.Name = &tval
 
 		}
 	}
@@ -649,9 +568,9 @@ handle_Number:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Synopsis:
+handle_Campus:
 
-	/* handler: j.Synopsis type=string kind=string quoted=false*/
+	/* handler: j.Campus type=string kind=string quoted=false*/
 
 	{
 
@@ -663,7 +582,7 @@ handle_Synopsis:
 
 		if tok == fflib.FFTok_null {
 
-			j.Synopsis = nil
+			j.Campus = nil
 
 		} else {
 
@@ -671,7 +590,7 @@ handle_Synopsis:
 			outBuf := fs.Output.Bytes()
 
 			tval = string(string(outBuf))
-			j.Synopsis = &tval
+			j.Campus = &tval
 
 		}
 	}
@@ -679,25 +598,32 @@ handle_Synopsis:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TopicName:
+handle_Latitude:
 
-	/* handler: j.TopicName type=string kind=string quoted=false*/
+	/* handler: j.Latitude type=float64 kind=float64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
 		}
+	}
+
+	{
 
 		if tok == fflib.FFTok_null {
 
+			j.Latitude = nil
+
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
 
-			j.TopicName = string(string(outBuf))
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := float64(tval)
+			j.Latitude = &ttypval
 
 		}
 	}
@@ -705,25 +631,32 @@ handle_TopicName:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TopicId:
+handle_Longitude:
 
-	/* handler: j.TopicId type=string kind=string quoted=false*/
+	/* handler: j.Longitude type=float64 kind=float64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
 		}
+	}
+
+	{
 
 		if tok == fflib.FFTok_null {
 
+			j.Longitude = nil
+
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
 
-			j.TopicId = string(string(outBuf))
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := float64(tval)
+			j.Longitude = &ttypval
 
 		}
 	}
@@ -731,188 +664,40 @@ handle_TopicId:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Sections:
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
 
-	/* handler: j.Sections type=[]*model.Section kind=slice quoted=false*/
+	return nil
+}
 
-	{
-
-		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-			j.Sections = nil
-		} else {
-
-			j.Sections = []*Section{}
-
-			wantVal := true
-
-			for {
-
-				var tmpJSections *Section
-
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
-
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
-
-				/* handler: tmpJSections type=*model.Section kind=ptr quoted=false*/
-
-				{
-					if tok == fflib.FFTok_null {
-
-						tmpJSections = nil
-
-					} else {
-
-						if tmpJSections == nil {
-							tmpJSections = new(Section)
-						}
-
-						err = tmpJSections.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
-
-				j.Sections = append(j.Sections, tmpJSections)
-
-				wantVal = false
-			}
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Metadata:
-
-	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-			j.Metadata = nil
-		} else {
-
-			j.Metadata = []*Metadata{}
-
-			wantVal := true
-
-			for {
-
-				var tmpJMetadata *Metadata
-
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
-
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
-
-				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
-
-				{
-					if tok == fflib.FFTok_null {
-
-						tmpJMetadata = nil
-
-					} else {
-
-						if tmpJMetadata == nil {
-							tmpJMetadata = new(Metadata)
-						}
-
-						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
-
-				j.Metadata = append(j.Metadata, tmpJMetadata)
-
-				wantVal = false
-			}
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
-
-	return nil
-}
-
-// MarshalJSON marshal bytes to json - template
-func (j *Data) MarshalJSON() ([]byte, error) {
-	var buf fflib.Buffer
-	if j == nil {
-		buf.WriteString("null")
-		return buf.Bytes(), nil
-	}
-	err := j.MarshalJSONBuf(&buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
+// MarshalJSON marshal bytes to json - template
+func (j *Course) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Data) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Course) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -921,12 +706,28 @@ func (j *Data) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ `)
-	if len(j.Universities) != 0 {
-		buf.WriteString(`"universities":`)
-		if j.Universities != nil {
+	buf.WriteString(`{"name":`)
+	fflib.WriteJsonString(buf, string(j.Name))
+	buf.WriteString(`,"number":`)
+	fflib.WriteJsonString(buf, string(j.Number))
+	buf.WriteByte(',')
+	if j.Synopsis != nil {
+		if true {
+			buf.WriteString(`"synopsis":`)
+			fflib.WriteJsonString(buf, string(*j.Synopsis))
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteString(`"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
+	buf.WriteString(`,"topic_id":`)
+	fflib.WriteJsonString(buf, string(j.TopicId))
+	buf.WriteByte(',')
+	if len(j.Sections) != 0 {
+		buf.WriteString(`"sections":`)
+		if j.Sections != nil {
 			buf.WriteString(`[`)
-			for i, v := range j.Universities {
+			for i, v := range j.Sections {
 				if i != 0 {
 					buf.WriteString(`,`)
 				}
@@ -952,11 +753,11 @@ func (j *Data) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
-	if len(j.Subjects) != 0 {
-		buf.WriteString(`"subjects":`)
-		if j.Subjects != nil {
+	if len(j.Metadata) != 0 {
+		buf.WriteString(`"metadata":`)
+		if j.Metadata != nil {
 			buf.WriteString(`[`)
-			for i, v := range j.Subjects {
+			for i, v := range j.Metadata {
 				if i != 0 {
 					buf.WriteString(`,`)
 				}
@@ -982,212 +783,78 @@ func (j *Data) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
-	if len(j.Courses) != 0 {
-		buf.WriteString(`"courses":`)
-		if j.Courses != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Courses {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
+	if j.Prerequisite != nil {
+		if true {
+			buf.WriteString(`"prerequisite":`)
 
-					}
+			{
 
+				err = j.Prerequisite.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
 				}
+
 			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
+			buf.WriteByte(',')
 		}
-		buf.WriteByte(',')
 	}
-	if len(j.Sections) != 0 {
-		buf.WriteString(`"sections":`)
-		if j.Sections != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Sections {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
+	buf.WriteString(`"content_hash":`)
+	fflib.WriteJsonString(buf, string(j.ContentHash))
+	buf.WriteByte('}')
+	return nil
+}
 
-				{
+const (
+	ffjtCoursebase = iota
+	ffjtCoursenosuchkey
 
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
-		}
-		buf.WriteByte(',')
-	}
-	if j.University != nil {
-		if true {
-			buf.WriteString(`"university":`)
-
-			{
-
-				err = j.University.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.Subject != nil {
-		if true {
-			buf.WriteString(`"subject":`)
-
-			{
-
-				err = j.Subject.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.Course != nil {
-		if true {
-			buf.WriteString(`"course":`)
-
-			{
-
-				err = j.Course.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.Section != nil {
-		if true {
-			buf.WriteString(`"section":`)
-
-			{
-
-				err = j.Section.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if len(j.SubscriptionView) != 0 {
-		buf.WriteString(`"subscription_view":`)
-		if j.SubscriptionView != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.SubscriptionView {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
-		}
-		buf.WriteByte(',')
-	}
-	buf.Rewind(1)
-	buf.WriteByte('}')
-	return nil
-}
-
-const (
-	ffjtDatabase = iota
-	ffjtDatanosuchkey
-
-	ffjtDataUniversities
+	ffjtCourseName
 
-	ffjtDataSubjects
+	ffjtCourseNumber
 
-	ffjtDataCourses
+	ffjtCourseSynopsis
 
-	ffjtDataSections
+	ffjtCourseTopicName
 
-	ffjtDataUniversity
+	ffjtCourseTopicId
 
-	ffjtDataSubject
+	ffjtCourseSections
 
-	ffjtDataCourse
+	ffjtCourseMetadata
 
-	ffjtDataSection
+	ffjtCoursePrerequisite
 
-	ffjtDataSubscriptionView
+	ffjtCourseContentHash
 )
 
-var ffjKeyDataUniversities = []byte("universities")
+var ffjKeyCourseName = []byte("name")
 
-var ffjKeyDataSubjects = []byte("subjects")
+var ffjKeyCourseNumber = []byte("number")
 
-var ffjKeyDataCourses = []byte("courses")
+var ffjKeyCourseSynopsis = []byte("synopsis")
 
-var ffjKeyDataSections = []byte("sections")
+var ffjKeyCourseTopicName = []byte("topic_name")
 
-var ffjKeyDataUniversity = []byte("university")
+var ffjKeyCourseTopicId = []byte("topic_id")
 
-var ffjKeyDataSubject = []byte("subject")
+var ffjKeyCourseSections = []byte("sections")
 
-var ffjKeyDataCourse = []byte("course")
+var ffjKeyCourseMetadata = []byte("metadata")
 
-var ffjKeyDataSection = []byte("section")
+var ffjKeyCoursePrerequisite = []byte("prerequisite")
 
-var ffjKeyDataSubscriptionView = []byte("subscription_view")
+var ffjKeyCourseContentHash = []byte("content_hash")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Data) UnmarshalJSON(input []byte) error {
+func (j *Course) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Data) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Course) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtDatabase
+	currentKey := ffjtCoursebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1233,7 +900,7 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtDatanosuchkey
+				currentKey = ffjtCoursenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
@@ -1241,115 +908,124 @@ mainparse:
 
 				case 'c':
 
-					if bytes.Equal(ffjKeyDataCourses, kn) {
-						currentKey = ffjtDataCourses
+					if bytes.Equal(ffjKeyCourseContentHash, kn) {
+						currentKey = ffjtCourseContentHash
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeyDataCourse, kn) {
-						currentKey = ffjtDataCourse
+				case 'm':
+
+					if bytes.Equal(ffjKeyCourseMetadata, kn) {
+						currentKey = ffjtCourseMetadata
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 's':
+				case 'n':
 
-					if bytes.Equal(ffjKeyDataSubjects, kn) {
-						currentKey = ffjtDataSubjects
+					if bytes.Equal(ffjKeyCourseName, kn) {
+						currentKey = ffjtCourseName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyDataSections, kn) {
-						currentKey = ffjtDataSections
+					} else if bytes.Equal(ffjKeyCourseNumber, kn) {
+						currentKey = ffjtCourseNumber
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeyDataSubject, kn) {
-						currentKey = ffjtDataSubject
+				case 'p':
+
+					if bytes.Equal(ffjKeyCoursePrerequisite, kn) {
+						currentKey = ffjtCoursePrerequisite
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeyDataSection, kn) {
-						currentKey = ffjtDataSection
+				case 's':
+
+					if bytes.Equal(ffjKeyCourseSynopsis, kn) {
+						currentKey = ffjtCourseSynopsis
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyDataSubscriptionView, kn) {
-						currentKey = ffjtDataSubscriptionView
+					} else if bytes.Equal(ffjKeyCourseSections, kn) {
+						currentKey = ffjtCourseSections
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'u':
+				case 't':
 
-					if bytes.Equal(ffjKeyDataUniversities, kn) {
-						currentKey = ffjtDataUniversities
+					if bytes.Equal(ffjKeyCourseTopicName, kn) {
+						currentKey = ffjtCourseTopicName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeyDataUniversity, kn) {
-						currentKey = ffjtDataUniversity
+					} else if bytes.Equal(ffjKeyCourseTopicId, kn) {
+						currentKey = ffjtCourseTopicId
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataSubscriptionView, kn) {
-					currentKey = ffjtDataSubscriptionView
+				if fflib.EqualFoldRight(ffjKeyCourseContentHash, kn) {
+					currentKey = ffjtCourseContentHash
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataSection, kn) {
-					currentKey = ffjtDataSection
+				if fflib.EqualFoldRight(ffjKeyCoursePrerequisite, kn) {
+					currentKey = ffjtCoursePrerequisite
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataCourse, kn) {
-					currentKey = ffjtDataCourse
+				if fflib.SimpleLetterEqualFold(ffjKeyCourseMetadata, kn) {
+					currentKey = ffjtCourseMetadata
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataSubject, kn) {
-					currentKey = ffjtDataSubject
+				if fflib.EqualFoldRight(ffjKeyCourseSections, kn) {
+					currentKey = ffjtCourseSections
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataUniversity, kn) {
-					currentKey = ffjtDataUniversity
+				if fflib.AsciiEqualFold(ffjKeyCourseTopicId, kn) {
+					currentKey = ffjtCourseTopicId
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataSections, kn) {
-					currentKey = ffjtDataSections
+				if fflib.AsciiEqualFold(ffjKeyCourseTopicName, kn) {
+					currentKey = ffjtCourseTopicName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataCourses, kn) {
-					currentKey = ffjtDataCourses
+				if fflib.EqualFoldRight(ffjKeyCourseSynopsis, kn) {
+					currentKey = ffjtCourseSynopsis
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataSubjects, kn) {
-					currentKey = ffjtDataSubjects
+				if fflib.SimpleLetterEqualFold(ffjKeyCourseNumber, kn) {
+					currentKey = ffjtCourseNumber
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyDataUniversities, kn) {
-					currentKey = ffjtDataUniversities
+				if fflib.SimpleLetterEqualFold(ffjKeyCourseName, kn) {
+					currentKey = ffjtCourseName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtDatanosuchkey
+				currentKey = ffjtCoursenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -1366,34 +1042,34 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtDataUniversities:
-					goto handle_Universities
+				case ffjtCourseName:
+					goto handle_Name
 
-				case ffjtDataSubjects:
-					goto handle_Subjects
+				case ffjtCourseNumber:
+					goto handle_Number
 
-				case ffjtDataCourses:
-					goto handle_Courses
+				case ffjtCourseSynopsis:
+					goto handle_Synopsis
 
-				case ffjtDataSections:
-					goto handle_Sections
+				case ffjtCourseTopicName:
+					goto handle_TopicName
 
-				case ffjtDataUniversity:
-					goto handle_University
+				case ffjtCourseTopicId:
+					goto handle_TopicId
 
-				case ffjtDataSubject:
-					goto handle_Subject
+				case ffjtCourseSections:
+					goto handle_Sections
 
-				case ffjtDataCourse:
-					goto handle_Course
+				case ffjtCourseMetadata:
+					goto handle_Metadata
 
-				case ffjtDataSection:
-					goto handle_Section
+				case ffjtCoursePrerequisite:
+					goto handle_Prerequisite
 
-				case ffjtDataSubscriptionView:
-					goto handle_SubscriptionView
+				case ffjtCourseContentHash:
+					goto handle_ContentHash
 
-				case ffjtDatanosuchkey:
+				case ffjtCoursenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -1407,157 +1083,143 @@ mainparse:
 		}
 	}
 
-handle_Universities:
+handle_Name:
 
-	/* handler: j.Universities type=[]*model.University kind=slice quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Universities = nil
-		} else {
 
-			j.Universities = []*University{}
+		} else {
 
-			wantVal := true
+			outBuf := fs.Output.Bytes()
 
-			for {
+			j.Name = string(string(outBuf))
 
-				var tmpJUniversities *University
+		}
+	}
 
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+handle_Number:
 
-				/* handler: tmpJUniversities type=*model.University kind=ptr quoted=false*/
+	/* handler: j.Number type=string kind=string quoted=false*/
 
-				{
-					if tok == fflib.FFTok_null {
+	{
 
-						tmpJUniversities = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-					} else {
+		if tok == fflib.FFTok_null {
 
-						if tmpJUniversities == nil {
-							tmpJUniversities = new(University)
-						}
+		} else {
 
-						err = tmpJUniversities.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+			outBuf := fs.Output.Bytes()
 
-				j.Universities = append(j.Universities, tmpJUniversities)
+			j.Number = string(string(outBuf))
 
-				wantVal = false
-			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Subjects:
+handle_Synopsis:
 
-	/* handler: j.Subjects type=[]*model.Subject kind=slice quoted=false*/
+	/* handler: j.Synopsis type=string kind=string quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Subjects = nil
+
+			j.Synopsis = nil
+
 		} else {
 
-			j.Subjects = []*Subject{}
+			var tval string
+			outBuf := fs.Output.Bytes()
 
-			wantVal := true
+			tval = string(string(outBuf))
+			j.Synopsis = &tval
 
-			for {
+		}
+	}
 
-				var tmpJSubjects *Subject
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
+handle_TopicName:
 
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+	/* handler: j.TopicName type=string kind=string quoted=false*/
 
-				/* handler: tmpJSubjects type=*model.Subject kind=ptr quoted=false*/
+	{
 
-				{
-					if tok == fflib.FFTok_null {
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-						tmpJSubjects = nil
+		if tok == fflib.FFTok_null {
 
-					} else {
+		} else {
 
-						if tmpJSubjects == nil {
-							tmpJSubjects = new(Subject)
-						}
+			outBuf := fs.Output.Bytes()
 
-						err = tmpJSubjects.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+			j.TopicName = string(string(outBuf))
 
-				j.Subjects = append(j.Subjects, tmpJSubjects)
+		}
+	}
 
-				wantVal = false
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TopicId:
+
+	/* handler: j.TopicId type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TopicId = string(string(outBuf))
+
+		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Courses:
+handle_Sections:
 
-	/* handler: j.Courses type=[]*model.Course kind=slice quoted=false*/
+	/* handler: j.Sections type=[]*model.Section kind=slice quoted=false*/
 
 	{
 
@@ -1568,16 +1230,16 @@ handle_Courses:
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Courses = nil
+			j.Sections = nil
 		} else {
 
-			j.Courses = []*Course{}
+			j.Sections = []*Section{}
 
 			wantVal := true
 
 			for {
 
-				var tmpJCourses *Course
+				var tmpJSections *Section
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -1598,20 +1260,20 @@ handle_Courses:
 					wantVal = true
 				}
 
-				/* handler: tmpJCourses type=*model.Course kind=ptr quoted=false*/
+				/* handler: tmpJSections type=*model.Section kind=ptr quoted=false*/
 
 				{
 					if tok == fflib.FFTok_null {
 
-						tmpJCourses = nil
+						tmpJSections = nil
 
 					} else {
 
-						if tmpJCourses == nil {
-							tmpJCourses = new(Course)
+						if tmpJSections == nil {
+							tmpJSections = new(Section)
 						}
 
-						err = tmpJCourses.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						err = tmpJSections.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 						if err != nil {
 							return err
 						}
@@ -1619,7 +1281,7 @@ handle_Courses:
 					state = fflib.FFParse_after_value
 				}
 
-				j.Courses = append(j.Courses, tmpJCourses)
+				j.Sections = append(j.Sections, tmpJSections)
 
 				wantVal = false
 			}
@@ -1629,9 +1291,9 @@ handle_Courses:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Sections:
+handle_Metadata:
 
-	/* handler: j.Sections type=[]*model.Section kind=slice quoted=false*/
+	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
 
 	{
 
@@ -1642,16 +1304,16 @@ handle_Sections:
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Sections = nil
+			j.Metadata = nil
 		} else {
 
-			j.Sections = []*Section{}
+			j.Metadata = []*Metadata{}
 
 			wantVal := true
 
 			for {
 
-				var tmpJSections *Section
+				var tmpJMetadata *Metadata
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -1672,20 +1334,20 @@ handle_Sections:
 					wantVal = true
 				}
 
-				/* handler: tmpJSections type=*model.Section kind=ptr quoted=false*/
+				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
 
 				{
 					if tok == fflib.FFTok_null {
 
-						tmpJSections = nil
+						tmpJMetadata = nil
 
 					} else {
 
-						if tmpJSections == nil {
-							tmpJSections = new(Section)
+						if tmpJMetadata == nil {
+							tmpJMetadata = new(Metadata)
 						}
 
-						err = tmpJSections.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 						if err != nil {
 							return err
 						}
@@ -1693,7 +1355,7 @@ handle_Sections:
 					state = fflib.FFParse_after_value
 				}
 
-				j.Sections = append(j.Sections, tmpJSections)
+				j.Metadata = append(j.Metadata, tmpJMetadata)
 
 				wantVal = false
 			}
@@ -1703,22 +1365,22 @@ handle_Sections:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_University:
+handle_Prerequisite:
 
-	/* handler: j.University type=model.University kind=struct quoted=false*/
+	/* handler: j.Prerequisite type=model.Prerequisite kind=struct quoted=false*/
 
 	{
 		if tok == fflib.FFTok_null {
 
-			j.University = nil
+			j.Prerequisite = nil
 
 		} else {
 
-			if j.University == nil {
-				j.University = new(University)
+			if j.Prerequisite == nil {
+				j.Prerequisite = new(Prerequisite)
 			}
 
-			err = j.University.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			err = j.Prerequisite.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 			if err != nil {
 				return err
 			}
@@ -1729,152 +1391,26 @@ handle_University:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Subject:
+handle_ContentHash:
 
-	/* handler: j.Subject type=model.Subject kind=struct quoted=false*/
+	/* handler: j.ContentHash type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
 
-			j.Subject = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
 
 		} else {
 
-			if j.Subject == nil {
-				j.Subject = new(Subject)
-			}
+			outBuf := fs.Output.Bytes()
+
+			j.ContentHash = string(string(outBuf))
 
-			err = j.Subject.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
-		}
-		state = fflib.FFParse_after_value
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Course:
-
-	/* handler: j.Course type=model.Course kind=struct quoted=false*/
-
-	{
-		if tok == fflib.FFTok_null {
-
-			j.Course = nil
-
-		} else {
-
-			if j.Course == nil {
-				j.Course = new(Course)
-			}
-
-			err = j.Course.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
-		}
-		state = fflib.FFParse_after_value
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Section:
-
-	/* handler: j.Section type=model.Section kind=struct quoted=false*/
-
-	{
-		if tok == fflib.FFTok_null {
-
-			j.Section = nil
-
-		} else {
-
-			if j.Section == nil {
-				j.Section = new(Section)
-			}
-
-			err = j.Section.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
-		}
-		state = fflib.FFParse_after_value
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_SubscriptionView:
-
-	/* handler: j.SubscriptionView type=[]*model.SubscriptionView kind=slice quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-			j.SubscriptionView = nil
-		} else {
-
-			j.SubscriptionView = []*SubscriptionView{}
-
-			wantVal := true
-
-			for {
-
-				var tmpJSubscriptionView *SubscriptionView
-
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
-
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
-
-				/* handler: tmpJSubscriptionView type=*model.SubscriptionView kind=ptr quoted=false*/
-
-				{
-					if tok == fflib.FFTok_null {
-
-						tmpJSubscriptionView = nil
-
-					} else {
-
-						if tmpJSubscriptionView == nil {
-							tmpJSubscriptionView = new(SubscriptionView)
-						}
-
-						err = tmpJSubscriptionView.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
-
-				j.SubscriptionView = append(j.SubscriptionView, tmpJSubscriptionView)
-
-				wantVal = false
-			}
 		}
 	}
 
@@ -1900,7 +1436,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Instructor) MarshalJSON() ([]byte, error) {
+func (j *CrossListing) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -1914,7 +1450,7 @@ func (j *Instructor) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Instructor) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *CrossListing) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -1923,31 +1459,49 @@ func (j *Instructor) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"name":`)
-	fflib.WriteJsonString(buf, string(j.Name))
+	buf.WriteString(`{"subject_number":`)
+	fflib.WriteJsonString(buf, string(j.SubjectNumber))
+	buf.WriteString(`,"course_number":`)
+	fflib.WriteJsonString(buf, string(j.CourseNumber))
+	buf.WriteString(`,"section_number":`)
+	fflib.WriteJsonString(buf, string(j.SectionNumber))
+	buf.WriteString(`,"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtInstructorbase = iota
-	ffjtInstructornosuchkey
+	ffjtCrossListingbase = iota
+	ffjtCrossListingnosuchkey
 
-	ffjtInstructorName
+	ffjtCrossListingSubjectNumber
+
+	ffjtCrossListingCourseNumber
+
+	ffjtCrossListingSectionNumber
+
+	ffjtCrossListingTopicName
 )
 
-var ffjKeyInstructorName = []byte("name")
+var ffjKeyCrossListingSubjectNumber = []byte("subject_number")
+
+var ffjKeyCrossListingCourseNumber = []byte("course_number")
+
+var ffjKeyCrossListingSectionNumber = []byte("section_number")
+
+var ffjKeyCrossListingTopicName = []byte("topic_name")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Instructor) UnmarshalJSON(input []byte) error {
+func (j *CrossListing) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Instructor) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *CrossListing) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtInstructorbase
+	currentKey := ffjtCrossListingbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -1993,29 +1547,68 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtInstructornosuchkey
+				currentKey = ffjtCrossListingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'n':
+				case 'c':
 
-					if bytes.Equal(ffjKeyInstructorName, kn) {
-						currentKey = ffjtInstructorName
+					if bytes.Equal(ffjKeyCrossListingCourseNumber, kn) {
+						currentKey = ffjtCrossListingCourseNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyCrossListingSubjectNumber, kn) {
+						currentKey = ffjtCrossListingSubjectNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyCrossListingSectionNumber, kn) {
+						currentKey = ffjtCrossListingSectionNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyCrossListingTopicName, kn) {
+						currentKey = ffjtCrossListingTopicName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyInstructorName, kn) {
-					currentKey = ffjtInstructorName
+				if fflib.AsciiEqualFold(ffjKeyCrossListingTopicName, kn) {
+					currentKey = ffjtCrossListingTopicName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtInstructornosuchkey
+				if fflib.EqualFoldRight(ffjKeyCrossListingSectionNumber, kn) {
+					currentKey = ffjtCrossListingSectionNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyCrossListingCourseNumber, kn) {
+					currentKey = ffjtCrossListingCourseNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyCrossListingSubjectNumber, kn) {
+					currentKey = ffjtCrossListingSubjectNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtCrossListingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -2032,10 +1625,19 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtInstructorName:
-					goto handle_Name
+				case ffjtCrossListingSubjectNumber:
+					goto handle_SubjectNumber
 
-				case ffjtInstructornosuchkey:
+				case ffjtCrossListingCourseNumber:
+					goto handle_CourseNumber
+
+				case ffjtCrossListingSectionNumber:
+					goto handle_SectionNumber
+
+				case ffjtCrossListingTopicName:
+					goto handle_TopicName
+
+				case ffjtCrossListingnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -2049,9 +1651,9 @@ mainparse:
 		}
 	}
 
-handle_Name:
+handle_SubjectNumber:
 
-	/* handler: j.Name type=string kind=string quoted=false*/
+	/* handler: j.SubjectNumber type=string kind=string quoted=false*/
 
 	{
 
@@ -2067,7 +1669,85 @@ handle_Name:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Name = string(string(outBuf))
+			j.SubjectNumber = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CourseNumber:
+
+	/* handler: j.CourseNumber type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.CourseNumber = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SectionNumber:
+
+	/* handler: j.SectionNumber type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SectionNumber = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TopicName:
+
+	/* handler: j.TopicName type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TopicName = string(string(outBuf))
 
 		}
 	}
@@ -2094,7 +1774,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Meeting) MarshalJSON() ([]byte, error) {
+func (j *Data) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -2108,7 +1788,7 @@ func (j *Meeting) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Meeting) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Data) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -2118,49 +1798,191 @@ func (j *Meeting) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	_ = obj
 	_ = err
 	buf.WriteString(`{ `)
-	if j.Room != nil {
-		if true {
-			buf.WriteString(`"room":`)
-			fflib.WriteJsonString(buf, string(*j.Room))
-			buf.WriteByte(',')
+	if len(j.Universities) != 0 {
+		buf.WriteString(`"universities":`)
+		if j.Universities != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Universities {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
 		}
+		buf.WriteByte(',')
 	}
-	if j.Day != nil {
+	if len(j.Subjects) != 0 {
+		buf.WriteString(`"subjects":`)
+		if j.Subjects != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Subjects {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Courses) != 0 {
+		buf.WriteString(`"courses":`)
+		if j.Courses != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Courses {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Sections) != 0 {
+		buf.WriteString(`"sections":`)
+		if j.Sections != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Sections {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if j.University != nil {
 		if true {
-			buf.WriteString(`"day":`)
-			fflib.WriteJsonString(buf, string(*j.Day))
+			buf.WriteString(`"university":`)
+
+			{
+
+				err = j.University.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
 			buf.WriteByte(',')
 		}
 	}
-	if j.StartTime != nil {
+	if j.Subject != nil {
 		if true {
-			buf.WriteString(`"start_time":`)
-			fflib.WriteJsonString(buf, string(*j.StartTime))
+			buf.WriteString(`"subject":`)
+
+			{
+
+				err = j.Subject.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
 			buf.WriteByte(',')
 		}
 	}
-	if j.EndTime != nil {
+	if j.Course != nil {
 		if true {
-			buf.WriteString(`"end_time":`)
-			fflib.WriteJsonString(buf, string(*j.EndTime))
+			buf.WriteString(`"course":`)
+
+			{
+
+				err = j.Course.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
 			buf.WriteByte(',')
 		}
 	}
-	if j.ClassType != nil {
+	if j.Section != nil {
 		if true {
-			buf.WriteString(`"class_type":`)
-			fflib.WriteJsonString(buf, string(*j.ClassType))
+			buf.WriteString(`"section":`)
+
+			{
+
+				err = j.Section.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
 			buf.WriteByte(',')
 		}
 	}
-	buf.WriteString(`"index":`)
-	fflib.FormatBits2(buf, uint64(j.Index), 10, j.Index < 0)
-	buf.WriteByte(',')
-	if len(j.Metadata) != 0 {
-		buf.WriteString(`"metadata":`)
-		if j.Metadata != nil {
+	if len(j.SubscriptionView) != 0 {
+		buf.WriteString(`"subscription_view":`)
+		if j.SubscriptionView != nil {
 			buf.WriteString(`[`)
-			for i, v := range j.Metadata {
+			for i, v := range j.SubscriptionView {
 				if i != 0 {
 					buf.WriteString(`,`)
 				}
@@ -2186,54 +2008,240 @@ func (j *Meeting) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
-	buf.Rewind(1)
-	buf.WriteByte('}')
-	return nil
-}
-
-const (
-	ffjtMeetingbase = iota
-	ffjtMeetingnosuchkey
+	if j.SectionHistory != nil {
+		if true {
+			buf.WriteString(`"section_history":`)
 
-	ffjtMeetingRoom
+			{
 
-	ffjtMeetingDay
+				err = j.SectionHistory.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
 
-	ffjtMeetingStartTime
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(j.SearchResults) != 0 {
+		buf.WriteString(`"search_results":`)
+		if j.SearchResults != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.SearchResults {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
 
-	ffjtMeetingEndTime
+				{
 
-	ffjtMeetingClassType
+					if v == nil {
+						buf.WriteString("null")
+					} else {
 
-	ffjtMeetingIndex
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
 
-	ffjtMeetingMetadata
-)
+					}
 
-var ffjKeyMeetingRoom = []byte("room")
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if j.Page != nil {
+		if true {
+			buf.WriteString(`"page":`)
 
-var ffjKeyMeetingDay = []byte("day")
+			{
 
-var ffjKeyMeetingStartTime = []byte("start_time")
+				err = j.Page.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
 
-var ffjKeyMeetingEndTime = []byte("end_time")
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(j.Schedules) != 0 {
+		buf.WriteString(`"schedules":`)
+		if j.Schedules != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Schedules {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
 
-var ffjKeyMeetingClassType = []byte("class_type")
+				{
 
-var ffjKeyMeetingIndex = []byte("index")
+					if v == nil {
+						buf.WriteString("null")
+					} else {
 
-var ffjKeyMeetingMetadata = []byte("metadata")
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if j.Prerequisite != nil {
+		if true {
+			buf.WriteString(`"prerequisite":`)
+
+			{
+
+				err = j.Prerequisite.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if j.InstructorProfile != nil {
+		if true {
+			buf.WriteString(`"instructor_profile":`)
+
+			{
+
+				err = j.InstructorProfile.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if len(j.Buildings) != 0 {
+		buf.WriteString(`"buildings":`)
+		if j.Buildings != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Buildings {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtDatabase = iota
+	ffjtDatanosuchkey
+
+	ffjtDataUniversities
+
+	ffjtDataSubjects
+
+	ffjtDataCourses
+
+	ffjtDataSections
+
+	ffjtDataUniversity
+
+	ffjtDataSubject
+
+	ffjtDataCourse
+
+	ffjtDataSection
+
+	ffjtDataSubscriptionView
+
+	ffjtDataSectionHistory
+
+	ffjtDataSearchResults
+
+	ffjtDataPage
+
+	ffjtDataSchedules
+
+	ffjtDataPrerequisite
+
+	ffjtDataInstructorProfile
+
+	ffjtDataBuildings
+)
+
+var ffjKeyDataUniversities = []byte("universities")
+
+var ffjKeyDataSubjects = []byte("subjects")
+
+var ffjKeyDataCourses = []byte("courses")
+
+var ffjKeyDataSections = []byte("sections")
+
+var ffjKeyDataUniversity = []byte("university")
+
+var ffjKeyDataSubject = []byte("subject")
+
+var ffjKeyDataCourse = []byte("course")
+
+var ffjKeyDataSection = []byte("section")
+
+var ffjKeyDataSubscriptionView = []byte("subscription_view")
+
+var ffjKeyDataSectionHistory = []byte("section_history")
+
+var ffjKeyDataSearchResults = []byte("search_results")
+
+var ffjKeyDataPage = []byte("page")
+
+var ffjKeyDataSchedules = []byte("schedules")
+
+var ffjKeyDataPrerequisite = []byte("prerequisite")
+
+var ffjKeyDataInstructorProfile = []byte("instructor_profile")
+
+var ffjKeyDataBuildings = []byte("buildings")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Meeting) UnmarshalJSON(input []byte) error {
+func (j *Data) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Meeting) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Data) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtMeetingbase
+	currentKey := ffjtDatabase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -2279,151 +2287,274 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtMeetingnosuchkey
+				currentKey = ffjtDatanosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'c':
+				case 'b':
 
-					if bytes.Equal(ffjKeyMeetingClassType, kn) {
-						currentKey = ffjtMeetingClassType
+					if bytes.Equal(ffjKeyDataBuildings, kn) {
+						currentKey = ffjtDataBuildings
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'd':
+				case 'c':
 
-					if bytes.Equal(ffjKeyMeetingDay, kn) {
-						currentKey = ffjtMeetingDay
+					if bytes.Equal(ffjKeyDataCourses, kn) {
+						currentKey = ffjtDataCourses
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
-
-				case 'e':
 
-					if bytes.Equal(ffjKeyMeetingEndTime, kn) {
-						currentKey = ffjtMeetingEndTime
+					} else if bytes.Equal(ffjKeyDataCourse, kn) {
+						currentKey = ffjtDataCourse
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'i':
 
-					if bytes.Equal(ffjKeyMeetingIndex, kn) {
-						currentKey = ffjtMeetingIndex
+					if bytes.Equal(ffjKeyDataInstructorProfile, kn) {
+						currentKey = ffjtDataInstructorProfile
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'm':
+				case 'p':
 
-					if bytes.Equal(ffjKeyMeetingMetadata, kn) {
-						currentKey = ffjtMeetingMetadata
+					if bytes.Equal(ffjKeyDataPage, kn) {
+						currentKey = ffjtDataPage
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataPrerequisite, kn) {
+						currentKey = ffjtDataPrerequisite
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'r':
+				case 's':
 
-					if bytes.Equal(ffjKeyMeetingRoom, kn) {
-						currentKey = ffjtMeetingRoom
+					if bytes.Equal(ffjKeyDataSubjects, kn) {
+						currentKey = ffjtDataSubjects
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataSections, kn) {
+						currentKey = ffjtDataSections
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataSubject, kn) {
+						currentKey = ffjtDataSubject
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataSection, kn) {
+						currentKey = ffjtDataSection
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataSubscriptionView, kn) {
+						currentKey = ffjtDataSubscriptionView
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataSectionHistory, kn) {
+						currentKey = ffjtDataSectionHistory
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataSearchResults, kn) {
+						currentKey = ffjtDataSearchResults
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataSchedules, kn) {
+						currentKey = ffjtDataSchedules
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 's':
+				case 'u':
 
-					if bytes.Equal(ffjKeyMeetingStartTime, kn) {
-						currentKey = ffjtMeetingStartTime
+					if bytes.Equal(ffjKeyDataUniversities, kn) {
+						currentKey = ffjtDataUniversities
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyDataUniversity, kn) {
+						currentKey = ffjtDataUniversity
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyMeetingMetadata, kn) {
-					currentKey = ffjtMeetingMetadata
+				if fflib.EqualFoldRight(ffjKeyDataBuildings, kn) {
+					currentKey = ffjtDataBuildings
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyMeetingIndex, kn) {
-					currentKey = ffjtMeetingIndex
+				if fflib.EqualFoldRight(ffjKeyDataInstructorProfile, kn) {
+					currentKey = ffjtDataInstructorProfile
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyMeetingClassType, kn) {
-					currentKey = ffjtMeetingClassType
+				if fflib.EqualFoldRight(ffjKeyDataPrerequisite, kn) {
+					currentKey = ffjtDataPrerequisite
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeyMeetingEndTime, kn) {
-					currentKey = ffjtMeetingEndTime
+				if fflib.EqualFoldRight(ffjKeyDataSchedules, kn) {
+					currentKey = ffjtDataSchedules
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeyMeetingStartTime, kn) {
-					currentKey = ffjtMeetingStartTime
+				if fflib.SimpleLetterEqualFold(ffjKeyDataPage, kn) {
+					currentKey = ffjtDataPage
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyMeetingDay, kn) {
-					currentKey = ffjtMeetingDay
+				if fflib.EqualFoldRight(ffjKeyDataSearchResults, kn) {
+					currentKey = ffjtDataSearchResults
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyMeetingRoom, kn) {
-					currentKey = ffjtMeetingRoom
+				if fflib.EqualFoldRight(ffjKeyDataSectionHistory, kn) {
+					currentKey = ffjtDataSectionHistory
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtMeetingnosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			}
-
-		case fflib.FFParse_want_colon:
-			if tok != fflib.FFTok_colon {
-				wantedTok = fflib.FFTok_colon
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_value
-			continue
-		case fflib.FFParse_want_value:
+				if fflib.EqualFoldRight(ffjKeyDataSubscriptionView, kn) {
+					currentKey = ffjtDataSubscriptionView
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
-				switch currentKey {
+				if fflib.EqualFoldRight(ffjKeyDataSection, kn) {
+					currentKey = ffjtDataSection
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingRoom:
-					goto handle_Room
+				if fflib.EqualFoldRight(ffjKeyDataCourse, kn) {
+					currentKey = ffjtDataCourse
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingDay:
-					goto handle_Day
+				if fflib.EqualFoldRight(ffjKeyDataSubject, kn) {
+					currentKey = ffjtDataSubject
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingStartTime:
-					goto handle_StartTime
+				if fflib.EqualFoldRight(ffjKeyDataUniversity, kn) {
+					currentKey = ffjtDataUniversity
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingEndTime:
-					goto handle_EndTime
+				if fflib.EqualFoldRight(ffjKeyDataSections, kn) {
+					currentKey = ffjtDataSections
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingClassType:
-					goto handle_ClassType
+				if fflib.EqualFoldRight(ffjKeyDataCourses, kn) {
+					currentKey = ffjtDataCourses
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingIndex:
-					goto handle_Index
+				if fflib.EqualFoldRight(ffjKeyDataSubjects, kn) {
+					currentKey = ffjtDataSubjects
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingMetadata:
-					goto handle_Metadata
+				if fflib.EqualFoldRight(ffjKeyDataUniversities, kn) {
+					currentKey = ffjtDataUniversities
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-				case ffjtMeetingnosuchkey:
+				currentKey = ffjtDatanosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtDataUniversities:
+					goto handle_Universities
+
+				case ffjtDataSubjects:
+					goto handle_Subjects
+
+				case ffjtDataCourses:
+					goto handle_Courses
+
+				case ffjtDataSections:
+					goto handle_Sections
+
+				case ffjtDataUniversity:
+					goto handle_University
+
+				case ffjtDataSubject:
+					goto handle_Subject
+
+				case ffjtDataCourse:
+					goto handle_Course
+
+				case ffjtDataSection:
+					goto handle_Section
+
+				case ffjtDataSubscriptionView:
+					goto handle_SubscriptionView
+
+				case ffjtDataSectionHistory:
+					goto handle_SectionHistory
+
+				case ffjtDataSearchResults:
+					goto handle_SearchResults
+
+				case ffjtDataPage:
+					goto handle_Page
+
+				case ffjtDataSchedules:
+					goto handle_Schedules
+
+				case ffjtDataPrerequisite:
+					goto handle_Prerequisite
+
+				case ffjtDataInstructorProfile:
+					goto handle_InstructorProfile
+
+				case ffjtDataBuildings:
+					goto handle_Buildings
+
+				case ffjtDatanosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -2437,189 +2568,231 @@ mainparse:
 		}
 	}
 
-handle_Room:
+handle_Universities:
 
-	/* handler: j.Room type=string kind=string quoted=false*/
+	/* handler: j.Universities type=[]*model.University kind=slice quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-
-			j.Room = nil
-
+			j.Universities = nil
 		} else {
 
-			var tval string
-			outBuf := fs.Output.Bytes()
+			j.Universities = []*University{}
 
-			tval = string(string(outBuf))
-			j.Room = &tval
+			wantVal := true
 
-		}
-	}
+			for {
 
-	state = fflib.FFParse_after_value
-	goto mainparse
+				var tmpJUniversities *University
 
-handle_Day:
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
 
-	/* handler: j.Day type=string kind=string quoted=false*/
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
 
-	{
+				/* handler: tmpJUniversities type=*model.University kind=ptr quoted=false*/
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
+				{
+					if tok == fflib.FFTok_null {
 
-		if tok == fflib.FFTok_null {
+						tmpJUniversities = nil
 
-			j.Day = nil
+					} else {
 
-		} else {
+						if tmpJUniversities == nil {
+							tmpJUniversities = new(University)
+						}
 
-			var tval string
-			outBuf := fs.Output.Bytes()
+						err = tmpJUniversities.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
 
-			tval = string(string(outBuf))
-			j.Day = &tval
+				j.Universities = append(j.Universities, tmpJUniversities)
 
+				wantVal = false
+			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_StartTime:
+handle_Subjects:
 
-	/* handler: j.StartTime type=string kind=string quoted=false*/
+	/* handler: j.Subjects type=[]*model.Subject kind=slice quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-
-			j.StartTime = nil
-
+			j.Subjects = nil
 		} else {
 
-			var tval string
-			outBuf := fs.Output.Bytes()
+			j.Subjects = []*Subject{}
 
-			tval = string(string(outBuf))
-			j.StartTime = &tval
+			wantVal := true
 
-		}
-	}
+			for {
 
-	state = fflib.FFParse_after_value
-	goto mainparse
+				var tmpJSubjects *Subject
 
-handle_EndTime:
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
 
-	/* handler: j.EndTime type=string kind=string quoted=false*/
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
 
-	{
+				/* handler: tmpJSubjects type=*model.Subject kind=ptr quoted=false*/
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
+				{
+					if tok == fflib.FFTok_null {
 
-		if tok == fflib.FFTok_null {
+						tmpJSubjects = nil
 
-			j.EndTime = nil
+					} else {
 
-		} else {
+						if tmpJSubjects == nil {
+							tmpJSubjects = new(Subject)
+						}
 
-			var tval string
-			outBuf := fs.Output.Bytes()
+						err = tmpJSubjects.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
 
-			tval = string(string(outBuf))
-			j.EndTime = &tval
+				j.Subjects = append(j.Subjects, tmpJSubjects)
 
+				wantVal = false
+			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_ClassType:
+handle_Courses:
 
-	/* handler: j.ClassType type=string kind=string quoted=false*/
+	/* handler: j.Courses type=[]*model.Course kind=slice quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-
-			j.ClassType = nil
-
+			j.Courses = nil
 		} else {
 
-			var tval string
-			outBuf := fs.Output.Bytes()
+			j.Courses = []*Course{}
 
-			tval = string(string(outBuf))
-			j.ClassType = &tval
+			wantVal := true
 
-		}
-	}
+			for {
 
-	state = fflib.FFParse_after_value
-	goto mainparse
+				var tmpJCourses *Course
 
-handle_Index:
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
 
-	/* handler: j.Index type=int32 kind=int32 quoted=false*/
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
 
-	{
-		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
-		}
-	}
+				/* handler: tmpJCourses type=*model.Course kind=ptr quoted=false*/
 
-	{
+				{
+					if tok == fflib.FFTok_null {
 
-		if tok == fflib.FFTok_null {
+						tmpJCourses = nil
 
-		} else {
+					} else {
 
-			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+						if tmpJCourses == nil {
+							tmpJCourses = new(Course)
+						}
 
-			if err != nil {
-				return fs.WrapErr(err)
-			}
+						err = tmpJCourses.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
 
-			j.Index = int32(tval)
+				j.Courses = append(j.Courses, tmpJCourses)
 
+				wantVal = false
+			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Metadata:
+handle_Sections:
 
-	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
+	/* handler: j.Sections type=[]*model.Section kind=slice quoted=false*/
 
 	{
 
@@ -2630,16 +2803,16 @@ handle_Metadata:
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Metadata = nil
+			j.Sections = nil
 		} else {
 
-			j.Metadata = []*Metadata{}
+			j.Sections = []*Section{}
 
 			wantVal := true
 
 			for {
 
-				var tmpJMetadata *Metadata
+				var tmpJSections *Section
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -2660,20 +2833,20 @@ handle_Metadata:
 					wantVal = true
 				}
 
-				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
+				/* handler: tmpJSections type=*model.Section kind=ptr quoted=false*/
 
 				{
 					if tok == fflib.FFTok_null {
 
-						tmpJMetadata = nil
+						tmpJSections = nil
 
 					} else {
 
-						if tmpJMetadata == nil {
-							tmpJMetadata = new(Metadata)
+						if tmpJSections == nil {
+							tmpJSections = new(Section)
 						}
 
-						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						err = tmpJSections.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 						if err != nil {
 							return err
 						}
@@ -2681,7 +2854,7 @@ handle_Metadata:
 					state = fflib.FFParse_after_value
 				}
 
-				j.Metadata = append(j.Metadata, tmpJMetadata)
+				j.Sections = append(j.Sections, tmpJSections)
 
 				wantVal = false
 			}
@@ -2691,509 +2864,504 @@ handle_Metadata:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
+handle_University:
 
-	return nil
-}
+	/* handler: j.University type=model.University kind=struct quoted=false*/
 
-// MarshalJSON marshal bytes to json - template
-func (j *Meta) MarshalJSON() ([]byte, error) {
-	var buf fflib.Buffer
-	if j == nil {
-		buf.WriteString("null")
-		return buf.Bytes(), nil
-	}
-	err := j.MarshalJSONBuf(&buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
+	{
+		if tok == fflib.FFTok_null {
 
-// MarshalJSONBuf marshal buff to json - template
-func (j *Meta) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
-	if j == nil {
-		buf.WriteString("null")
-		return nil
-	}
-	var err error
-	var obj []byte
-	_ = obj
-	_ = err
-	buf.WriteString(`{ `)
-	if j.Code != nil {
-		if true {
-			buf.WriteString(`"code":`)
-			fflib.FormatBits2(buf, uint64(*j.Code), 10, *j.Code < 0)
-			buf.WriteByte(',')
-		}
-	}
-	if j.Message != nil {
-		if true {
-			buf.WriteString(`"message":`)
-			fflib.WriteJsonString(buf, string(*j.Message))
-			buf.WriteByte(',')
-		}
-	}
-	buf.Rewind(1)
-	buf.WriteByte('}')
-	return nil
-}
+			j.University = nil
 
-const (
-	ffjtMetabase = iota
-	ffjtMetanosuchkey
+		} else {
 
-	ffjtMetaCode
+			if j.University == nil {
+				j.University = new(University)
+			}
 
-	ffjtMetaMessage
-)
+			err = j.University.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
 
-var ffjKeyMetaCode = []byte("code")
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-var ffjKeyMetaMessage = []byte("message")
+handle_Subject:
 
-// UnmarshalJSON umarshall json - template of ffjson
-func (j *Meta) UnmarshalJSON(input []byte) error {
-	fs := fflib.NewFFLexer(input)
-	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
-}
+	/* handler: j.Subject type=model.Subject kind=struct quoted=false*/
 
-// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Meta) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
-	var err error
-	currentKey := ffjtMetabase
-	_ = currentKey
-	tok := fflib.FFTok_init
-	wantedTok := fflib.FFTok_init
+	{
+		if tok == fflib.FFTok_null {
 
-mainparse:
-	for {
-		tok = fs.Scan()
-		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
-		if tok == fflib.FFTok_error {
-			goto tokerror
-		}
+			j.Subject = nil
 
-		switch state {
+		} else {
 
-		case fflib.FFParse_map_start:
-			if tok != fflib.FFTok_left_bracket {
-				wantedTok = fflib.FFTok_left_bracket
-				goto wrongtokenerror
+			if j.Subject == nil {
+				j.Subject = new(Subject)
 			}
-			state = fflib.FFParse_want_key
-			continue
 
-		case fflib.FFParse_after_value:
-			if tok == fflib.FFTok_comma {
-				state = fflib.FFParse_want_key
-			} else if tok == fflib.FFTok_right_bracket {
-				goto done
-			} else {
-				wantedTok = fflib.FFTok_comma
-				goto wrongtokenerror
+			err = j.Subject.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
 			}
+		}
+		state = fflib.FFParse_after_value
+	}
 
-		case fflib.FFParse_want_key:
-			// json {} ended. goto exit. woo.
-			if tok == fflib.FFTok_right_bracket {
-				goto done
-			}
-			if tok != fflib.FFTok_string {
-				wantedTok = fflib.FFTok_string
-				goto wrongtokenerror
-			}
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-			kn := fs.Output.Bytes()
-			if len(kn) <= 0 {
-				// "" case. hrm.
-				currentKey = ffjtMetanosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			} else {
-				switch kn[0] {
+handle_Course:
 
-				case 'c':
+	/* handler: j.Course type=model.Course kind=struct quoted=false*/
 
-					if bytes.Equal(ffjKeyMetaCode, kn) {
-						currentKey = ffjtMetaCode
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+	{
+		if tok == fflib.FFTok_null {
 
-				case 'm':
+			j.Course = nil
 
-					if bytes.Equal(ffjKeyMetaMessage, kn) {
-						currentKey = ffjtMetaMessage
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+		} else {
 
-				}
+			if j.Course == nil {
+				j.Course = new(Course)
+			}
 
-				if fflib.EqualFoldRight(ffjKeyMetaMessage, kn) {
-					currentKey = ffjtMetaMessage
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
+			err = j.Course.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyMetaCode, kn) {
-					currentKey = ffjtMetaCode
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				currentKey = ffjtMetanosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
+handle_Section:
+
+	/* handler: j.Section type=model.Section kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Section = nil
+
+		} else {
+
+			if j.Section == nil {
+				j.Section = new(Section)
 			}
 
-		case fflib.FFParse_want_colon:
-			if tok != fflib.FFTok_colon {
-				wantedTok = fflib.FFTok_colon
-				goto wrongtokenerror
+			err = j.Section.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
 			}
-			state = fflib.FFParse_want_value
-			continue
-		case fflib.FFParse_want_value:
+		}
+		state = fflib.FFParse_after_value
+	}
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
-				switch currentKey {
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				case ffjtMetaCode:
-					goto handle_Code
+handle_SubscriptionView:
 
-				case ffjtMetaMessage:
-					goto handle_Message
+	/* handler: j.SubscriptionView type=[]*model.SubscriptionView kind=slice quoted=false*/
 
-				case ffjtMetanosuchkey:
-					err = fs.SkipField(tok)
-					if err != nil {
-						return fs.WrapErr(err)
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.SubscriptionView = nil
+		} else {
+
+			j.SubscriptionView = []*SubscriptionView{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJSubscriptionView *SubscriptionView
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJSubscriptionView type=*model.SubscriptionView kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJSubscriptionView = nil
+
+					} else {
+
+						if tmpJSubscriptionView == nil {
+							tmpJSubscriptionView = new(SubscriptionView)
+						}
+
+						err = tmpJSubscriptionView.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
 					}
 					state = fflib.FFParse_after_value
-					goto mainparse
 				}
-			} else {
-				goto wantedvalue
+
+				j.SubscriptionView = append(j.SubscriptionView, tmpJSubscriptionView)
+
+				wantVal = false
 			}
 		}
 	}
 
-handle_Code:
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-	/* handler: j.Code type=int32 kind=int32 quoted=false*/
+handle_SectionHistory:
 
-	{
-		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
-		}
-	}
+	/* handler: j.SectionHistory type=model.SectionHistory kind=struct quoted=false*/
 
 	{
-
 		if tok == fflib.FFTok_null {
 
-			j.Code = nil
+			j.SectionHistory = nil
 
 		} else {
 
-			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+			if j.SectionHistory == nil {
+				j.SectionHistory = new(SectionHistory)
+			}
 
+			err = j.SectionHistory.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 			if err != nil {
-				return fs.WrapErr(err)
+				return err
 			}
-
-			ttypval := int32(tval)
-			j.Code = &ttypval
-
 		}
+		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Message:
+handle_SearchResults:
 
-	/* handler: j.Message type=string kind=string quoted=false*/
+	/* handler: j.SearchResults type=[]*model.SearchResult kind=slice quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
+			j.SearchResults = nil
+		} else {
 
-			j.Message = nil
+			j.SearchResults = []*SearchResult{}
 
-		} else {
+			wantVal := true
 
-			var tval string
-			outBuf := fs.Output.Bytes()
+			for {
 
-			tval = string(string(outBuf))
-			j.Message = &tval
+				var tmpJSearchResults *SearchResult
 
-		}
-	}
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
 
-	state = fflib.FFParse_after_value
-	goto mainparse
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
 
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
+				/* handler: tmpJSearchResults type=*model.SearchResult kind=ptr quoted=false*/
 
-	return nil
-}
+				{
+					if tok == fflib.FFTok_null {
 
-// MarshalJSON marshal bytes to json - template
-func (j *Metadata) MarshalJSON() ([]byte, error) {
-	var buf fflib.Buffer
-	if j == nil {
-		buf.WriteString("null")
-		return buf.Bytes(), nil
-	}
-	err := j.MarshalJSONBuf(&buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
+						tmpJSearchResults = nil
 
-// MarshalJSONBuf marshal buff to json - template
-func (j *Metadata) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
-	if j == nil {
-		buf.WriteString("null")
-		return nil
-	}
-	var err error
-	var obj []byte
-	_ = obj
-	_ = err
-	buf.WriteString(`{"title":`)
-	fflib.WriteJsonString(buf, string(j.Title))
-	buf.WriteString(`,"content":`)
-	fflib.WriteJsonString(buf, string(j.Content))
-	buf.WriteByte('}')
-	return nil
-}
+					} else {
 
-const (
-	ffjtMetadatabase = iota
-	ffjtMetadatanosuchkey
+						if tmpJSearchResults == nil {
+							tmpJSearchResults = new(SearchResult)
+						}
 
-	ffjtMetadataTitle
+						err = tmpJSearchResults.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
 
-	ffjtMetadataContent
-)
+				j.SearchResults = append(j.SearchResults, tmpJSearchResults)
 
-var ffjKeyMetadataTitle = []byte("title")
+				wantVal = false
+			}
+		}
+	}
 
-var ffjKeyMetadataContent = []byte("content")
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-// UnmarshalJSON umarshall json - template of ffjson
-func (j *Metadata) UnmarshalJSON(input []byte) error {
-	fs := fflib.NewFFLexer(input)
-	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
-}
+handle_Page:
 
-// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Metadata) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
-	var err error
-	currentKey := ffjtMetadatabase
-	_ = currentKey
-	tok := fflib.FFTok_init
-	wantedTok := fflib.FFTok_init
+	/* handler: j.Page type=model.Page kind=struct quoted=false*/
 
-mainparse:
-	for {
-		tok = fs.Scan()
-		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
-		if tok == fflib.FFTok_error {
-			goto tokerror
-		}
+	{
+		if tok == fflib.FFTok_null {
 
-		switch state {
+			j.Page = nil
 
-		case fflib.FFParse_map_start:
-			if tok != fflib.FFTok_left_bracket {
-				wantedTok = fflib.FFTok_left_bracket
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_key
-			continue
+		} else {
 
-		case fflib.FFParse_after_value:
-			if tok == fflib.FFTok_comma {
-				state = fflib.FFParse_want_key
-			} else if tok == fflib.FFTok_right_bracket {
-				goto done
-			} else {
-				wantedTok = fflib.FFTok_comma
-				goto wrongtokenerror
+			if j.Page == nil {
+				j.Page = new(Page)
 			}
 
-		case fflib.FFParse_want_key:
-			// json {} ended. goto exit. woo.
-			if tok == fflib.FFTok_right_bracket {
-				goto done
+			err = j.Page.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
 			}
-			if tok != fflib.FFTok_string {
-				wantedTok = fflib.FFTok_string
-				goto wrongtokenerror
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Schedules:
+
+	/* handler: j.Schedules type=[]*model.Schedule kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
+		}
 
-			kn := fs.Output.Bytes()
-			if len(kn) <= 0 {
-				// "" case. hrm.
-				currentKey = ffjtMetadatanosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			} else {
-				switch kn[0] {
+		if tok == fflib.FFTok_null {
+			j.Schedules = nil
+		} else {
 
-				case 'c':
+			j.Schedules = []*Schedule{}
 
-					if bytes.Equal(ffjKeyMetadataContent, kn) {
-						currentKey = ffjtMetadataContent
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+			wantVal := true
 
-				case 't':
+			for {
 
-					if bytes.Equal(ffjKeyMetadataTitle, kn) {
-						currentKey = ffjtMetadataTitle
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+				var tmpJSchedules *Schedule
 
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
 				}
-
-				if fflib.SimpleLetterEqualFold(ffjKeyMetadataContent, kn) {
-					currentKey = ffjtMetadataContent
-					state = fflib.FFParse_want_colon
-					goto mainparse
+				if tok == fflib.FFTok_right_brace {
+					break
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyMetadataTitle, kn) {
-					currentKey = ffjtMetadataTitle
-					state = fflib.FFParse_want_colon
-					goto mainparse
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
 				}
 
-				currentKey = ffjtMetadatanosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			}
+				/* handler: tmpJSchedules type=*model.Schedule kind=ptr quoted=false*/
 
-		case fflib.FFParse_want_colon:
-			if tok != fflib.FFTok_colon {
-				wantedTok = fflib.FFTok_colon
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_value
-			continue
-		case fflib.FFParse_want_value:
+				{
+					if tok == fflib.FFTok_null {
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
-				switch currentKey {
+						tmpJSchedules = nil
 
-				case ffjtMetadataTitle:
-					goto handle_Title
+					} else {
 
-				case ffjtMetadataContent:
-					goto handle_Content
+						if tmpJSchedules == nil {
+							tmpJSchedules = new(Schedule)
+						}
 
-				case ffjtMetadatanosuchkey:
-					err = fs.SkipField(tok)
-					if err != nil {
-						return fs.WrapErr(err)
+						err = tmpJSchedules.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
 					}
 					state = fflib.FFParse_after_value
-					goto mainparse
 				}
-			} else {
-				goto wantedvalue
+
+				j.Schedules = append(j.Schedules, tmpJSchedules)
+
+				wantVal = false
 			}
 		}
 	}
 
-handle_Title:
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-	/* handler: j.Title type=string kind=string quoted=false*/
+handle_Prerequisite:
+
+	/* handler: j.Prerequisite type=model.Prerequisite kind=struct quoted=false*/
 
 	{
+		if tok == fflib.FFTok_null {
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			j.Prerequisite = nil
+
+		} else {
+
+			if j.Prerequisite == nil {
+				j.Prerequisite = new(Prerequisite)
+			}
+
+			err = j.Prerequisite.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
 			}
 		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_InstructorProfile:
+
+	/* handler: j.InstructorProfile type=model.InstructorProfile kind=struct quoted=false*/
 
+	{
 		if tok == fflib.FFTok_null {
 
-		} else {
+			j.InstructorProfile = nil
 
-			outBuf := fs.Output.Bytes()
+		} else {
 
-			j.Title = string(string(outBuf))
+			if j.InstructorProfile == nil {
+				j.InstructorProfile = new(InstructorProfile)
+			}
 
+			err = j.InstructorProfile.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
 		}
+		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Content:
+handle_Buildings:
 
-	/* handler: j.Content type=string kind=string quoted=false*/
+	/* handler: j.Buildings type=[]*model.Building kind=slice quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-
+			j.Buildings = nil
 		} else {
 
-			outBuf := fs.Output.Bytes()
+			j.Buildings = []*Building{}
 
-			j.Content = string(string(outBuf))
+			wantVal := true
+
+			for {
+
+				var tmpJBuildings *Building
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJBuildings type=*model.Building kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJBuildings = nil
+
+					} else {
+
+						if tmpJBuildings == nil {
+							tmpJBuildings = new(Building)
+						}
+
+						err = tmpJBuildings.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
 
+				j.Buildings = append(j.Buildings, tmpJBuildings)
+
+				wantVal = false
+			}
 		}
 	}
 
@@ -3219,7 +3387,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Registration) MarshalJSON() ([]byte, error) {
+func (j *Envelope) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -3233,7 +3401,7 @@ func (j *Registration) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Registration) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Envelope) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -3242,37 +3410,55 @@ func (j *Registration) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"period":`)
-	fflib.WriteJsonString(buf, string(j.Period))
-	buf.WriteString(`,"period_date":`)
-	fflib.FormatBits2(buf, uint64(j.PeriodDate), 10, j.PeriodDate < 0)
+	buf.WriteString(`{"schema_version":`)
+	fflib.WriteJsonString(buf, string(j.SchemaVersion))
+	buf.WriteString(`,"producer":`)
+	fflib.WriteJsonString(buf, string(j.Producer))
+	buf.WriteString(`,"scraped_at":`)
+	fflib.FormatBits2(buf, uint64(j.ScrapedAt), 10, j.ScrapedAt < 0)
+	buf.WriteString(`,"content_hash":`)
+	fflib.WriteJsonString(buf, string(j.ContentHash))
+	buf.WriteString(`,"format":`)
+	fflib.WriteJsonString(buf, string(j.Format))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtRegistrationbase = iota
-	ffjtRegistrationnosuchkey
+	ffjtEnvelopebase = iota
+	ffjtEnvelopenosuchkey
 
-	ffjtRegistrationPeriod
+	ffjtEnvelopeSchemaVersion
 
-	ffjtRegistrationPeriodDate
+	ffjtEnvelopeProducer
+
+	ffjtEnvelopeScrapedAt
+
+	ffjtEnvelopeContentHash
+
+	ffjtEnvelopeFormat
 )
 
-var ffjKeyRegistrationPeriod = []byte("period")
+var ffjKeyEnvelopeSchemaVersion = []byte("schema_version")
 
-var ffjKeyRegistrationPeriodDate = []byte("period_date")
+var ffjKeyEnvelopeProducer = []byte("producer")
+
+var ffjKeyEnvelopeScrapedAt = []byte("scraped_at")
+
+var ffjKeyEnvelopeContentHash = []byte("content_hash")
+
+var ffjKeyEnvelopeFormat = []byte("format")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Registration) UnmarshalJSON(input []byte) error {
+func (j *Envelope) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Registration) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Envelope) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtRegistrationbase
+	currentKey := ffjtEnvelopebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -3318,40 +3504,82 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtRegistrationnosuchkey
+				currentKey = ffjtEnvelopenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'p':
+				case 'c':
 
-					if bytes.Equal(ffjKeyRegistrationPeriod, kn) {
-						currentKey = ffjtRegistrationPeriod
+					if bytes.Equal(ffjKeyEnvelopeContentHash, kn) {
+						currentKey = ffjtEnvelopeContentHash
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeyRegistrationPeriodDate, kn) {
-						currentKey = ffjtRegistrationPeriodDate
+				case 'f':
+
+					if bytes.Equal(ffjKeyEnvelopeFormat, kn) {
+						currentKey = ffjtEnvelopeFormat
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				}
+				case 'p':
 
-				if fflib.AsciiEqualFold(ffjKeyRegistrationPeriodDate, kn) {
-					currentKey = ffjtRegistrationPeriodDate
+					if bytes.Equal(ffjKeyEnvelopeProducer, kn) {
+						currentKey = ffjtEnvelopeProducer
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyEnvelopeSchemaVersion, kn) {
+						currentKey = ffjtEnvelopeSchemaVersion
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyEnvelopeScrapedAt, kn) {
+						currentKey = ffjtEnvelopeScrapedAt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyEnvelopeFormat, kn) {
+					currentKey = ffjtEnvelopeFormat
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyRegistrationPeriod, kn) {
-					currentKey = ffjtRegistrationPeriod
+				if fflib.EqualFoldRight(ffjKeyEnvelopeContentHash, kn) {
+					currentKey = ffjtEnvelopeContentHash
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtRegistrationnosuchkey
+				if fflib.EqualFoldRight(ffjKeyEnvelopeScrapedAt, kn) {
+					currentKey = ffjtEnvelopeScrapedAt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyEnvelopeProducer, kn) {
+					currentKey = ffjtEnvelopeProducer
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyEnvelopeSchemaVersion, kn) {
+					currentKey = ffjtEnvelopeSchemaVersion
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtEnvelopenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -3368,13 +3596,22 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtRegistrationPeriod:
-					goto handle_Period
+				case ffjtEnvelopeSchemaVersion:
+					goto handle_SchemaVersion
 
-				case ffjtRegistrationPeriodDate:
-					goto handle_PeriodDate
+				case ffjtEnvelopeProducer:
+					goto handle_Producer
 
-				case ffjtRegistrationnosuchkey:
+				case ffjtEnvelopeScrapedAt:
+					goto handle_ScrapedAt
+
+				case ffjtEnvelopeContentHash:
+					goto handle_ContentHash
+
+				case ffjtEnvelopeFormat:
+					goto handle_Format
+
+				case ffjtEnvelopenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -3388,9 +3625,9 @@ mainparse:
 		}
 	}
 
-handle_Period:
+handle_SchemaVersion:
 
-	/* handler: j.Period type=string kind=string quoted=false*/
+	/* handler: j.SchemaVersion type=string kind=string quoted=false*/
 
 	{
 
@@ -3406,7 +3643,7 @@ handle_Period:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Period = string(string(outBuf))
+			j.SchemaVersion = string(string(outBuf))
 
 		}
 	}
@@ -3414,9 +3651,35 @@ handle_Period:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_PeriodDate:
+handle_Producer:
 
-	/* handler: j.PeriodDate type=int64 kind=int64 quoted=false*/
+	/* handler: j.Producer type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Producer = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ScrapedAt:
+
+	/* handler: j.ScrapedAt type=int64 kind=int64 quoted=false*/
 
 	{
 		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
@@ -3436,7 +3699,59 @@ handle_PeriodDate:
 				return fs.WrapErr(err)
 			}
 
-			j.PeriodDate = int64(tval)
+			j.ScrapedAt = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ContentHash:
+
+	/* handler: j.ContentHash type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ContentHash = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Format:
+
+	/* handler: j.Format type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Format = string(string(outBuf))
 
 		}
 	}
@@ -3463,7 +3778,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *ResolvedSemester) MarshalJSON() ([]byte, error) {
+func (j *Instructor) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -3477,7 +3792,7 @@ func (j *ResolvedSemester) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *ResolvedSemester) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Instructor) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -3486,84 +3801,37 @@ func (j *ResolvedSemester) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ `)
-	if j.Current != nil {
-		if true {
-			buf.WriteString(`"current":`)
-
-			{
-
-				err = j.Current.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.Last != nil {
-		if true {
-			buf.WriteString(`"last":`)
-
-			{
-
-				err = j.Last.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.Next != nil {
-		if true {
-			buf.WriteString(`"next":`)
-
-			{
-
-				err = j.Next.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
-
-			}
-			buf.WriteByte(',')
-		}
-	}
-	buf.Rewind(1)
+	buf.WriteString(`{"name":`)
+	fflib.WriteJsonString(buf, string(j.Name))
+	buf.WriteString(`,"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtResolvedSemesterbase = iota
-	ffjtResolvedSemesternosuchkey
-
-	ffjtResolvedSemesterCurrent
+	ffjtInstructorbase = iota
+	ffjtInstructornosuchkey
 
-	ffjtResolvedSemesterLast
+	ffjtInstructorName
 
-	ffjtResolvedSemesterNext
+	ffjtInstructorTopicName
 )
 
-var ffjKeyResolvedSemesterCurrent = []byte("current")
-
-var ffjKeyResolvedSemesterLast = []byte("last")
+var ffjKeyInstructorName = []byte("name")
 
-var ffjKeyResolvedSemesterNext = []byte("next")
+var ffjKeyInstructorTopicName = []byte("topic_name")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *ResolvedSemester) UnmarshalJSON(input []byte) error {
+func (j *Instructor) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *ResolvedSemester) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Instructor) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtResolvedSemesterbase
+	currentKey := ffjtInstructorbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -3609,57 +3877,43 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtResolvedSemesternosuchkey
+				currentKey = ffjtInstructornosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'c':
-
-					if bytes.Equal(ffjKeyResolvedSemesterCurrent, kn) {
-						currentKey = ffjtResolvedSemesterCurrent
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'l':
+				case 'n':
 
-					if bytes.Equal(ffjKeyResolvedSemesterLast, kn) {
-						currentKey = ffjtResolvedSemesterLast
+					if bytes.Equal(ffjKeyInstructorName, kn) {
+						currentKey = ffjtInstructorName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'n':
+				case 't':
 
-					if bytes.Equal(ffjKeyResolvedSemesterNext, kn) {
-						currentKey = ffjtResolvedSemesterNext
+					if bytes.Equal(ffjKeyInstructorTopicName, kn) {
+						currentKey = ffjtInstructorTopicName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyResolvedSemesterNext, kn) {
-					currentKey = ffjtResolvedSemesterNext
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeyResolvedSemesterLast, kn) {
-					currentKey = ffjtResolvedSemesterLast
+				if fflib.AsciiEqualFold(ffjKeyInstructorTopicName, kn) {
+					currentKey = ffjtInstructorTopicName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyResolvedSemesterCurrent, kn) {
-					currentKey = ffjtResolvedSemesterCurrent
+				if fflib.SimpleLetterEqualFold(ffjKeyInstructorName, kn) {
+					currentKey = ffjtInstructorName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtResolvedSemesternosuchkey
+				currentKey = ffjtInstructornosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -3676,16 +3930,13 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtResolvedSemesterCurrent:
-					goto handle_Current
-
-				case ffjtResolvedSemesterLast:
-					goto handle_Last
+				case ffjtInstructorName:
+					goto handle_Name
 
-				case ffjtResolvedSemesterNext:
-					goto handle_Next
+				case ffjtInstructorTopicName:
+					goto handle_TopicName
 
-				case ffjtResolvedSemesternosuchkey:
+				case ffjtInstructornosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -3699,79 +3950,53 @@ mainparse:
 		}
 	}
 
-handle_Current:
+handle_Name:
 
-	/* handler: j.Current type=model.Semester kind=struct quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
 
-			j.Current = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
 
 		} else {
 
-			if j.Current == nil {
-				j.Current = new(Semester)
-			}
+			outBuf := fs.Output.Bytes()
 
-			err = j.Current.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
-		}
-		state = fflib.FFParse_after_value
+			j.Name = string(string(outBuf))
+
+		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Last:
+handle_TopicName:
 
-	/* handler: j.Last type=model.Semester kind=struct quoted=false*/
+	/* handler: j.TopicName type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
-
-			j.Last = nil
-
-		} else {
-
-			if j.Last == nil {
-				j.Last = new(Semester)
-			}
 
-			err = j.Last.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
-		state = fflib.FFParse_after_value
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Next:
-
-	/* handler: j.Next type=model.Semester kind=struct quoted=false*/
 
-	{
 		if tok == fflib.FFTok_null {
 
-			j.Next = nil
-
 		} else {
 
-			if j.Next == nil {
-				j.Next = new(Semester)
-			}
+			outBuf := fs.Output.Bytes()
+
+			j.TopicName = string(string(outBuf))
 
-			err = j.Next.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
 		}
-		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
@@ -3796,7 +4021,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Response) MarshalJSON() ([]byte, error) {
+func (j *InstructorProfile) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -3810,7 +4035,7 @@ func (j *Response) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Response) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *InstructorProfile) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -3819,36 +4044,40 @@ func (j *Response) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ `)
-	if j.Meta != nil {
-		if true {
-			buf.WriteString(`"meta":`)
+	buf.WriteString(`{ "name":`)
+	fflib.WriteJsonString(buf, string(j.Name))
+	buf.WriteString(`,"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
+	buf.WriteByte(',')
+	if len(j.Teaching) != 0 {
+		buf.WriteString(`"teaching":`)
+		if j.Teaching != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Teaching {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
 
-			{
+				{
 
-				err = j.Meta.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
-				}
+					if v == nil {
+						buf.WriteString("null")
+					} else {
 
-			}
-			buf.WriteByte(',')
-		}
-	}
-	if j.Data != nil {
-		if true {
-			buf.WriteString(`"data":`)
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
 
-			{
+					}
 
-				err = j.Data.MarshalJSONBuf(buf)
-				if err != nil {
-					return err
 				}
-
 			}
-			buf.WriteByte(',')
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
 		}
+		buf.WriteByte(',')
 	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
@@ -3856,28 +4085,32 @@ func (j *Response) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 }
 
 const (
-	ffjtResponsebase = iota
-	ffjtResponsenosuchkey
+	ffjtInstructorProfilebase = iota
+	ffjtInstructorProfilenosuchkey
 
-	ffjtResponseMeta
+	ffjtInstructorProfileName
 
-	ffjtResponseData
+	ffjtInstructorProfileTopicName
+
+	ffjtInstructorProfileTeaching
 )
 
-var ffjKeyResponseMeta = []byte("meta")
+var ffjKeyInstructorProfileName = []byte("name")
 
-var ffjKeyResponseData = []byte("data")
+var ffjKeyInstructorProfileTopicName = []byte("topic_name")
+
+var ffjKeyInstructorProfileTeaching = []byte("teaching")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Response) UnmarshalJSON(input []byte) error {
+func (j *InstructorProfile) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Response) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *InstructorProfile) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtResponsebase
+	currentKey := ffjtInstructorProfilebase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -3923,43 +4156,54 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtResponsenosuchkey
+				currentKey = ffjtInstructorProfilenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'd':
+				case 'n':
 
-					if bytes.Equal(ffjKeyResponseData, kn) {
-						currentKey = ffjtResponseData
+					if bytes.Equal(ffjKeyInstructorProfileName, kn) {
+						currentKey = ffjtInstructorProfileName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'm':
+				case 't':
 
-					if bytes.Equal(ffjKeyResponseMeta, kn) {
-						currentKey = ffjtResponseMeta
+					if bytes.Equal(ffjKeyInstructorProfileTopicName, kn) {
+						currentKey = ffjtInstructorProfileTopicName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyInstructorProfileTeaching, kn) {
+						currentKey = ffjtInstructorProfileTeaching
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyResponseData, kn) {
-					currentKey = ffjtResponseData
+				if fflib.SimpleLetterEqualFold(ffjKeyInstructorProfileTeaching, kn) {
+					currentKey = ffjtInstructorProfileTeaching
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeyResponseMeta, kn) {
-					currentKey = ffjtResponseMeta
+				if fflib.AsciiEqualFold(ffjKeyInstructorProfileTopicName, kn) {
+					currentKey = ffjtInstructorProfileTopicName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtResponsenosuchkey
+				if fflib.SimpleLetterEqualFold(ffjKeyInstructorProfileName, kn) {
+					currentKey = ffjtInstructorProfileName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtInstructorProfilenosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -3976,13 +4220,16 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtResponseMeta:
-					goto handle_Meta
+				case ffjtInstructorProfileName:
+					goto handle_Name
 
-				case ffjtResponseData:
-					goto handle_Data
+				case ffjtInstructorProfileTopicName:
+					goto handle_TopicName
 
-				case ffjtResponsenosuchkey:
+				case ffjtInstructorProfileTeaching:
+					goto handle_Teaching
+
+				case ffjtInstructorProfilenosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -3996,53 +4243,127 @@ mainparse:
 		}
 	}
 
-handle_Meta:
+handle_Name:
 
-	/* handler: j.Meta type=model.Meta kind=struct quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
 
-			j.Meta = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
 
 		} else {
 
-			if j.Meta == nil {
-				j.Meta = new(Meta)
-			}
+			outBuf := fs.Output.Bytes()
+
+			j.Name = string(string(outBuf))
 
-			err = j.Meta.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
-			}
 		}
-		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Data:
+handle_TopicName:
 
-	/* handler: j.Data type=model.Data kind=struct quoted=false*/
+	/* handler: j.TopicName type=string kind=string quoted=false*/
 
 	{
-		if tok == fflib.FFTok_null {
 
-			j.Data = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
 
 		} else {
 
-			if j.Data == nil {
-				j.Data = new(Data)
+			outBuf := fs.Output.Bytes()
+
+			j.TopicName = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Teaching:
+
+	/* handler: j.Teaching type=[]*model.Teaching kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
+		}
 
-			err = j.Data.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-			if err != nil {
-				return err
+		if tok == fflib.FFTok_null {
+			j.Teaching = nil
+		} else {
+
+			j.Teaching = []*Teaching{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJTeaching *Teaching
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJTeaching type=*model.Teaching kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJTeaching = nil
+
+					} else {
+
+						if tmpJTeaching == nil {
+							tmpJTeaching = new(Teaching)
+						}
+
+						err = tmpJTeaching.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Teaching = append(j.Teaching, tmpJTeaching)
+
+				wantVal = false
 			}
 		}
-		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
@@ -4067,7 +4388,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Section) MarshalJSON() ([]byte, error) {
+func (j *Meeting) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -4081,7 +4402,7 @@ func (j *Section) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Section) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Meeting) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -4090,28 +4411,50 @@ func (j *Section) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ "number":`)
-	fflib.WriteJsonString(buf, string(j.Number))
-	buf.WriteString(`,"call_number":`)
-	fflib.WriteJsonString(buf, string(j.CallNumber))
-	buf.WriteString(`,"max":`)
-	fflib.FormatBits2(buf, uint64(j.Max), 10, j.Max < 0)
-	buf.WriteString(`,"now":`)
-	fflib.FormatBits2(buf, uint64(j.Now), 10, j.Now < 0)
-	buf.WriteString(`,"status":`)
-	fflib.WriteJsonString(buf, string(j.Status))
-	buf.WriteString(`,"credits":`)
-	fflib.WriteJsonString(buf, string(j.Credits))
-	buf.WriteString(`,"topic_name":`)
-	fflib.WriteJsonString(buf, string(j.TopicName))
-	buf.WriteString(`,"topic_id":`)
-	fflib.WriteJsonString(buf, string(j.TopicId))
+	buf.WriteByte('{')
+	if j.Room != nil {
+		if true {
+			buf.WriteString(`"room":`)
+			fflib.WriteJsonString(buf, string(*j.Room))
+			buf.WriteByte(',')
+		}
+	}
+	if j.Day != nil {
+		if true {
+			buf.WriteString(`"day":`)
+			fflib.WriteJsonString(buf, string(*j.Day))
+			buf.WriteByte(',')
+		}
+	}
+	if j.StartTime != nil {
+		if true {
+			buf.WriteString(`"start_time":`)
+			fflib.WriteJsonString(buf, string(*j.StartTime))
+			buf.WriteByte(',')
+		}
+	}
+	if j.EndTime != nil {
+		if true {
+			buf.WriteString(`"end_time":`)
+			fflib.WriteJsonString(buf, string(*j.EndTime))
+			buf.WriteByte(',')
+		}
+	}
+	if j.ClassType != nil {
+		if true {
+			buf.WriteString(`"class_type":`)
+			fflib.WriteJsonString(buf, string(*j.ClassType))
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteString(`"index":`)
+	fflib.FormatBits2(buf, uint64(j.Index), 10, j.Index < 0)
 	buf.WriteByte(',')
-	if len(j.Meetings) != 0 {
-		buf.WriteString(`"meetings":`)
-		if j.Meetings != nil {
+	if len(j.Metadata) != 0 {
+		buf.WriteString(`"metadata":`)
+		if j.Metadata != nil {
 			buf.WriteString(`[`)
-			for i, v := range j.Meetings {
+			for i, v := range j.Metadata {
 				if i != 0 {
 					buf.WriteString(`,`)
 				}
@@ -4137,164 +4480,133 @@ func (j *Section) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
-	if len(j.Instructors) != 0 {
-		buf.WriteString(`"instructors":`)
-		if j.Instructors != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Instructors {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
+	if j.Weekday != nil {
+		if true {
+			buf.WriteString(`"weekday":`)
+			fflib.FormatBits2(buf, uint64(*j.Weekday), 10, *j.Weekday < 0)
+			buf.WriteByte(',')
 		}
-		buf.WriteByte(',')
 	}
-	if len(j.Books) != 0 {
-		buf.WriteString(`"books":`)
-		if j.Books != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Books {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
+	if j.StartMinute != nil {
+		if true {
+			buf.WriteString(`"start_minute":`)
+			fflib.FormatBits2(buf, uint64(*j.StartMinute), 10, *j.StartMinute < 0)
+			buf.WriteByte(',')
 		}
-		buf.WriteByte(',')
 	}
-	if len(j.Metadata) != 0 {
-		buf.WriteString(`"metadata":`)
-		if j.Metadata != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Metadata {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
+	if j.Duration != nil {
+		if true {
+			buf.WriteString(`"duration":`)
+			fflib.FormatBits2(buf, uint64(*j.Duration), 10, *j.Duration < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.BuildingCode != nil {
+		if true {
+			buf.WriteString(`"building_code":`)
+			fflib.WriteJsonString(buf, string(*j.BuildingCode))
+			buf.WriteByte(',')
+		}
+	}
+	if j.RoomNumber != nil {
+		if true {
+			buf.WriteString(`"room_number":`)
+			fflib.WriteJsonString(buf, string(*j.RoomNumber))
+			buf.WriteByte(',')
+		}
+	}
+	if j.Building != nil {
+		if true {
+			buf.WriteString(`"building":`)
 
-					}
+			{
 
+				err = j.Building.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
 				}
+
 			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
+			buf.WriteByte(',')
 		}
-		buf.WriteByte(',')
 	}
-	buf.Rewind(1)
+	buf.WriteString(`"content_hash":`)
+	fflib.WriteJsonString(buf, string(j.ContentHash))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtSectionbase = iota
-	ffjtSectionnosuchkey
+	ffjtMeetingbase = iota
+	ffjtMeetingnosuchkey
 
-	ffjtSectionNumber
+	ffjtMeetingRoom
 
-	ffjtSectionCallNumber
+	ffjtMeetingDay
 
-	ffjtSectionMax
+	ffjtMeetingStartTime
 
-	ffjtSectionNow
+	ffjtMeetingEndTime
 
-	ffjtSectionStatus
+	ffjtMeetingClassType
 
-	ffjtSectionCredits
+	ffjtMeetingIndex
 
-	ffjtSectionTopicName
+	ffjtMeetingMetadata
 
-	ffjtSectionTopicId
+	ffjtMeetingWeekday
 
-	ffjtSectionMeetings
+	ffjtMeetingStartMinute
 
-	ffjtSectionInstructors
+	ffjtMeetingDuration
 
-	ffjtSectionBooks
+	ffjtMeetingBuildingCode
 
-	ffjtSectionMetadata
+	ffjtMeetingRoomNumber
+
+	ffjtMeetingBuilding
+
+	ffjtMeetingContentHash
 )
 
-var ffjKeySectionNumber = []byte("number")
+var ffjKeyMeetingRoom = []byte("room")
 
-var ffjKeySectionCallNumber = []byte("call_number")
+var ffjKeyMeetingDay = []byte("day")
 
-var ffjKeySectionMax = []byte("max")
+var ffjKeyMeetingStartTime = []byte("start_time")
 
-var ffjKeySectionNow = []byte("now")
+var ffjKeyMeetingEndTime = []byte("end_time")
 
-var ffjKeySectionStatus = []byte("status")
+var ffjKeyMeetingClassType = []byte("class_type")
 
-var ffjKeySectionCredits = []byte("credits")
+var ffjKeyMeetingIndex = []byte("index")
 
-var ffjKeySectionTopicName = []byte("topic_name")
+var ffjKeyMeetingMetadata = []byte("metadata")
 
-var ffjKeySectionTopicId = []byte("topic_id")
+var ffjKeyMeetingWeekday = []byte("weekday")
 
-var ffjKeySectionMeetings = []byte("meetings")
+var ffjKeyMeetingStartMinute = []byte("start_minute")
 
-var ffjKeySectionInstructors = []byte("instructors")
+var ffjKeyMeetingDuration = []byte("duration")
 
-var ffjKeySectionBooks = []byte("books")
+var ffjKeyMeetingBuildingCode = []byte("building_code")
 
-var ffjKeySectionMetadata = []byte("metadata")
+var ffjKeyMeetingRoomNumber = []byte("room_number")
+
+var ffjKeyMeetingBuilding = []byte("building")
+
+var ffjKeyMeetingContentHash = []byte("content_hash")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Section) UnmarshalJSON(input []byte) error {
+func (j *Meeting) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Section) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Meeting) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtSectionbase
+	currentKey := ffjtMeetingbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -4340,7 +4652,7 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtSectionnosuchkey
+				currentKey = ffjtMeetingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
@@ -4348,160 +4660,188 @@ mainparse:
 
 				case 'b':
 
-					if bytes.Equal(ffjKeySectionBooks, kn) {
-						currentKey = ffjtSectionBooks
+					if bytes.Equal(ffjKeyMeetingBuildingCode, kn) {
+						currentKey = ffjtMeetingBuildingCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyMeetingBuilding, kn) {
+						currentKey = ffjtMeetingBuilding
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 'c':
 
-					if bytes.Equal(ffjKeySectionCallNumber, kn) {
-						currentKey = ffjtSectionCallNumber
+					if bytes.Equal(ffjKeyMeetingClassType, kn) {
+						currentKey = ffjtMeetingClassType
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeySectionCredits, kn) {
-						currentKey = ffjtSectionCredits
+					} else if bytes.Equal(ffjKeyMeetingContentHash, kn) {
+						currentKey = ffjtMeetingContentHash
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'i':
+				case 'd':
 
-					if bytes.Equal(ffjKeySectionInstructors, kn) {
-						currentKey = ffjtSectionInstructors
+					if bytes.Equal(ffjKeyMeetingDay, kn) {
+						currentKey = ffjtMeetingDay
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyMeetingDuration, kn) {
+						currentKey = ffjtMeetingDuration
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'm':
+				case 'e':
 
-					if bytes.Equal(ffjKeySectionMax, kn) {
-						currentKey = ffjtSectionMax
+					if bytes.Equal(ffjKeyMeetingEndTime, kn) {
+						currentKey = ffjtMeetingEndTime
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeySectionMeetings, kn) {
-						currentKey = ffjtSectionMeetings
+				case 'i':
+
+					if bytes.Equal(ffjKeyMeetingIndex, kn) {
+						currentKey = ffjtMeetingIndex
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeySectionMetadata, kn) {
-						currentKey = ffjtSectionMetadata
+				case 'm':
+
+					if bytes.Equal(ffjKeyMeetingMetadata, kn) {
+						currentKey = ffjtMeetingMetadata
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'n':
+				case 'r':
 
-					if bytes.Equal(ffjKeySectionNumber, kn) {
-						currentKey = ffjtSectionNumber
+					if bytes.Equal(ffjKeyMeetingRoom, kn) {
+						currentKey = ffjtMeetingRoom
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeySectionNow, kn) {
-						currentKey = ffjtSectionNow
+					} else if bytes.Equal(ffjKeyMeetingRoomNumber, kn) {
+						currentKey = ffjtMeetingRoomNumber
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 's':
 
-					if bytes.Equal(ffjKeySectionStatus, kn) {
-						currentKey = ffjtSectionStatus
+					if bytes.Equal(ffjKeyMeetingStartTime, kn) {
+						currentKey = ffjtMeetingStartTime
 						state = fflib.FFParse_want_colon
 						goto mainparse
-					}
-
-				case 't':
 
-					if bytes.Equal(ffjKeySectionTopicName, kn) {
-						currentKey = ffjtSectionTopicName
+					} else if bytes.Equal(ffjKeyMeetingStartMinute, kn) {
+						currentKey = ffjtMeetingStartMinute
 						state = fflib.FFParse_want_colon
 						goto mainparse
+					}
 
-					} else if bytes.Equal(ffjKeySectionTopicId, kn) {
-						currentKey = ffjtSectionTopicId
+				case 'w':
+
+					if bytes.Equal(ffjKeyMeetingWeekday, kn) {
+						currentKey = ffjtMeetingWeekday
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySectionMetadata, kn) {
-					currentKey = ffjtSectionMetadata
+				if fflib.EqualFoldRight(ffjKeyMeetingContentHash, kn) {
+					currentKey = ffjtMeetingContentHash
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySectionBooks, kn) {
-					currentKey = ffjtSectionBooks
+				if fflib.SimpleLetterEqualFold(ffjKeyMeetingBuilding, kn) {
+					currentKey = ffjtMeetingBuilding
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySectionInstructors, kn) {
-					currentKey = ffjtSectionInstructors
+				if fflib.AsciiEqualFold(ffjKeyMeetingRoomNumber, kn) {
+					currentKey = ffjtMeetingRoomNumber
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySectionMeetings, kn) {
-					currentKey = ffjtSectionMeetings
+				if fflib.AsciiEqualFold(ffjKeyMeetingBuildingCode, kn) {
+					currentKey = ffjtMeetingBuildingCode
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeySectionTopicId, kn) {
-					currentKey = ffjtSectionTopicId
+				if fflib.SimpleLetterEqualFold(ffjKeyMeetingDuration, kn) {
+					currentKey = ffjtMeetingDuration
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeySectionTopicName, kn) {
-					currentKey = ffjtSectionTopicName
+				if fflib.EqualFoldRight(ffjKeyMeetingStartMinute, kn) {
+					currentKey = ffjtMeetingStartMinute
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySectionCredits, kn) {
-					currentKey = ffjtSectionCredits
+				if fflib.EqualFoldRight(ffjKeyMeetingWeekday, kn) {
+					currentKey = ffjtMeetingWeekday
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySectionStatus, kn) {
-					currentKey = ffjtSectionStatus
+				if fflib.SimpleLetterEqualFold(ffjKeyMeetingMetadata, kn) {
+					currentKey = ffjtMeetingMetadata
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySectionNow, kn) {
-					currentKey = ffjtSectionNow
+				if fflib.SimpleLetterEqualFold(ffjKeyMeetingIndex, kn) {
+					currentKey = ffjtMeetingIndex
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySectionMax, kn) {
-					currentKey = ffjtSectionMax
+				if fflib.EqualFoldRight(ffjKeyMeetingClassType, kn) {
+					currentKey = ffjtMeetingClassType
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeySectionCallNumber, kn) {
-					currentKey = ffjtSectionCallNumber
+				if fflib.AsciiEqualFold(ffjKeyMeetingEndTime, kn) {
+					currentKey = ffjtMeetingEndTime
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySectionNumber, kn) {
-					currentKey = ffjtSectionNumber
+				if fflib.EqualFoldRight(ffjKeyMeetingStartTime, kn) {
+					currentKey = ffjtMeetingStartTime
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtSectionnosuchkey
+				if fflib.SimpleLetterEqualFold(ffjKeyMeetingDay, kn) {
+					currentKey = ffjtMeetingDay
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyMeetingRoom, kn) {
+					currentKey = ffjtMeetingRoom
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtMeetingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -4518,43 +4858,49 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtSectionNumber:
-					goto handle_Number
+				case ffjtMeetingRoom:
+					goto handle_Room
 
-				case ffjtSectionCallNumber:
-					goto handle_CallNumber
+				case ffjtMeetingDay:
+					goto handle_Day
 
-				case ffjtSectionMax:
-					goto handle_Max
+				case ffjtMeetingStartTime:
+					goto handle_StartTime
 
-				case ffjtSectionNow:
-					goto handle_Now
+				case ffjtMeetingEndTime:
+					goto handle_EndTime
 
-				case ffjtSectionStatus:
-					goto handle_Status
+				case ffjtMeetingClassType:
+					goto handle_ClassType
 
-				case ffjtSectionCredits:
-					goto handle_Credits
+				case ffjtMeetingIndex:
+					goto handle_Index
 
-				case ffjtSectionTopicName:
-					goto handle_TopicName
+				case ffjtMeetingMetadata:
+					goto handle_Metadata
 
-				case ffjtSectionTopicId:
-					goto handle_TopicId
+				case ffjtMeetingWeekday:
+					goto handle_Weekday
 
-				case ffjtSectionMeetings:
-					goto handle_Meetings
+				case ffjtMeetingStartMinute:
+					goto handle_StartMinute
 
-				case ffjtSectionInstructors:
-					goto handle_Instructors
+				case ffjtMeetingDuration:
+					goto handle_Duration
 
-				case ffjtSectionBooks:
-					goto handle_Books
+				case ffjtMeetingBuildingCode:
+					goto handle_BuildingCode
 
-				case ffjtSectionMetadata:
-					goto handle_Metadata
+				case ffjtMeetingRoomNumber:
+					goto handle_RoomNumber
 
-				case ffjtSectionnosuchkey:
+				case ffjtMeetingBuilding:
+					goto handle_Building
+
+				case ffjtMeetingContentHash:
+					goto handle_ContentHash
+
+				case ffjtMeetingnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -4568,9 +4914,9 @@ mainparse:
 		}
 	}
 
-handle_Number:
+handle_Room:
 
-	/* handler: j.Number type=string kind=string quoted=false*/
+	/* handler: j.Room type=string kind=string quoted=false*/
 
 	{
 
@@ -4582,11 +4928,15 @@ handle_Number:
 
 		if tok == fflib.FFTok_null {
 
+			j.Room = nil
+
 		} else {
 
+			var tval string
 			outBuf := fs.Output.Bytes()
 
-			j.Number = string(string(outBuf))
+			tval = string(string(outBuf))
+			j.Room = &tval
 
 		}
 	}
@@ -4594,9 +4944,9 @@ handle_Number:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_CallNumber:
+handle_Day:
 
-	/* handler: j.CallNumber type=string kind=string quoted=false*/
+	/* handler: j.Day type=string kind=string quoted=false*/
 
 	{
 
@@ -4608,11 +4958,15 @@ handle_CallNumber:
 
 		if tok == fflib.FFTok_null {
 
+			j.Day = nil
+
 		} else {
 
+			var tval string
 			outBuf := fs.Output.Bytes()
 
-			j.CallNumber = string(string(outBuf))
+			tval = string(string(outBuf))
+			j.Day = &tval
 
 		}
 	}
@@ -4620,13 +4974,103 @@ handle_CallNumber:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Max:
+handle_StartTime:
 
-	/* handler: j.Max type=int64 kind=int64 quoted=false*/
+	/* handler: j.StartTime type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+			j.StartTime = nil
+
+		} else {
+
+			var tval string
+			outBuf := fs.Output.Bytes()
+
+			tval = string(string(outBuf))
+			j.StartTime = &tval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_EndTime:
+
+	/* handler: j.EndTime type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+			j.EndTime = nil
+
+		} else {
+
+			var tval string
+			outBuf := fs.Output.Bytes()
+
+			tval = string(string(outBuf))
+			j.EndTime = &tval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ClassType:
+
+	/* handler: j.ClassType type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+			j.ClassType = nil
+
+		} else {
+
+			var tval string
+			outBuf := fs.Output.Bytes()
+
+			tval = string(string(outBuf))
+			j.ClassType = &tval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Index:
+
+	/* handler: j.Index type=int32 kind=int32 quoted=false*/
 
 	{
 		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
 		}
 	}
 
@@ -4636,13 +5080,13 @@ handle_Max:
 
 		} else {
 
-			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
 
 			if err != nil {
 				return fs.WrapErr(err)
 			}
 
-			j.Max = int64(tval)
+			j.Index = int32(tval)
 
 		}
 	}
@@ -4650,91 +5094,6456 @@ handle_Max:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Now:
+handle_Metadata:
 
-	/* handler: j.Now type=int64 kind=int64 quoted=false*/
+	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Metadata = nil
+		} else {
+
+			j.Metadata = []*Metadata{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJMetadata *Metadata
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJMetadata = nil
+
+					} else {
+
+						if tmpJMetadata == nil {
+							tmpJMetadata = new(Metadata)
+						}
+
+						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Metadata = append(j.Metadata, tmpJMetadata)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Weekday:
+
+	/* handler: j.Weekday type=model.Weekday kind=int32 quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Weekday = nil
+
+		} else {
+
+			tbuf, err := fs.CaptureField(tok)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			if j.Weekday == nil {
+				j.Weekday = new(Weekday)
+			}
+
+			err = j.Weekday.UnmarshalJSON(tbuf)
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_StartMinute:
+
+	/* handler: j.StartMinute type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.StartMinute = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int32(tval)
+			j.StartMinute = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Duration:
+
+	/* handler: j.Duration type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.Duration = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int32(tval)
+			j.Duration = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_BuildingCode:
+
+	/* handler: j.BuildingCode type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+			j.BuildingCode = nil
+
+		} else {
+
+			var tval string
+			outBuf := fs.Output.Bytes()
+
+			tval = string(string(outBuf))
+			j.BuildingCode = &tval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_RoomNumber:
+
+	/* handler: j.RoomNumber type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+			j.RoomNumber = nil
+
+		} else {
+
+			var tval string
+			outBuf := fs.Output.Bytes()
+
+			tval = string(string(outBuf))
+			j.RoomNumber = &tval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Building:
+
+	/* handler: j.Building type=model.Building kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Building = nil
+
+		} else {
+
+			if j.Building == nil {
+				j.Building = new(Building)
+			}
+
+			err = j.Building.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ContentHash:
+
+	/* handler: j.ContentHash type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ContentHash = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Meta) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Meta) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if j.Code != nil {
+		if true {
+			buf.WriteString(`"code":`)
+			fflib.FormatBits2(buf, uint64(*j.Code), 10, *j.Code < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.Message != nil {
+		if true {
+			buf.WriteString(`"message":`)
+			fflib.WriteJsonString(buf, string(*j.Message))
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtMetabase = iota
+	ffjtMetanosuchkey
+
+	ffjtMetaCode
+
+	ffjtMetaMessage
+)
+
+var ffjKeyMetaCode = []byte("code")
+
+var ffjKeyMetaMessage = []byte("message")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Meta) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Meta) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtMetabase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtMetanosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyMetaCode, kn) {
+						currentKey = ffjtMetaCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyMetaMessage, kn) {
+						currentKey = ffjtMetaMessage
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyMetaMessage, kn) {
+					currentKey = ffjtMetaMessage
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyMetaCode, kn) {
+					currentKey = ffjtMetaCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtMetanosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtMetaCode:
+					goto handle_Code
+
+				case ffjtMetaMessage:
+					goto handle_Message
+
+				case ffjtMetanosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Code:
+
+	/* handler: j.Code type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.Code = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int32(tval)
+			j.Code = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Message:
+
+	/* handler: j.Message type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+			j.Message = nil
+
+		} else {
+
+			var tval string
+			outBuf := fs.Output.Bytes()
+
+			tval = string(string(outBuf))
+			j.Message = &tval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Metadata) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Metadata) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"title":`)
+	fflib.WriteJsonString(buf, string(j.Title))
+	buf.WriteString(`,"content":`)
+	fflib.WriteJsonString(buf, string(j.Content))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtMetadatabase = iota
+	ffjtMetadatanosuchkey
+
+	ffjtMetadataTitle
+
+	ffjtMetadataContent
+)
+
+var ffjKeyMetadataTitle = []byte("title")
+
+var ffjKeyMetadataContent = []byte("content")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Metadata) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Metadata) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtMetadatabase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtMetadatanosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyMetadataContent, kn) {
+						currentKey = ffjtMetadataContent
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyMetadataTitle, kn) {
+						currentKey = ffjtMetadataTitle
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyMetadataContent, kn) {
+					currentKey = ffjtMetadataContent
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyMetadataTitle, kn) {
+					currentKey = ffjtMetadataTitle
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtMetadatanosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtMetadataTitle:
+					goto handle_Title
+
+				case ffjtMetadataContent:
+					goto handle_Content
+
+				case ffjtMetadatanosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Title:
+
+	/* handler: j.Title type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Title = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Content:
+
+	/* handler: j.Content type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Content = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Page) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Page) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"offset":`)
+	fflib.FormatBits2(buf, uint64(j.Offset), 10, j.Offset < 0)
+	buf.WriteString(`,"limit":`)
+	fflib.FormatBits2(buf, uint64(j.Limit), 10, j.Limit < 0)
+	buf.WriteString(`,"total":`)
+	fflib.FormatBits2(buf, uint64(j.Total), 10, j.Total < 0)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtPagebase = iota
+	ffjtPagenosuchkey
+
+	ffjtPageOffset
+
+	ffjtPageLimit
+
+	ffjtPageTotal
+)
+
+var ffjKeyPageOffset = []byte("offset")
+
+var ffjKeyPageLimit = []byte("limit")
+
+var ffjKeyPageTotal = []byte("total")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Page) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Page) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtPagebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtPagenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'l':
+
+					if bytes.Equal(ffjKeyPageLimit, kn) {
+						currentKey = ffjtPageLimit
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeyPageOffset, kn) {
+						currentKey = ffjtPageOffset
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyPageTotal, kn) {
+						currentKey = ffjtPageTotal
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPageTotal, kn) {
+					currentKey = ffjtPageTotal
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPageLimit, kn) {
+					currentKey = ffjtPageLimit
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPageOffset, kn) {
+					currentKey = ffjtPageOffset
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtPagenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtPageOffset:
+					goto handle_Offset
+
+				case ffjtPageLimit:
+					goto handle_Limit
+
+				case ffjtPageTotal:
+					goto handle_Total
+
+				case ffjtPagenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Offset:
+
+	/* handler: j.Offset type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Offset = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Limit:
+
+	/* handler: j.Limit type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Limit = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Total:
+
+	/* handler: j.Total type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Total = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Prerequisite) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Prerequisite) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"operator":`)
+	fflib.WriteJsonString(buf, string(j.Operator))
+	buf.WriteByte(',')
+	if len(j.Operands) != 0 {
+		buf.WriteString(`"operands":`)
+		if j.Operands != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Operands {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"subject_number":`)
+	fflib.WriteJsonString(buf, string(j.SubjectNumber))
+	buf.WriteString(`,"course_number":`)
+	fflib.WriteJsonString(buf, string(j.CourseNumber))
+	buf.WriteString(`,"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtPrerequisitebase = iota
+	ffjtPrerequisitenosuchkey
+
+	ffjtPrerequisiteOperator
+
+	ffjtPrerequisiteOperands
+
+	ffjtPrerequisiteSubjectNumber
+
+	ffjtPrerequisiteCourseNumber
+
+	ffjtPrerequisiteTopicName
+)
+
+var ffjKeyPrerequisiteOperator = []byte("operator")
+
+var ffjKeyPrerequisiteOperands = []byte("operands")
+
+var ffjKeyPrerequisiteSubjectNumber = []byte("subject_number")
+
+var ffjKeyPrerequisiteCourseNumber = []byte("course_number")
+
+var ffjKeyPrerequisiteTopicName = []byte("topic_name")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Prerequisite) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Prerequisite) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtPrerequisitebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtPrerequisitenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyPrerequisiteCourseNumber, kn) {
+						currentKey = ffjtPrerequisiteCourseNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'o':
+
+					if bytes.Equal(ffjKeyPrerequisiteOperator, kn) {
+						currentKey = ffjtPrerequisiteOperator
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyPrerequisiteOperands, kn) {
+						currentKey = ffjtPrerequisiteOperands
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyPrerequisiteSubjectNumber, kn) {
+						currentKey = ffjtPrerequisiteSubjectNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeyPrerequisiteTopicName, kn) {
+						currentKey = ffjtPrerequisiteTopicName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyPrerequisiteTopicName, kn) {
+					currentKey = ffjtPrerequisiteTopicName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPrerequisiteCourseNumber, kn) {
+					currentKey = ffjtPrerequisiteCourseNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPrerequisiteSubjectNumber, kn) {
+					currentKey = ffjtPrerequisiteSubjectNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyPrerequisiteOperands, kn) {
+					currentKey = ffjtPrerequisiteOperands
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyPrerequisiteOperator, kn) {
+					currentKey = ffjtPrerequisiteOperator
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtPrerequisitenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtPrerequisiteOperator:
+					goto handle_Operator
+
+				case ffjtPrerequisiteOperands:
+					goto handle_Operands
+
+				case ffjtPrerequisiteSubjectNumber:
+					goto handle_SubjectNumber
+
+				case ffjtPrerequisiteCourseNumber:
+					goto handle_CourseNumber
+
+				case ffjtPrerequisiteTopicName:
+					goto handle_TopicName
+
+				case ffjtPrerequisitenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Operator:
+
+	/* handler: j.Operator type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Operator = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Operands:
+
+	/* handler: j.Operands type=[]*model.Prerequisite kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Operands = nil
+		} else {
+
+			j.Operands = []*Prerequisite{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJOperands *Prerequisite
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJOperands type=*model.Prerequisite kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJOperands = nil
+
+					} else {
+
+						if tmpJOperands == nil {
+							tmpJOperands = new(Prerequisite)
+						}
+
+						err = tmpJOperands.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Operands = append(j.Operands, tmpJOperands)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_SubjectNumber:
+
+	/* handler: j.SubjectNumber type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.SubjectNumber = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CourseNumber:
+
+	/* handler: j.CourseNumber type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.CourseNumber = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TopicName:
+
+	/* handler: j.TopicName type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TopicName = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Registration) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Registration) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"period":`)
+	fflib.WriteJsonString(buf, string(j.Period))
+	buf.WriteString(`,"period_date":`)
+	fflib.FormatBits2(buf, uint64(j.PeriodDate), 10, j.PeriodDate < 0)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtRegistrationbase = iota
+	ffjtRegistrationnosuchkey
+
+	ffjtRegistrationPeriod
+
+	ffjtRegistrationPeriodDate
+)
+
+var ffjKeyRegistrationPeriod = []byte("period")
+
+var ffjKeyRegistrationPeriodDate = []byte("period_date")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Registration) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Registration) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtRegistrationbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtRegistrationnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'p':
+
+					if bytes.Equal(ffjKeyRegistrationPeriod, kn) {
+						currentKey = ffjtRegistrationPeriod
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeyRegistrationPeriodDate, kn) {
+						currentKey = ffjtRegistrationPeriodDate
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyRegistrationPeriodDate, kn) {
+					currentKey = ffjtRegistrationPeriodDate
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyRegistrationPeriod, kn) {
+					currentKey = ffjtRegistrationPeriod
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtRegistrationnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtRegistrationPeriod:
+					goto handle_Period
+
+				case ffjtRegistrationPeriodDate:
+					goto handle_PeriodDate
+
+				case ffjtRegistrationnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Period:
+
+	/* handler: j.Period type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Period = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_PeriodDate:
+
+	/* handler: j.PeriodDate type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.PeriodDate = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *ResolvedSemester) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *ResolvedSemester) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if j.Current != nil {
+		if true {
+			buf.WriteString(`"current":`)
+
+			{
+
+				err = j.Current.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if j.Last != nil {
+		if true {
+			buf.WriteString(`"last":`)
+
+			{
+
+				err = j.Last.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if j.Next != nil {
+		if true {
+			buf.WriteString(`"next":`)
+
+			{
+
+				err = j.Next.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtResolvedSemesterbase = iota
+	ffjtResolvedSemesternosuchkey
+
+	ffjtResolvedSemesterCurrent
+
+	ffjtResolvedSemesterLast
+
+	ffjtResolvedSemesterNext
+)
+
+var ffjKeyResolvedSemesterCurrent = []byte("current")
+
+var ffjKeyResolvedSemesterLast = []byte("last")
+
+var ffjKeyResolvedSemesterNext = []byte("next")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *ResolvedSemester) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *ResolvedSemester) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtResolvedSemesterbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtResolvedSemesternosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeyResolvedSemesterCurrent, kn) {
+						currentKey = ffjtResolvedSemesterCurrent
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'l':
+
+					if bytes.Equal(ffjKeyResolvedSemesterLast, kn) {
+						currentKey = ffjtResolvedSemesterLast
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeyResolvedSemesterNext, kn) {
+						currentKey = ffjtResolvedSemesterNext
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyResolvedSemesterNext, kn) {
+					currentKey = ffjtResolvedSemesterNext
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyResolvedSemesterLast, kn) {
+					currentKey = ffjtResolvedSemesterLast
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyResolvedSemesterCurrent, kn) {
+					currentKey = ffjtResolvedSemesterCurrent
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtResolvedSemesternosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtResolvedSemesterCurrent:
+					goto handle_Current
+
+				case ffjtResolvedSemesterLast:
+					goto handle_Last
+
+				case ffjtResolvedSemesterNext:
+					goto handle_Next
+
+				case ffjtResolvedSemesternosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Current:
+
+	/* handler: j.Current type=model.Semester kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Current = nil
+
+		} else {
+
+			if j.Current == nil {
+				j.Current = new(Semester)
+			}
+
+			err = j.Current.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Last:
+
+	/* handler: j.Last type=model.Semester kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Last = nil
+
+		} else {
+
+			if j.Last == nil {
+				j.Last = new(Semester)
+			}
+
+			err = j.Last.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Next:
+
+	/* handler: j.Next type=model.Semester kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Next = nil
+
+		} else {
+
+			if j.Next == nil {
+				j.Next = new(Semester)
+			}
+
+			err = j.Next.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Response) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Response) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ `)
+	if j.Meta != nil {
+		if true {
+			buf.WriteString(`"meta":`)
+
+			{
+
+				err = j.Meta.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if j.Data != nil {
+		if true {
+			buf.WriteString(`"data":`)
+
+			{
+
+				err = j.Data.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtResponsebase = iota
+	ffjtResponsenosuchkey
+
+	ffjtResponseMeta
+
+	ffjtResponseData
+)
+
+var ffjKeyResponseMeta = []byte("meta")
+
+var ffjKeyResponseData = []byte("data")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Response) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Response) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtResponsebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'd':
+
+					if bytes.Equal(ffjKeyResponseData, kn) {
+						currentKey = ffjtResponseData
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeyResponseMeta, kn) {
+						currentKey = ffjtResponseMeta
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyResponseData, kn) {
+					currentKey = ffjtResponseData
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeyResponseMeta, kn) {
+					currentKey = ffjtResponseMeta
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtResponsenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtResponseMeta:
+					goto handle_Meta
+
+				case ffjtResponseData:
+					goto handle_Data
+
+				case ffjtResponsenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Meta:
+
+	/* handler: j.Meta type=model.Meta kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Meta = nil
+
+		} else {
+
+			if j.Meta == nil {
+				j.Meta = new(Meta)
+			}
+
+			err = j.Meta.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Data:
+
+	/* handler: j.Data type=model.Data kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Data = nil
+
+		} else {
+
+			if j.Data == nil {
+				j.Data = new(Data)
+			}
+
+			err = j.Data.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Schedule) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Schedule) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteByte('{')
+	if len(j.Sections) != 0 {
+		buf.WriteString(`"sections":`)
+		if j.Sections != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Sections {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"days":`)
+	fflib.FormatBits2(buf, uint64(j.Days), 10, j.Days < 0)
+	buf.WriteString(`,"gap_minutes":`)
+	fflib.FormatBits2(buf, uint64(j.GapMinutes), 10, j.GapMinutes < 0)
+	buf.WriteString(`,"earliest_start":`)
+	fflib.FormatBits2(buf, uint64(j.EarliestStart), 10, j.EarliestStart < 0)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSchedulebase = iota
+	ffjtSchedulenosuchkey
+
+	ffjtScheduleSections
+
+	ffjtScheduleDays
+
+	ffjtScheduleGapMinutes
+
+	ffjtScheduleEarliestStart
+)
+
+var ffjKeyScheduleSections = []byte("sections")
+
+var ffjKeyScheduleDays = []byte("days")
+
+var ffjKeyScheduleGapMinutes = []byte("gap_minutes")
+
+var ffjKeyScheduleEarliestStart = []byte("earliest_start")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Schedule) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Schedule) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSchedulebase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSchedulenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'd':
+
+					if bytes.Equal(ffjKeyScheduleDays, kn) {
+						currentKey = ffjtScheduleDays
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'e':
+
+					if bytes.Equal(ffjKeyScheduleEarliestStart, kn) {
+						currentKey = ffjtScheduleEarliestStart
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'g':
+
+					if bytes.Equal(ffjKeyScheduleGapMinutes, kn) {
+						currentKey = ffjtScheduleGapMinutes
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeyScheduleSections, kn) {
+						currentKey = ffjtScheduleSections
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeyScheduleEarliestStart, kn) {
+					currentKey = ffjtScheduleEarliestStart
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyScheduleGapMinutes, kn) {
+					currentKey = ffjtScheduleGapMinutes
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyScheduleDays, kn) {
+					currentKey = ffjtScheduleDays
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyScheduleSections, kn) {
+					currentKey = ffjtScheduleSections
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSchedulenosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtScheduleSections:
+					goto handle_Sections
+
+				case ffjtScheduleDays:
+					goto handle_Days
+
+				case ffjtScheduleGapMinutes:
+					goto handle_GapMinutes
+
+				case ffjtScheduleEarliestStart:
+					goto handle_EarliestStart
+
+				case ffjtSchedulenosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Sections:
+
+	/* handler: j.Sections type=[]*model.Section kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Sections = nil
+		} else {
+
+			j.Sections = []*Section{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJSections *Section
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJSections type=*model.Section kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJSections = nil
+
+					} else {
+
+						if tmpJSections == nil {
+							tmpJSections = new(Section)
+						}
+
+						err = tmpJSections.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Sections = append(j.Sections, tmpJSections)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Days:
+
+	/* handler: j.Days type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Days = int32(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_GapMinutes:
+
+	/* handler: j.GapMinutes type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.GapMinutes = int32(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_EarliestStart:
+
+	/* handler: j.EarliestStart type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.EarliestStart = int32(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *SearchResult) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *SearchResult) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "kind":`)
+	fflib.WriteJsonString(buf, string(j.Kind))
+	buf.WriteString(`,"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
+	buf.WriteString(`,"name":`)
+	fflib.WriteJsonString(buf, string(j.Name))
+	buf.WriteString(`,"rank":`)
+	fflib.AppendFloat(buf, float64(j.Rank), 'g', -1, 64)
+	buf.WriteByte(',')
+	if j.Course != nil {
+		if true {
+			buf.WriteString(`"course":`)
+
+			{
+
+				err = j.Course.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	if j.Section != nil {
+		if true {
+			buf.WriteString(`"section":`)
+
+			{
+
+				err = j.Section.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSearchResultbase = iota
+	ffjtSearchResultnosuchkey
+
+	ffjtSearchResultKind
+
+	ffjtSearchResultTopicName
+
+	ffjtSearchResultName
+
+	ffjtSearchResultRank
+
+	ffjtSearchResultCourse
+
+	ffjtSearchResultSection
+)
+
+var ffjKeySearchResultKind = []byte("kind")
+
+var ffjKeySearchResultTopicName = []byte("topic_name")
+
+var ffjKeySearchResultName = []byte("name")
+
+var ffjKeySearchResultRank = []byte("rank")
+
+var ffjKeySearchResultCourse = []byte("course")
+
+var ffjKeySearchResultSection = []byte("section")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *SearchResult) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *SearchResult) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSearchResultbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSearchResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeySearchResultCourse, kn) {
+						currentKey = ffjtSearchResultCourse
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'k':
+
+					if bytes.Equal(ffjKeySearchResultKind, kn) {
+						currentKey = ffjtSearchResultKind
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeySearchResultName, kn) {
+						currentKey = ffjtSearchResultName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'r':
+
+					if bytes.Equal(ffjKeySearchResultRank, kn) {
+						currentKey = ffjtSearchResultRank
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeySearchResultSection, kn) {
+						currentKey = ffjtSearchResultSection
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySearchResultTopicName, kn) {
+						currentKey = ffjtSearchResultTopicName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeySearchResultSection, kn) {
+					currentKey = ffjtSearchResultSection
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySearchResultCourse, kn) {
+					currentKey = ffjtSearchResultCourse
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySearchResultRank, kn) {
+					currentKey = ffjtSearchResultRank
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySearchResultName, kn) {
+					currentKey = ffjtSearchResultName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySearchResultTopicName, kn) {
+					currentKey = ffjtSearchResultTopicName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySearchResultKind, kn) {
+					currentKey = ffjtSearchResultKind
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSearchResultnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtSearchResultKind:
+					goto handle_Kind
+
+				case ffjtSearchResultTopicName:
+					goto handle_TopicName
+
+				case ffjtSearchResultName:
+					goto handle_Name
+
+				case ffjtSearchResultRank:
+					goto handle_Rank
+
+				case ffjtSearchResultCourse:
+					goto handle_Course
+
+				case ffjtSearchResultSection:
+					goto handle_Section
+
+				case ffjtSearchResultnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Kind:
+
+	/* handler: j.Kind type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Kind = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TopicName:
+
+	/* handler: j.TopicName type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TopicName = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Name:
+
+	/* handler: j.Name type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Name = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Rank:
+
+	/* handler: j.Rank type=float64 kind=float64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_double && tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for float64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseFloat(fs.Output.Bytes(), 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Rank = float64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Course:
+
+	/* handler: j.Course type=model.Course kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Course = nil
+
+		} else {
+
+			if j.Course == nil {
+				j.Course = new(Course)
+			}
+
+			err = j.Course.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Section:
+
+	/* handler: j.Section type=model.Section kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Section = nil
+
+		} else {
+
+			if j.Section == nil {
+				j.Section = new(Section)
+			}
+
+			err = j.Section.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Section) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Section) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"number":`)
+	fflib.WriteJsonString(buf, string(j.Number))
+	buf.WriteString(`,"call_number":`)
+	fflib.WriteJsonString(buf, string(j.CallNumber))
+	buf.WriteString(`,"max":`)
+	fflib.FormatBits2(buf, uint64(j.Max), 10, j.Max < 0)
+	buf.WriteString(`,"now":`)
+	fflib.FormatBits2(buf, uint64(j.Now), 10, j.Now < 0)
+	buf.WriteString(`,"status":`)
+	fflib.WriteJsonString(buf, string(j.Status))
+	buf.WriteString(`,"credits":`)
+	fflib.WriteJsonString(buf, string(j.Credits))
+	buf.WriteString(`,"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
+	buf.WriteString(`,"topic_id":`)
+	fflib.WriteJsonString(buf, string(j.TopicId))
+	buf.WriteByte(',')
+	if len(j.Meetings) != 0 {
+		buf.WriteString(`"meetings":`)
+		if j.Meetings != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Meetings {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Instructors) != 0 {
+		buf.WriteString(`"instructors":`)
+		if j.Instructors != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Instructors {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Books) != 0 {
+		buf.WriteString(`"books":`)
+		if j.Books != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Books {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Metadata) != 0 {
+		buf.WriteString(`"metadata":`)
+		if j.Metadata != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Metadata {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.CrossListings) != 0 {
+		buf.WriteString(`"cross_listings":`)
+		if j.CrossListings != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.CrossListings {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if j.WaitlistMax != nil {
+		if true {
+			buf.WriteString(`"waitlist_max":`)
+			fflib.FormatBits2(buf, uint64(*j.WaitlistMax), 10, *j.WaitlistMax < 0)
+			buf.WriteByte(',')
+		}
+	}
+	if j.WaitlistNow != nil {
+		if true {
+			buf.WriteString(`"waitlist_now":`)
+			fflib.FormatBits2(buf, uint64(*j.WaitlistNow), 10, *j.WaitlistNow < 0)
+			buf.WriteByte(',')
+		}
+	}
+	buf.WriteString(`"content_hash":`)
+	fflib.WriteJsonString(buf, string(j.ContentHash))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSectionbase = iota
+	ffjtSectionnosuchkey
+
+	ffjtSectionNumber
+
+	ffjtSectionCallNumber
+
+	ffjtSectionMax
+
+	ffjtSectionNow
+
+	ffjtSectionStatus
+
+	ffjtSectionCredits
+
+	ffjtSectionTopicName
+
+	ffjtSectionTopicId
+
+	ffjtSectionMeetings
+
+	ffjtSectionInstructors
+
+	ffjtSectionBooks
+
+	ffjtSectionMetadata
+
+	ffjtSectionCrossListings
+
+	ffjtSectionWaitlistMax
+
+	ffjtSectionWaitlistNow
+
+	ffjtSectionContentHash
+)
+
+var ffjKeySectionNumber = []byte("number")
+
+var ffjKeySectionCallNumber = []byte("call_number")
+
+var ffjKeySectionMax = []byte("max")
+
+var ffjKeySectionNow = []byte("now")
+
+var ffjKeySectionStatus = []byte("status")
+
+var ffjKeySectionCredits = []byte("credits")
+
+var ffjKeySectionTopicName = []byte("topic_name")
+
+var ffjKeySectionTopicId = []byte("topic_id")
+
+var ffjKeySectionMeetings = []byte("meetings")
+
+var ffjKeySectionInstructors = []byte("instructors")
+
+var ffjKeySectionBooks = []byte("books")
+
+var ffjKeySectionMetadata = []byte("metadata")
+
+var ffjKeySectionCrossListings = []byte("cross_listings")
+
+var ffjKeySectionWaitlistMax = []byte("waitlist_max")
+
+var ffjKeySectionWaitlistNow = []byte("waitlist_now")
+
+var ffjKeySectionContentHash = []byte("content_hash")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Section) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Section) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSectionbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSectionnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'b':
+
+					if bytes.Equal(ffjKeySectionBooks, kn) {
+						currentKey = ffjtSectionBooks
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'c':
+
+					if bytes.Equal(ffjKeySectionCallNumber, kn) {
+						currentKey = ffjtSectionCallNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionCredits, kn) {
+						currentKey = ffjtSectionCredits
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionCrossListings, kn) {
+						currentKey = ffjtSectionCrossListings
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionContentHash, kn) {
+						currentKey = ffjtSectionContentHash
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'i':
+
+					if bytes.Equal(ffjKeySectionInstructors, kn) {
+						currentKey = ffjtSectionInstructors
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeySectionMax, kn) {
+						currentKey = ffjtSectionMax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionMeetings, kn) {
+						currentKey = ffjtSectionMeetings
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionMetadata, kn) {
+						currentKey = ffjtSectionMetadata
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeySectionNumber, kn) {
+						currentKey = ffjtSectionNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionNow, kn) {
+						currentKey = ffjtSectionNow
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeySectionStatus, kn) {
+						currentKey = ffjtSectionStatus
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySectionTopicName, kn) {
+						currentKey = ffjtSectionTopicName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionTopicId, kn) {
+						currentKey = ffjtSectionTopicId
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'w':
+
+					if bytes.Equal(ffjKeySectionWaitlistMax, kn) {
+						currentKey = ffjtSectionWaitlistMax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionWaitlistNow, kn) {
+						currentKey = ffjtSectionWaitlistNow
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionContentHash, kn) {
+					currentKey = ffjtSectionContentHash
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionWaitlistNow, kn) {
+					currentKey = ffjtSectionWaitlistNow
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionWaitlistMax, kn) {
+					currentKey = ffjtSectionWaitlistMax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionCrossListings, kn) {
+					currentKey = ffjtSectionCrossListings
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySectionMetadata, kn) {
+					currentKey = ffjtSectionMetadata
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionBooks, kn) {
+					currentKey = ffjtSectionBooks
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionInstructors, kn) {
+					currentKey = ffjtSectionInstructors
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionMeetings, kn) {
+					currentKey = ffjtSectionMeetings
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySectionTopicId, kn) {
+					currentKey = ffjtSectionTopicId
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySectionTopicName, kn) {
+					currentKey = ffjtSectionTopicName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionCredits, kn) {
+					currentKey = ffjtSectionCredits
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionStatus, kn) {
+					currentKey = ffjtSectionStatus
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySectionNow, kn) {
+					currentKey = ffjtSectionNow
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySectionMax, kn) {
+					currentKey = ffjtSectionMax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySectionCallNumber, kn) {
+					currentKey = ffjtSectionCallNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySectionNumber, kn) {
+					currentKey = ffjtSectionNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSectionnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtSectionNumber:
+					goto handle_Number
+
+				case ffjtSectionCallNumber:
+					goto handle_CallNumber
+
+				case ffjtSectionMax:
+					goto handle_Max
+
+				case ffjtSectionNow:
+					goto handle_Now
+
+				case ffjtSectionStatus:
+					goto handle_Status
+
+				case ffjtSectionCredits:
+					goto handle_Credits
+
+				case ffjtSectionTopicName:
+					goto handle_TopicName
+
+				case ffjtSectionTopicId:
+					goto handle_TopicId
+
+				case ffjtSectionMeetings:
+					goto handle_Meetings
+
+				case ffjtSectionInstructors:
+					goto handle_Instructors
+
+				case ffjtSectionBooks:
+					goto handle_Books
+
+				case ffjtSectionMetadata:
+					goto handle_Metadata
+
+				case ffjtSectionCrossListings:
+					goto handle_CrossListings
+
+				case ffjtSectionWaitlistMax:
+					goto handle_WaitlistMax
+
+				case ffjtSectionWaitlistNow:
+					goto handle_WaitlistNow
+
+				case ffjtSectionContentHash:
+					goto handle_ContentHash
+
+				case ffjtSectionnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Number:
+
+	/* handler: j.Number type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Number = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CallNumber:
+
+	/* handler: j.CallNumber type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.CallNumber = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Max:
+
+	/* handler: j.Max type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Max = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Now:
+
+	/* handler: j.Now type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Now = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Status:
+
+	/* handler: j.Status type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Status = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Credits:
+
+	/* handler: j.Credits type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Credits = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TopicName:
+
+	/* handler: j.TopicName type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TopicName = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TopicId:
+
+	/* handler: j.TopicId type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TopicId = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Meetings:
+
+	/* handler: j.Meetings type=[]*model.Meeting kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Meetings = nil
+		} else {
+
+			j.Meetings = []*Meeting{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJMeetings *Meeting
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJMeetings type=*model.Meeting kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJMeetings = nil
+
+					} else {
+
+						if tmpJMeetings == nil {
+							tmpJMeetings = new(Meeting)
+						}
+
+						err = tmpJMeetings.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Meetings = append(j.Meetings, tmpJMeetings)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Instructors:
+
+	/* handler: j.Instructors type=[]*model.Instructor kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Instructors = nil
+		} else {
+
+			j.Instructors = []*Instructor{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJInstructors *Instructor
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJInstructors type=*model.Instructor kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJInstructors = nil
+
+					} else {
+
+						if tmpJInstructors == nil {
+							tmpJInstructors = new(Instructor)
+						}
+
+						err = tmpJInstructors.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Instructors = append(j.Instructors, tmpJInstructors)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Books:
+
+	/* handler: j.Books type=[]*model.Book kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Books = nil
+		} else {
+
+			j.Books = []*Book{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJBooks *Book
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJBooks type=*model.Book kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJBooks = nil
+
+					} else {
+
+						if tmpJBooks == nil {
+							tmpJBooks = new(Book)
+						}
+
+						err = tmpJBooks.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Books = append(j.Books, tmpJBooks)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Metadata:
+
+	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Metadata = nil
+		} else {
+
+			j.Metadata = []*Metadata{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJMetadata *Metadata
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJMetadata = nil
+
+					} else {
+
+						if tmpJMetadata == nil {
+							tmpJMetadata = new(Metadata)
+						}
+
+						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Metadata = append(j.Metadata, tmpJMetadata)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CrossListings:
+
+	/* handler: j.CrossListings type=[]*model.CrossListing kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.CrossListings = nil
+		} else {
+
+			j.CrossListings = []*CrossListing{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJCrossListings *CrossListing
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJCrossListings type=*model.CrossListing kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJCrossListings = nil
+
+					} else {
+
+						if tmpJCrossListings == nil {
+							tmpJCrossListings = new(CrossListing)
+						}
+
+						err = tmpJCrossListings.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.CrossListings = append(j.CrossListings, tmpJCrossListings)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WaitlistMax:
+
+	/* handler: j.WaitlistMax type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.WaitlistMax = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int64(tval)
+			j.WaitlistMax = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_WaitlistNow:
+
+	/* handler: j.WaitlistNow type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+			j.WaitlistNow = nil
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			ttypval := int64(tval)
+			j.WaitlistNow = &ttypval
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ContentHash:
+
+	/* handler: j.ContentHash type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ContentHash = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *SectionHistory) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *SectionHistory) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{ "topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
+	buf.WriteByte(',')
+	if len(j.Snapshots) != 0 {
+		buf.WriteString(`"snapshots":`)
+		if j.Snapshots != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Snapshots {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if j.Stats != nil {
+		if true {
+			buf.WriteString(`"stats":`)
+
+			{
+
+				err = j.Stats.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
+	}
+	buf.Rewind(1)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSectionHistorybase = iota
+	ffjtSectionHistorynosuchkey
+
+	ffjtSectionHistoryTopicName
+
+	ffjtSectionHistorySnapshots
+
+	ffjtSectionHistoryStats
+)
+
+var ffjKeySectionHistoryTopicName = []byte("topic_name")
+
+var ffjKeySectionHistorySnapshots = []byte("snapshots")
+
+var ffjKeySectionHistoryStats = []byte("stats")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *SectionHistory) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *SectionHistory) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSectionHistorybase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSectionHistorynosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 's':
+
+					if bytes.Equal(ffjKeySectionHistorySnapshots, kn) {
+						currentKey = ffjtSectionHistorySnapshots
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionHistoryStats, kn) {
+						currentKey = ffjtSectionHistoryStats
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySectionHistoryTopicName, kn) {
+						currentKey = ffjtSectionHistoryTopicName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionHistoryStats, kn) {
+					currentKey = ffjtSectionHistoryStats
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionHistorySnapshots, kn) {
+					currentKey = ffjtSectionHistorySnapshots
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySectionHistoryTopicName, kn) {
+					currentKey = ffjtSectionHistoryTopicName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSectionHistorynosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtSectionHistoryTopicName:
+					goto handle_TopicName
+
+				case ffjtSectionHistorySnapshots:
+					goto handle_Snapshots
+
+				case ffjtSectionHistoryStats:
+					goto handle_Stats
+
+				case ffjtSectionHistorynosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_TopicName:
+
+	/* handler: j.TopicName type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TopicName = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Snapshots:
+
+	/* handler: j.Snapshots type=[]*model.SectionSnapshot kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Snapshots = nil
+		} else {
+
+			j.Snapshots = []*SectionSnapshot{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJSnapshots *SectionSnapshot
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJSnapshots type=*model.SectionSnapshot kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJSnapshots = nil
+
+					} else {
+
+						if tmpJSnapshots == nil {
+							tmpJSnapshots = new(SectionSnapshot)
+						}
+
+						err = tmpJSnapshots.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Snapshots = append(j.Snapshots, tmpJSnapshots)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Stats:
+
+	/* handler: j.Stats type=model.SectionHistoryStats kind=struct quoted=false*/
+
+	{
+		if tok == fflib.FFTok_null {
+
+			j.Stats = nil
+
+		} else {
+
+			if j.Stats == nil {
+				j.Stats = new(SectionHistoryStats)
+			}
+
+			err = j.Stats.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
+		}
+		state = fflib.FFParse_after_value
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *SectionHistoryStats) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *SectionHistoryStats) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"time_to_fill":`)
+	fflib.FormatBits2(buf, uint64(j.TimeToFill), 10, j.TimeToFill < 0)
+	buf.WriteString(`,"reopenings":`)
+	fflib.FormatBits2(buf, uint64(j.Reopenings), 10, j.Reopenings < 0)
+	buf.WriteString(`,"typical_open_hour":`)
+	fflib.FormatBits2(buf, uint64(j.TypicalOpenHour), 10, j.TypicalOpenHour < 0)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSectionHistoryStatsbase = iota
+	ffjtSectionHistoryStatsnosuchkey
+
+	ffjtSectionHistoryStatsTimeToFill
+
+	ffjtSectionHistoryStatsReopenings
+
+	ffjtSectionHistoryStatsTypicalOpenHour
+)
+
+var ffjKeySectionHistoryStatsTimeToFill = []byte("time_to_fill")
+
+var ffjKeySectionHistoryStatsReopenings = []byte("reopenings")
+
+var ffjKeySectionHistoryStatsTypicalOpenHour = []byte("typical_open_hour")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *SectionHistoryStats) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *SectionHistoryStats) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSectionHistoryStatsbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSectionHistoryStatsnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'r':
+
+					if bytes.Equal(ffjKeySectionHistoryStatsReopenings, kn) {
+						currentKey = ffjtSectionHistoryStatsReopenings
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySectionHistoryStatsTimeToFill, kn) {
+						currentKey = ffjtSectionHistoryStatsTimeToFill
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySectionHistoryStatsTypicalOpenHour, kn) {
+						currentKey = ffjtSectionHistoryStatsTypicalOpenHour
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySectionHistoryStatsTypicalOpenHour, kn) {
+					currentKey = ffjtSectionHistoryStatsTypicalOpenHour
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionHistoryStatsReopenings, kn) {
+					currentKey = ffjtSectionHistoryStatsReopenings
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySectionHistoryStatsTimeToFill, kn) {
+					currentKey = ffjtSectionHistoryStatsTimeToFill
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSectionHistoryStatsnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtSectionHistoryStatsTimeToFill:
+					goto handle_TimeToFill
+
+				case ffjtSectionHistoryStatsReopenings:
+					goto handle_Reopenings
+
+				case ffjtSectionHistoryStatsTypicalOpenHour:
+					goto handle_TypicalOpenHour
+
+				case ffjtSectionHistoryStatsnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_TimeToFill:
+
+	/* handler: j.TimeToFill type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.TimeToFill = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Reopenings:
+
+	/* handler: j.Reopenings type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Reopenings = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TypicalOpenHour:
+
+	/* handler: j.TypicalOpenHour type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.TypicalOpenHour = int32(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *SectionSnapshot) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *SectionSnapshot) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"now":`)
+	fflib.FormatBits2(buf, uint64(j.Now), 10, j.Now < 0)
+	buf.WriteString(`,"max":`)
+	fflib.FormatBits2(buf, uint64(j.Max), 10, j.Max < 0)
+	buf.WriteString(`,"status":`)
+	fflib.WriteJsonString(buf, string(j.Status))
+	buf.WriteString(`,"created_at":`)
+	fflib.FormatBits2(buf, uint64(j.CreatedAt), 10, j.CreatedAt < 0)
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSectionSnapshotbase = iota
+	ffjtSectionSnapshotnosuchkey
+
+	ffjtSectionSnapshotNow
+
+	ffjtSectionSnapshotMax
+
+	ffjtSectionSnapshotStatus
+
+	ffjtSectionSnapshotCreatedAt
+)
+
+var ffjKeySectionSnapshotNow = []byte("now")
+
+var ffjKeySectionSnapshotMax = []byte("max")
+
+var ffjKeySectionSnapshotStatus = []byte("status")
+
+var ffjKeySectionSnapshotCreatedAt = []byte("created_at")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *SectionSnapshot) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *SectionSnapshot) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSectionSnapshotbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSectionSnapshotnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeySectionSnapshotCreatedAt, kn) {
+						currentKey = ffjtSectionSnapshotCreatedAt
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeySectionSnapshotMax, kn) {
+						currentKey = ffjtSectionSnapshotMax
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeySectionSnapshotNow, kn) {
+						currentKey = ffjtSectionSnapshotNow
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeySectionSnapshotStatus, kn) {
+						currentKey = ffjtSectionSnapshotStatus
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySectionSnapshotCreatedAt, kn) {
+					currentKey = ffjtSectionSnapshotCreatedAt
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySectionSnapshotStatus, kn) {
+					currentKey = ffjtSectionSnapshotStatus
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySectionSnapshotMax, kn) {
+					currentKey = ffjtSectionSnapshotMax
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySectionSnapshotNow, kn) {
+					currentKey = ffjtSectionSnapshotNow
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSectionSnapshotnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtSectionSnapshotNow:
+					goto handle_Now
+
+				case ffjtSectionSnapshotMax:
+					goto handle_Max
+
+				case ffjtSectionSnapshotStatus:
+					goto handle_Status
+
+				case ffjtSectionSnapshotCreatedAt:
+					goto handle_CreatedAt
+
+				case ffjtSectionSnapshotnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Now:
+
+	/* handler: j.Now type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Now = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Max:
+
+	/* handler: j.Max type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Max = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Status:
+
+	/* handler: j.Status type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Status = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CreatedAt:
+
+	/* handler: j.CreatedAt type=int64 kind=int64 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.CreatedAt = int64(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Semester) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Semester) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"year":`)
+	fflib.FormatBits2(buf, uint64(j.Year), 10, j.Year < 0)
+	buf.WriteString(`,"season":`)
+	fflib.WriteJsonString(buf, string(j.Season))
+	buf.WriteString(`,"term_code":`)
+	fflib.FormatBits2(buf, uint64(j.TermCode), 10, j.TermCode < 0)
+	buf.WriteString(`,"term_name":`)
+	fflib.WriteJsonString(buf, string(j.TermName))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSemesterbase = iota
+	ffjtSemesternosuchkey
+
+	ffjtSemesterYear
+
+	ffjtSemesterSeason
+
+	ffjtSemesterTermCode
+
+	ffjtSemesterTermName
+)
+
+var ffjKeySemesterYear = []byte("year")
+
+var ffjKeySemesterSeason = []byte("season")
+
+var ffjKeySemesterTermCode = []byte("term_code")
+
+var ffjKeySemesterTermName = []byte("term_name")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Semester) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Semester) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSemesterbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSemesternosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 's':
+
+					if bytes.Equal(ffjKeySemesterSeason, kn) {
+						currentKey = ffjtSemesterSeason
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySemesterTermCode, kn) {
+						currentKey = ffjtSemesterTermCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySemesterTermName, kn) {
+						currentKey = ffjtSemesterTermName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'y':
+
+					if bytes.Equal(ffjKeySemesterYear, kn) {
+						currentKey = ffjtSemesterYear
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySemesterTermName, kn) {
+					currentKey = ffjtSemesterTermName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeySemesterTermCode, kn) {
+					currentKey = ffjtSemesterTermCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeySemesterSeason, kn) {
+					currentKey = ffjtSemesterSeason
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.SimpleLetterEqualFold(ffjKeySemesterYear, kn) {
+					currentKey = ffjtSemesterYear
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				currentKey = ffjtSemesternosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			}
+
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
+
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
+
+				case ffjtSemesterYear:
+					goto handle_Year
+
+				case ffjtSemesterSeason:
+					goto handle_Season
+
+				case ffjtSemesterTermCode:
+					goto handle_TermCode
+
+				case ffjtSemesterTermName:
+					goto handle_TermName
+
+				case ffjtSemesternosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
+		}
+	}
+
+handle_Year:
+
+	/* handler: j.Year type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.Year = int32(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Season:
+
+	/* handler: j.Season type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Season = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TermCode:
+
+	/* handler: j.TermCode type=int32 kind=int32 quoted=false*/
+
+	{
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int32", tok))
+		}
+	}
+
+	{
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 32)
+
+			if err != nil {
+				return fs.WrapErr(err)
+			}
+
+			j.TermCode = int32(tval)
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_TermName:
+
+	/* handler: j.TermName type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TermName = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+wantedvalue:
+	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+wrongtokenerror:
+	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
+tokerror:
+	if fs.BigError != nil {
+		return fs.WrapErr(fs.BigError)
+	}
+	err = fs.Error.ToError()
+	if err != nil {
+		return fs.WrapErr(err)
+	}
+	panic("ffjson-generated: unreachable, please report bug.")
+done:
+
+	return nil
+}
+
+// MarshalJSON marshal bytes to json - template
+func (j *Subject) MarshalJSON() ([]byte, error) {
+	var buf fflib.Buffer
+	if j == nil {
+		buf.WriteString("null")
+		return buf.Bytes(), nil
+	}
+	err := j.MarshalJSONBuf(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSONBuf marshal buff to json - template
+func (j *Subject) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+	if j == nil {
+		buf.WriteString("null")
+		return nil
+	}
+	var err error
+	var obj []byte
+	_ = obj
+	_ = err
+	buf.WriteString(`{"name":`)
+	fflib.WriteJsonString(buf, string(j.Name))
+	buf.WriteString(`,"number":`)
+	fflib.WriteJsonString(buf, string(j.Number))
+	buf.WriteString(`,"season":`)
+	fflib.WriteJsonString(buf, string(j.Season))
+	buf.WriteString(`,"year":`)
+	fflib.WriteJsonString(buf, string(j.Year))
+	buf.WriteString(`,"topic_name":`)
+	fflib.WriteJsonString(buf, string(j.TopicName))
+	buf.WriteString(`,"topic_id":`)
+	fflib.WriteJsonString(buf, string(j.TopicId))
+	buf.WriteByte(',')
+	if len(j.Courses) != 0 {
+		buf.WriteString(`"courses":`)
+		if j.Courses != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Courses {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	if len(j.Metadata) != 0 {
+		buf.WriteString(`"metadata":`)
+		if j.Metadata != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Metadata {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"term_code":`)
+	fflib.FormatBits2(buf, uint64(j.TermCode), 10, j.TermCode < 0)
+	buf.WriteString(`,"term_name":`)
+	fflib.WriteJsonString(buf, string(j.TermName))
+	buf.WriteString(`,"content_hash":`)
+	fflib.WriteJsonString(buf, string(j.ContentHash))
+	buf.WriteByte('}')
+	return nil
+}
+
+const (
+	ffjtSubjectbase = iota
+	ffjtSubjectnosuchkey
+
+	ffjtSubjectName
+
+	ffjtSubjectNumber
+
+	ffjtSubjectSeason
+
+	ffjtSubjectYear
+
+	ffjtSubjectTopicName
+
+	ffjtSubjectTopicId
+
+	ffjtSubjectCourses
+
+	ffjtSubjectMetadata
+
+	ffjtSubjectTermCode
+
+	ffjtSubjectTermName
+
+	ffjtSubjectContentHash
+)
+
+var ffjKeySubjectName = []byte("name")
+
+var ffjKeySubjectNumber = []byte("number")
+
+var ffjKeySubjectSeason = []byte("season")
+
+var ffjKeySubjectYear = []byte("year")
+
+var ffjKeySubjectTopicName = []byte("topic_name")
+
+var ffjKeySubjectTopicId = []byte("topic_id")
+
+var ffjKeySubjectCourses = []byte("courses")
+
+var ffjKeySubjectMetadata = []byte("metadata")
+
+var ffjKeySubjectTermCode = []byte("term_code")
+
+var ffjKeySubjectTermName = []byte("term_name")
+
+var ffjKeySubjectContentHash = []byte("content_hash")
+
+// UnmarshalJSON umarshall json - template of ffjson
+func (j *Subject) UnmarshalJSON(input []byte) error {
+	fs := fflib.NewFFLexer(input)
+	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
+}
+
+// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
+func (j *Subject) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+	var err error
+	currentKey := ffjtSubjectbase
+	_ = currentKey
+	tok := fflib.FFTok_init
+	wantedTok := fflib.FFTok_init
+
+mainparse:
+	for {
+		tok = fs.Scan()
+		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
+		if tok == fflib.FFTok_error {
+			goto tokerror
+		}
+
+		switch state {
+
+		case fflib.FFParse_map_start:
+			if tok != fflib.FFTok_left_bracket {
+				wantedTok = fflib.FFTok_left_bracket
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_key
+			continue
+
+		case fflib.FFParse_after_value:
+			if tok == fflib.FFTok_comma {
+				state = fflib.FFParse_want_key
+			} else if tok == fflib.FFTok_right_bracket {
+				goto done
+			} else {
+				wantedTok = fflib.FFTok_comma
+				goto wrongtokenerror
+			}
+
+		case fflib.FFParse_want_key:
+			// json {} ended. goto exit. woo.
+			if tok == fflib.FFTok_right_bracket {
+				goto done
+			}
+			if tok != fflib.FFTok_string {
+				wantedTok = fflib.FFTok_string
+				goto wrongtokenerror
+			}
+
+			kn := fs.Output.Bytes()
+			if len(kn) <= 0 {
+				// "" case. hrm.
+				currentKey = ffjtSubjectnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
+			} else {
+				switch kn[0] {
+
+				case 'c':
+
+					if bytes.Equal(ffjKeySubjectCourses, kn) {
+						currentKey = ffjtSubjectCourses
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySubjectContentHash, kn) {
+						currentKey = ffjtSubjectContentHash
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'm':
+
+					if bytes.Equal(ffjKeySubjectMetadata, kn) {
+						currentKey = ffjtSubjectMetadata
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'n':
+
+					if bytes.Equal(ffjKeySubjectName, kn) {
+						currentKey = ffjtSubjectName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySubjectNumber, kn) {
+						currentKey = ffjtSubjectNumber
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 's':
+
+					if bytes.Equal(ffjKeySubjectSeason, kn) {
+						currentKey = ffjtSubjectSeason
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 't':
+
+					if bytes.Equal(ffjKeySubjectTopicName, kn) {
+						currentKey = ffjtSubjectTopicName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySubjectTopicId, kn) {
+						currentKey = ffjtSubjectTopicId
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySubjectTermCode, kn) {
+						currentKey = ffjtSubjectTermCode
+						state = fflib.FFParse_want_colon
+						goto mainparse
+
+					} else if bytes.Equal(ffjKeySubjectTermName, kn) {
+						currentKey = ffjtSubjectTermName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
+				case 'y':
+
+					if bytes.Equal(ffjKeySubjectYear, kn) {
+						currentKey = ffjtSubjectYear
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
 
-	{
-		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
-		}
-	}
+				}
 
-	{
+				if fflib.EqualFoldRight(ffjKeySubjectContentHash, kn) {
+					currentKey = ffjtSubjectContentHash
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-		if tok == fflib.FFTok_null {
+				if fflib.AsciiEqualFold(ffjKeySubjectTermName, kn) {
+					currentKey = ffjtSubjectTermName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-		} else {
+				if fflib.AsciiEqualFold(ffjKeySubjectTermCode, kn) {
+					currentKey = ffjtSubjectTermCode
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
+				if fflib.SimpleLetterEqualFold(ffjKeySubjectMetadata, kn) {
+					currentKey = ffjtSubjectMetadata
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-			if err != nil {
-				return fs.WrapErr(err)
-			}
+				if fflib.EqualFoldRight(ffjKeySubjectCourses, kn) {
+					currentKey = ffjtSubjectCourses
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-			j.Now = int64(tval)
+				if fflib.AsciiEqualFold(ffjKeySubjectTopicId, kn) {
+					currentKey = ffjtSubjectTopicId
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-		}
-	}
+				if fflib.AsciiEqualFold(ffjKeySubjectTopicName, kn) {
+					currentKey = ffjtSubjectTopicName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-	state = fflib.FFParse_after_value
-	goto mainparse
+				if fflib.SimpleLetterEqualFold(ffjKeySubjectYear, kn) {
+					currentKey = ffjtSubjectYear
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-handle_Status:
+				if fflib.EqualFoldRight(ffjKeySubjectSeason, kn) {
+					currentKey = ffjtSubjectSeason
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-	/* handler: j.Status type=string kind=string quoted=false*/
+				if fflib.SimpleLetterEqualFold(ffjKeySubjectNumber, kn) {
+					currentKey = ffjtSubjectNumber
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-	{
+				if fflib.SimpleLetterEqualFold(ffjKeySubjectName, kn) {
+					currentKey = ffjtSubjectName
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+				currentKey = ffjtSubjectnosuchkey
+				state = fflib.FFParse_want_colon
+				goto mainparse
 			}
-		}
-
-		if tok == fflib.FFTok_null {
 
-		} else {
+		case fflib.FFParse_want_colon:
+			if tok != fflib.FFTok_colon {
+				wantedTok = fflib.FFTok_colon
+				goto wrongtokenerror
+			}
+			state = fflib.FFParse_want_value
+			continue
+		case fflib.FFParse_want_value:
 
-			outBuf := fs.Output.Bytes()
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
 
-			j.Status = string(string(outBuf))
+				case ffjtSubjectName:
+					goto handle_Name
 
-		}
-	}
+				case ffjtSubjectNumber:
+					goto handle_Number
 
-	state = fflib.FFParse_after_value
-	goto mainparse
+				case ffjtSubjectSeason:
+					goto handle_Season
 
-handle_Credits:
+				case ffjtSubjectYear:
+					goto handle_Year
 
-	/* handler: j.Credits type=string kind=string quoted=false*/
+				case ffjtSubjectTopicName:
+					goto handle_TopicName
 
-	{
+				case ffjtSubjectTopicId:
+					goto handle_TopicId
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
+				case ffjtSubjectCourses:
+					goto handle_Courses
 
-		if tok == fflib.FFTok_null {
+				case ffjtSubjectMetadata:
+					goto handle_Metadata
 
-		} else {
+				case ffjtSubjectTermCode:
+					goto handle_TermCode
 
-			outBuf := fs.Output.Bytes()
+				case ffjtSubjectTermName:
+					goto handle_TermName
 
-			j.Credits = string(string(outBuf))
+				case ffjtSubjectContentHash:
+					goto handle_ContentHash
 
+				case ffjtSubjectnosuchkey:
+					err = fs.SkipField(tok)
+					if err != nil {
+						return fs.WrapErr(err)
+					}
+					state = fflib.FFParse_after_value
+					goto mainparse
+				}
+			} else {
+				goto wantedvalue
+			}
 		}
 	}
 
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_TopicName:
+handle_Name:
 
-	/* handler: j.TopicName type=string kind=string quoted=false*/
+	/* handler: j.Name type=string kind=string quoted=false*/
 
 	{
 
@@ -4750,7 +11559,7 @@ handle_TopicName:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TopicName = string(string(outBuf))
+			j.Name = string(string(outBuf))
 
 		}
 	}
@@ -4758,9 +11567,9 @@ handle_TopicName:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TopicId:
+handle_Number:
 
-	/* handler: j.TopicId type=string kind=string quoted=false*/
+	/* handler: j.Number type=string kind=string quoted=false*/
 
 	{
 
@@ -4776,7 +11585,7 @@ handle_TopicId:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TopicId = string(string(outBuf))
+			j.Number = string(string(outBuf))
 
 		}
 	}
@@ -4784,231 +11593,113 @@ handle_TopicId:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Meetings:
+handle_Season:
 
-	/* handler: j.Meetings type=[]*model.Meeting kind=slice quoted=false*/
+	/* handler: j.Season type=string kind=string quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Meetings = nil
-		} else {
-
-			j.Meetings = []*Meeting{}
-
-			wantVal := true
-
-			for {
-
-				var tmpJMeetings *Meeting
-
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
-
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
-
-				/* handler: tmpJMeetings type=*model.Meeting kind=ptr quoted=false*/
-
-				{
-					if tok == fflib.FFTok_null {
 
-						tmpJMeetings = nil
-
-					} else {
-
-						if tmpJMeetings == nil {
-							tmpJMeetings = new(Meeting)
-						}
+		} else {
 
-						err = tmpJMeetings.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+			outBuf := fs.Output.Bytes()
 
-				j.Meetings = append(j.Meetings, tmpJMeetings)
+			j.Season = string(string(outBuf))
 
-				wantVal = false
-			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Instructors:
-
-	/* handler: j.Instructors type=[]*model.Instructor kind=slice quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-			j.Instructors = nil
-		} else {
-
-			j.Instructors = []*Instructor{}
-
-			wantVal := true
-
-			for {
-
-				var tmpJInstructors *Instructor
-
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
-
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+handle_Year:
 
-				/* handler: tmpJInstructors type=*model.Instructor kind=ptr quoted=false*/
+	/* handler: j.Year type=string kind=string quoted=false*/
 
-				{
-					if tok == fflib.FFTok_null {
+	{
 
-						tmpJInstructors = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-					} else {
+		if tok == fflib.FFTok_null {
 
-						if tmpJInstructors == nil {
-							tmpJInstructors = new(Instructor)
-						}
+		} else {
 
-						err = tmpJInstructors.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+			outBuf := fs.Output.Bytes()
 
-				j.Instructors = append(j.Instructors, tmpJInstructors)
+			j.Year = string(string(outBuf))
 
-				wantVal = false
-			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Books:
+handle_TopicName:
 
-	/* handler: j.Books type=[]*model.Book kind=slice quoted=false*/
+	/* handler: j.TopicName type=string kind=string quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Books = nil
-		} else {
 
-			j.Books = []*Book{}
+		} else {
 
-			wantVal := true
+			outBuf := fs.Output.Bytes()
 
-			for {
+			j.TopicName = string(string(outBuf))
 
-				var tmpJBooks *Book
+		}
+	}
 
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+handle_TopicId:
 
-				/* handler: tmpJBooks type=*model.Book kind=ptr quoted=false*/
+	/* handler: j.TopicId type=string kind=string quoted=false*/
 
-				{
-					if tok == fflib.FFTok_null {
+	{
 
-						tmpJBooks = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-					} else {
+		if tok == fflib.FFTok_null {
 
-						if tmpJBooks == nil {
-							tmpJBooks = new(Book)
-						}
+		} else {
 
-						err = tmpJBooks.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+			outBuf := fs.Output.Bytes()
 
-				j.Books = append(j.Books, tmpJBooks)
+			j.TopicId = string(string(outBuf))
 
-				wantVal = false
-			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Metadata:
+handle_Courses:
 
-	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
+	/* handler: j.Courses type=[]*model.Course kind=slice quoted=false*/
 
 	{
 
@@ -5019,16 +11710,16 @@ handle_Metadata:
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Metadata = nil
+			j.Courses = nil
 		} else {
 
-			j.Metadata = []*Metadata{}
+			j.Courses = []*Course{}
 
 			wantVal := true
 
 			for {
 
-				var tmpJMetadata *Metadata
+				var tmpJCourses *Course
 
 				tok = fs.Scan()
 				if tok == fflib.FFTok_error {
@@ -5049,20 +11740,20 @@ handle_Metadata:
 					wantVal = true
 				}
 
-				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
+				/* handler: tmpJCourses type=*model.Course kind=ptr quoted=false*/
 
 				{
 					if tok == fflib.FFTok_null {
 
-						tmpJMetadata = nil
+						tmpJCourses = nil
 
 					} else {
 
-						if tmpJMetadata == nil {
-							tmpJMetadata = new(Metadata)
+						if tmpJCourses == nil {
+							tmpJCourses = new(Course)
 						}
 
-						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						err = tmpJCourses.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
 						if err != nil {
 							return err
 						}
@@ -5070,7 +11761,7 @@ handle_Metadata:
 					state = fflib.FFParse_after_value
 				}
 
-				j.Metadata = append(j.Metadata, tmpJMetadata)
+				j.Courses = append(j.Courses, tmpJCourses)
 
 				wantVal = false
 			}
@@ -5080,200 +11771,83 @@ handle_Metadata:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-wantedvalue:
-	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-wrongtokenerror:
-	return fs.WrapErr(fmt.Errorf("ffjson: wanted token: %v, but got token: %v output=%s", wantedTok, tok, fs.Output.String()))
-tokerror:
-	if fs.BigError != nil {
-		return fs.WrapErr(fs.BigError)
-	}
-	err = fs.Error.ToError()
-	if err != nil {
-		return fs.WrapErr(err)
-	}
-	panic("ffjson-generated: unreachable, please report bug.")
-done:
-
-	return nil
-}
-
-// MarshalJSON marshal bytes to json - template
-func (j *Semester) MarshalJSON() ([]byte, error) {
-	var buf fflib.Buffer
-	if j == nil {
-		buf.WriteString("null")
-		return buf.Bytes(), nil
-	}
-	err := j.MarshalJSONBuf(&buf)
-	if err != nil {
-		return nil, err
-	}
-	return buf.Bytes(), nil
-}
-
-// MarshalJSONBuf marshal buff to json - template
-func (j *Semester) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
-	if j == nil {
-		buf.WriteString("null")
-		return nil
-	}
-	var err error
-	var obj []byte
-	_ = obj
-	_ = err
-	buf.WriteString(`{"year":`)
-	fflib.FormatBits2(buf, uint64(j.Year), 10, j.Year < 0)
-	buf.WriteString(`,"season":`)
-	fflib.WriteJsonString(buf, string(j.Season))
-	buf.WriteByte('}')
-	return nil
-}
-
-const (
-	ffjtSemesterbase = iota
-	ffjtSemesternosuchkey
-
-	ffjtSemesterYear
-
-	ffjtSemesterSeason
-)
-
-var ffjKeySemesterYear = []byte("year")
-
-var ffjKeySemesterSeason = []byte("season")
-
-// UnmarshalJSON umarshall json - template of ffjson
-func (j *Semester) UnmarshalJSON(input []byte) error {
-	fs := fflib.NewFFLexer(input)
-	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
-}
-
-// UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Semester) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
-	var err error
-	currentKey := ffjtSemesterbase
-	_ = currentKey
-	tok := fflib.FFTok_init
-	wantedTok := fflib.FFTok_init
-
-mainparse:
-	for {
-		tok = fs.Scan()
-		//	println(fmt.Sprintf("debug: tok: %v  state: %v", tok, state))
-		if tok == fflib.FFTok_error {
-			goto tokerror
-		}
-
-		switch state {
+handle_Metadata:
 
-		case fflib.FFParse_map_start:
-			if tok != fflib.FFTok_left_bracket {
-				wantedTok = fflib.FFTok_left_bracket
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_key
-			continue
+	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
 
-		case fflib.FFParse_after_value:
-			if tok == fflib.FFTok_comma {
-				state = fflib.FFParse_want_key
-			} else if tok == fflib.FFTok_right_bracket {
-				goto done
-			} else {
-				wantedTok = fflib.FFTok_comma
-				goto wrongtokenerror
-			}
+	{
 
-		case fflib.FFParse_want_key:
-			// json {} ended. goto exit. woo.
-			if tok == fflib.FFTok_right_bracket {
-				goto done
-			}
-			if tok != fflib.FFTok_string {
-				wantedTok = fflib.FFTok_string
-				goto wrongtokenerror
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
 			}
+		}
 
-			kn := fs.Output.Bytes()
-			if len(kn) <= 0 {
-				// "" case. hrm.
-				currentKey = ffjtSemesternosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			} else {
-				switch kn[0] {
+		if tok == fflib.FFTok_null {
+			j.Metadata = nil
+		} else {
 
-				case 's':
+			j.Metadata = []*Metadata{}
 
-					if bytes.Equal(ffjKeySemesterSeason, kn) {
-						currentKey = ffjtSemesterSeason
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+			wantVal := true
 
-				case 'y':
+			for {
 
-					if bytes.Equal(ffjKeySemesterYear, kn) {
-						currentKey = ffjtSemesterYear
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
+				var tmpJMetadata *Metadata
 
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
 				}
-
-				if fflib.EqualFoldRight(ffjKeySemesterSeason, kn) {
-					currentKey = ffjtSemesterSeason
-					state = fflib.FFParse_want_colon
-					goto mainparse
+				if tok == fflib.FFTok_right_brace {
+					break
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySemesterYear, kn) {
-					currentKey = ffjtSemesterYear
-					state = fflib.FFParse_want_colon
-					goto mainparse
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
 				}
 
-				currentKey = ffjtSemesternosuchkey
-				state = fflib.FFParse_want_colon
-				goto mainparse
-			}
+				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
 
-		case fflib.FFParse_want_colon:
-			if tok != fflib.FFTok_colon {
-				wantedTok = fflib.FFTok_colon
-				goto wrongtokenerror
-			}
-			state = fflib.FFParse_want_value
-			continue
-		case fflib.FFParse_want_value:
+				{
+					if tok == fflib.FFTok_null {
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
-				switch currentKey {
+						tmpJMetadata = nil
 
-				case ffjtSemesterYear:
-					goto handle_Year
+					} else {
 
-				case ffjtSemesterSeason:
-					goto handle_Season
+						if tmpJMetadata == nil {
+							tmpJMetadata = new(Metadata)
+						}
 
-				case ffjtSemesternosuchkey:
-					err = fs.SkipField(tok)
-					if err != nil {
-						return fs.WrapErr(err)
+						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
 					}
 					state = fflib.FFParse_after_value
-					goto mainparse
 				}
-			} else {
-				goto wantedvalue
+
+				j.Metadata = append(j.Metadata, tmpJMetadata)
+
+				wantVal = false
 			}
 		}
 	}
 
-handle_Year:
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-	/* handler: j.Year type=int32 kind=int32 quoted=false*/
+handle_TermCode:
+
+	/* handler: j.TermCode type=int32 kind=int32 quoted=false*/
 
 	{
 		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
@@ -5293,7 +11867,7 @@ handle_Year:
 				return fs.WrapErr(err)
 			}
 
-			j.Year = int32(tval)
+			j.TermCode = int32(tval)
 
 		}
 	}
@@ -5301,9 +11875,9 @@ handle_Year:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Season:
+handle_TermName:
 
-	/* handler: j.Season type=string kind=string quoted=false*/
+	/* handler: j.TermName type=string kind=string quoted=false*/
 
 	{
 
@@ -5319,7 +11893,33 @@ handle_Season:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Season = string(string(outBuf))
+			j.TermName = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_ContentHash:
+
+	/* handler: j.ContentHash type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.ContentHash = string(string(outBuf))
 
 		}
 	}
@@ -5346,7 +11946,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Subject) MarshalJSON() ([]byte, error) {
+func (j *Subscription) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -5360,7 +11960,7 @@ func (j *Subject) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Subject) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Subscription) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -5369,131 +11969,61 @@ func (j *Subject) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{ "name":`)
-	fflib.WriteJsonString(buf, string(j.Name))
-	buf.WriteString(`,"number":`)
-	fflib.WriteJsonString(buf, string(j.Number))
-	buf.WriteString(`,"season":`)
-	fflib.WriteJsonString(buf, string(j.Season))
-	buf.WriteString(`,"year":`)
-	fflib.WriteJsonString(buf, string(j.Year))
+	buf.WriteString(`{"id":`)
+	fflib.WriteJsonString(buf, string(j.Id))
+	buf.WriteString(`,"os":`)
+	fflib.WriteJsonString(buf, string(j.Os))
+	buf.WriteString(`,"is_subscribed":`)
+	fflib.WriteJsonString(buf, string(j.IsSubscribed))
 	buf.WriteString(`,"topic_name":`)
 	fflib.WriteJsonString(buf, string(j.TopicName))
-	buf.WriteString(`,"topic_id":`)
-	fflib.WriteJsonString(buf, string(j.TopicId))
-	buf.WriteByte(',')
-	if len(j.Courses) != 0 {
-		buf.WriteString(`"courses":`)
-		if j.Courses != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Courses {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
-		}
-		buf.WriteByte(',')
-	}
-	if len(j.Metadata) != 0 {
-		buf.WriteString(`"metadata":`)
-		if j.Metadata != nil {
-			buf.WriteString(`[`)
-			for i, v := range j.Metadata {
-				if i != 0 {
-					buf.WriteString(`,`)
-				}
-
-				{
-
-					if v == nil {
-						buf.WriteString("null")
-					} else {
-
-						err = v.MarshalJSONBuf(buf)
-						if err != nil {
-							return err
-						}
-
-					}
-
-				}
-			}
-			buf.WriteString(`]`)
-		} else {
-			buf.WriteString(`null`)
-		}
-		buf.WriteByte(',')
-	}
-	buf.Rewind(1)
+	buf.WriteString(`,"fcm_token":`)
+	fflib.WriteJsonString(buf, string(j.FcmToken))
+	buf.WriteString(`,"created_at":`)
+	fflib.WriteJsonString(buf, string(j.CreatedAt))
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtSubjectbase = iota
-	ffjtSubjectnosuchkey
-
-	ffjtSubjectName
-
-	ffjtSubjectNumber
+	ffjtSubscriptionbase = iota
+	ffjtSubscriptionnosuchkey
 
-	ffjtSubjectSeason
+	ffjtSubscriptionId
 
-	ffjtSubjectYear
+	ffjtSubscriptionOs
 
-	ffjtSubjectTopicName
+	ffjtSubscriptionIsSubscribed
 
-	ffjtSubjectTopicId
+	ffjtSubscriptionTopicName
 
-	ffjtSubjectCourses
+	ffjtSubscriptionFcmToken
 
-	ffjtSubjectMetadata
+	ffjtSubscriptionCreatedAt
 )
 
-var ffjKeySubjectName = []byte("name")
-
-var ffjKeySubjectNumber = []byte("number")
-
-var ffjKeySubjectSeason = []byte("season")
+var ffjKeySubscriptionId = []byte("id")
 
-var ffjKeySubjectYear = []byte("year")
+var ffjKeySubscriptionOs = []byte("os")
 
-var ffjKeySubjectTopicName = []byte("topic_name")
+var ffjKeySubscriptionIsSubscribed = []byte("is_subscribed")
 
-var ffjKeySubjectTopicId = []byte("topic_id")
+var ffjKeySubscriptionTopicName = []byte("topic_name")
 
-var ffjKeySubjectCourses = []byte("courses")
+var ffjKeySubscriptionFcmToken = []byte("fcm_token")
 
-var ffjKeySubjectMetadata = []byte("metadata")
+var ffjKeySubscriptionCreatedAt = []byte("created_at")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Subject) UnmarshalJSON(input []byte) error {
+func (j *Subscription) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Subject) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Subscription) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtSubjectbase
+	currentKey := ffjtSubscriptionbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -5539,121 +12069,96 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtSubjectnosuchkey
+				currentKey = ffjtSubscriptionnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
 				case 'c':
-
-					if bytes.Equal(ffjKeySubjectCourses, kn) {
-						currentKey = ffjtSubjectCourses
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'm':
-
-					if bytes.Equal(ffjKeySubjectMetadata, kn) {
-						currentKey = ffjtSubjectMetadata
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'n':
-
-					if bytes.Equal(ffjKeySubjectName, kn) {
-						currentKey = ffjtSubjectName
-						state = fflib.FFParse_want_colon
-						goto mainparse
-
-					} else if bytes.Equal(ffjKeySubjectNumber, kn) {
-						currentKey = ffjtSubjectNumber
+
+					if bytes.Equal(ffjKeySubscriptionCreatedAt, kn) {
+						currentKey = ffjtSubscriptionCreatedAt
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 's':
+				case 'f':
 
-					if bytes.Equal(ffjKeySubjectSeason, kn) {
-						currentKey = ffjtSubjectSeason
+					if bytes.Equal(ffjKeySubscriptionFcmToken, kn) {
+						currentKey = ffjtSubscriptionFcmToken
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 't':
+				case 'i':
 
-					if bytes.Equal(ffjKeySubjectTopicName, kn) {
-						currentKey = ffjtSubjectTopicName
+					if bytes.Equal(ffjKeySubscriptionId, kn) {
+						currentKey = ffjtSubscriptionId
 						state = fflib.FFParse_want_colon
 						goto mainparse
 
-					} else if bytes.Equal(ffjKeySubjectTopicId, kn) {
-						currentKey = ffjtSubjectTopicId
+					} else if bytes.Equal(ffjKeySubscriptionIsSubscribed, kn) {
+						currentKey = ffjtSubscriptionIsSubscribed
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'y':
+				case 'o':
 
-					if bytes.Equal(ffjKeySubjectYear, kn) {
-						currentKey = ffjtSubjectYear
+					if bytes.Equal(ffjKeySubscriptionOs, kn) {
+						currentKey = ffjtSubscriptionOs
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				}
+				case 't':
 
-				if fflib.SimpleLetterEqualFold(ffjKeySubjectMetadata, kn) {
-					currentKey = ffjtSubjectMetadata
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
+					if bytes.Equal(ffjKeySubscriptionTopicName, kn) {
+						currentKey = ffjtSubscriptionTopicName
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
 
-				if fflib.EqualFoldRight(ffjKeySubjectCourses, kn) {
-					currentKey = ffjtSubjectCourses
-					state = fflib.FFParse_want_colon
-					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeySubjectTopicId, kn) {
-					currentKey = ffjtSubjectTopicId
+				if fflib.AsciiEqualFold(ffjKeySubscriptionCreatedAt, kn) {
+					currentKey = ffjtSubscriptionCreatedAt
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeySubjectTopicName, kn) {
-					currentKey = ffjtSubjectTopicName
+				if fflib.EqualFoldRight(ffjKeySubscriptionFcmToken, kn) {
+					currentKey = ffjtSubscriptionFcmToken
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySubjectYear, kn) {
-					currentKey = ffjtSubjectYear
+				if fflib.AsciiEqualFold(ffjKeySubscriptionTopicName, kn) {
+					currentKey = ffjtSubscriptionTopicName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySubjectSeason, kn) {
-					currentKey = ffjtSubjectSeason
+				if fflib.EqualFoldRight(ffjKeySubscriptionIsSubscribed, kn) {
+					currentKey = ffjtSubscriptionIsSubscribed
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySubjectNumber, kn) {
-					currentKey = ffjtSubjectNumber
+				if fflib.EqualFoldRight(ffjKeySubscriptionOs, kn) {
+					currentKey = ffjtSubscriptionOs
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySubjectName, kn) {
-					currentKey = ffjtSubjectName
+				if fflib.SimpleLetterEqualFold(ffjKeySubscriptionId, kn) {
+					currentKey = ffjtSubscriptionId
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtSubjectnosuchkey
+				currentKey = ffjtSubscriptionnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -5670,31 +12175,25 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtSubjectName:
-					goto handle_Name
-
-				case ffjtSubjectNumber:
-					goto handle_Number
+				case ffjtSubscriptionId:
+					goto handle_Id
 
-				case ffjtSubjectSeason:
-					goto handle_Season
+				case ffjtSubscriptionOs:
+					goto handle_Os
 
-				case ffjtSubjectYear:
-					goto handle_Year
+				case ffjtSubscriptionIsSubscribed:
+					goto handle_IsSubscribed
 
-				case ffjtSubjectTopicName:
+				case ffjtSubscriptionTopicName:
 					goto handle_TopicName
 
-				case ffjtSubjectTopicId:
-					goto handle_TopicId
-
-				case ffjtSubjectCourses:
-					goto handle_Courses
+				case ffjtSubscriptionFcmToken:
+					goto handle_FcmToken
 
-				case ffjtSubjectMetadata:
-					goto handle_Metadata
+				case ffjtSubscriptionCreatedAt:
+					goto handle_CreatedAt
 
-				case ffjtSubjectnosuchkey:
+				case ffjtSubscriptionnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -5708,113 +12207,9 @@ mainparse:
 		}
 	}
 
-handle_Name:
-
-	/* handler: j.Name type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Name = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Number:
-
-	/* handler: j.Number type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Number = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Season:
-
-	/* handler: j.Season type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Season = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_Year:
-
-	/* handler: j.Year type=string kind=string quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Year = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_TopicName:
+handle_Id:
 
-	/* handler: j.TopicName type=string kind=string quoted=false*/
+	/* handler: j.Id type=string kind=string quoted=false*/
 
 	{
 
@@ -5830,7 +12225,7 @@ handle_TopicName:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TopicName = string(string(outBuf))
+			j.Id = string(string(outBuf))
 
 		}
 	}
@@ -5838,9 +12233,9 @@ handle_TopicName:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_TopicId:
+handle_Os:
 
-	/* handler: j.TopicId type=string kind=string quoted=false*/
+	/* handler: j.Os type=string kind=string quoted=false*/
 
 	{
 
@@ -5856,7 +12251,7 @@ handle_TopicId:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TopicId = string(string(outBuf))
+			j.Os = string(string(outBuf))
 
 		}
 	}
@@ -5864,149 +12259,105 @@ handle_TopicId:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Courses:
-
-	/* handler: j.Courses type=[]*model.Course kind=slice quoted=false*/
-
-	{
-
-		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-			j.Courses = nil
-		} else {
-
-			j.Courses = []*Course{}
-
-			wantVal := true
-
-			for {
-
-				var tmpJCourses *Course
-
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
-
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+handle_IsSubscribed:
 
-				/* handler: tmpJCourses type=*model.Course kind=ptr quoted=false*/
+	/* handler: j.IsSubscribed type=string kind=string quoted=false*/
 
-				{
-					if tok == fflib.FFTok_null {
+	{
 
-						tmpJCourses = nil
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-					} else {
+		if tok == fflib.FFTok_null {
 
-						if tmpJCourses == nil {
-							tmpJCourses = new(Course)
-						}
+		} else {
 
-						err = tmpJCourses.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+			outBuf := fs.Output.Bytes()
 
-				j.Courses = append(j.Courses, tmpJCourses)
+			j.IsSubscribed = string(string(outBuf))
 
-				wantVal = false
-			}
 		}
 	}
 
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Metadata:
+handle_TopicName:
 
-	/* handler: j.Metadata type=[]*model.Metadata kind=slice quoted=false*/
+	/* handler: j.TopicName type=string kind=string quoted=false*/
 
 	{
 
 		{
-			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
 
 		if tok == fflib.FFTok_null {
-			j.Metadata = nil
+
 		} else {
 
-			j.Metadata = []*Metadata{}
+			outBuf := fs.Output.Bytes()
 
-			wantVal := true
+			j.TopicName = string(string(outBuf))
 
-			for {
+		}
+	}
 
-				var tmpJMetadata *Metadata
+	state = fflib.FFParse_after_value
+	goto mainparse
 
-				tok = fs.Scan()
-				if tok == fflib.FFTok_error {
-					goto tokerror
-				}
-				if tok == fflib.FFTok_right_brace {
-					break
-				}
+handle_FcmToken:
 
-				if tok == fflib.FFTok_comma {
-					if wantVal == true {
-						// TODO(pquerna): this isn't an ideal error message, this handles
-						// things like [,,,] as an array value.
-						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
-					}
-					continue
-				} else {
-					wantVal = true
-				}
+	/* handler: j.FcmToken type=string kind=string quoted=false*/
 
-				/* handler: tmpJMetadata type=*model.Metadata kind=ptr quoted=false*/
+	{
 
-				{
-					if tok == fflib.FFTok_null {
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
-						tmpJMetadata = nil
+		if tok == fflib.FFTok_null {
 
-					} else {
+		} else {
 
-						if tmpJMetadata == nil {
-							tmpJMetadata = new(Metadata)
-						}
+			outBuf := fs.Output.Bytes()
 
-						err = tmpJMetadata.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
-						if err != nil {
-							return err
-						}
-					}
-					state = fflib.FFParse_after_value
-				}
+			j.FcmToken = string(string(outBuf))
 
-				j.Metadata = append(j.Metadata, tmpJMetadata)
+		}
+	}
 
-				wantVal = false
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_CreatedAt:
+
+	/* handler: j.CreatedAt type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
 			}
 		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.CreatedAt = string(string(outBuf))
+
+		}
 	}
 
 	state = fflib.FFParse_after_value
@@ -6031,7 +12382,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *Subscription) MarshalJSON() ([]byte, error) {
+func (j *SubscriptionView) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -6045,7 +12396,7 @@ func (j *Subscription) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *Subscription) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *SubscriptionView) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -6054,61 +12405,46 @@ func (j *Subscription) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"id":`)
-	fflib.WriteJsonString(buf, string(j.Id))
-	buf.WriteString(`,"os":`)
-	fflib.WriteJsonString(buf, string(j.Os))
-	buf.WriteString(`,"is_subscribed":`)
-	fflib.WriteJsonString(buf, string(j.IsSubscribed))
-	buf.WriteString(`,"topic_name":`)
+	buf.WriteString(`{"topic_name":`)
 	fflib.WriteJsonString(buf, string(j.TopicName))
-	buf.WriteString(`,"fcm_token":`)
-	fflib.WriteJsonString(buf, string(j.FcmToken))
-	buf.WriteString(`,"created_at":`)
-	fflib.WriteJsonString(buf, string(j.CreatedAt))
+	buf.WriteString(`,"subscribers":`)
+	fflib.FormatBits2(buf, uint64(j.Subscribers), 10, j.Subscribers < 0)
+	if j.IsHot {
+		buf.WriteString(`,"is_hot":true`)
+	} else {
+		buf.WriteString(`,"is_hot":false`)
+	}
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtSubscriptionbase = iota
-	ffjtSubscriptionnosuchkey
-
-	ffjtSubscriptionId
-
-	ffjtSubscriptionOs
-
-	ffjtSubscriptionIsSubscribed
+	ffjtSubscriptionViewbase = iota
+	ffjtSubscriptionViewnosuchkey
 
-	ffjtSubscriptionTopicName
+	ffjtSubscriptionViewTopicName
 
-	ffjtSubscriptionFcmToken
+	ffjtSubscriptionViewSubscribers
 
-	ffjtSubscriptionCreatedAt
+	ffjtSubscriptionViewIsHot
 )
 
-var ffjKeySubscriptionId = []byte("id")
-
-var ffjKeySubscriptionOs = []byte("os")
-
-var ffjKeySubscriptionIsSubscribed = []byte("is_subscribed")
-
-var ffjKeySubscriptionTopicName = []byte("topic_name")
+var ffjKeySubscriptionViewTopicName = []byte("topic_name")
 
-var ffjKeySubscriptionFcmToken = []byte("fcm_token")
+var ffjKeySubscriptionViewSubscribers = []byte("subscribers")
 
-var ffjKeySubscriptionCreatedAt = []byte("created_at")
+var ffjKeySubscriptionViewIsHot = []byte("is_hot")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *Subscription) UnmarshalJSON(input []byte) error {
+func (j *SubscriptionView) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *Subscription) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *SubscriptionView) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtSubscriptionbase
+	currentKey := ffjtSubscriptionViewbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -6154,96 +12490,57 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtSubscriptionnosuchkey
+				currentKey = ffjtSubscriptionViewnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'c':
-
-					if bytes.Equal(ffjKeySubscriptionCreatedAt, kn) {
-						currentKey = ffjtSubscriptionCreatedAt
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
-				case 'f':
-
-					if bytes.Equal(ffjKeySubscriptionFcmToken, kn) {
-						currentKey = ffjtSubscriptionFcmToken
-						state = fflib.FFParse_want_colon
-						goto mainparse
-					}
-
 				case 'i':
 
-					if bytes.Equal(ffjKeySubscriptionId, kn) {
-						currentKey = ffjtSubscriptionId
-						state = fflib.FFParse_want_colon
-						goto mainparse
-
-					} else if bytes.Equal(ffjKeySubscriptionIsSubscribed, kn) {
-						currentKey = ffjtSubscriptionIsSubscribed
+					if bytes.Equal(ffjKeySubscriptionViewIsHot, kn) {
+						currentKey = ffjtSubscriptionViewIsHot
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 'o':
+				case 's':
 
-					if bytes.Equal(ffjKeySubscriptionOs, kn) {
-						currentKey = ffjtSubscriptionOs
+					if bytes.Equal(ffjKeySubscriptionViewSubscribers, kn) {
+						currentKey = ffjtSubscriptionViewSubscribers
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 't':
 
-					if bytes.Equal(ffjKeySubscriptionTopicName, kn) {
-						currentKey = ffjtSubscriptionTopicName
+					if bytes.Equal(ffjKeySubscriptionViewTopicName, kn) {
+						currentKey = ffjtSubscriptionViewTopicName
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.AsciiEqualFold(ffjKeySubscriptionCreatedAt, kn) {
-					currentKey = ffjtSubscriptionCreatedAt
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeySubscriptionFcmToken, kn) {
-					currentKey = ffjtSubscriptionFcmToken
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.AsciiEqualFold(ffjKeySubscriptionTopicName, kn) {
-					currentKey = ffjtSubscriptionTopicName
-					state = fflib.FFParse_want_colon
-					goto mainparse
-				}
-
-				if fflib.EqualFoldRight(ffjKeySubscriptionIsSubscribed, kn) {
-					currentKey = ffjtSubscriptionIsSubscribed
+				if fflib.EqualFoldRight(ffjKeySubscriptionViewIsHot, kn) {
+					currentKey = ffjtSubscriptionViewIsHot
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySubscriptionOs, kn) {
-					currentKey = ffjtSubscriptionOs
+				if fflib.EqualFoldRight(ffjKeySubscriptionViewSubscribers, kn) {
+					currentKey = ffjtSubscriptionViewSubscribers
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.SimpleLetterEqualFold(ffjKeySubscriptionId, kn) {
-					currentKey = ffjtSubscriptionId
+				if fflib.AsciiEqualFold(ffjKeySubscriptionViewTopicName, kn) {
+					currentKey = ffjtSubscriptionViewTopicName
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtSubscriptionnosuchkey
+				currentKey = ffjtSubscriptionViewnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -6257,28 +12554,19 @@ mainparse:
 			continue
 		case fflib.FFParse_want_value:
 
-			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
-				switch currentKey {
-
-				case ffjtSubscriptionId:
-					goto handle_Id
-
-				case ffjtSubscriptionOs:
-					goto handle_Os
-
-				case ffjtSubscriptionIsSubscribed:
-					goto handle_IsSubscribed
+			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
+				switch currentKey {
 
-				case ffjtSubscriptionTopicName:
+				case ffjtSubscriptionViewTopicName:
 					goto handle_TopicName
 
-				case ffjtSubscriptionFcmToken:
-					goto handle_FcmToken
+				case ffjtSubscriptionViewSubscribers:
+					goto handle_Subscribers
 
-				case ffjtSubscriptionCreatedAt:
-					goto handle_CreatedAt
+				case ffjtSubscriptionViewIsHot:
+					goto handle_IsHot
 
-				case ffjtSubscriptionnosuchkey:
+				case ffjtSubscriptionViewnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -6292,9 +12580,9 @@ mainparse:
 		}
 	}
 
-handle_Id:
+handle_TopicName:
 
-	/* handler: j.Id type=string kind=string quoted=false*/
+	/* handler: j.TopicName type=string kind=string quoted=false*/
 
 	{
 
@@ -6310,7 +12598,7 @@ handle_Id:
 
 			outBuf := fs.Output.Bytes()
 
-			j.Id = string(string(outBuf))
+			j.TopicName = string(string(outBuf))
 
 		}
 	}
@@ -6318,77 +12606,29 @@ handle_Id:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Os:
+handle_Subscribers:
 
-	/* handler: j.Os type=string kind=string quoted=false*/
+	/* handler: j.Subscribers type=int64 kind=int64 quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.Os = string(string(outBuf))
-
+		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
 		}
 	}
 
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_IsSubscribed:
-
-	/* handler: j.IsSubscribed type=string kind=string quoted=false*/
-
 	{
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
-		}
-
 		if tok == fflib.FFTok_null {
 
 		} else {
 
-			outBuf := fs.Output.Bytes()
-
-			j.IsSubscribed = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
-
-handle_TopicName:
-
-	/* handler: j.TopicName type=string kind=string quoted=false*/
-
-	{
+			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			if err != nil {
+				return fs.WrapErr(err)
 			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
 
-			outBuf := fs.Output.Bytes()
-
-			j.TopicName = string(string(outBuf))
+			j.Subscribers = int64(tval)
 
 		}
 	}
@@ -6396,51 +12636,34 @@ handle_TopicName:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_FcmToken:
+handle_IsHot:
 
-	/* handler: j.FcmToken type=string kind=string quoted=false*/
+	/* handler: j.IsHot type=bool kind=bool quoted=false*/
 
 	{
-
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
-			}
+		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
+			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
 		}
+	}
 
+	{
 		if tok == fflib.FFTok_null {
 
 		} else {
+			tmpb := fs.Output.Bytes()
 
-			outBuf := fs.Output.Bytes()
-
-			j.FcmToken = string(string(outBuf))
-
-		}
-	}
-
-	state = fflib.FFParse_after_value
-	goto mainparse
+			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
 
-handle_CreatedAt:
+				j.IsHot = true
 
-	/* handler: j.CreatedAt type=string kind=string quoted=false*/
+			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
 
-	{
+				j.IsHot = false
 
-		{
-			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
-				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			} else {
+				err = errors.New("unexpected bytes for true/false value")
+				return fs.WrapErr(err)
 			}
-		}
-
-		if tok == fflib.FFTok_null {
-
-		} else {
-
-			outBuf := fs.Output.Bytes()
-
-			j.CreatedAt = string(string(outBuf))
 
 		}
 	}
@@ -6467,7 +12690,7 @@ done:
 }
 
 // MarshalJSON marshal bytes to json - template
-func (j *SubscriptionView) MarshalJSON() ([]byte, error) {
+func (j *Teaching) MarshalJSON() ([]byte, error) {
 	var buf fflib.Buffer
 	if j == nil {
 		buf.WriteString("null")
@@ -6481,7 +12704,7 @@ func (j *SubscriptionView) MarshalJSON() ([]byte, error) {
 }
 
 // MarshalJSONBuf marshal buff to json - template
-func (j *SubscriptionView) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
+func (j *Teaching) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	if j == nil {
 		buf.WriteString("null")
 		return nil
@@ -6490,46 +12713,58 @@ func (j *SubscriptionView) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 	var obj []byte
 	_ = obj
 	_ = err
-	buf.WriteString(`{"topic_name":`)
-	fflib.WriteJsonString(buf, string(j.TopicName))
-	buf.WriteString(`,"subscribers":`)
-	fflib.FormatBits2(buf, uint64(j.Subscribers), 10, j.Subscribers < 0)
-	if j.IsHot {
-		buf.WriteString(`,"is_hot":true`)
-	} else {
-		buf.WriteString(`,"is_hot":false`)
+	buf.WriteString(`{ "season":`)
+	fflib.WriteJsonString(buf, string(j.Season))
+	buf.WriteString(`,"year":`)
+	fflib.WriteJsonString(buf, string(j.Year))
+	buf.WriteByte(',')
+	if j.Course != nil {
+		if true {
+			buf.WriteString(`"course":`)
+
+			{
+
+				err = j.Course.MarshalJSONBuf(buf)
+				if err != nil {
+					return err
+				}
+
+			}
+			buf.WriteByte(',')
+		}
 	}
+	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
 }
 
 const (
-	ffjtSubscriptionViewbase = iota
-	ffjtSubscriptionViewnosuchkey
+	ffjtTeachingbase = iota
+	ffjtTeachingnosuchkey
 
-	ffjtSubscriptionViewTopicName
+	ffjtTeachingSeason
 
-	ffjtSubscriptionViewSubscribers
+	ffjtTeachingYear
 
-	ffjtSubscriptionViewIsHot
+	ffjtTeachingCourse
 )
 
-var ffjKeySubscriptionViewTopicName = []byte("topic_name")
+var ffjKeyTeachingSeason = []byte("season")
 
-var ffjKeySubscriptionViewSubscribers = []byte("subscribers")
+var ffjKeyTeachingYear = []byte("year")
 
-var ffjKeySubscriptionViewIsHot = []byte("is_hot")
+var ffjKeyTeachingCourse = []byte("course")
 
 // UnmarshalJSON umarshall json - template of ffjson
-func (j *SubscriptionView) UnmarshalJSON(input []byte) error {
+func (j *Teaching) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
 	return j.UnmarshalJSONFFLexer(fs, fflib.FFParse_map_start)
 }
 
 // UnmarshalJSONFFLexer fast json unmarshall - template ffjson
-func (j *SubscriptionView) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
+func (j *Teaching) UnmarshalJSONFFLexer(fs *fflib.FFLexer, state fflib.FFParseState) error {
 	var err error
-	currentKey := ffjtSubscriptionViewbase
+	currentKey := ffjtTeachingbase
 	_ = currentKey
 	tok := fflib.FFTok_init
 	wantedTok := fflib.FFTok_init
@@ -6575,57 +12810,57 @@ mainparse:
 			kn := fs.Output.Bytes()
 			if len(kn) <= 0 {
 				// "" case. hrm.
-				currentKey = ffjtSubscriptionViewnosuchkey
+				currentKey = ffjtTeachingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			} else {
 				switch kn[0] {
 
-				case 'i':
+				case 'c':
 
-					if bytes.Equal(ffjKeySubscriptionViewIsHot, kn) {
-						currentKey = ffjtSubscriptionViewIsHot
+					if bytes.Equal(ffjKeyTeachingCourse, kn) {
+						currentKey = ffjtTeachingCourse
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				case 's':
 
-					if bytes.Equal(ffjKeySubscriptionViewSubscribers, kn) {
-						currentKey = ffjtSubscriptionViewSubscribers
+					if bytes.Equal(ffjKeyTeachingSeason, kn) {
+						currentKey = ffjtTeachingSeason
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
-				case 't':
+				case 'y':
 
-					if bytes.Equal(ffjKeySubscriptionViewTopicName, kn) {
-						currentKey = ffjtSubscriptionViewTopicName
+					if bytes.Equal(ffjKeyTeachingYear, kn) {
+						currentKey = ffjtTeachingYear
 						state = fflib.FFParse_want_colon
 						goto mainparse
 					}
 
 				}
 
-				if fflib.EqualFoldRight(ffjKeySubscriptionViewIsHot, kn) {
-					currentKey = ffjtSubscriptionViewIsHot
+				if fflib.EqualFoldRight(ffjKeyTeachingCourse, kn) {
+					currentKey = ffjtTeachingCourse
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.EqualFoldRight(ffjKeySubscriptionViewSubscribers, kn) {
-					currentKey = ffjtSubscriptionViewSubscribers
+				if fflib.SimpleLetterEqualFold(ffjKeyTeachingYear, kn) {
+					currentKey = ffjtTeachingYear
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				if fflib.AsciiEqualFold(ffjKeySubscriptionViewTopicName, kn) {
-					currentKey = ffjtSubscriptionViewTopicName
+				if fflib.EqualFoldRight(ffjKeyTeachingSeason, kn) {
+					currentKey = ffjtTeachingSeason
 					state = fflib.FFParse_want_colon
 					goto mainparse
 				}
 
-				currentKey = ffjtSubscriptionViewnosuchkey
+				currentKey = ffjtTeachingnosuchkey
 				state = fflib.FFParse_want_colon
 				goto mainparse
 			}
@@ -6642,16 +12877,16 @@ mainparse:
 			if tok == fflib.FFTok_left_brace || tok == fflib.FFTok_left_bracket || tok == fflib.FFTok_integer || tok == fflib.FFTok_double || tok == fflib.FFTok_string || tok == fflib.FFTok_bool || tok == fflib.FFTok_null {
 				switch currentKey {
 
-				case ffjtSubscriptionViewTopicName:
-					goto handle_TopicName
+				case ffjtTeachingSeason:
+					goto handle_Season
 
-				case ffjtSubscriptionViewSubscribers:
-					goto handle_Subscribers
+				case ffjtTeachingYear:
+					goto handle_Year
 
-				case ffjtSubscriptionViewIsHot:
-					goto handle_IsHot
+				case ffjtTeachingCourse:
+					goto handle_Course
 
-				case ffjtSubscriptionViewnosuchkey:
+				case ffjtTeachingnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
 						return fs.WrapErr(err)
@@ -6665,9 +12900,9 @@ mainparse:
 		}
 	}
 
-handle_TopicName:
+handle_Season:
 
-	/* handler: j.TopicName type=string kind=string quoted=false*/
+	/* handler: j.Season type=string kind=string quoted=false*/
 
 	{
 
@@ -6683,7 +12918,7 @@ handle_TopicName:
 
 			outBuf := fs.Output.Bytes()
 
-			j.TopicName = string(string(outBuf))
+			j.Season = string(string(outBuf))
 
 		}
 	}
@@ -6691,29 +12926,25 @@ handle_TopicName:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_Subscribers:
+handle_Year:
 
-	/* handler: j.Subscribers type=int64 kind=int64 quoted=false*/
+	/* handler: j.Year type=string kind=string quoted=false*/
 
 	{
-		if tok != fflib.FFTok_integer && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for int64", tok))
-		}
-	}
 
-	{
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
 
 		if tok == fflib.FFTok_null {
 
 		} else {
 
-			tval, err := fflib.ParseInt(fs.Output.Bytes(), 10, 64)
-
-			if err != nil {
-				return fs.WrapErr(err)
-			}
+			outBuf := fs.Output.Bytes()
 
-			j.Subscribers = int64(tval)
+			j.Year = string(string(outBuf))
 
 		}
 	}
@@ -6721,36 +12952,27 @@ handle_Subscribers:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
-handle_IsHot:
-
-	/* handler: j.IsHot type=bool kind=bool quoted=false*/
+handle_Course:
 
-	{
-		if tok != fflib.FFTok_bool && tok != fflib.FFTok_null {
-			return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for bool", tok))
-		}
-	}
+	/* handler: j.Course type=model.Course kind=struct quoted=false*/
 
 	{
 		if tok == fflib.FFTok_null {
 
-		} else {
-			tmpb := fs.Output.Bytes()
-
-			if bytes.Compare([]byte{'t', 'r', 'u', 'e'}, tmpb) == 0 {
-
-				j.IsHot = true
-
-			} else if bytes.Compare([]byte{'f', 'a', 'l', 's', 'e'}, tmpb) == 0 {
+			j.Course = nil
 
-				j.IsHot = false
+		} else {
 
-			} else {
-				err = errors.New("unexpected bytes for true/false value")
-				return fs.WrapErr(err)
+			if j.Course == nil {
+				j.Course = new(Course)
 			}
 
+			err = j.Course.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+			if err != nil {
+				return err
+			}
 		}
+		state = fflib.FFParse_after_value
 	}
 
 	state = fflib.FFParse_after_value
@@ -6814,6 +13036,25 @@ func (j *UCTNotification) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 
 	}
+	buf.WriteByte(',')
+	if len(j.CrossListedTopicNames) != 0 {
+		buf.WriteString(`"cross_listed_topic_names":`)
+		if j.CrossListedTopicNames != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.CrossListedTopicNames {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+				fflib.WriteJsonString(buf, string(v))
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"type":`)
+	fflib.WriteJsonString(buf, string(j.Type))
 	buf.WriteByte('}')
 	return nil
 }
@@ -6829,6 +13070,10 @@ const (
 	ffjtUCTNotificationStatus
 
 	ffjtUCTNotificationUniversity
+
+	ffjtUCTNotificationCrossListedTopicNames
+
+	ffjtUCTNotificationType
 )
 
 var ffjKeyUCTNotificationNotificationId = []byte("notification_id")
@@ -6839,6 +13084,10 @@ var ffjKeyUCTNotificationStatus = []byte("status")
 
 var ffjKeyUCTNotificationUniversity = []byte("university")
 
+var ffjKeyUCTNotificationCrossListedTopicNames = []byte("cross_listed_topic_names")
+
+var ffjKeyUCTNotificationType = []byte("type")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *UCTNotification) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -6900,6 +13149,14 @@ mainparse:
 			} else {
 				switch kn[0] {
 
+				case 'c':
+
+					if bytes.Equal(ffjKeyUCTNotificationCrossListedTopicNames, kn) {
+						currentKey = ffjtUCTNotificationCrossListedTopicNames
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'n':
 
 					if bytes.Equal(ffjKeyUCTNotificationNotificationId, kn) {
@@ -6922,6 +13179,11 @@ mainparse:
 						currentKey = ffjtUCTNotificationTopicName
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyUCTNotificationType, kn) {
+						currentKey = ffjtUCTNotificationType
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				case 'u':
@@ -6934,6 +13196,18 @@ mainparse:
 
 				}
 
+				if fflib.SimpleLetterEqualFold(ffjKeyUCTNotificationType, kn) {
+					currentKey = ffjtUCTNotificationType
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.EqualFoldRight(ffjKeyUCTNotificationCrossListedTopicNames, kn) {
+					currentKey = ffjtUCTNotificationCrossListedTopicNames
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.EqualFoldRight(ffjKeyUCTNotificationUniversity, kn) {
 					currentKey = ffjtUCTNotificationUniversity
 					state = fflib.FFParse_want_colon
@@ -6987,6 +13261,12 @@ mainparse:
 				case ffjtUCTNotificationUniversity:
 					goto handle_University
 
+				case ffjtUCTNotificationCrossListedTopicNames:
+					goto handle_CrossListedTopicNames
+
+				case ffjtUCTNotificationType:
+					goto handle_Type
+
 				case ffjtUCTNotificationnosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -7103,6 +13383,106 @@ handle_University:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_CrossListedTopicNames:
+
+	/* handler: j.CrossListedTopicNames type=[]string kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.CrossListedTopicNames = nil
+		} else {
+
+			j.CrossListedTopicNames = []string{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJCrossListedTopicNames string
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJCrossListedTopicNames type=string kind=string quoted=false*/
+
+				{
+
+					{
+						if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+							return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+						}
+					}
+
+					if tok == fflib.FFTok_null {
+
+					} else {
+
+						outBuf := fs.Output.Bytes()
+
+						tmpJCrossListedTopicNames = string(string(outBuf))
+
+					}
+				}
+
+				j.CrossListedTopicNames = append(j.CrossListedTopicNames, tmpJCrossListedTopicNames)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Type:
+
+	/* handler: j.Type type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.Type = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
@@ -7299,6 +13679,39 @@ func (j *University) MarshalJSONBuf(buf fflib.EncodingBuffer) error {
 		}
 		buf.WriteByte(',')
 	}
+	buf.WriteString(`"time_zone":`)
+	fflib.WriteJsonString(buf, string(j.TimeZone))
+	buf.WriteByte(',')
+	if len(j.Buildings) != 0 {
+		buf.WriteString(`"buildings":`)
+		if j.Buildings != nil {
+			buf.WriteString(`[`)
+			for i, v := range j.Buildings {
+				if i != 0 {
+					buf.WriteString(`,`)
+				}
+
+				{
+
+					if v == nil {
+						buf.WriteString("null")
+					} else {
+
+						err = v.MarshalJSONBuf(buf)
+						if err != nil {
+							return err
+						}
+
+					}
+
+				}
+			}
+			buf.WriteString(`]`)
+		} else {
+			buf.WriteString(`null`)
+		}
+		buf.WriteByte(',')
+	}
 	buf.Rewind(1)
 	buf.WriteByte('}')
 	return nil
@@ -7335,6 +13748,10 @@ const (
 	ffjtUniversityRegistrations
 
 	ffjtUniversityMetadata
+
+	ffjtUniversityTimeZone
+
+	ffjtUniversityBuildings
 )
 
 var ffjKeyUniversityId = []byte("id")
@@ -7365,6 +13782,10 @@ var ffjKeyUniversityRegistrations = []byte("registrations")
 
 var ffjKeyUniversityMetadata = []byte("metadata")
 
+var ffjKeyUniversityTimeZone = []byte("time_zone")
+
+var ffjKeyUniversityBuildings = []byte("buildings")
+
 // UnmarshalJSON umarshall json - template of ffjson
 func (j *University) UnmarshalJSON(input []byte) error {
 	fs := fflib.NewFFLexer(input)
@@ -7444,6 +13865,14 @@ mainparse:
 						goto mainparse
 					}
 
+				case 'b':
+
+					if bytes.Equal(ffjKeyUniversityBuildings, kn) {
+						currentKey = ffjtUniversityBuildings
+						state = fflib.FFParse_want_colon
+						goto mainparse
+					}
+
 				case 'h':
 
 					if bytes.Equal(ffjKeyUniversityHomePage, kn) {
@@ -7518,10 +13947,27 @@ mainparse:
 						currentKey = ffjtUniversityTopicId
 						state = fflib.FFParse_want_colon
 						goto mainparse
+
+					} else if bytes.Equal(ffjKeyUniversityTimeZone, kn) {
+						currentKey = ffjtUniversityTimeZone
+						state = fflib.FFParse_want_colon
+						goto mainparse
 					}
 
 				}
 
+				if fflib.EqualFoldRight(ffjKeyUniversityBuildings, kn) {
+					currentKey = ffjtUniversityBuildings
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
+				if fflib.AsciiEqualFold(ffjKeyUniversityTimeZone, kn) {
+					currentKey = ffjtUniversityTimeZone
+					state = fflib.FFParse_want_colon
+					goto mainparse
+				}
+
 				if fflib.SimpleLetterEqualFold(ffjKeyUniversityMetadata, kn) {
 					currentKey = ffjtUniversityMetadata
 					state = fflib.FFParse_want_colon
@@ -7665,6 +14111,12 @@ mainparse:
 				case ffjtUniversityMetadata:
 					goto handle_Metadata
 
+				case ffjtUniversityTimeZone:
+					goto handle_TimeZone
+
+				case ffjtUniversityBuildings:
+					goto handle_Buildings
+
 				case ffjtUniversitynosuchkey:
 					err = fs.SkipField(tok)
 					if err != nil {
@@ -8239,6 +14691,106 @@ handle_Metadata:
 	state = fflib.FFParse_after_value
 	goto mainparse
 
+handle_TimeZone:
+
+	/* handler: j.TimeZone type=string kind=string quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_string && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for string", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+
+		} else {
+
+			outBuf := fs.Output.Bytes()
+
+			j.TimeZone = string(string(outBuf))
+
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
+handle_Buildings:
+
+	/* handler: j.Buildings type=[]*model.Building kind=slice quoted=false*/
+
+	{
+
+		{
+			if tok != fflib.FFTok_left_brace && tok != fflib.FFTok_null {
+				return fs.WrapErr(fmt.Errorf("cannot unmarshal %s into Go value for ", tok))
+			}
+		}
+
+		if tok == fflib.FFTok_null {
+			j.Buildings = nil
+		} else {
+
+			j.Buildings = []*Building{}
+
+			wantVal := true
+
+			for {
+
+				var tmpJBuildings *Building
+
+				tok = fs.Scan()
+				if tok == fflib.FFTok_error {
+					goto tokerror
+				}
+				if tok == fflib.FFTok_right_brace {
+					break
+				}
+
+				if tok == fflib.FFTok_comma {
+					if wantVal == true {
+						// TODO(pquerna): this isn't an ideal error message, this handles
+						// things like [,,,] as an array value.
+						return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
+					}
+					continue
+				} else {
+					wantVal = true
+				}
+
+				/* handler: tmpJBuildings type=*model.Building kind=ptr quoted=false*/
+
+				{
+					if tok == fflib.FFTok_null {
+
+						tmpJBuildings = nil
+
+					} else {
+
+						if tmpJBuildings == nil {
+							tmpJBuildings = new(Building)
+						}
+
+						err = tmpJBuildings.UnmarshalJSONFFLexer(fs, fflib.FFParse_want_key)
+						if err != nil {
+							return err
+						}
+					}
+					state = fflib.FFParse_after_value
+				}
+
+				j.Buildings = append(j.Buildings, tmpJBuildings)
+
+				wantVal = false
+			}
+		}
+	}
+
+	state = fflib.FFParse_after_value
+	goto mainparse
+
 wantedvalue:
 	return fs.WrapErr(fmt.Errorf("wanted value token, but got token: %v", tok))
 wrongtokenerror:
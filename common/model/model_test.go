@@ -9,175 +9,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-var rutgers = []*Registration{
-	{
-		Period:     InFall.String(),
-		PeriodDate: time.Date(0000, time.September, 6, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     InSpring.String(),
-		PeriodDate: time.Date(0000, time.January, 17, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     InSummer.String(),
-		PeriodDate: time.Date(0000, time.May, 30, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     InWinter.String(),
-		PeriodDate: time.Date(0000, time.December, 23, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     StartFall.String(),
-		PeriodDate: time.Date(0000, time.March, 20, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     StartSpring.String(),
-		PeriodDate: time.Date(0000, time.October, 18, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     StartSummer.String(),
-		PeriodDate: time.Date(0000, time.January, 14, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     StartWinter.String(),
-		PeriodDate: time.Date(0000, time.September, 21, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     EndFall.String(),
-		PeriodDate: time.Date(0000, time.September, 13, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     EndSpring.String(),
-		PeriodDate: time.Date(0000, time.January, 27, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     EndSummer.String(),
-		PeriodDate: time.Date(0000, time.June, 15, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     EndWinter.String(),
-		PeriodDate: time.Date(0000, time.December, 22, 0, 0, 0, 0, time.UTC).Unix(),
-	}}
-
-func TestResolveSemesters(t *testing.T) {
-
-	semesters := ResolveSemesters(time.Now(), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Summer, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Fall, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Winter, semesters.Next.Season)
-
-	semesters = ResolveSemesters(time.Date(2015, time.December, 24, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2015, int(semesters.Last.Year))
-	assert.Equal(t, Winter, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Spring, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Summer, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.January, 8, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2015, int(semesters.Last.Year))
-	assert.Equal(t, Winter, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Spring, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Summer, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.March, 19, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2015, int(semesters.Last.Year))
-	assert.Equal(t, Winter, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Spring, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Summer, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.March, 20, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Spring, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Summer, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Fall, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.April, 30, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Spring, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Summer, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Fall, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.June, 14, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Spring, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Summer, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Fall, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.June, 15, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Summer, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Fall, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Winter, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.September, 15, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Summer, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Fall, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Winter, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.October, 17, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Summer, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Fall, semesters.Current.Season)
-	assert.Equal(t, 2016, int(semesters.Next.Year))
-	assert.Equal(t, Winter, semesters.Next.Season)
-
-	semesters = ResolveSemesters(time.Date(2016, time.October, 18, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Fall, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Winter, semesters.Current.Season)
-	assert.Equal(t, 2017, int(semesters.Next.Year))
-	assert.Equal(t, Spring, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.November, 1, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Fall, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Winter, semesters.Current.Season)
-	assert.Equal(t, 2017, int(semesters.Next.Year))
-	assert.Equal(t, Spring, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-	semesters = ResolveSemesters(time.Date(2016, time.December, 22, 0, 0, 0, 0, time.UTC), rutgers)
-	assert.Equal(t, 2016, int(semesters.Last.Year))
-	assert.Equal(t, Fall, semesters.Last.Season)
-	assert.Equal(t, 2016, int(semesters.Current.Year))
-	assert.Equal(t, Winter, semesters.Current.Season)
-	assert.Equal(t, 2017, int(semesters.Next.Year))
-	assert.Equal(t, Spring, semesters.Next.Season)
-	//fmt.Printf("%#v\n", semesters)
-
-}
-
 func TestToTitle(t *testing.T) {
 	str := "ART APPRECIATION VIIIII"
 	expect := "Art Appreciation VIIIII"
@@ -283,3 +114,30 @@ func BenchmarkToTopicName(b *testing.B) {
 		ToTopicName(str)
 	}
 }
+
+func TestSemesterDates(t *testing.T) {
+	registrations := []*Registration{
+		{Period: InFall.String(), PeriodDate: time.Date(2000, time.September, 6, 0, 0, 0, 0, time.UTC).Unix()},
+		{Period: InSpring.String(), PeriodDate: time.Date(2000, time.January, 17, 0, 0, 0, 0, time.UTC).Unix()},
+		{Period: InSummer.String(), PeriodDate: time.Date(2000, time.May, 30, 0, 0, 0, 0, time.UTC).Unix()},
+		{Period: InWinter.String(), PeriodDate: time.Date(2000, time.December, 23, 0, 0, 0, 0, time.UTC).Unix()},
+	}
+
+	start, end, err := SemesterDates(registrations, Semester{Season: Fall, Year: 2017})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, time.September, 6, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2017, time.December, 22, 0, 0, 0, 0, time.UTC), end)
+
+	start, end, err = SemesterDates(registrations, Semester{Season: Winter, Year: 2017})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2017, time.December, 23, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2018, time.January, 16, 0, 0, 0, 0, time.UTC), end)
+
+	_, _, err = SemesterDates(registrations[:1], Semester{Season: Fall, Year: 2017})
+	assert.Error(t, err)
+
+	// Only the legacy seasons end the day before the following season
+	registrations = append(registrations, &Registration{Period: "summer_1", PeriodDate: time.Date(2000, time.May, 30, 0, 0, 0, 0, time.UTC).Unix()})
+	_, _, err = SemesterDates(registrations, Semester{Season: "summer_1", Year: 2026})
+	assert.Error(t, err)
+}
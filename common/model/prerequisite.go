@@ -0,0 +1,229 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	And = "and"
+	Or  = "or"
+)
+
+// Titles of the metadata that universities use for prerequisite notes
+var prerequisiteTitles = map[string]bool{
+	"Prequisites":            true,
+	"Prerequisites":          true,
+	"Enrollment Requirement": true,
+}
+
+var (
+	htmlTag = regexp.MustCompile(`<[^>]*>`)
+	// Parentheses, operators and course references such as 01:640:135, 640:135 or MTH 2030
+	prerequisiteToken = regexp.MustCompile(`\(|\)|(?i:\band\b|\bor\b)|&|\b(?:\d{2}:)?(\d{3}):(\d{3})\b|\b([A-Z]{2,5}) ?(\d{3,5}[A-Z]?)\b`)
+)
+
+type prerequisiteParser struct {
+	tokens [][]string
+	pos    int
+	// Number of open parentheses
+	depth int
+}
+
+// ParsePrerequisite parses prerequisite notes into an expression of course references. Text other than
+// course references, parentheses, "and" and "or" is ignored and adjacent references are required together.
+// Returns nil when the notes do not reference any course.
+func ParsePrerequisite(notes string) *Prerequisite {
+	notes = htmlTag.ReplaceAllString(notes, " ")
+	parser := &prerequisiteParser{tokens: prerequisiteToken.FindAllStringSubmatch(notes, -1)}
+
+	return parser.parseOr()
+}
+
+func (parser *prerequisiteParser) peek() string {
+	if parser.pos >= len(parser.tokens) {
+		return ""
+	}
+	return strings.ToLower(parser.tokens[parser.pos][0])
+}
+
+func (parser *prerequisiteParser) parseOr() *Prerequisite {
+	operands := []*Prerequisite{parser.parseAnd()}
+	for parser.peek() == Or {
+		parser.pos++
+		operands = append(operands, parser.parseAnd())
+	}
+	return combine(Or, operands)
+}
+
+func (parser *prerequisiteParser) parseAnd() *Prerequisite {
+	operands := []*Prerequisite{parser.parseOperand()}
+	for {
+		switch token := parser.peek(); token {
+		case And, "&":
+			parser.pos++
+			operands = append(operands, parser.parseOperand())
+		case ")":
+			if parser.depth > 0 {
+				return combine(And, operands)
+			}
+			// Unbalanced closing parenthesis
+			parser.pos++
+		case "", Or:
+			return combine(And, operands)
+		default:
+			operands = append(operands, parser.parseOperand())
+		}
+	}
+}
+
+func (parser *prerequisiteParser) parseOperand() *Prerequisite {
+	switch parser.peek() {
+	case "", ")":
+		return nil
+	case "(":
+		parser.pos++
+		parser.depth++
+		operand := parser.parseOr()
+		parser.depth--
+		if parser.peek() == ")" {
+			parser.pos++
+		}
+		return operand
+	case And, "&", Or:
+		// An operator without a left operand, e.g "with a grade of C or better"
+		parser.pos++
+		return nil
+	}
+
+	token := parser.tokens[parser.pos]
+	parser.pos++
+	if token[1] != "" {
+		return &Prerequisite{SubjectNumber: token[1], CourseNumber: token[2]}
+	}
+	return &Prerequisite{SubjectNumber: token[3], CourseNumber: token[4]}
+}
+
+// combine joins the operands under the operator, dropping empty and repeated operands and merging operands
+// with the same operator.
+func combine(operator string, operands []*Prerequisite) *Prerequisite {
+	var combined []*Prerequisite
+	add := func(operand *Prerequisite) {
+		for _, existing := range combined {
+			if existing.Equal(operand) {
+				return
+			}
+		}
+		combined = append(combined, operand)
+	}
+
+	for _, operand := range operands {
+		if operand == nil {
+			continue
+		} else if operand.Operator == operator {
+			for _, nested := range operand.Operands {
+				add(nested)
+			}
+		} else {
+			add(operand)
+		}
+	}
+
+	switch len(combined) {
+	case 0:
+		return nil
+	case 1:
+		return combined[0]
+	default:
+		return &Prerequisite{Operator: operator, Operands: combined}
+	}
+}
+
+// TopicNames returns the topic names of every course referenced by the expression that is offered in
+// the same semester.
+func (p *Prerequisite) TopicNames() (topicNames []string) {
+	if p == nil {
+		return
+	}
+
+	seen := map[string]bool{}
+	var walk func(p *Prerequisite)
+	walk = func(p *Prerequisite) {
+		if p.TopicName != "" && !seen[p.TopicName] {
+			seen[p.TopicName] = true
+			topicNames = append(topicNames, p.TopicName)
+		}
+		for _, operand := range p.Operands {
+			walk(operand)
+		}
+	}
+	walk(p)
+
+	return
+}
+
+// resolvePrerequisites parses the prerequisite notes of every course and links each reference to the
+// course with the same subject and course number in the same semester. Notes are read from the course's
+// metadata, or from its first section's when the university records them per section.
+func resolvePrerequisites(university *University) {
+	index := prerequisiteIndex{}
+	for _, subject := range university.Subjects {
+		index.add(subject)
+	}
+
+	for _, subject := range university.Subjects {
+		index.link(subject)
+	}
+}
+
+// prerequisiteIndex is the topic names of courses by their prerequisite key.
+type prerequisiteIndex map[string]string
+
+func (index prerequisiteIndex) add(subject *Subject) {
+	for _, course := range subject.Courses {
+		index[prerequisiteKey(subject.Season, subject.Year, subject.Number, course.Number)] = course.TopicName
+	}
+}
+
+// link parses the prerequisites of the courses of the subject and links them to the courses in the index. It
+// returns the keys of the courses that are not in the index.
+func (index prerequisiteIndex) link(subject *Subject) (missing []string) {
+	for _, course := range subject.Courses {
+		metadata := course.Metadata
+		if len(course.Sections) > 0 {
+			metadata = append(metadata[:len(metadata):len(metadata)], course.Sections[0].Metadata...)
+		}
+
+		course.Prerequisite = nil
+		for _, m := range metadata {
+			if prerequisiteTitles[m.Title] {
+				course.Prerequisite = ParsePrerequisite(m.Content)
+				break
+			}
+		}
+
+		var link func(p *Prerequisite)
+		link = func(p *Prerequisite) {
+			if p == nil {
+				return
+			}
+			if p.Operator == "" {
+				key := prerequisiteKey(subject.Season, subject.Year, p.SubjectNumber, p.CourseNumber)
+				topicName, ok := index[key]
+				if !ok {
+					missing = append(missing, key)
+				}
+				p.TopicName = topicName
+			}
+			for _, operand := range p.Operands {
+				link(operand)
+			}
+		}
+		link(course.Prerequisite)
+	}
+	return
+}
+
+func prerequisiteKey(season, year, subjectNumber, courseNumber string) string {
+	return strings.Join([]string{season, year, subjectNumber, courseNumber}, ":")
+}
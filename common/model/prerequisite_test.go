@@ -0,0 +1,74 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ref(subjectNumber, courseNumber string) *Prerequisite {
+	return &Prerequisite{SubjectNumber: subjectNumber, CourseNumber: courseNumber}
+}
+
+func TestParsePrerequisite(t *testing.T) {
+	tests := []struct {
+		name  string
+		notes string
+		want  *Prerequisite
+	}{
+		{
+			name:  "rutgers",
+			notes: "((01:640:135 CALC I ) or (01:640:151 CALC I MATH/PHYS )) and (01:750:203 GENERAL PHYSICS I )",
+			want: &Prerequisite{Operator: And, Operands: []*Prerequisite{
+				{Operator: Or, Operands: []*Prerequisite{ref("640", "135"), ref("640", "151")}},
+				ref("750", "203"),
+			}},
+		},
+		{
+			name:  "rutgers html",
+			notes: "(01:198:111 INTRO COMPUTER SCI)<em> OR </em>(01:198:111 INTRO COMPUTER SCI)<em> OR </em>(14:332:252 PROG METH I)",
+			want:  &Prerequisite{Operator: Or, Operands: []*Prerequisite{ref("198", "111"), ref("332", "252")}},
+		},
+		{
+			name:  "cuny",
+			notes: "Prerequisite: MTH 2030 or MTH 2205 with a grade of C or better",
+			want:  &Prerequisite{Operator: Or, Operands: []*Prerequisite{ref("MTH", "2030"), ref("MTH", "2205")}},
+		},
+		{
+			name:  "adjacent references",
+			notes: "Pre: ENG2100, ACC 2101 & (STA 2000",
+			want:  &Prerequisite{Operator: And, Operands: []*Prerequisite{ref("ENG", "2100"), ref("ACC", "2101"), ref("STA", "2000")}},
+		},
+		{
+			name:  "unbalanced",
+			notes: "640:135) or 640:151",
+			want:  &Prerequisite{Operator: Or, Operands: []*Prerequisite{ref("640", "135"), ref("640", "151")}},
+		},
+		{name: "no references", notes: "Permission of department or instructor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParsePrerequisite(tt.notes))
+		})
+	}
+}
+
+func TestResolvePrerequisites(t *testing.T) {
+	calculus := &Course{Number: "135", TopicName: "calc"}
+	physics := &Course{Number: "203", TopicName: "physics"}
+	mechanics := &Course{Number: "271", TopicName: "mechanics", Metadata: []*Metadata{
+		{Title: "Prequisites", Content: "(01:640:135 CALC I ) and (01:750:203 GENERAL PHYSICS I ) or 01:640:251"},
+	}}
+
+	university := &University{Subjects: []*Subject{
+		{Number: "640", Season: Fall, Year: "2017", Courses: []*Course{calculus}},
+		{Number: "750", Season: Fall, Year: "2017", Courses: []*Course{physics, mechanics}},
+		{Number: "640", Season: Spring, Year: "2018", Courses: []*Course{{Number: "251", TopicName: "multi"}}},
+	}}
+
+	resolvePrerequisites(university)
+
+	assert.Nil(t, calculus.Prerequisite)
+	assert.Equal(t, []string{"calc", "physics"}, mechanics.Prerequisite.TopicNames())
+	assert.Equal(t, "", mechanics.Prerequisite.Operands[1].TopicName)
+}
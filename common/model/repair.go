@@ -0,0 +1,274 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Change is a repair a fixer made to the data of a university. Path locates the repaired entity by the
+// identifiers the scraper gave it, e.g subject:640 fall 2017/course:151/section:01 12345
+type Change struct {
+	Fixer   string `json:"fixer"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s %s: %s", c.Fixer, c.Path, c.Message)
+}
+
+// ChangeLog lists every repair made to a university, so that the scraper bugs behind them are visible.
+type ChangeLog struct {
+	University string   `json:"university"`
+	Changes    []Change `json:"changes"`
+}
+
+// Count returns the number of changes made by the fixer.
+func (changeLog *ChangeLog) Count(fixer string) (count int) {
+	for _, change := range changeLog.Changes {
+		if change.Fixer == fixer {
+			count++
+		}
+	}
+	return
+}
+
+// WriteTo writes the changes one per line.
+func (changeLog *ChangeLog) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for _, change := range changeLog.Changes {
+		n, err := fmt.Fprintln(w, change)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// WriteJSON exports the change log as JSON.
+func (changeLog *ChangeLog) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(changeLog)
+}
+
+// Recorder records a change made to the entity at the path.
+type Recorder func(path, format string, args ...interface{})
+
+// Fixer repairs a class of defects in scraped data before it is validated.
+type Fixer struct {
+	Name        string
+	Description string
+	Fix         func(university *University, record Recorder)
+}
+
+// Fixers are the fixers known to the repair pipeline, in the order they run by default. Sections are merged
+// first so the other fixers see the merged sections.
+var Fixers = []Fixer{
+	{"merge-sections", "merge sections of a course with the same call number", mergeSections},
+	{"dedupe-instructors", "remove instructors listed more than once in a section", dedupeInstructors},
+	{"title-case", "title-case the names of subjects, courses and all uppercase instructors", titleCaseNames},
+	{"credits", "normalize credits to a decimal, or -1 when they are not numeric e.g BA", normalizeCredits},
+	{"blank-metadata", "remove metadata with a blank title or content", dropBlankMetadata},
+	{"empty-meetings", "remove meetings with neither a day nor a start and end time", dropEmptyMeetings},
+}
+
+// LookupFixers returns the fixers with the names in the order of the names.
+func LookupFixers(names ...string) ([]Fixer, error) {
+	var fixers []Fixer
+	for _, name := range names {
+		found := false
+		for _, fixer := range Fixers {
+			if fixer.Name == name {
+				fixers = append(fixers, fixer)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown fixer %q", name)
+		}
+	}
+	return fixers, nil
+}
+
+// Repair runs the fixers over the university in order and returns the changes they made.
+func Repair(university *University, fixers ...Fixer) *ChangeLog {
+	changeLog := &ChangeLog{University: university.Name}
+	for _, fixer := range fixers {
+		name := fixer.Name
+		fixer.Fix(university, func(path, format string, args ...interface{}) {
+			changeLog.Changes = append(changeLog.Changes, Change{Fixer: name, Path: path, Message: fmt.Sprintf(format, args...)})
+		})
+	}
+	return changeLog
+}
+
+func subjectPath(subject *Subject) string {
+	return "subject:" + strings.Join([]string{subject.Number, subject.Season, subject.Year}, " ")
+}
+
+func coursePath(subject *Subject, course *Course) string {
+	return subjectPath(subject) + "/course:" + course.Number
+}
+
+func sectionPath(subject *Subject, course *Course, section *Section) string {
+	return coursePath(subject, course) + "/section:" + section.Number + " " + section.CallNumber
+}
+
+// eachSection calls fn with every section of the university and its path.
+func eachSection(university *University, fn func(path string, course *Course, section *Section)) {
+	for _, subject := range university.Subjects {
+		for _, course := range subject.Courses {
+			for _, section := range course.Sections {
+				fn(sectionPath(subject, course, section), course, section)
+			}
+		}
+	}
+}
+
+func mergeSections(university *University, record Recorder) {
+	for _, subject := range university.Subjects {
+		for _, course := range subject.Courses {
+			index := map[string]*Section{}
+			var sections []*Section
+			for _, section := range course.Sections {
+				callNumber := TrimAll(section.CallNumber)
+				first, ok := index[callNumber]
+				if callNumber == "" || !ok {
+					index[callNumber] = section
+					sections = append(sections, section)
+					continue
+				}
+
+				first.Meetings = append(first.Meetings, section.Meetings...)
+				first.Instructors = append(first.Instructors, section.Instructors...)
+				first.Books = append(first.Books, section.Books...)
+				first.Metadata = append(first.Metadata, section.Metadata...)
+				record(sectionPath(subject, course, first), "merged section %s with the same call number", section.Number)
+			}
+			course.Sections = sections
+		}
+	}
+}
+
+func dedupeInstructors(university *University, record Recorder) {
+	eachSection(university, func(path string, course *Course, section *Section) {
+		seen := map[string]bool{}
+		var instructors []*Instructor
+		for _, instructor := range section.Instructors {
+			key := NormalizeInstructorName(instructor.Name)
+			if seen[key] {
+				record(path, "removed duplicate instructor %q", instructor.Name)
+				continue
+			}
+			seen[key] = true
+			instructors = append(instructors, instructor)
+		}
+		section.Instructors = instructors
+	})
+}
+
+func titleCaseNames(university *University, record Recorder) {
+	titleCase := func(path, kind string, name *string) {
+		if trim(*name) == "" {
+			return
+		}
+		if title := ToTitle(TrimAll(*name)); title != *name {
+			record(path, "renamed %s %q to %q", kind, *name, title)
+			*name = title
+		}
+	}
+
+	for _, subject := range university.Subjects {
+		titleCase(subjectPath(subject), "subject", &subject.Name)
+		for _, course := range subject.Courses {
+			titleCase(coursePath(subject, course), "course", &course.Name)
+		}
+	}
+
+	// Only names in all caps, a title-cased name may have capitals within e.g McDonald
+	eachSection(university, func(path string, course *Course, section *Section) {
+		for _, instructor := range section.Instructors {
+			if instructor.Name == strings.ToUpper(instructor.Name) {
+				titleCase(path, "instructor", &instructor.Name)
+			}
+		}
+	})
+}
+
+// Credits registrars give sections whose credits are arranged with the instructor or vary
+var unknownCredits = map[string]bool{
+	"":               true,
+	"BA":             true,
+	"BY ARRANGEMENT": true,
+	"TBA":            true,
+	"VAR":            true,
+	"VARIABLE":       true,
+}
+
+func normalizeCredits(university *University, record Recorder) {
+	eachSection(university, func(path string, course *Course, section *Section) {
+		credits := TrimAll(section.Credits)
+		if value, err := strconv.ParseFloat(credits, 64); err == nil && value >= 0 {
+			credits = fmt.Sprintf("%.1f", value)
+		} else if err == nil || unknownCredits[strings.ToUpper(credits)] {
+			credits = "-1"
+		}
+
+		if credits != section.Credits {
+			record(path, "normalized credits %q to %q", section.Credits, credits)
+			section.Credits = credits
+		}
+	})
+}
+
+func dropBlankMetadata(university *University, record Recorder) {
+	drop := func(path string, metadata []*Metadata) []*Metadata {
+		var kept []*Metadata
+		for _, m := range metadata {
+			if TrimAll(m.Title) == "" || TrimAll(m.Content) == "" {
+				record(path, "removed blank metadata %q", m.Title)
+				continue
+			}
+			kept = append(kept, m)
+		}
+		return kept
+	}
+
+	university.Metadata = drop("university", university.Metadata)
+	for _, subject := range university.Subjects {
+		subject.Metadata = drop(subjectPath(subject), subject.Metadata)
+		for _, course := range subject.Courses {
+			course.Metadata = drop(coursePath(subject, course), course.Metadata)
+		}
+	}
+	eachSection(university, func(path string, course *Course, section *Section) {
+		section.Metadata = drop(path, section.Metadata)
+		for meetingIndex, meeting := range section.Meetings {
+			meeting.Metadata = drop(path+"/meeting:"+strconv.Itoa(meetingIndex), meeting.Metadata)
+		}
+	})
+}
+
+func dropEmptyMeetings(university *University, record Recorder) {
+	blank := func(s *string) bool {
+		return s == nil || TrimAll(*s) == ""
+	}
+
+	eachSection(university, func(path string, course *Course, section *Section) {
+		var meetings []*Meeting
+		for meetingIndex, meeting := range section.Meetings {
+			if blank(meeting.Day) && (blank(meeting.StartTime) || blank(meeting.EndTime)) {
+				record(path+"/meeting:"+strconv.Itoa(meetingIndex), "removed meeting without a day or time")
+				continue
+			}
+			meetings = append(meetings, meeting)
+		}
+		section.Meetings = meetings
+	})
+}
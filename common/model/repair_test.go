@@ -0,0 +1,81 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func repairUniversity() *University {
+	return &University{
+		Name: "Rutgers University–New Brunswick",
+		Subjects: []*Subject{
+			{Name: "MATHEMATICS", Number: "640", Season: Fall, Year: "2017", Metadata: []*Metadata{{Title: "", Content: "Math"}}, Courses: []*Course{
+				{Name: "calculus i", Number: "151", Sections: []*Section{
+					{
+						Number: "01", CallNumber: "12345", Credits: "3",
+						Instructors: []*Instructor{{Name: "SMITH, JOHN"}, {Name: "Smith,  John."}, {Name: "McDonald, Ann"}},
+						Meetings: []*Meeting{
+							{Day: proto.String("Monday"), StartTime: proto.String("10:20 AM"), EndTime: proto.String("11:40 AM")},
+							{Day: proto.String(" "), Room: proto.String("HLL-114")},
+						},
+						Metadata: []*Metadata{{Title: "Notes", Content: "  "}},
+					},
+					{
+						Number: "01", CallNumber: "12345", Credits: "3",
+						Meetings: []*Meeting{{Day: proto.String("Wednesday"), StartTime: proto.String("10:20 AM"), EndTime: proto.String("11:40 AM")}},
+					},
+					{Number: "02", CallNumber: "12346", Credits: "BA"},
+				}},
+			}},
+		},
+	}
+}
+
+func TestRepair(t *testing.T) {
+	university := repairUniversity()
+	changeLog := Repair(university, Fixers...)
+
+	assert.Equal(t, 1, changeLog.Count("merge-sections"))
+	assert.Equal(t, 1, changeLog.Count("dedupe-instructors"))
+	assert.Equal(t, 2, changeLog.Count("blank-metadata"))
+	assert.Equal(t, 1, changeLog.Count("empty-meetings"))
+
+	subject := university.Subjects[0]
+	assert.Equal(t, "Mathematics", subject.Name)
+	assert.Equal(t, "Calculus I", subject.Courses[0].Name)
+	assert.Empty(t, subject.Metadata)
+
+	sections := subject.Courses[0].Sections
+	if assert.Len(t, sections, 2) {
+		assert.Equal(t, "3.0", sections[0].Credits)
+		assert.Equal(t, "-1", sections[1].Credits)
+
+		assert.Len(t, sections[0].Meetings, 2)
+		assert.Empty(t, sections[0].Metadata)
+
+		var names []string
+		for _, instructor := range sections[0].Instructors {
+			names = append(names, instructor.Name)
+		}
+		assert.Equal(t, []string{"Smith, John", "McDonald, Ann"}, names)
+	}
+
+	assert.Equal(t, "subject:640 fall 2017/course:151/section:01 12345", changeLog.Changes[0].Path)
+
+	// Repaired data is left alone
+	assert.Empty(t, Repair(university, Fixers...).Changes)
+}
+
+func TestLookupFixers(t *testing.T) {
+	fixers, err := LookupFixers("credits", "merge-sections")
+	assert.NoError(t, err)
+	if assert.Len(t, fixers, 2) {
+		assert.Equal(t, "credits", fixers[0].Name)
+		assert.Equal(t, "merge-sections", fixers[1].Name)
+	}
+
+	_, err = LookupFixers("unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Severity is how a violation of a validation rule is handled.
+type Severity int
+
+const (
+	// The entity is dropped from the university and the rest of the snapshot is accepted
+	Warning Severity = 1 + iota
+	// The snapshot is rejected
+	Error
+)
+
+var severity = [...]string{
+	"warning",
+	"error",
+}
+
+func (s Severity) String() string {
+	if s < Warning || int(s) > len(severity) {
+		return fmt.Sprintf("severity(%d)", s)
+	}
+	return severity[s-1]
+}
+
+// ParseSeverity parses the name of a severity, ignoring case.
+func ParseSeverity(name string) (Severity, error) {
+	for i := range severity {
+		if strings.EqualFold(name, severity[i]) {
+			return Severity(i + 1), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown severity %q", name)
+}
+
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Severity) UnmarshalText(text []byte) (err error) {
+	*s, err = ParseSeverity(string(text))
+	return
+}
+
+// RuleError is returned by the Validate method of an entity for the rule it broke. Rules are named after the
+// entity and the check, e.g book.url.
+type RuleError struct {
+	Rule    string
+	Field   string
+	Message string
+}
+
+func (e *RuleError) Error() string {
+	return e.Message
+}
+
+func ruleError(rule, field, format string, args ...interface{}) error {
+	return &RuleError{Rule: rule, Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// Violation is a rule an entity of a university broke. Path locates the entity under the topic name of its
+// parent, e.g rutgers.universitynew.brunswick.../section:01/book:0
+type Violation struct {
+	Path     string   `json:"path"`
+	Field    string   `json:"field,omitempty"`
+	Rule     string   `json:"rule"`
+	Message  string   `json:"message"`
+	Severity Severity `json:"severity"`
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s %s %s: %s", v.Severity, v.Path, v.Rule, v.Message)
+}
+
+// ValidationPolicy sets the severity of rules. A rule not in the policy takes the severity of its entity,
+// e.g book for book.url, and is an error when neither is set.
+type ValidationPolicy map[string]Severity
+
+// DefaultValidationPolicy drops the parts of a section that are not needed to track its status, so a bad book
+// or meeting does not reject a whole university.
+var DefaultValidationPolicy = ValidationPolicy{
+	"book":       Warning,
+	"building":   Warning,
+	"instructor": Warning,
+	"meeting":    Warning,
+	"metadata":   Warning,
+}
+
+// ParseValidationPolicy parses comma separated rule=severity pairs, e.g "book=error,meeting.time=warning",
+// over the default policy.
+func ParseValidationPolicy(policy string) (ValidationPolicy, error) {
+	parsed := ValidationPolicy{}
+	for rule, severity := range DefaultValidationPolicy {
+		parsed[rule] = severity
+	}
+
+	for _, pair := range strings.Split(policy, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("expected rule=severity, got %q", pair)
+		}
+		severity, err := ParseSeverity(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, err
+		}
+		parsed[strings.TrimSpace(parts[0])] = severity
+	}
+	return parsed, nil
+}
+
+// Severity returns the severity of the rule.
+func (policy ValidationPolicy) Severity(rule string) Severity {
+	if severity, ok := policy[rule]; ok {
+		return severity
+	}
+	if i := strings.Index(rule, "."); i > 0 {
+		if severity, ok := policy[rule[:i]]; ok {
+			return severity
+		}
+	}
+	return Error
+}
+
+// ValidationReport lists every violation found while validating a university.
+type ValidationReport struct {
+	University string      `json:"university"`
+	Violations []Violation `json:"violations"`
+}
+
+func (report *ValidationReport) add(policy ValidationPolicy, path string, err error) Severity {
+	violation := Violation{Path: path, Rule: "invalid", Message: err.Error()}
+	if ruleErr, ok := err.(*RuleError); ok {
+		violation.Rule, violation.Field = ruleErr.Rule, ruleErr.Field
+	}
+	violation.Severity = policy.Severity(violation.Rule)
+	report.Violations = append(report.Violations, violation)
+	return violation.Severity
+}
+
+// Count returns the number of violations of the severity.
+func (report *ValidationReport) Count(severity Severity) (count int) {
+	for _, violation := range report.Violations {
+		if violation.Severity == severity {
+			count++
+		}
+	}
+	return
+}
+
+// Err returns an error when any violation is an error, which rejects the university.
+func (report *ValidationReport) Err() error {
+	for _, violation := range report.Violations {
+		if violation.Severity == Error {
+			return fmt.Errorf("%d validation errors, first %s", report.Count(Error), violation)
+		}
+	}
+	return nil
+}
+
+// WriteTo writes the violations one per line.
+func (report *ValidationReport) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for _, violation := range report.Violations {
+		n, err := fmt.Fprintln(w, violation)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// WriteJSON exports the report as JSON.
+func (report *ValidationReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
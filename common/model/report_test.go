@@ -0,0 +1,176 @@
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func reportUniversity() *University {
+	section := func(number string) *Section {
+		return &Section{
+			Number:     number,
+			CallNumber: "1234" + number,
+			Status:     Open.String(),
+			Max:        30,
+			Credits:    "3",
+			Meetings: []*Meeting{
+				{Day: proto.String("Monday"), StartTime: proto.String("10:20 AM"), EndTime: proto.String("11:40 AM")},
+			},
+			Books: []*Book{
+				{Title: "Calculus", Url: "http://books.example.com/calculus"},
+			},
+		}
+	}
+
+	return &University{
+		Name:              "Rutgers University–New Brunswick",
+		HomePage:          "http://rutgers.edu",
+		RegistrationPage:  "http://sis.rutgers.edu",
+		Registrations:     []*Registration{{Period: InFall.String()}},
+		ResolvedSemesters: &ResolvedSemester{Current: NewSemester(Fall, 2017)},
+		Subjects: []*Subject{
+			{Name: "Mathematics", Number: "640", Season: Fall, Year: "2017", Courses: []*Course{
+				{Name: "Calculus I", Number: "151", Sections: []*Section{section("01"), section("02"), section("03")}},
+			}},
+		},
+	}
+}
+
+func TestValidateReport(t *testing.T) {
+	university := reportUniversity()
+	sections := university.Subjects[0].Courses[0].Sections
+	sections[0].Books[0].Url = "not a url"
+	sections[1].Meetings[0].EndTime = nil
+	sections[2].Credits = "three"
+
+	report := ValidateReport(university, DefaultValidationPolicy)
+	if assert.Len(t, report.Violations, 3) {
+		assert.Equal(t, "book.url", report.Violations[0].Rule)
+		assert.Equal(t, Warning, report.Violations[0].Severity)
+		assert.Equal(t, sections[0].TopicName+"/book:0", report.Violations[0].Path)
+
+		assert.Equal(t, "meeting.time", report.Violations[1].Rule)
+		assert.Equal(t, Warning, report.Violations[1].Severity)
+
+		assert.Equal(t, "section.credits", report.Violations[2].Rule)
+		assert.Equal(t, "credits", report.Violations[2].Field)
+		assert.Equal(t, Error, report.Violations[2].Severity)
+	}
+	assert.Error(t, report.Err())
+
+	// Every violation is collected before the university is rejected, warnings drop their entity
+	sections = university.Subjects[0].Courses[0].Sections
+	assert.Len(t, sections, 2)
+	assert.Empty(t, sections[0].Books)
+	assert.Empty(t, sections[1].Meetings)
+}
+
+func TestValidateReport_Policy(t *testing.T) {
+	policy, err := ParseValidationPolicy("section.credits=warning, book.url=error")
+	assert.NoError(t, err)
+	assert.Equal(t, Warning, policy.Severity("section.credits"))
+	assert.Equal(t, Error, policy.Severity("section.status"))
+	assert.Equal(t, Error, policy.Severity("book.url"))
+	assert.Equal(t, Warning, policy.Severity("book.title"))
+
+	university := reportUniversity()
+	university.Subjects[0].Courses[0].Sections[2].Credits = "three"
+
+	report := ValidateReport(university, policy)
+	assert.NoError(t, report.Err())
+	assert.Equal(t, 1, report.Count(Warning))
+	assert.Len(t, university.Subjects[0].Courses[0].Sections, 2)
+
+	_, err = ParseValidationPolicy("book.url=fatal")
+	assert.Error(t, err)
+}
+
+func TestValidateReport_University(t *testing.T) {
+	university := reportUniversity()
+	university.HomePage = ""
+
+	report := ValidateReport(university, DefaultValidationPolicy)
+	assert.Len(t, report.Violations, 1)
+	assert.Equal(t, "university.home_page", report.Violations[0].Rule)
+	assert.NoError(t, ValidateAll(reportUniversity()))
+}
+
+func TestStreamValidator(t *testing.T) {
+	// Computer Science links to a later subject, Physics only to an earlier one
+	newUniversity := func() *University {
+		university := reportUniversity()
+		math := university.Subjects[0]
+		math.Courses[0].Sections[1].CrossListings = []*CrossListing{{SubjectNumber: "198", CourseNumber: "111", SectionNumber: "01"}}
+
+		cs := &Subject{Name: "Computer Science", Number: "198", Season: Fall, Year: "2017", Courses: []*Course{
+			{Name: "Intro to Computer Science", Number: "111", Metadata: []*Metadata{{Title: "Prerequisites", Content: "01:640:151"}}},
+		}}
+		physics := &Subject{Name: "Physics", Number: "750", Season: Fall, Year: "2017", Courses: []*Course{
+			{Name: "General Physics", Number: "203", Metadata: []*Metadata{{Title: "Prerequisites", Content: "01:640:151"}}},
+		}}
+		for _, course := range []*Course{cs.Courses[0], physics.Courses[0]} {
+			course.Sections = []*Section{proto.Clone(math.Courses[0].Sections[0]).(*Section)}
+		}
+		cs.Courses[0].Sections[0].CrossListings = []*CrossListing{{SubjectNumber: "640", CourseNumber: "151", SectionNumber: "01"}}
+
+		university.Subjects = []*Subject{cs, math, physics}
+		return university
+	}
+
+	expected := newUniversity()
+	assert.NoError(t, ValidateReport(expected, DefaultValidationPolicy).Err())
+
+	university := newUniversity()
+	subjects := university.Subjects
+	university.Subjects = nil
+
+	validator := NewStreamValidator(university, DefaultValidationPolicy)
+	for _, subject := range subjects {
+		assert.True(t, validator.ValidateSubject(subject))
+	}
+	assert.NoError(t, validator.Report().Err())
+
+	if assert.Equal(t, []string{subjects[0].TopicName}, validator.Unlinked()) {
+		assert.False(t, expected.Subjects[0].Equal(subjects[0]))
+		assert.NoError(t, validator.Link(subjects[0]))
+	}
+
+	for i := range subjects {
+		assert.True(t, expected.Subjects[i].Equal(subjects[i]), subjects[i].TopicName)
+	}
+	assert.Equal(t, expected.TopicName, university.TopicName)
+
+	// A rejected university rejects every subject
+	university = newUniversity()
+	subjects = university.Subjects
+	university.Subjects, university.HomePage = nil, ""
+	validator = NewStreamValidator(university, DefaultValidationPolicy)
+	assert.False(t, validator.ValidateSubject(subjects[0]))
+	assert.Error(t, validator.Report().Err())
+}
+
+func TestValidationReport_Write(t *testing.T) {
+	report := &ValidationReport{
+		University: "rutgers",
+		Violations: []Violation{{Path: "rutgers/subject:640", Rule: "subject.name", Message: "Subject name == is empty", Severity: Error}},
+	}
+
+	var text bytes.Buffer
+	_, err := report.WriteTo(&text)
+	assert.NoError(t, err)
+	assert.Equal(t, "error rutgers/subject:640 subject.name: Subject name == is empty\n", text.String())
+
+	var json bytes.Buffer
+	assert.NoError(t, report.WriteJSON(&json))
+	assert.Contains(t, json.String(), `"severity": "error"`)
+}
+
+func TestSeverity_String(t *testing.T) {
+	assert.Equal(t, "warning", Warning.String())
+	assert.Equal(t, "error", Error.String())
+	assert.Equal(t, "severity(0)", Severity(0).String())
+	assert.Equal(t, "severity(3)", Severity(3).String())
+}
@@ -16,26 +16,11 @@ func (a SemesterSorter) Less(i, j int) bool {
 	if a[j].Year < a[i].Year {
 		return true
 	} else if a[i].Year == a[j].Year {
-		return rankSeason(a[j].Season) < rankSeason(a[i].Season)
+		return a[j].termCode() < a[i].termCode()
 	}
 	return false
 }
 
-func rankSeason(seasonStr string) int {
-	seasonStr = strings.ToLower(seasonStr)
-	switch seasonStr {
-	case "fall":
-		return 4
-	case "summer":
-		return 3
-	case "spring":
-		return 2
-	case "winter":
-		return 1
-	}
-	return 0
-}
-
 type courseSorter struct {
 	courses []*Course
 }
@@ -125,9 +110,9 @@ func (a meetingSorter) Swap(i, j int) {
 }
 
 func (a meetingSorter) Less(i, j int) bool {
-	/*	if a.meetings[i].dayRank() < a.meetings[j].dayRank() {
-		return a.meetings[i].hash() < a.meetings[j].hash()
-	}*/
+	if a.meetings[i].dayRank() == a.meetings[j].dayRank() {
+		return a.meetings[i].GetStartMinute() < a.meetings[j].GetStartMinute()
+	}
 	return a.meetings[i].dayRank() < a.meetings[j].dayRank()
 }
 
@@ -187,25 +172,13 @@ func (a SubjectByName) Less(i, j int) bool {
 	return strings.Compare(a[i].Name, a[j].Name) < 0
 }
 
+// dayRank orders meetings from Monday to Sunday, meetings without a day come last.
 func (meeting Meeting) dayRank() int {
-	if meeting.Day == nil {
+	if meeting.Weekday == nil {
 		return 8
 	}
-	switch *meeting.Day {
-	case "Monday":
-		return 1
-	case "Tuesday":
-		return 2
-	case "Wednesday":
-		return 3
-	case "Thurdsday":
-		return 4
-	case "Friday":
-		return 5
-	case "Saturday":
-		return 6
-	case "Sunday":
+	if *meeting.Weekday == Weekday_SUNDAY {
 		return 7
 	}
-	return 8
+	return int(*meeting.Weekday)
 }
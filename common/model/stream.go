@@ -0,0 +1,184 @@
+package model
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// MaxStreamRecordSize is the largest record a StreamDecoder will read. A subject with hundreds of courses is
+// a few megabytes at most, anything larger is a corrupt length.
+const MaxStreamRecordSize = 64 << 20
+
+// StreamEncoder writes a university in the stream format: the university without its subjects, then each
+// subject, every record prefixed with its length as a uvarint. Subjects can be written as they are scraped,
+// without holding the whole university in memory.
+type StreamEncoder struct {
+	w      io.Writer
+	header bool
+}
+
+// NewStreamEncoder returns an encoder that writes to w.
+func NewStreamEncoder(w io.Writer) *StreamEncoder {
+	return &StreamEncoder{w: w}
+}
+
+// WriteHeader writes the university without its subjects. It must be written once, before any subject.
+func (enc *StreamEncoder) WriteHeader(university *University) error {
+	if enc.header {
+		return errors.New("stream header already written")
+	}
+	header := *university
+	header.Subjects = nil
+
+	data, err := header.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to encode university header")
+	}
+	enc.header = true
+	return writeRecord(enc.w, data)
+}
+
+// WriteSubject writes the next subject of the university.
+func (enc *StreamEncoder) WriteSubject(subject *Subject) error {
+	if !enc.header {
+		return errors.New("stream header not written")
+	}
+	data, err := subject.Marshal()
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode subject %s", subject.TopicName)
+	}
+	return writeRecord(enc.w, data)
+}
+
+// Encode writes the whole university.
+func (enc *StreamEncoder) Encode(university *University) error {
+	if err := enc.WriteHeader(university); err != nil {
+		return err
+	}
+	for _, subject := range university.Subjects {
+		if err := enc.WriteSubject(subject); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamDecoder reads a university in the stream format one subject at a time, so only a single subject is
+// held in memory.
+type StreamDecoder struct {
+	r      *bufio.Reader
+	header *University
+	buf    []byte
+}
+
+// NewStreamDecoder returns a decoder that reads from r.
+func NewStreamDecoder(r io.Reader) *StreamDecoder {
+	return &StreamDecoder{r: bufio.NewReader(r)}
+}
+
+// Header returns the university without its subjects, reading it if it has not been read.
+func (dec *StreamDecoder) Header() (*University, error) {
+	if dec.header != nil {
+		return dec.header, nil
+	}
+
+	data, err := dec.readRecord()
+	if err == io.EOF {
+		return nil, errors.New("failed to decode university header: empty stream")
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to decode university header")
+	}
+
+	university := &University{}
+	if err := university.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode university header")
+	}
+	dec.header = university
+	return university, nil
+}
+
+// Next returns the next subject of the university, or io.EOF after the last subject.
+func (dec *StreamDecoder) Next() (*Subject, error) {
+	if _, err := dec.Header(); err != nil {
+		return nil, err
+	}
+
+	data, err := dec.readRecord()
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to decode subject")
+	}
+
+	subject := &Subject{}
+	if err := subject.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode subject")
+	}
+	return subject, nil
+}
+
+// Decode reads the whole university into m.
+func (dec *StreamDecoder) Decode(m *University) error {
+	header, err := dec.Header()
+	if err != nil {
+		return err
+	}
+	*m = *header
+	m.Subjects = nil
+
+	for {
+		subject, err := dec.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		m.Subjects = append(m.Subjects, subject)
+	}
+}
+
+func (dec *StreamDecoder) readRecord() (data []byte, err error) {
+	data, err = readRecord(dec.r, dec.buf)
+	dec.buf = data[:cap(data)]
+	return
+}
+
+// writeRecord writes data prefixed with its length as a uvarint.
+func writeRecord(w io.Writer, data []byte) error {
+	var size [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(size[:], uint64(len(data)))
+	if _, err := w.Write(size[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// readRecord reads a record written by writeRecord into buf, growing it when the record does not fit. It returns
+// io.EOF only at the boundary between records, a record cut short is an error.
+func readRecord(r *bufio.Reader, buf []byte) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return buf[:0], io.EOF
+	} else if err != nil {
+		return buf[:0], errors.Wrap(err, "failed to read record length")
+	}
+	if size > MaxStreamRecordSize {
+		return buf[:0], errors.Errorf("record of %d bytes exceeds the maximum of %d", size, MaxStreamRecordSize)
+	}
+
+	if uint64(cap(buf)) < size {
+		buf = make([]byte, size)
+	}
+	data := buf[:size]
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return data, errors.Wrap(err, "failed to read record")
+	}
+	return data, nil
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func streamUniversity() University {
+	return University{
+		Name:     "Rutgers University–New Brunswick",
+		Abbr:     "RU-NB",
+		HomePage: "http://rutgers.edu",
+		Subjects: []*Subject{
+			{Name: "Mathematics", Number: "640", Season: Fall, Year: "2017", Courses: []*Course{
+				{Name: "Calculus I", Number: "151", Sections: []*Section{hashSection()}},
+			}},
+			{Name: "Physics", Number: "750", Season: Fall, Year: "2017"},
+		},
+	}
+}
+
+func TestStreamDecoder(t *testing.T) {
+	university := streamUniversity()
+
+	var buf bytes.Buffer
+	assert.NoError(t, NewStreamEncoder(&buf).Encode(&university))
+
+	decoder := NewStreamDecoder(&buf)
+	header, err := decoder.Header()
+	assert.NoError(t, err)
+	assert.Equal(t, university.Name, header.Name)
+	assert.Empty(t, header.Subjects)
+
+	var subjects []*Subject
+	for {
+		subject, err := decoder.Next()
+		if err == io.EOF {
+			break
+		}
+		if !assert.NoError(t, err) {
+			return
+		}
+		subjects = append(subjects, subject)
+	}
+	if assert.Len(t, subjects, 2) {
+		assert.True(t, university.Subjects[0].Equal(subjects[0]))
+		assert.Equal(t, "Physics", subjects[1].Name)
+	}
+
+	_, err = decoder.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestStreamDecoder_Truncated(t *testing.T) {
+	university := streamUniversity()
+
+	var buf bytes.Buffer
+	assert.NoError(t, NewStreamEncoder(&buf).Encode(&university))
+
+	decoder := NewStreamDecoder(bytes.NewReader(buf.Bytes()[:buf.Len()-5]))
+	_, err := decoder.Next()
+	assert.NoError(t, err)
+	_, err = decoder.Next()
+	assert.Error(t, err)
+	assert.NotEqual(t, io.EOF, err)
+
+	_, err = NewStreamDecoder(&bytes.Buffer{}).Header()
+	assert.Error(t, err)
+}
+
+func TestStreamEncoder_Header(t *testing.T) {
+	encoder := NewStreamEncoder(&bytes.Buffer{})
+	assert.Error(t, encoder.WriteSubject(&Subject{}))
+	assert.NoError(t, encoder.WriteHeader(&University{Name: "NJIT"}))
+	assert.Error(t, encoder.WriteHeader(&University{Name: "NJIT"}))
+}
+
+func TestMarshalMessage_Stream(t *testing.T) {
+	university := streamUniversity()
+
+	reader, err := MarshalMessage(Stream, university)
+	assert.NoError(t, err)
+
+	var decoded University
+	assert.NoError(t, UnmarshalMessage(Stream, reader, &decoded))
+	assert.True(t, university.Equal(decoded))
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TermType is a kind of term a university holds every year, e.g the fall semester, a quarter or a summer
+// session. Season is the key subjects and semesters are stored and requested by, Code orders the terms
+// within a year and Name is displayed to users.
+type TermType struct {
+	Season string
+	Code   int32
+	Name   string
+}
+
+// Term types shared by most universities. Codes are spaced so that other terms can be ordered between them.
+var (
+	WinterTerm = TermType{Season: Winter, Code: 10, Name: "Winter"}
+	SpringTerm = TermType{Season: Spring, Code: 20, Name: "Spring"}
+	SummerTerm = TermType{Season: Summer, Code: 30, Name: "Summer"}
+	FallTerm   = TermType{Season: Fall, Code: 40, Name: "Fall"}
+)
+
+var (
+	termTypesMu sync.RWMutex
+	termTypes   = map[string]TermType{}
+)
+
+func init() {
+	RegisterTermType(WinterTerm, SpringTerm, SummerTerm, FallTerm)
+}
+
+// RegisterTermType makes term types known to this process, so subjects and semesters of that season are
+// given its code and name. A scraper registers the term types its university holds besides the default ones.
+func RegisterTermType(types ...TermType) {
+	termTypesMu.Lock()
+	defer termTypesMu.Unlock()
+	for _, termType := range types {
+		termType.Season = strings.ToLower(termType.Season)
+		termTypes[termType.Season] = termType
+	}
+}
+
+// LookupTermType returns the registered term type of the season.
+func LookupTermType(season string) (TermType, bool) {
+	termTypesMu.RLock()
+	defer termTypesMu.RUnlock()
+	termType, ok := termTypes[strings.ToLower(season)]
+	return termType, ok
+}
+
+// TermTypes returns the registered term types ordered by their code.
+func TermTypes() []TermType {
+	termTypesMu.RLock()
+	defer termTypesMu.RUnlock()
+
+	types := make([]TermType, 0, len(termTypes))
+	for _, termType := range termTypes {
+		types = append(types, termType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Code < types[j].Code
+	})
+	return types
+}
+
+// NewSemester returns the semester of the season in the year, with the code and name of its term type.
+func NewSemester(season string, year int32) *Semester {
+	semester := &Semester{Season: season, Year: year}
+	if termType, ok := LookupTermType(season); ok {
+		semester.Season, semester.TermCode, semester.TermName = termType.Season, termType.Code, termType.Name
+	}
+	return semester
+}
+
+// termCode returns the code the semester carries, or the code of its registered term type for semesters that
+// predate term codes.
+func (semester *Semester) termCode() int32 {
+	if semester.TermCode != 0 {
+		return semester.TermCode
+	}
+	termType, _ := LookupTermType(semester.Season)
+	return termType.Code
+}
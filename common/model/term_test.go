@@ -0,0 +1,49 @@
+package model
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemesterSorter_TermCodes(t *testing.T) {
+	summerI := TermType{Season: "summer_1", Code: 31, Name: "Summer Session I"}
+	summerII := TermType{Season: "summer_2", Code: 32, Name: "Summer Session II"}
+	RegisterTermType(summerI, summerII)
+
+	semesters := []*Semester{
+		NewSemester("summer_2", 2017),
+		NewSemester(Spring, 2017),
+		NewSemester(Fall, 2017),
+		// Carries its own code, the term type is not registered in this process
+		{Season: "intersession", Year: 2017, TermCode: 15, TermName: "Intersession"},
+		NewSemester("summer_1", 2017),
+	}
+
+	sort.Sort(SemesterSorter(semesters))
+
+	var seasons []string
+	for _, semester := range semesters {
+		seasons = append(seasons, semester.Season)
+	}
+	assert.Equal(t, []string{Fall, "summer_2", "summer_1", Spring, "intersession"}, seasons)
+	assert.Equal(t, "Summer Session II", semesters[1].TermName)
+}
+
+func TestSubject_ValidateTermType(t *testing.T) {
+	university := &University{TopicName: "university"}
+
+	subject := &Subject{Name: "Math", Number: "640", Season: "Spring", Year: "2017"}
+	assert.NoError(t, subject.Validate(university))
+	assert.Equal(t, Spring, subject.Season)
+	assert.Equal(t, SpringTerm.Code, subject.TermCode)
+	assert.Equal(t, "Spring", subject.TermName)
+
+	subject = &Subject{Name: "Math", Number: "640", Season: "maymester", Year: "2017", TermCode: 25}
+	assert.NoError(t, subject.Validate(university))
+	assert.Equal(t, "Maymester", subject.TermName)
+
+	subject = &Subject{Name: "Math", Number: "640", Season: "maymester", Year: "2017"}
+	assert.Error(t, subject.Validate(university))
+}
@@ -11,7 +11,7 @@ import (
 	"unicode"
 
 	log "github.com/Sirupsen/logrus"
-	"github.com/pkg/errors"
+	"github.com/gogo/protobuf/proto"
 )
 
 var trim = strings.TrimSpace
@@ -105,141 +105,291 @@ func ToTitle(str string) string {
 	return str
 }
 
-func ValidateAll(university *University) (err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Println("Recovered in ValidateAll", r)
-			err = fmt.Errorf("%v+", r)
-		}
-	}()
+// ValidateAll validates the university under the default policy, dropping entities that only broke warning rules.
+// It returns an error when any rule that rejects the university was broken.
+func ValidateAll(university *University) error {
+	return ValidateReport(university, DefaultValidationPolicy).Err()
+}
+
+// ValidateReport validates every entity of the university and reports each rule they broke. An entity that broke
+// a rule is removed from the university along with its children, so that a university whose report has no
+// errors can be stored.
+func ValidateReport(university *University, policy ValidationPolicy) *ValidationReport {
+	v := &validator{policy: policy, report: &ValidationReport{University: university.Name}}
 
-	if err := university.Validate(); err != nil {
-		return err
+	if !v.validate("university", func() error { return university.Validate() }) {
+		return v.report
 	}
+	v.report.University = university.TopicName
 
-	if err := ValidateAllSubjects(university); err != nil {
-		return err
+	university.Buildings = v.validateBuildings(university)
+	university.Subjects = v.validateSubjects(university)
+
+	resolvePrerequisites(university)
+	resolveCrossListings(university)
+	resolveInstructors(university)
+	resolveLocations(university)
+
+	university.Metadata = v.validateMetadata(university.TopicName, university.Metadata)
+	v.validate(university.TopicName, func() error { return SetContentHashes(university) })
+
+	return v.report
+}
+
+// StreamValidator validates a university read from a stream one subject at a time, applying the same rules as
+// ValidateReport. Only the topic names of validated subjects are kept, so a subject is linked to the subjects
+// validated before it. A subject that references a later subject, or that a later subject lists as a
+// cross-listing, is returned by Unlinked and must be linked again with Link once every subject was validated.
+type StreamValidator struct {
+	v             *validator
+	university    *University
+	valid         bool
+	subjects      map[string]int
+	prerequisites prerequisiteIndex
+	crossListings *crossListingIndex
+	// Topic names of the subjects of the sections in crossListings
+	sectionSubjects map[string]string
+	// Topic names of the subjects waiting for a course or section by its key
+	waiting        map[string][]string
+	unlinked       []string
+	unlinkedTopics map[string]bool
+}
+
+// NewStreamValidator validates the university read from the header of a stream along with its buildings and
+// metadata. The university must not have any subjects.
+func NewStreamValidator(university *University, policy ValidationPolicy) *StreamValidator {
+	sv := &StreamValidator{
+		v:               &validator{policy: policy, report: &ValidationReport{University: university.Name}},
+		university:      university,
+		subjects:        map[string]int{},
+		prerequisites:   prerequisiteIndex{},
+		crossListings:   newCrossListingIndex(),
+		sectionSubjects: map[string]string{},
+		waiting:         map[string][]string{},
+		unlinkedTopics:  map[string]bool{},
 	}
 
-	// university []Metadata
-	metadata := university.Metadata
-	for metadataIndex := range metadata {
-		metadata := metadata[metadataIndex]
-		if err := metadata.Validate(); err != nil {
-			return err
-		}
+	if !sv.v.validate("university", func() error { return university.Validate() }) {
+		return sv
 	}
+	sv.valid = true
+	sv.v.report.University = university.TopicName
 
-	return nil
+	university.Buildings = sv.v.validateBuildings(university)
+	university.Metadata = sv.v.validateMetadata(university.TopicName, university.Metadata)
+
+	return sv
 }
 
-func ValidateAllSubjects(university *University) error {
-	makeUniqueSubjects(university.Subjects)
-	for subjectIndex := range university.Subjects {
-		subject := university.Subjects[subjectIndex]
-		if err := subject.Validate(university); err != nil {
-			return err
+// Report returns the violations found so far.
+func (sv *StreamValidator) Report() *ValidationReport {
+	return sv.v.report
+}
+
+// ValidateSubject validates the next subject of the university and links it to the subjects validated before
+// it. It returns false when the subject broke a rule and must be dropped.
+func (sv *StreamValidator) ValidateSubject(subject *Subject) bool {
+	if !sv.valid {
+		return false
+	}
+
+	makeUniqueSubject(sv.subjects, subject)
+	if !sv.v.validateSubject(sv.university, subject) {
+		return false
+	}
+
+	university := sv.subjectUniversity(subject)
+	resolveInstructors(university)
+	resolveLocations(university)
+
+	sv.prerequisites.add(subject)
+	sv.crossListings.add(subject)
+	for _, course := range subject.Courses {
+		sv.resume(prerequisiteKey(subject.Season, subject.Year, subject.Number, course.Number), subject)
+		for _, section := range course.Sections {
+			key := crossListingKey(subject.Season, subject.Year, subject.Number, course.Number, section.Number)
+			sv.sectionSubjects[key] = subject.TopicName
+			sv.resume(key, subject)
 		}
+	}
 
-		if err := ValidateAllCourses(subject); err != nil {
-			return err
+	// An earlier subject lists back to the sections this subject lists
+	for _, key := range sv.crossListings.list(subject) {
+		if topicName, ok := sv.sectionSubjects[key]; ok && topicName != subject.TopicName {
+			sv.unlink(topicName)
 		}
+	}
 
+	for _, key := range append(sv.prerequisites.link(subject), sv.crossListings.link(subject)...) {
+		sv.waiting[key] = append(sv.waiting[key], subject.TopicName)
 	}
 
-	return nil
+	return sv.v.validate(subjectPath(subject), func() error { return SetContentHashes(university) })
 }
 
-func ValidateAllCourses(subject *Subject) error {
-	courses := subject.Courses
-	makeUniqueCourses(subject, courses)
-	for courseIndex := range courses {
-		course := courses[courseIndex]
-		if err := course.Validate(subject); err != nil {
-			return err
-		}
+// Link links a subject that was validated to every subject validated so far and sets its content hashes again.
+func (sv *StreamValidator) Link(subject *Subject) error {
+	sv.prerequisites.link(subject)
+	sv.crossListings.link(subject)
+	return SetContentHashes(sv.subjectUniversity(subject))
+}
+
+// Unlinked returns the topic names of the subjects that were validated before a subject they are linked to.
+func (sv *StreamValidator) Unlinked() []string {
+	return sv.unlinked
+}
 
-		if err := ValidateAllSections(course); err != nil {
-			return err
+// resume marks the subjects waiting for the course or section with the key as unlinked.
+func (sv *StreamValidator) resume(key string, subject *Subject) {
+	for _, topicName := range sv.waiting[key] {
+		if topicName != subject.TopicName {
+			sv.unlink(topicName)
 		}
+	}
+	delete(sv.waiting, key)
+}
 
-		// Course []Metadata
-		metadata := course.Metadata
-		for metadataIndex := range metadata {
-			metadata := metadata[metadataIndex]
-			if err := metadata.Validate(); err != nil {
-				return err
-			}
+func (sv *StreamValidator) unlink(topicName string) {
+	if !sv.unlinkedTopics[topicName] {
+		sv.unlinkedTopics[topicName] = true
+		sv.unlinked = append(sv.unlinked, topicName)
+	}
+}
+
+// subjectUniversity returns the university with only the subject.
+func (sv *StreamValidator) subjectUniversity(subject *Subject) *University {
+	return &University{Name: sv.university.Name, TopicName: sv.university.TopicName, Buildings: sv.university.Buildings, Subjects: []*Subject{subject}}
+}
+
+type validator struct {
+	policy ValidationPolicy
+	report *ValidationReport
+}
+
+// validate runs the validation of an entity at the path, recovering from a panic as a violation. It returns
+// whether the entity is valid.
+func (v *validator) validate(path string, validate func() error) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			v.report.add(v.policy, path, fmt.Errorf("panic: %v", r))
+			ok = false
 		}
+	}()
+
+	if err := validate(); err != nil {
+		v.report.add(v.policy, path, err)
+		return false
 	}
+	return true
+}
 
-	return nil
+func (v *validator) validateBuildings(university *University) []*Building {
+	var buildings []*Building
+	for _, building := range university.Buildings {
+		path := university.TopicName + "/building:" + building.Code
+		if v.validate(path, building.Validate) {
+			buildings = append(buildings, building)
+		}
+	}
+	return makeUniqueBuildings(buildings)
 }
 
-func ValidateAllSections(course *Course) error {
-	sections := course.Sections
+func (v *validator) validateSubjects(university *University) []*Subject {
+	makeUniqueSubjects(university.Subjects)
 
-	for sectionIndex := range sections {
-		section := sections[sectionIndex]
-		if err := section.Validate(course); err != nil {
-			return err
+	var subjects []*Subject
+	for _, subject := range university.Subjects {
+		if v.validateSubject(university, subject) {
+			subjects = append(subjects, subject)
 		}
+	}
+	return subjects
+}
 
-		//[]Instructors
-		instructors := section.Instructors
-		for instructorIndex := range instructors {
-			instructor := instructors[instructorIndex]
-			instructor.Index = int32(instructorIndex)
-			if err := instructor.Validate(); err != nil {
-				return err
-			}
+func (v *validator) validateSubject(university *University, subject *Subject) bool {
+	path := university.TopicName + "/subject:" + subject.Number
+	if !v.validate(path, func() error { return subject.Validate(university) }) {
+		return false
+	}
+	subject.Courses = v.validateCourses(subject)
+	return true
+}
+
+func (v *validator) validateCourses(subject *Subject) []*Course {
+	makeUniqueCourses(subject, subject.Courses)
+
+	var courses []*Course
+	for _, course := range subject.Courses {
+		path := subject.TopicName + "/course:" + course.Number
+		if !v.validate(path, func() error { return course.Validate(subject) }) {
+			continue
 		}
+		course.Sections = v.validateSections(course)
+		course.Metadata = v.validateMetadata(course.TopicName, course.Metadata)
+		courses = append(courses, course)
+	}
+	return courses
+}
 
-		//[]Meeting
-		meetings := section.Meetings
-		for meetingIndex := range meetings {
-			meeting := meetings[meetingIndex]
-			meeting.Index = int32(meetingIndex)
-			if err := meeting.Validate(); err != nil {
-				return err
-			}
+func (v *validator) validateSections(course *Course) []*Section {
+	var sections []*Section
+	for _, section := range course.Sections {
+		path := course.TopicName + "/section:" + section.Number
+		if !v.validate(path, func() error { return section.Validate(course) }) {
+			continue
+		}
 
-			// Meeting []Metadata
-			metadatas := meeting.Metadata
-			for metadataIndex := range metadatas {
-				metadata := metadatas[metadataIndex]
-				if err := metadata.Validate(); err != nil {
-					return err
-				}
+		var instructors []*Instructor
+		for instructorIndex, instructor := range section.Instructors {
+			path := section.TopicName + "/instructor:" + strconv.Itoa(instructorIndex)
+			if v.validate(path, instructor.Validate) {
+				instructor.Index = int32(len(instructors))
+				instructors = append(instructors, instructor)
 			}
 		}
-
-		//[]Books
-		books := section.Books
-		for bookIndex := range books {
-			book := books[bookIndex]
-			if err := book.Validate(); err != nil {
-				return err
+		section.Instructors = instructors
+
+		var meetings []*Meeting
+		for meetingIndex, meeting := range section.Meetings {
+			path := section.TopicName + "/meeting:" + strconv.Itoa(meetingIndex)
+			if v.validate(path, meeting.Validate) {
+				meeting.Index = int32(len(meetings))
+				meeting.Metadata = v.validateMetadata(path, meeting.Metadata)
+				meetings = append(meetings, meeting)
 			}
 		}
+		section.Meetings = meetings
 
-		// Section []Metadata
-		metadatas := section.Metadata
-		for metadataIndex := range metadatas {
-			metadata := metadatas[metadataIndex]
-			if err := metadata.Validate(); err != nil {
-				return err
+		var books []*Book
+		for bookIndex, book := range section.Books {
+			path := section.TopicName + "/book:" + strconv.Itoa(bookIndex)
+			if v.validate(path, book.Validate) {
+				books = append(books, book)
 			}
 		}
+		section.Books = books
+
+		section.Metadata = v.validateMetadata(section.TopicName, section.Metadata)
+		sections = append(sections, section)
 	}
+	return sections
+}
 
-	return nil
+func (v *validator) validateMetadata(parent string, metadata []*Metadata) []*Metadata {
+	var valid []*Metadata
+	for metadataIndex, m := range metadata {
+		path := parent + "/metadata:" + strconv.Itoa(metadataIndex)
+		if v.validate(path, m.Validate) {
+			valid = append(valid, m)
+		}
+	}
+	return valid
 }
 
 func (u *University) Validate() error {
 	// Name
 	if u.Name == "" {
-		return errors.New("University name == is empty")
+		return ruleError("university.name", "name", "University name == is empty")
 	}
 
 	// Abbr
@@ -255,26 +405,26 @@ func (u *University) Validate() error {
 
 	// Homepage
 	if u.HomePage == "" {
-		return errors.New("HomePage == is empty")
+		return ruleError("university.home_page", "home_page", "HomePage == is empty")
 	}
 
 	u.HomePage = trim(u.HomePage)
 
 	if homePageUrl, err := url.ParseRequestURI(u.HomePage); err != nil {
-		return err
+		return ruleError("university.home_page", "home_page", "%s", err)
 	} else {
 		u.HomePage = homePageUrl.String()
 	}
 
 	// RegistrationPage
 	if u.RegistrationPage == "" {
-		return errors.New("RegistrationPage == is empty")
+		return ruleError("university.registration_page", "registration_page", "RegistrationPage == is empty")
 	}
 
 	u.RegistrationPage = trim(u.RegistrationPage)
 
 	if registrationPageUrl, err := url.ParseRequestURI(u.RegistrationPage); err != nil {
-		return err
+		return ruleError("university.registration_page", "registration_page", "%s", err)
 	} else {
 		u.RegistrationPage = registrationPageUrl.String()
 	}
@@ -289,17 +439,28 @@ func (u *University) Validate() error {
 		u.AccentColor = "00000000"
 	}
 
+	// TimeZone
+	if u.TimeZone == "" {
+		u.TimeZone = DefaultTimeZone
+	}
+
 	// Registration
-	if len(u.Registrations) != 12 {
-		return errors.New("Registration != 12 ")
+	if len(u.Registrations) == 0 {
+		return ruleError("university.registrations", "registrations", "Registrations is empty")
 	}
 
 	if u.ResolvedSemesters == nil {
-		return errors.New("ResolvedSemesters is nil")
+		return ruleError("university.resolved_semesters", "resolved_semesters", "ResolvedSemesters is nil")
 	}
 
 	if u.ResolvedSemesters.Current == nil {
-		return errors.New("ResolvedSemesters.Current is nil")
+		return ruleError("university.resolved_semesters", "resolved_semesters", "ResolvedSemesters.Current is nil")
+	}
+
+	for _, semester := range []*Semester{u.ResolvedSemesters.Last, u.ResolvedSemesters.Current, u.ResolvedSemesters.Next} {
+		if semester != nil && semester.TermCode == 0 {
+			*semester = *NewSemester(semester.Season, semester.Year)
+		}
 	}
 
 	u.TopicName = ToTopicName(u.Name)
@@ -311,12 +472,26 @@ func (u *University) Validate() error {
 func (sub *Subject) Validate(uni *University) error {
 	// Name
 	if sub.Name == "" {
-		return errors.New("Subject name == is empty")
+		return ruleError("subject.name", "name", "Subject name == is empty")
 	}
 
 	sub.Name = TrimAll(sub.Name)
 	sub.Name = ToTitle(sub.Name)
 
+	// Season
+	sub.Season = strings.ToLower(trim(sub.Season))
+	if sub.TermCode == 0 {
+		termType, ok := LookupTermType(sub.Season)
+		if !ok {
+			return ruleError("subject.season", "season", "Subject season %q is not a registered term type", sub.Season)
+		}
+		sub.TermCode, sub.TermName = termType.Code, termType.Name
+	}
+
+	if sub.TermName == "" {
+		sub.TermName = ToTitle(strings.Replace(sub.Season, "_", " ", -1))
+	}
+
 	// TopicName
 	sub.TopicName = strings.Join([]string{uni.TopicName, sub.Number, sub.Name, sub.Season, sub.Year}, ".")
 	sub.TopicName = ToTopicName(sub.TopicName)
@@ -334,7 +509,7 @@ func (sub *Subject) Validate(uni *University) error {
 func (course *Course) Validate(subject *Subject) error {
 	// Name
 	if course.Name == "" {
-		return errors.New("Course name == is empty: " + course.Name)
+		return ruleError("course.name", "name", "Course name == is empty")
 	}
 
 	course.Name = TrimAll(course.Name)
@@ -342,7 +517,7 @@ func (course *Course) Validate(subject *Subject) error {
 
 	// Number
 	if course.Number == "" {
-		return errors.New("Number == is empty")
+		return ruleError("course.number", "number", "Number == is empty")
 	}
 
 	// Synopsis
@@ -369,23 +544,23 @@ func (course *Course) Validate(subject *Subject) error {
 func (section *Section) Validate(course *Course) error {
 	// Number
 	if section.Number == "" {
-		return errors.New("Number == is empty")
+		return ruleError("section.number", "number", "Number == is empty")
 	}
 
 	section.Number = trim(section.Number)
 
 	// Call Number
 	if section.CallNumber == "" {
-		return errors.New("CallNumber == is empty")
+		return ruleError("section.call_number", "call_number", "CallNumber == is empty")
 	}
 
 	section.CallNumber = trim(section.CallNumber)
 
 	// Status
 	if section.Status == "" {
-		return errors.New("Status == is empty")
+		return ruleError("section.status", "status", "Status == is empty")
 	} else if strings.ToLower(section.Status) != "open" && strings.ToLower(section.Status) != "closed" {
-		return errors.New("Status != open || status != closed status=" + section.Status)
+		return ruleError("section.status", "status", "Status != open || status != closed status=%s", section.Status)
 	}
 
 	// Max
@@ -398,11 +573,20 @@ func (section *Section) Validate(course *Course) error {
 		}
 	}
 
+	// Waitlist
+	if section.WaitlistMax == nil && section.WaitlistNow != nil {
+		return ruleError("section.waitlist", "waitlist_now", "WaitlistNow is set without WaitlistMax")
+	} else if section.GetWaitlistMax() < 0 || section.GetWaitlistNow() < 0 {
+		return ruleError("section.waitlist", "waitlist_max", "Waitlist is negative max=%d now=%d", section.GetWaitlistMax(), section.GetWaitlistNow())
+	} else if section.WaitlistMax != nil && section.WaitlistNow == nil {
+		section.WaitlistNow = proto.Int64(0)
+	}
+
 	// Credits must be a numeric type
 	if section.Credits == "" {
-		return errors.New("Credits == is empty")
+		return ruleError("section.credits", "credits", "Credits == is empty")
 	} else if _, err := strconv.ParseFloat(section.Credits, 64); err != nil {
-		return err
+		return ruleError("section.credits", "credits", "Credits %q is not a number", section.Credits)
 	}
 
 	section.TopicName = strings.Join([]string{course.TopicName, section.Number, section.CallNumber}, ".")
@@ -414,6 +598,15 @@ func (section *Section) Validate(course *Course) error {
 }
 
 func (meeting *Meeting) Validate() error {
+	if meeting.Day != nil {
+		day := TrimAll(*meeting.Day)
+		if day == "" {
+			meeting.Day = nil
+		} else {
+			meeting.Day = &day
+		}
+	}
+
 	if meeting.StartTime != nil {
 		a := TrimAll(*meeting.StartTime)
 		if a == "" {
@@ -432,13 +625,13 @@ func (meeting *Meeting) Validate() error {
 		}
 	}
 
-	return nil
+	return meeting.normalizeTimes()
 }
 
 func (instructor *Instructor) Validate() error {
 
 	if instructor.Name == "" {
-		return errors.New("Instructor name == is empty")
+		return ruleError("instructor.name", "name", "Instructor name == is empty")
 	}
 
 	if instructor.Name[len(instructor.Name)-1:] == "-" {
@@ -452,19 +645,19 @@ func (instructor *Instructor) Validate() error {
 
 func (book *Book) Validate() error {
 	if book.Title == "" {
-		return errors.New("Title  == is empty")
+		return ruleError("book.title", "title", "Title == is empty")
 	}
 
 	book.Title = trim(book.Title)
 
 	if book.Url == "" {
-		return errors.New("Url == is empty")
+		return ruleError("book.url", "url", "Url == is empty")
 	}
 
 	book.Url = trim(book.Url)
 
 	if url, err := url.ParseRequestURI(book.Url); err != nil {
-		return err
+		return ruleError("book.url", "url", "%s", err)
 	} else {
 		book.Url = url.String()
 	}
@@ -475,14 +668,14 @@ func (book *Book) Validate() error {
 func (metaData *Metadata) Validate() error {
 	// Title
 	if metaData.Title == "" {
-		return errors.New("Title == is empty")
+		return ruleError("metadata.title", "title", "Title == is empty")
 	}
 
 	metaData.Title = trim(metaData.Title)
 
 	// Content
 	if metaData.Content == "" {
-		return errors.New("Content == is empty")
+		return ruleError("metadata.content", "content", "Content == is empty")
 	}
 
 	metaData.Content = trim(metaData.Content)
@@ -493,13 +686,17 @@ func (metaData *Metadata) Validate() error {
 func makeUniqueSubjects(subjects []*Subject) {
 	m := make(map[string]int)
 	for subjectIndex := range subjects {
-		subject := subjects[subjectIndex]
-		key := strings.Join([]string{subject.Season, subject.Year, subject.Name, subject.Number}, "")
-		m[key]++
-		if m[key] > 1 {
-			log.WithFields(log.Fields{"key": key, "count": m[key]}).Debugln("Duplicate subject")
-			subject.Name = subject.Name + "_" + strconv.Itoa(m[key])
-		}
+		makeUniqueSubject(m, subjects[subjectIndex])
+	}
+}
+
+// makeUniqueSubject renames a subject that has the same name as a subject counted in m before it.
+func makeUniqueSubject(m map[string]int, subject *Subject) {
+	key := strings.Join([]string{subject.Season, subject.Year, subject.Name, subject.Number}, "")
+	m[key]++
+	if m[key] > 1 {
+		log.WithFields(log.Fields{"key": key, "count": m[key]}).Debugln("Duplicate subject")
+		subject.Name = subject.Name + "_" + strconv.Itoa(m[key])
 	}
 }
 
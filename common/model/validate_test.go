@@ -3,6 +3,7 @@ package model
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -33,3 +34,28 @@ func TestCheckUniqueCourse(t *testing.T) {
 	assert.True(t, courses[1].Name == "a_2")
 	assert.True(t, courses[2].Name == "a_3")
 }
+
+func TestSection_ValidateWaitlist(t *testing.T) {
+	course := &Course{TopicName: "course"}
+	section := func() *Section {
+		return &Section{Number: "01", CallNumber: "1234", Status: Closed.String(), Max: 30, Now: 30, Credits: "3"}
+	}
+
+	s := section()
+	assert.NoError(t, s.Validate(course))
+	assert.Nil(t, s.WaitlistMax)
+	assert.Nil(t, s.WaitlistNow)
+
+	s = section()
+	s.WaitlistMax = proto.Int64(10)
+	assert.NoError(t, s.Validate(course))
+	assert.Equal(t, int64(0), s.GetWaitlistNow())
+
+	s = section()
+	s.WaitlistNow = proto.Int64(3)
+	assert.Error(t, s.Validate(course))
+
+	s = section()
+	s.WaitlistMax, s.WaitlistNow = proto.Int64(10), proto.Int64(-1)
+	assert.Error(t, s.Validate(course))
+}
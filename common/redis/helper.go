@@ -1,6 +1,8 @@
 package redis
 
 import (
+	"io"
+
 	"github.com/Sirupsen/logrus"
 	"github.com/tevjef/uct-backend/common/conf"
 	"github.com/tevjef/uct-backend/common/model"
@@ -17,6 +19,9 @@ const (
 	ScraperQueue  = BaseNamespace + "scraper:queue"
 )
 
+// valueChunkSize is the number of bytes a value reader fetches at once.
+const valueChunkSize = 1 << 20
+
 func nameSpaceForApp(appName string) string {
 	return BaseNamespace + appName
 }
@@ -128,3 +133,52 @@ func (r Helper) Exists(list, key string) (int64, error) {
 
 	return -1, nil
 }
+
+// ValueReader returns a reader of the string value at the key that fetches it in ranges, so a large value is never
+// held in memory at once. The value must not be modified while it is read.
+func (r Helper) ValueReader(key string) io.Reader {
+	return &valueReader{client: r.Client, key: key}
+}
+
+type valueReader struct {
+	client *redis.Client
+	key    string
+	offset int64
+	chunk  []byte
+}
+
+func (vr *valueReader) Read(p []byte) (int, error) {
+	if len(vr.chunk) == 0 {
+		chunk, err := vr.client.GetRange(vr.key, vr.offset, vr.offset+valueChunkSize-1).Bytes()
+		if err != nil {
+			return 0, err
+		}
+		if len(chunk) == 0 {
+			return 0, io.EOF
+		}
+		vr.offset += int64(len(chunk))
+		vr.chunk = chunk
+	}
+
+	n := copy(p, vr.chunk)
+	vr.chunk = vr.chunk[n:]
+	return n, nil
+}
+
+// ValueWriter returns a writer that appends to the string value at the key. Every write is sent to redis, small
+// writes should be buffered.
+func (r Helper) ValueWriter(key string) io.Writer {
+	return valueWriter{client: r.Client, key: key}
+}
+
+type valueWriter struct {
+	client *redis.Client
+	key    string
+}
+
+func (vw valueWriter) Write(p []byte) (int, error) {
+	if err := vw.client.Append(vw.key, string(p)).Err(); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
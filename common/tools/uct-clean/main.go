@@ -11,11 +11,18 @@ import (
 )
 
 var (
-	app      = kingpin.New("print", "An application to print and translate json and protobuf")
-	logLevel = app.Flag("log-level", "Log level").Short('l').Default("debug").String()
-	format   = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json).PlaceHolder("[protobuf, json]").Required().String()
-	out      = app.Flag("output", "output format").Short('o').HintOptions(model.Protobuf, model.Json).PlaceHolder("[protobuf, json]").String()
-	file     = app.Arg("input", "file to clean").File()
+	app           = kingpin.New("print", "An application to repair, validate and translate json and protobuf")
+	logLevel      = app.Flag("log-level", "Log level").Short('l').Default("debug").String()
+	format        = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json, model.Stream).PlaceHolder("[protobuf, json, stream]").Required().String()
+	out           = app.Flag("output", "output format").Short('o').HintOptions(model.Protobuf, model.Json, model.Stream).PlaceHolder("[protobuf, json, stream]").String()
+	fix           = app.Flag("fix", "run only the named fixers in the order given, repeatable. Runs every fixer by default").PlaceHolder("FIXER").Strings()
+	noRepair      = app.Flag("no-repair", "do not repair the data before validating it").Bool()
+	changes       = app.Flag("changes", "print the change log of repairs to stderr").Bool()
+	exportChanges = app.Flag("export-changes", "export the change log of repairs as json to a file").PlaceHolder("FILE").String()
+	policy        = app.Flag("policy", "comma separated rule=severity pairs over the default validation policy, e.g book=error").String()
+	report        = app.Flag("report", "print the validation report to stderr").Bool()
+	exportReport  = app.Flag("export-report", "export the validation report as json to a file").PlaceHolder("FILE").String()
+	file          = app.Arg("input", "file to clean").File()
 )
 
 func main() {
@@ -25,7 +32,7 @@ func main() {
 	} else {
 		log.SetLevel(lvl)
 	}
-	if *format != model.Json && *format != model.Protobuf {
+	if *format != model.Json && *format != model.Protobuf && *format != model.Stream {
 		log.WithField("format", *format).Fatal("Invalid format")
 	}
 
@@ -42,7 +49,47 @@ func main() {
 		log.WithError(err).Fatalf("Failed to unmarshall message")
 	}
 
-	if err := model.ValidateAll(&university); err != nil {
+	if !*noRepair {
+		fixers := model.Fixers
+		if len(*fix) > 0 {
+			var err error
+			if fixers, err = model.LookupFixers(*fix...); err != nil {
+				log.WithError(err).Fatal("Invalid fixer")
+			}
+		}
+
+		changeLog := model.Repair(&university, fixers...)
+		log.WithField("changes", len(changeLog.Changes)).Debugln("Repaired message")
+
+		if *changes {
+			changeLog.WriteTo(os.Stderr)
+		}
+
+		if *exportChanges != "" {
+			if err := exportJSON(*exportChanges, changeLog.WriteJSON); err != nil {
+				log.WithError(err).Fatal("Failed to export change log")
+			}
+		}
+	}
+
+	validationPolicy, err := model.ParseValidationPolicy(*policy)
+	if err != nil {
+		log.WithError(err).Fatal("Invalid validation policy")
+	}
+
+	validationReport := model.ValidateReport(&university, validationPolicy)
+
+	if *report {
+		validationReport.WriteTo(os.Stderr)
+	}
+
+	if *exportReport != "" {
+		if err := exportJSON(*exportReport, validationReport.WriteJSON); err != nil {
+			log.WithError(err).Fatal("Failed to export validation report")
+		}
+	}
+
+	if err := validationReport.Err(); err != nil {
 		log.WithError(err).Fatalf("Failed to validate message")
 	}
 
@@ -60,3 +107,12 @@ func main() {
 		}
 	}
 }
+
+func exportJSON(path string, writeJSON func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeJSON(f)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"io"
 	"os"
 
@@ -12,10 +13,12 @@ import (
 
 var (
 	app      = kingpin.New("model-diff", "An application to filter unchanged objects")
-	format   = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json).PlaceHolder("[protobuf, json]").Required().String()
+	format   = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json, model.Stream).PlaceHolder("[protobuf, json, stream]").Required().String()
 	old      = app.Arg("old", "the first file to compare").Required().File()
 	new      = app.Arg("new", "the second file to compare").File()
 	logLevel = app.Flag("log-level", "Log level").Short('l').Default("debug").String()
+	events   = app.Flag("events", "output change events as json lines instead of the filtered university").Short('e').Bool()
+	subtrees = app.Flag("subtrees", "output the subjects, courses and sections whose content hash changed as json lines").Short('s').Bool()
 )
 
 func main() {
@@ -27,7 +30,7 @@ func main() {
 		log.SetLevel(lvl)
 	}
 
-	if *format != model.Json && *format != model.Protobuf {
+	if *format != model.Json && *format != model.Protobuf && *format != model.Stream {
 		log.Fatalln("Invalid format:", *format)
 	}
 
@@ -52,6 +55,32 @@ func main() {
 		log.WithError(err).Fatalf("Failed to unmarshall message")
 	}
 
+	if *subtrees {
+		for _, university := range []*model.University{&oldUniversity, &newUniversity} {
+			if err := model.SetContentHashes(university); err != nil {
+				log.WithError(err).Fatal("Failed to hash university")
+			}
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		for _, subtree := range model.DiffUniversity(oldUniversity, newUniversity).Subtrees() {
+			if err := encoder.Encode(subtree); err != nil {
+				log.WithError(err).Fatal()
+			}
+		}
+		return
+	}
+
+	if *events {
+		encoder := json.NewEncoder(os.Stdout)
+		for _, event := range model.Changes(oldUniversity, newUniversity) {
+			if err := encoder.Encode(event); err != nil {
+				log.WithError(err).Fatal()
+			}
+		}
+		return
+	}
+
 	filteredUniversity := model.DiffAndFilter(oldUniversity, newUniversity)
 
 	if reader, err := model.MarshalMessage(*format, filteredUniversity); err != nil {
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/tevjef/uct-backend/common/export"
+	"github.com/tevjef/uct-backend/common/model"
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+)
+
+var (
+	app       = kingpin.New("export", "An application to export the sections of a university as a table")
+	format    = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json, model.Stream).PlaceHolder("[protobuf, json, stream]").Required().String()
+	out       = app.Flag("output", "output format").Short('o').Default(export.CSV).Enum(export.CSV, export.Columnar)
+	columns   = app.Flag("column", "name of a column to export in the order given, repeatable. Exports every column by default").Short('c').PlaceHolder("COLUMN").Strings()
+	semesters = app.Flag("semester", "only export sections in the semester e.g fall-2017, repeatable").Short('s').PlaceHolder("SEASON-YEAR").Strings()
+	list      = app.Flag("list-columns", "list the names of the columns and exit").Bool()
+	file      = app.Arg("input", "file to export").File()
+)
+
+func main() {
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	if *list {
+		for _, column := range export.Columns {
+			os.Stdout.WriteString(column.Name + "\n")
+		}
+		return
+	}
+
+	if *format != model.Json && *format != model.Protobuf && *format != model.Stream {
+		log.Fatalln("Invalid format:", *format)
+	}
+
+	exportColumns, err := export.LookupColumns(*columns...)
+	if err != nil {
+		log.WithError(err).Fatal("Invalid column")
+	}
+
+	var filter []model.Semester
+	for _, s := range *semesters {
+		semester, err := export.ParseSemester(s)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid semester")
+		}
+		filter = append(filter, semester)
+	}
+
+	var input *bufio.Reader
+	if *file != nil {
+		input = bufio.NewReader(*file)
+	} else {
+		input = bufio.NewReader(os.Stdin)
+	}
+
+	output := bufio.NewWriter(os.Stdout)
+	defer output.Flush()
+
+	// CSV is written a subject at a time from a stream
+	if *format == model.Stream && *out == export.CSV {
+		if err := exportStream(input, output, exportColumns, filter); err != nil {
+			log.WithError(err).Fatal()
+		}
+		return
+	}
+
+	var university model.University
+
+	if err := model.UnmarshalMessage(*format, input, &university); err != nil {
+		log.WithError(err).Fatalf("Failed to unmarshall message")
+	}
+
+	if err := export.Write(*out, output, exportColumns, export.Flatten(&university, filter)); err != nil {
+		log.WithError(err).Fatal()
+	}
+}
+
+func exportStream(input io.Reader, output io.Writer, columns []export.Column, filter []model.Semester) error {
+	decoder := model.NewStreamDecoder(input)
+	university, err := decoder.Header()
+	if err != nil {
+		return err
+	}
+
+	writer := export.NewCSVWriter(output, columns)
+	for {
+		subject, err := decoder.Next()
+		if err == io.EOF {
+			return writer.Flush()
+		} else if err != nil {
+			return err
+		}
+		if err := writer.Write(export.FlattenSubject(university, subject, filter)); err != nil {
+			return err
+		}
+	}
+}
@@ -2,26 +2,33 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"io"
 	"os"
+	"strconv"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/gogo/protobuf/proto"
+	"github.com/tevjef/uct-backend/common/ical"
 	"github.com/tevjef/uct-backend/common/model"
 	kingpin "gopkg.in/alecthomas/kingpin.v2"
 )
 
 var (
-	app    = kingpin.New("print", "An application to print and translate json and protobuf")
-	format = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json).PlaceHolder("[protobuf, json]").Required().String()
-	out    = app.Flag("output", "output format").Short('o').HintOptions(model.Protobuf, model.Json).PlaceHolder("[protobuf, json]").String()
-	file   = app.Arg("input", "file to print").File()
+	app      = kingpin.New("print", "An application to print and translate json and protobuf")
+	format   = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json, model.Stream).PlaceHolder("[protobuf, json, stream]").Required().String()
+	out      = app.Flag("output", "output format").Short('o').HintOptions(model.Protobuf, model.Json, model.Stream, ics).PlaceHolder("[protobuf, json, stream, ics]").String()
+	sections = app.Flag("section", "topic name of a section to include in an ics calendar, defaults to every section").Strings()
+	file     = app.Arg("input", "file to print").File()
 )
 
+const ics = "ics"
+
 func main() {
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
-	if *format != model.Json && *format != model.Protobuf {
+	if *format != model.Json && *format != model.Protobuf && *format != model.Stream {
 		log.Fatalln("Invalid format:", *format)
 	}
 
@@ -32,12 +39,27 @@ func main() {
 		input = bufio.NewReader(os.Stdin)
 	}
 
+	// A stream is printed a subject at a time unless the output needs the whole university
+	if *format == model.Stream && (*out == "" || *out == model.Stream || *out == model.Json) {
+		if err := printStream(input, *out); err != nil {
+			log.WithError(err).Fatal()
+		}
+		return
+	}
+
 	var university model.University
 
 	if err := model.UnmarshalMessage(*format, input, &university); err != nil {
 		log.WithError(err).Fatalf("Failed to unmarshall message")
 	}
 
+	if *out == ics {
+		if err := printCalendar(university, *sections); err != nil {
+			log.WithError(err).Fatal()
+		}
+		return
+	}
+
 	if *format == model.Json {
 		if *out != "" {
 			io.Copy(os.Stdout, input)
@@ -62,3 +84,87 @@ func main() {
 		}
 	}
 }
+
+// printStream copies a stream, or prints the university header and each subject of it as a line of json, as
+// each subject is decoded.
+func printStream(input io.Reader, out string) error {
+	decoder := model.NewStreamDecoder(input)
+	header, err := decoder.Header()
+	if err != nil {
+		return err
+	}
+
+	output := bufio.NewWriter(os.Stdout)
+	defer output.Flush()
+
+	var write func(interface{}) error
+	if out == model.Json {
+		write = json.NewEncoder(output).Encode
+	} else {
+		encoder := model.NewStreamEncoder(output)
+		write = func(m interface{}) error {
+			if subject, ok := m.(*model.Subject); ok {
+				return encoder.WriteSubject(subject)
+			}
+			return encoder.WriteHeader(m.(*model.University))
+		}
+	}
+
+	if err := write(header); err != nil {
+		return err
+	}
+	for {
+		subject, err := decoder.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := write(subject); err != nil {
+			return err
+		}
+	}
+}
+
+// printCalendar writes the sections of the university as an iCalendar, each section's meetings repeat
+// weekly for the semester of its subject.
+func printCalendar(university model.University, sectionTopicNames []string) error {
+	timeZone := university.TimeZone
+	if timeZone == "" {
+		timeZone = model.DefaultTimeZone
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	for _, topicName := range sectionTopicNames {
+		wanted[topicName] = true
+	}
+
+	calendar := ical.New(university.Name, location, time.Now())
+	for _, subject := range university.Subjects {
+		year, err := strconv.Atoi(subject.Year)
+		if err != nil {
+			return err
+		}
+
+		first, last, err := model.SemesterDates(university.Registrations, model.Semester{Season: subject.Season, Year: int32(year)})
+		if err != nil {
+			return err
+		}
+
+		for _, course := range subject.Courses {
+			for _, section := range course.Sections {
+				if len(wanted) == 0 || wanted[section.TopicName] {
+					calendar.AddSection(course.Name, section, first, last)
+				}
+			}
+		}
+	}
+
+	_, err = calendar.WriteTo(os.Stdout)
+	return err
+}
@@ -13,15 +13,15 @@ import (
 
 var (
 	app    = kingpin.New("push", "An application to print and translate json and protobuf")
-	format = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json).PlaceHolder("[protobuf, json]").Required().String()
-	out    = app.Flag("output", "output format").Short('o').HintOptions(model.Protobuf, model.Json).PlaceHolder("[protobuf, json]").String()
+	format = app.Flag("format", "choose file input format").Short('f').HintOptions(model.Protobuf, model.Json, model.Stream).PlaceHolder("[protobuf, json, stream]").Required().String()
+	out    = app.Flag("output", "output format").Short('o').HintOptions(model.Protobuf, model.Json, model.Stream).PlaceHolder("[protobuf, json, stream]").String()
 	file   = app.Arg("input", "file to print").File()
 )
 
 func main() {
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
-	if *format != model.Json && *format != model.Protobuf {
+	if *format != model.Json && *format != model.Protobuf && *format != model.Stream {
 		log.Fatalln("Invalid format:", *format)
 	}
 
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/tevjef/uct-backend/common/database"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+// Owners of staged metadata
+const (
+	subjectOwner = "subject"
+	courseOwner  = "course"
+	sectionOwner = "section"
+	meetingOwner = "meeting"
+)
+
+type bulkTable struct {
+	name    string
+	columns []string
+	rows    [][]interface{}
+}
+
+func (table *bulkTable) add(values ...interface{}) {
+	table.rows = append(table.rows, values)
+}
+
+// bulkInsertSubjects stages every subject, course, section, meeting, instructor, book and metadata
+// of the university into temporary tables with COPY, then merges each table with a single statement.
+func (ein *ein) bulkInsertSubjects(university *model.University) {
+	defer model.TimeTrack(time.Now(), "bulkInsertSubjects")
+
+	tx := ein.tx()
+
+	subjects := &bulkTable{name: "tmp_subject", columns: []string{"university_id", "name", "number", "season", "year", "term_code", "term_name", "topic_name", "topic_id", "content_hash"}}
+	courses := &bulkTable{name: "tmp_course", columns: []string{"subject_topic_name", "name", "number", "synopsis", "topic_name", "topic_id", "content_hash"}}
+	sections := &bulkTable{name: "tmp_section", columns: []string{"course_topic_name", "number", "call_number", "max", "now", "status", "credits", "topic_name", "topic_id", "data", "cross_listed_topic_names", "waitlist_max", "waitlist_now", "content_hash"}}
+	meetings := &bulkTable{name: "tmp_meeting", columns: []string{"section_topic_name", "room", "day", "start_time", "end_time", "class_type", "index", "building_code", "room_number", "content_hash"}}
+	instructors := &bulkTable{name: "tmp_instructor", columns: []string{"section_topic_name", "name", "index", "normalized_name", "topic_name"}}
+	books := &bulkTable{name: "tmp_book", columns: []string{"section_topic_name", "title", "url"}}
+	metadata := &bulkTable{name: "tmp_metadata", columns: []string{"owner", "topic_name", "meeting_index", "title", "content"}}
+
+	for _, subject := range university.Subjects {
+		subjects.add(university.Id, subject.Name, subject.Number, subject.Season, subject.Year, subject.TermCode, subject.TermName, subject.TopicName, subject.TopicId, subject.ContentHash)
+		for _, m := range subject.Metadata {
+			metadata.add(subjectOwner, subject.TopicName, nil, m.Title, m.Content)
+		}
+
+		for _, course := range subject.Courses {
+			courses.add(subject.TopicName, course.Name, course.Number, nullString(course.Synopsis), course.TopicName, course.TopicId, course.ContentHash)
+			for _, m := range course.Metadata {
+				metadata.add(courseOwner, course.TopicName, nil, m.Title, m.Content)
+			}
+
+			for _, section := range course.Sections {
+				data, err := section.Marshal()
+				if err != nil {
+					log.WithError(err).Panicln("failed to marshal section")
+				}
+
+				sections.add(course.TopicName, section.Number, section.CallNumber, section.Max, section.Now, section.Status, section.Credits, section.TopicName, section.TopicId, data, strings.Join(section.CrossListedTopicNames(), ","), nullInt64(section.WaitlistMax), nullInt64(section.WaitlistNow), section.ContentHash)
+				for _, m := range section.Metadata {
+					metadata.add(sectionOwner, section.TopicName, nil, m.Title, m.Content)
+				}
+
+				for _, meeting := range section.Meetings {
+					meetings.add(section.TopicName, nullString(meeting.Room), nullString(meeting.Day), nullString(meeting.StartTime), nullString(meeting.EndTime), nullString(meeting.ClassType), meeting.Index, nullString(meeting.BuildingCode), nullString(meeting.RoomNumber), meeting.ContentHash)
+					for _, m := range meeting.Metadata {
+						metadata.add(meetingOwner, section.TopicName, meeting.Index, m.Title, m.Content)
+					}
+				}
+
+				for _, instructor := range section.Instructors {
+					instructors.add(section.TopicName, instructor.Name, instructor.Index, nullEmpty(model.NormalizeInstructorName(instructor.Name)), nullEmpty(instructor.TopicName))
+				}
+
+				for _, book := range section.Books {
+					books.add(section.TopicName, book.Title, book.Url)
+				}
+			}
+		}
+	}
+
+	ein.bulkExec(tx, BulkCreateTablesQuery)
+	ein.bulkExec(tx, BulkTruncateTablesQuery)
+
+	for _, table := range []*bulkTable{subjects, courses, sections, meetings, instructors, books, metadata} {
+		if err := tx.CopyIn(table.name, table.columns, table.rows); err != nil {
+			log.WithError(err).Panicln()
+		}
+	}
+
+	// Parents are merged before their children so that children can find their parent's id
+	for _, query := range []string{
+		BulkMergeSubjectQuery,
+		BulkMergeCourseQuery,
+		BulkMergeSectionQuery,
+		BulkMergeMeetingQuery,
+		BulkMergeInstructorProfileQuery,
+		BulkMergeInstructorQuery,
+		BulkMergeBookQuery,
+		BulkMergeSubjectMetadataQuery,
+		BulkMergeCourseMetadataQuery,
+		BulkMergeSectionMetadataQuery,
+		BulkMergeMeetingMetadataQuery,
+	} {
+		ein.bulkExec(tx, query)
+	}
+}
+
+// bulkUpdateSerial replaces the serialized data of every subject and course with a single statement each.
+func (ein *ein) bulkUpdateSerial(subjects []*model.Subject, courses []*model.Course) {
+	defer model.TimeTrack(time.Now(), "bulkUpdateSerial")
+
+	tx := ein.tx()
+
+	serials := &bulkTable{name: "tmp_serial", columns: []string{"owner", "topic_name", "data", "content_hash", "prerequisite_topic_names"}}
+
+	for _, subject := range subjects {
+		data, err := subject.Marshal()
+		if err != nil {
+			log.WithError(err).Panicln("failed to marshal subject")
+		}
+		serials.add(subjectOwner, subject.TopicName, data, subject.ContentHash, nil)
+	}
+
+	for _, course := range courses {
+		data, err := course.Marshal()
+		if err != nil {
+			log.WithError(err).Panicln("failed to marshal course")
+		}
+		serials.add(courseOwner, course.TopicName, data, course.ContentHash, strings.Join(course.Prerequisite.TopicNames(), ","))
+	}
+
+	ein.bulkExec(tx, BulkCreateSerialTableQuery)
+	ein.bulkExec(tx, BulkTruncateSerialTableQuery)
+
+	if err := tx.CopyIn(serials.name, serials.columns, serials.rows); err != nil {
+		log.WithError(err).Panicln()
+	}
+
+	ein.bulkExec(tx, BulkSerialSubjectUpdateQuery)
+	ein.bulkExec(tx, BulkSerialCourseUpdateQuery)
+}
+
+func (ein *ein) bulkExec(tx database.Tx, query string) {
+	if rows, err := tx.Exec(query); err != nil {
+		log.WithError(err).WithField("query", query).Panicln("failed to execute bulk statement")
+	} else {
+		log.WithFields(log.Fields{"rows": rows, "query": query}).Debugln("bulkExec")
+	}
+}
+
+// Bulk ingestion relies on COPY which is only available in a transaction.
+func (ein *ein) tx() database.Tx {
+	tx, ok := ein.postgres.(database.Tx)
+	if !ok {
+		log.Panicln("bulk ingestion must run in a transaction")
+	}
+	return tx
+}
+
+func nullString(s *string) interface{} {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func nullEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func nullInt64(i *int64) interface{} {
+	if i == nil {
+		return nil
+	}
+	return *i
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+// Rows of section_history are appended by a trigger on the section table. applyHistoryPolicy keeps
+// the table from growing without bound. Rows older than the compaction age keep every status change
+// but only the last seat count of each day, rows older than the retention age are deleted.
+// A zero age disables that part of the policy.
+func (ein *ein) applyHistoryPolicy() {
+	defer model.TimeTrack(time.Now(), "applyHistoryPolicy")
+
+	tx := ein.tx()
+
+	if compactAfter := ein.config.historyCompactAfter; compactAfter > 0 {
+		if rows, err := tx.Exec(CompactSectionHistoryQuery, int64(compactAfter.Seconds()), int64((compactAfter + historyCompactionWindow).Seconds())); err != nil {
+			log.WithError(err).Panicln("failed to compact section history")
+		} else if rows > 0 {
+			log.WithField("rows", rows).Infoln("compacted section history")
+		}
+	}
+
+	if retention := ein.config.historyRetention; retention > 0 {
+		if rows, err := tx.Exec(DeleteSectionHistoryQuery, int64(retention.Seconds())); err != nil {
+			log.WithError(err).Panicln("failed to delete section history")
+		} else if rows > 0 {
+			log.WithField("rows", rows).Infoln("deleted section history")
+		}
+	}
+}
+
+// Only rows that crossed the compaction age recently are compacted, rows before that were compacted by earlier runs.
+const historyCompactionWindow = 7 * 24 * time.Hour
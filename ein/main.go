@@ -3,6 +3,8 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"strconv"
 	"strings"
@@ -65,7 +67,14 @@ type einConfig struct {
 	service     conf.Config
 	noDiff      bool
 	fullUpsert  bool
+	hardDelete  bool
+	bulk        bool
 	inputFormat string
+
+	validationPolicy model.ValidationPolicy
+
+	historyRetention    time.Duration
+	historyCompactAfter time.Duration
 }
 
 func init() {
@@ -90,13 +99,37 @@ func main() {
 		Envar("EIN_INSERT_ALL").
 		BoolVar(&econf.fullUpsert)
 
+	app.Flag("hard-delete", "delete subjects, courses and sections missing from the data instead of marking them as removed.").
+		Default("false").
+		Envar("EIN_HARD_DELETE").
+		BoolVar(&econf.hardDelete)
+
+	app.Flag("bulk", "stage all objects with COPY and merge them with set-based statements, implies --insert-all.").
+		Default("false").
+		Envar("EIN_BULK").
+		BoolVar(&econf.bulk)
+
+	app.Flag("history-retention", "delete section history older than this, 0 keeps all history.").
+		Default("8760h").
+		Envar("EIN_HISTORY_RETENTION").
+		DurationVar(&econf.historyRetention)
+
+	app.Flag("history-compact-after", "keep only status changes and the last seat count of each day for section history older than this, 0 disables compaction.").
+		Default("720h").
+		Envar("EIN_HISTORY_COMPACT_AFTER").
+		DurationVar(&econf.historyCompactAfter)
+
 	app.Flag("format", "choose input format").
 		Short('f').
-		HintOptions(model.Json, model.Protobuf).
-		PlaceHolder("[protobuf, json]").
+		HintOptions(model.Json, model.Protobuf, model.Stream).
+		PlaceHolder("[protobuf, json, stream]").
 		Required().
 		Envar("EIN_INPUT_FORMAT").
-		EnumVar(&econf.inputFormat, "protobuf", "json")
+		EnumVar(&econf.inputFormat, "protobuf", "json", "stream")
+
+	validationPolicy := app.Flag("validation-policy", "comma separated rule=severity pairs over the default validation policy, e.g book=error,section.credits=warning.").
+		Envar("EIN_VALIDATION_POLICY").
+		String()
 
 	configFile := app.Flag("config", "configuration file for the application").
 		Short('c').
@@ -105,6 +138,16 @@ func main() {
 
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
+	if econf.bulk {
+		econf.fullUpsert = true
+	}
+
+	policy, err := model.ParseValidationPolicy(*validationPolicy)
+	if err != nil {
+		log.WithError(err).Fatalln("invalid validation policy")
+	}
+	econf.validationPolicy = policy
+
 	// Parse configuration file
 	econf.service = conf.OpenConfigWithName(*configFile, app.Name)
 
@@ -281,15 +324,56 @@ func (ein *ein) process(data []string) error {
 
 	val := data[1]
 	latestData := val + ":data:latest"
-	oldData := val + ":data:old"
+	ingestData := val + ":data:ingest"
 
 	log.WithFields(log.Fields{"key": val}).Debugln("RPOP")
 
-	raw, err := ein.redis.Client.Get(latestData).Bytes()
-	if err != nil {
+	// Move the payload out of the way of the next scrape, which may be pushed while this one is read
+	if err := ein.redis.Client.Rename(latestData, ingestData).Err(); err != nil {
 		return errors.New("error while getting latest data")
 	}
 
+	payload, err := ein.openEnvelope(val, ein.redis.ValueReader(ingestData))
+	if err != nil {
+		ein.quarantine(val)
+		return err
+	}
+
+	if ein.config.inputFormat == model.Stream {
+		err = ein.processStream(val, payload)
+	} else {
+		err = ein.processUniversity(val, payload)
+	}
+
+	if errors.Cause(err) == model.ErrContentHash {
+		log.WithError(err).WithFields(log.Fields{"key": val}).Errorln("quarantining payload")
+		ein.quarantine(val)
+		return errors.Wrap(err, "rejected payload")
+	} else if err != nil {
+		// Keep the payload for the next run unless a newer scrape replaced it
+		if _, rErr := ein.redis.Client.RenameNX(ingestData, latestData).Result(); rErr != nil {
+			log.WithError(rErr).Errorln("failed to restore payload")
+		}
+		return err
+	}
+
+	if _, err := ein.redis.Client.Del(ingestData).Result(); err != nil {
+		log.WithError(err).Errorln("failed to remove ingested payload")
+	}
+	return nil
+}
+
+// processUniversity ingests a university encoded in a format that can only be decoded whole. It is diffed
+// against the university ingested by the previous run.
+func (ein *ein) processUniversity(key string, payload io.Reader) error {
+	latestData := key + ":data:latest"
+	oldData := key + ":data:old"
+
+	raw, err := ioutil.ReadAll(payload)
+	if err != nil {
+		return errors.Wrap(err, "error while getting latest data")
+	}
+
 	var university model.University
 
 	// Try getting older data from redis
@@ -305,7 +389,7 @@ func (ein *ein) process(data []string) error {
 	}
 
 	// Make sure the data received is primed for the database
-	if err := model.ValidateAll(&newUniversity); err != nil {
+	if err := ein.validate(key, &newUniversity); err != nil {
 		return errors.Wrap(err, "error while validating newUniversity")
 	}
 
@@ -316,38 +400,132 @@ func (ein *ein) process(data []string) error {
 			return errors.Wrap(err, "error while unmarshalling old data")
 		}
 
-		if err := model.ValidateAll(&oldUniversity); err != nil {
+		if err := model.ValidateReport(&oldUniversity, ein.config.validationPolicy).Err(); err != nil {
 			return errors.Wrap(err, "error while validating oldUniversity")
 		}
 
-		university = model.DiffAndFilter(oldUniversity, newUniversity)
+		diff := model.DiffUniversity(oldUniversity, newUniversity)
+		for _, event := range diff.Changes() {
+			log.WithFields(event.Fields()).Debugln("change")
+		}
+
+		university = diff.Filter()
 
 	} else {
 		university = newUniversity
 	}
 
-	// Replace old data with new data we just received.
-	if _, err := ein.redis.Client.Set(oldData, raw, 0).Result(); err != nil {
-		return errors.Wrap(err, "error updating old data")
-	}
-
 	ein.metrics.payloadBytes.With(prometheus.Labels{"university_name": university.TopicName}).Set(float64(len([]byte(raw))))
 	// Log bytes received
 	log.WithFields(log.Fields{"bytes": len([]byte(raw)), "university_name": university.TopicName}).Infoln(latestData)
 
 	go statsCollector(ein, university.TopicName)
 
-	ein.insertUniversity(university)
-	ein.updateSerial(raw, university)
+	err = ein.ingest(raw, university, newUniversity)
 
 	collectDatabaseStats(ein.postgres)
 	doneAudit <- true
 	<-doneAudit
-	//break
+
+	if err != nil {
+		return errors.Wrap(err, "error while ingesting university")
+	}
+
+	// Replace old data with new data we just received. Only done once the data is committed so
+	// that a failed run is diffed against the same data on the next run.
+	if _, err := ein.redis.Client.Set(oldData, raw, 0).Result(); err != nil {
+		return errors.Wrap(err, "error updating old data")
+	}
 
 	return nil
 }
 
+// openEnvelope opens the envelope jet sealed the payload in and returns the payload. A payload written with an
+// unsupported schema or in an unexpected format is rejected, a payload that was corrupted is only detected once
+// it was read to the end. Payloads from scrapers that predate envelopes are accepted as they are.
+func (ein *ein) openEnvelope(key string, sealed io.Reader) (io.Reader, error) {
+	envelope, payload, err := model.OpenEnvelope(sealed)
+	if err == model.ErrNoEnvelope {
+		log.WithFields(log.Fields{"key": key}).Warningln("payload is not in an envelope")
+		return payload, nil
+	}
+
+	if err == nil && envelope.Format != ein.config.inputFormat {
+		err = fmt.Errorf("payload format %q does not match input format %q", envelope.Format, ein.config.inputFormat)
+	}
+
+	if err != nil {
+		fields := log.Fields{"key": key}
+		if envelope != nil {
+			fields["producer"] = envelope.Producer
+			fields["schema_version"] = envelope.SchemaVersion
+			fields["scraped_at"] = envelope.ScrapedAt
+		}
+		log.WithError(err).WithFields(fields).Errorln("quarantining payload")
+		return nil, errors.Wrap(err, "rejected payload")
+	}
+
+	log.WithFields(log.Fields{
+		"key":            key,
+		"producer":       envelope.Producer,
+		"schema_version": envelope.SchemaVersion,
+		"scraped_at":     envelope.ScrapedAt,
+	}).Debugln("opened envelope")
+	return payload, nil
+}
+
+// quarantine moves the payload that was being ingested to a quarantine key, where it is kept for inspection.
+func (ein *ein) quarantine(key string) {
+	if _, err := ein.redis.Client.Rename(key+":data:ingest", key+":data:quarantine").Result(); err != nil {
+		log.WithError(err).Errorln("failed to quarantine payload")
+	}
+}
+
+// ingest writes the university in a single transaction so that readers never see a partially
+// ingested university. Any failure rolls back every write made by this run.
+func (ein *ein) ingest(raw []byte, university, newUniversity model.University) error {
+	defer model.TimeTrack(time.Now(), "ingest")
+
+	return ein.transact(func(tx database.Tx) error {
+		txEin := *ein
+		txEin.postgres = tx
+
+		txEin.insertUniversity(university)
+		if err := txEin.removeMissing(newUniversity); err != nil {
+			return err
+		}
+		txEin.updateSerial(raw, university)
+		txEin.applyHistoryPolicy()
+		return nil
+	})
+}
+
+// transact runs fn in a single transaction, which is rolled back when fn returns an error or panics.
+func (ein *ein) transact(fn func(tx database.Tx) error) (err error) {
+	tx, err := ein.postgres.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from error while ingesting: %v", r)
+		}
+
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				log.WithError(rollbackErr).Errorln("failed to rollback transaction")
+			}
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return errors.Wrap(tx.Commit(), "failed to commit transaction")
+}
+
 // uses raw because the previously validated university was mutated some where and I couldn't find where
 func (ein *ein) updateSerial(raw []byte, diff model.University) {
 	defer model.TimeTrack(time.Now(), "updateSerial")
@@ -359,10 +537,16 @@ func (ein *ein) updateSerial(raw []byte, diff model.University) {
 	}
 
 	// Make sure the data received is primed for the database
-	if err := model.ValidateAll(&newUniversity); err != nil {
+	if err := model.ValidateReport(&newUniversity, ein.config.validationPolicy).Err(); err != nil {
 		log.WithError(err).Fatalln("error while validating newUniversity")
 	}
 
+	ein.updateSerialSubjects(newUniversity, diff)
+}
+
+// updateSerialSubjects serializes every subject of the university along with the courses and sections that are
+// in the diff.
+func (ein *ein) updateSerialSubjects(newUniversity, diff model.University) {
 	diffCourses := diffAndMergeCourses(newUniversity, diff)
 
 	// log number of subjects, courses, and sections found
@@ -370,6 +554,13 @@ func (ein *ein) updateSerial(raw []byte, diff model.University) {
 	countUniversity(diff, diffSubjectCh, diffCourseCh, diffSectionCh, diffMeetingCh, diffMetadataCh)
 	countSubjects(newUniversity.Subjects, diffCourses, diffSerialSubjectCh, diffSerialCourseCh, diffSerialSectionCh, diffSerialMeetingCountCh, diffSerialMetadataCountCh)
 
+	// Section data was merged along with the sections
+	if ein.config.bulk {
+		ein.bulkUpdateSerial(newUniversity.Subjects, diffCourses)
+		ein.updateSearchDocuments(diffCourses)
+		return
+	}
+
 	sem := make(chan bool, ein.config.service.Postgres.ConnMax)
 
 	for subjectIndex := range newUniversity.Subjects {
@@ -397,6 +588,8 @@ func (ein *ein) updateSerial(raw []byte, diff model.University) {
 		}()
 	}
 	cwg.Wait()
+
+	ein.updateSearchDocuments(diffCourses)
 }
 
 // For ever course that's in the diff return the course that has full data.
@@ -426,8 +619,21 @@ func diffAndMergeCourses(full, diff model.University) (coursesToUpdate []*model.
 }
 
 type serial struct {
-	TopicName string `db:"topic_name"`
-	Data      []byte `db:"data"`
+	TopicName   string `db:"topic_name"`
+	Data        []byte `db:"data"`
+	ContentHash string `db:"content_hash"`
+}
+
+// Courses also record the courses referenced by their prerequisites, separated by commas
+type courseSerial struct {
+	serial
+	PrerequisiteTopicNames string `db:"prerequisite_topic_names"`
+}
+
+// Sections also record the sections they are cross-listed with, separated by commas
+type sectionSerial struct {
+	serial
+	CrossListedTopicNames string `db:"cross_listed_topic_names"`
 }
 
 func (ein *ein) updateSerialSubject(subject *model.Subject) {
@@ -435,7 +641,7 @@ func (ein *ein) updateSerialSubject(subject *model.Subject) {
 	if err != nil {
 		log.WithError(err).Fatalln("failed to marshal subject")
 	}
-	arg := serial{TopicName: subject.TopicName, Data: data}
+	arg := serial{TopicName: subject.TopicName, Data: data, ContentHash: subject.ContentHash}
 	ein.postgres.Update(SerialSubjectUpdateQuery, arg)
 
 	// Sanity Check
@@ -447,7 +653,10 @@ func (ein *ein) updateSerialCourse(course *model.Course) {
 	if err != nil {
 		log.WithError(err).Fatalln("failed to marshal course")
 	}
-	arg := serial{TopicName: course.TopicName, Data: data}
+	arg := courseSerial{
+		serial:                 serial{TopicName: course.TopicName, Data: data, ContentHash: course.ContentHash},
+		PrerequisiteTopicNames: strings.Join(course.Prerequisite.TopicNames(), ","),
+	}
 	ein.postgres.Update(SerialCourseUpdateQuery, arg)
 
 	// Sanity Check
@@ -459,18 +668,27 @@ func (ein *ein) updateSerialSection(section *model.Section) {
 	if err != nil {
 		log.WithError(err).Fatalln("failed to marshal section")
 	}
-	arg := serial{TopicName: section.TopicName, Data: data}
+	arg := sectionSerial{
+		serial:                serial{TopicName: section.TopicName, Data: data, ContentHash: section.ContentHash},
+		CrossListedTopicNames: strings.Join(section.CrossListedTopicNames(), ","),
+	}
 	ein.postgres.Update(SerialSectionUpdateQuery, arg)
 
 	// Sanity Check
 	log.WithFields(log.Fields{"section": section.TopicId, "bytes": len(data)}).Debugln("sanity")
 }
 
-func (ein *ein) insertUniversity(university model.University) {
+func (ein *ein) insertUniversity(university model.University) int64 {
 	defer model.TimeTrack(time.Now(), "insertUniversity")
 
 	university.Id = ein.postgres.Upsert(UniversityInsertQuery, UniversityUpdateQuery, university)
 
+	// Buildings
+	for _, building := range university.Buildings {
+		building.UniversityId = university.Id
+		ein.insertBuilding(building)
+	}
+
 	ein.insertSubjects(&university)
 
 	// ResolvedSemesters
@@ -490,9 +708,15 @@ func (ein *ein) insertUniversity(university model.University) {
 		metadata.UniversityId = &university.Id
 		ein.insertMetadata(metadata)
 	}
+
+	return university.Id
 }
 
 func (ein *ein) insertSubjects(university *model.University) {
+	if ein.config.bulk {
+		ein.bulkInsertSubjects(university)
+		return
+	}
 
 	for subjectIndex := range university.Subjects {
 		subject := university.Subjects[subjectIndex]
@@ -615,14 +839,21 @@ func (ein *ein) insertCourse(course *model.Course) (courseId int64) {
 }
 
 func (ein *ein) insertSemester(university *model.University) int64 {
+	resolved := university.ResolvedSemesters
 	return ein.postgres.Upsert(SemesterInsertQuery, SemesterUpdateQuery, &model.DBResolvedSemester{
-		UniversityId:  university.Id,
-		CurrentSeason: university.ResolvedSemesters.Current.Season,
-		CurrentYear:   strconv.Itoa(int(university.ResolvedSemesters.Current.Year)),
-		LastSeason:    university.ResolvedSemesters.Last.Season,
-		LastYear:      strconv.Itoa(int(university.ResolvedSemesters.Last.Year)),
-		NextSeason:    university.ResolvedSemesters.Next.Season,
-		NextYear:      strconv.Itoa(int(university.ResolvedSemesters.Next.Year)),
+		UniversityId:    university.Id,
+		CurrentSeason:   resolved.Current.Season,
+		CurrentYear:     strconv.Itoa(int(resolved.Current.Year)),
+		CurrentTermCode: resolved.Current.TermCode,
+		CurrentTermName: resolved.Current.TermName,
+		LastSeason:      resolved.Last.Season,
+		LastYear:        strconv.Itoa(int(resolved.Last.Year)),
+		LastTermCode:    resolved.Last.TermCode,
+		LastTermName:    resolved.Last.TermName,
+		NextSeason:      resolved.Next.Season,
+		NextYear:        strconv.Itoa(int(resolved.Next.Year)),
+		NextTermCode:    resolved.Next.TermCode,
+		NextTermName:    resolved.Next.TermName,
 	})
 }
 
@@ -639,10 +870,28 @@ func (ein *ein) insertMeeting(meeting *model.Meeting) (meetingId int64) {
 	return ein.postgres.Upsert(MeetingInsertQuery, MeetingUpdateQuery, meeting)
 }
 
+// The instructor_profile that identifies an instructor across sections
+type instructorProfile struct {
+	SectionId      int64  `db:"section_id"`
+	Name           string `db:"name"`
+	NormalizedName string `db:"normalized_name"`
+	TopicName      string `db:"topic_name"`
+}
+
 func (ein *ein) insertInstructor(instructor *model.Instructor) (instructorId int64) {
 	if instructorId = ein.postgres.Exists(InstructorExistQuery, instructor); instructorId != 0 {
 		return
 	}
+
+	if instructor.TopicName != "" {
+		ein.postgres.Insert(InstructorProfileInsertQuery, instructorProfile{
+			SectionId:      instructor.SectionId,
+			Name:           instructor.Name,
+			NormalizedName: model.NormalizeInstructorName(instructor.Name),
+			TopicName:      instructor.TopicName,
+		})
+	}
+
 	return ein.postgres.Upsert(InstructorInsertQuery, InstructorUpdateQuery, instructor)
 }
 
@@ -656,6 +905,10 @@ func (ein *ein) insertRegistration(registration *model.Registration) int64 {
 	return ein.postgres.Upsert(RegistrationInsertQuery, RegistrationUpdateQuery, registration)
 }
 
+func (ein *ein) insertBuilding(building *model.Building) int64 {
+	return ein.postgres.Upsert(BuildingInsertQuery, BuildingUpdateQuery, building)
+}
+
 func (ein *ein) insertMetadata(metadata *model.Metadata) (metadataId int64) {
 	var insertQuery string
 	var updateQuery string
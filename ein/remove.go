@@ -0,0 +1,176 @@
+package main
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/pkg/errors"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+type semester struct {
+	TopicName string `db:"topic_name"`
+	Season    string `db:"season"`
+	Year      string `db:"year"`
+}
+
+type topicRow struct {
+	TopicName       string `db:"topic_name"`
+	ParentTopicName string `db:"parent_topic_name"`
+	Removed         bool   `db:"removed"`
+}
+
+type removalQueries struct {
+	topics  string
+	remove  string
+	restore string
+	delete  string
+}
+
+var (
+	subjectRemoval = removalQueries{SubjectTopicsQuery, SubjectRemoveQuery, SubjectRestoreQuery, SubjectDeleteQuery}
+	courseRemoval  = removalQueries{CourseTopicsQuery, CourseRemoveQuery, CourseRestoreQuery, CourseDeleteQuery}
+	sectionRemoval = removalQueries{SectionTopicsQuery, SectionRemoveQuery, SectionRestoreQuery, SectionDeleteQuery}
+)
+
+// ingestedTopics are the topics of the subjects, courses and sections that were ingested, grouped by the
+// semesters they were ingested for.
+type ingestedTopics struct {
+	semesters map[semester]bool
+	subjects  map[string]bool
+	courses   map[string]bool
+	sections  map[string]bool
+}
+
+func newIngestedTopics() *ingestedTopics {
+	return &ingestedTopics{
+		semesters: map[semester]bool{},
+		subjects:  map[string]bool{},
+		courses:   map[string]bool{},
+		sections:  map[string]bool{},
+	}
+}
+
+func (topics *ingestedTopics) add(universityTopicName string, subject *model.Subject) {
+	topics.semesters[semester{TopicName: universityTopicName, Season: subject.Season, Year: subject.Year}] = true
+	topics.subjects[subject.TopicName] = true
+
+	for _, course := range subject.Courses {
+		topics.courses[course.TopicName] = true
+
+		for _, section := range course.Sections {
+			topics.sections[section.TopicName] = true
+		}
+	}
+}
+
+// removeMissing marks subjects, courses and sections that are in the database but missing from the
+// university as removed, or deletes them when hard deletes are enabled. Only the semesters present
+// in the university are considered, so a scrape that skipped a semester does not remove it.
+// Rows that were marked as removed but have reappeared are restored.
+func (ein *ein) removeMissing(university model.University) error {
+	defer model.TimeTrack(time.Now(), "removeMissing")
+
+	topics := newIngestedTopics()
+	subjects := map[string]*model.Subject{}
+	courses := map[string]*model.Course{}
+	// Topic names of subjects by the topic names of their courses
+	courseSubjects := map[string]string{}
+
+	for _, subject := range university.Subjects {
+		topics.add(university.TopicName, subject)
+		subjects[subject.TopicName] = subject
+
+		for _, course := range subject.Courses {
+			courses[course.TopicName] = course
+			courseSubjects[course.TopicName] = subject.TopicName
+		}
+	}
+
+	removedCourses, removedSections, err := ein.removeMissingTopics(topics)
+	if err != nil {
+		return err
+	}
+
+	// Subjects and courses that lost a course or section still carry it in their serialized data
+	refreshed := map[string]bool{}
+	refreshSubject := func(topicName string) {
+		if subject := subjects[topicName]; subject != nil && !refreshed[subject.TopicName] {
+			refreshed[subject.TopicName] = true
+			ein.updateSerialSubject(subject)
+		}
+	}
+
+	for _, row := range removedSections {
+		if course := courses[row.ParentTopicName]; course != nil && !refreshed[course.TopicName] {
+			refreshed[course.TopicName] = true
+			ein.updateSerialCourse(course)
+			refreshSubject(courseSubjects[course.TopicName])
+		}
+	}
+
+	for _, row := range removedCourses {
+		refreshSubject(row.ParentTopicName)
+	}
+
+	return nil
+}
+
+// removeMissingTopics removes the rows of the semesters in topics that were not ingested and returns the
+// courses and sections that were removed.
+func (ein *ein) removeMissingTopics(topics *ingestedTopics) (removedCourses, removedSections []topicRow, err error) {
+	for sem := range topics.semesters {
+		var courses, sections []topicRow
+		if _, err = ein.removeTopics(subjectRemoval, sem, func(topicName string) bool { return topics.subjects[topicName] }); err != nil {
+			return
+		}
+		if courses, err = ein.removeTopics(courseRemoval, sem, func(topicName string) bool { return topics.courses[topicName] }); err != nil {
+			return
+		}
+		if sections, err = ein.removeTopics(sectionRemoval, sem, func(topicName string) bool { return topics.sections[topicName] }); err != nil {
+			return
+		}
+
+		removedCourses = append(removedCourses, courses...)
+		removedSections = append(removedSections, sections...)
+	}
+
+	return
+}
+
+func (ein *ein) removeTopics(queries removalQueries, sem semester, exists func(topicName string) bool) (removed []topicRow, err error) {
+	var rows []topicRow
+	if err := ein.postgres.Select(queries.topics, &rows, sem); err != nil {
+		return nil, errors.Wrap(err, "failed to select topics")
+	}
+
+	removeQuery := queries.remove
+	if ein.config.hardDelete {
+		removeQuery = queries.delete
+	}
+
+	restored := 0
+	for _, row := range rows {
+		if exists(row.TopicName) {
+			if row.Removed {
+				ein.postgres.Update(queries.restore, row)
+				restored++
+			}
+		} else if !row.Removed {
+			ein.postgres.Update(removeQuery, row)
+			removed = append(removed, row)
+		}
+	}
+
+	if len(removed) > 0 || restored > 0 {
+		log.WithFields(log.Fields{
+			"season":      sem.Season,
+			"year":        sem.Year,
+			"removed":     len(removed),
+			"restored":    restored,
+			"hard_delete": ein.config.hardDelete,
+		}).Infoln("removeTopics")
+	}
+
+	return
+}
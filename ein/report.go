@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+// validate validates the university under the configured policy. Every violation is logged and the report is
+// exported to redis next to the data of the university, so the warnings of an accepted university and the
+// errors of a rejected one can be inspected after the run.
+func (ein *ein) validate(key string, university *model.University) error {
+	return ein.exportReport(key, model.ValidateReport(university, ein.config.validationPolicy))
+}
+
+// exportReport logs every violation of the report and exports it to redis. It returns the error of the report.
+func (ein *ein) exportReport(key string, report *model.ValidationReport) error {
+	for _, violation := range report.Violations {
+		entry := log.WithFields(log.Fields{
+			"university_name": report.University,
+			"path":            violation.Path,
+			"field":           violation.Field,
+			"rule":            violation.Rule,
+		})
+		if violation.Severity == model.Error {
+			entry.Errorln(violation.Message)
+		} else {
+			entry.Warningln(violation.Message)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"university_name": report.University,
+		"errors":          report.Count(model.Error),
+		"warnings":        report.Count(model.Warning),
+	}).Infoln("validation report")
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		log.WithError(err).Errorln("failed to encode validation report")
+	} else if err := ein.redis.Client.Set(key+":report:latest", buf.Bytes(), 0).Err(); err != nil {
+		log.WithError(err).Errorln("failed to export validation report")
+	}
+
+	return report.Err()
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/lib/pq"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+// updateSearchDocuments recomputes the full-text search documents of the courses and their sections once
+// their metadata and instructors are written, so that spike searches an index instead of every row.
+func (ein *ein) updateSearchDocuments(courses []*model.Course) {
+	defer model.TimeTrack(time.Now(), "updateSearchDocuments")
+
+	if len(courses) == 0 {
+		return
+	}
+
+	topicNames := make([]string, len(courses))
+	for i, course := range courses {
+		topicNames[i] = course.TopicName
+	}
+
+	tx := ein.tx()
+	for _, query := range []string{CourseSearchDocumentUpdateQuery, SectionSearchDocumentUpdateQuery} {
+		if rows, err := tx.Exec(query, pq.Array(topicNames)); err != nil {
+			log.WithError(err).Panicln("failed to update search documents")
+		} else {
+			log.WithField("rows", rows).Debugln("updated search documents")
+		}
+	}
+}
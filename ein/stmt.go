@@ -18,10 +18,13 @@ var queries = []string{UniversityInsertQuery,
 	InstructorExistQuery,
 	InstructorUpdateQuery,
 	InstructorInsertQuery,
+	InstructorProfileInsertQuery,
 	BookUpdateQuery,
 	BookInsertQuery,
 	RegistrationUpdateQuery,
 	RegistrationInsertQuery,
+	BuildingUpdateQuery,
+	BuildingInsertQuery,
 	MetaUniExistQuery,
 	MetaUniUpdateQuery,
 	MetaUniInsertQuery,
@@ -40,60 +43,80 @@ var queries = []string{UniversityInsertQuery,
 	SerialSubjectUpdateQuery,
 	SerialCourseUpdateQuery,
 	SerialSectionUpdateQuery,
+	SubjectDataQuery,
+	SubjectTopicsQuery,
+	SubjectRemoveQuery,
+	SubjectRestoreQuery,
+	SubjectDeleteQuery,
+	CourseTopicsQuery,
+	CourseRemoveQuery,
+	CourseRestoreQuery,
+	CourseDeleteQuery,
+	SectionTopicsQuery,
+	SectionRemoveQuery,
+	SectionRestoreQuery,
+	SectionDeleteQuery,
 }
 
 const (
-	UniversityInsertQuery = `INSERT INTO university (name, abbr, home_page, registration_page, main_color, accent_color, topic_name, topic_id)
-                    VALUES (:name, :abbr, :home_page, :registration_page, :main_color, :accent_color, :topic_name, :topic_id)
+	UniversityInsertQuery = `INSERT INTO university (name, abbr, home_page, registration_page, main_color, accent_color, topic_name, topic_id, time_zone)
+                    VALUES (:name, :abbr, :home_page, :registration_page, :main_color, :accent_color, :topic_name, :topic_id, :time_zone)
                     RETURNING university.id`
-	UniversityUpdateQuery = `UPDATE university SET (abbr, home_page, registration_page, main_color, accent_color, topic_name, topic_id) =
-	                (:abbr, :home_page, :registration_page, :main_color, :accent_color, :topic_name, :topic_id)
+	UniversityUpdateQuery = `UPDATE university SET (abbr, home_page, registration_page, main_color, accent_color, topic_name, topic_id, time_zone) =
+	                (:abbr, :home_page, :registration_page, :main_color, :accent_color, :topic_name, :topic_id, :time_zone)
 	                WHERE name = :name
 	                RETURNING university.id`
 
-	SemesterInsertQuery = `INSERT INTO semester (university_id, current_season, current_year, last_season, last_year, next_season, next_year)
-							VALUES (:university_id, :current_season, :current_year, :last_season, :last_year, :next_season, :next_year) RETURNING semester.id`
-	SemesterUpdateQuery = `UPDATE semester SET (current_season, current_year, last_season, last_year, next_season, next_year) =
-						(:current_season, :current_year, :last_season, :last_year, :next_season, :next_year) WHERE university_id = :university_id RETURNING semester.id`
+	SemesterInsertQuery = `INSERT INTO semester (university_id, current_season, current_year, current_term_code, current_term_name, last_season, last_year, last_term_code, last_term_name, next_season, next_year, next_term_code, next_term_name)
+							VALUES (:university_id, :current_season, :current_year, :current_term_code, :current_term_name, :last_season, :last_year, :last_term_code, :last_term_name, :next_season, :next_year, :next_term_code, :next_term_name) RETURNING semester.id`
+	SemesterUpdateQuery = `UPDATE semester SET (current_season, current_year, current_term_code, current_term_name, last_season, last_year, last_term_code, last_term_name, next_season, next_year, next_term_code, next_term_name) =
+						(:current_season, :current_year, :current_term_code, :current_term_name, :last_season, :last_year, :last_term_code, :last_term_name, :next_season, :next_year, :next_term_code, :next_term_name) WHERE university_id = :university_id RETURNING semester.id`
 
 	SubjectExistQuery = `SELECT subject.id FROM subject WHERE topic_name = :topic_name`
 
-	SubjectInsertQuery = `INSERT INTO subject (university_id, name, number, season, year, topic_name, topic_id)
-                   	VALUES  (:university_id, :name, :number, :season, :year, :topic_name, :topic_id)
+	SubjectInsertQuery = `INSERT INTO subject (university_id, name, number, season, year, term_code, term_name, topic_name, topic_id, content_hash)
+                   	VALUES  (:university_id, :name, :number, :season, :year, :term_code, :term_name, :topic_name, :topic_id, :content_hash)
                    	RETURNING subject.id`
 
 	SubjectUpdateQuery = SubjectExistQuery
 
-	CourseInsertQuery = `INSERT INTO course (subject_id, name, number, synopsis, topic_name, topic_id) VALUES  (:subject_id, :name, :number, :synopsis, :topic_name, :topic_id) RETURNING course.id`
+	CourseInsertQuery = `INSERT INTO course (subject_id, name, number, synopsis, topic_name, topic_id, content_hash) VALUES  (:subject_id, :name, :number, :synopsis, :topic_name, :topic_id, :content_hash) RETURNING course.id`
 	CourseExistQuery  = `SELECT course.id FROM course WHERE topic_name = :topic_name`
 	CourseUpdateQuery = `UPDATE course SET (synopsis) = (:synopsis) WHERE topic_name = :topic_name RETURNING course.id`
 
-	SectionInsertQuery = `INSERT INTO section (course_id, number, call_number, max, now, status, credits, topic_name, topic_id)
-                    VALUES (:course_id, :number, :call_number, :max, :now, :status, :credits, :topic_name, :topic_id)
+	SectionInsertQuery = `INSERT INTO section (course_id, number, call_number, max, now, status, credits, topic_name, topic_id, waitlist_max, waitlist_now, content_hash)
+                    VALUES (:course_id, :number, :call_number, :max, :now, :status, :credits, :topic_name, :topic_id, :waitlist_max, :waitlist_now, :content_hash)
                     RETURNING section.id`
 
-	SectionUpdateQuery = `UPDATE section SET (max, now, status, credits) = (:max, :now, :status, :credits) WHERE topic_name = :topic_name RETURNING section.id`
+	SectionUpdateQuery = `UPDATE section SET (max, now, status, credits, waitlist_max, waitlist_now) = (:max, :now, :status, :credits, :waitlist_max, :waitlist_now) WHERE topic_name = :topic_name RETURNING section.id`
 
 	MeetingExistQuery = `SELECT id FROM meeting WHERE section_id = :section_id AND index = :index`
 
-	MeetingUpdateQuery = `UPDATE meeting SET (room, day, start_time, end_time, class_type) = (:room, :day, :start_time, :end_time, :class_type)
+	MeetingUpdateQuery = `UPDATE meeting SET (room, day, start_time, end_time, class_type, building_code, room_number, content_hash) = (:room, :day, :start_time, :end_time, :class_type, :building_code, :room_number, :content_hash)
 					WHERE section_id = :section_id AND index = :index
                     RETURNING meeting.id`
 
-	MeetingInsertQuery = `INSERT INTO meeting (section_id, room, day, start_time, end_time, class_type, index)
-                    VALUES  (:section_id, :room, :day, :start_time, :end_time, :class_type, :index)
+	MeetingInsertQuery = `INSERT INTO meeting (section_id, room, day, start_time, end_time, class_type, index, building_code, room_number, content_hash)
+                    VALUES  (:section_id, :room, :day, :start_time, :end_time, :class_type, :index, :building_code, :room_number, :content_hash)
                     RETURNING meeting.id`
 
 	InstructorExistQuery = `SELECT id FROM instructor
-				WHERE section_id = :section_id AND index = :index`
+				WHERE section_id = :section_id AND index = :index AND name = :name AND topic_name IS NOT DISTINCT FROM NULLIF(:topic_name, '')`
 
-	InstructorUpdateQuery = `UPDATE instructor SET name = :name WHERE section_id = :section_id AND index = :index
+	InstructorUpdateQuery = `UPDATE instructor SET (name, topic_name) = (:name, NULLIF(:topic_name, '')) WHERE section_id = :section_id AND index = :index
 				RETURNING instructor.id`
 
-	InstructorInsertQuery = `INSERT INTO instructor (section_id, name, index)
-				VALUES  (:section_id, :name, :index)
+	InstructorInsertQuery = `INSERT INTO instructor (section_id, name, index, topic_name)
+				VALUES  (:section_id, :name, :index, NULLIF(:topic_name, ''))
 				RETURNING instructor.id`
 
+	InstructorProfileInsertQuery = `INSERT INTO instructor_profile (university_id, name, normalized_name, topic_name)
+				SELECT subject.university_id, :name, :normalized_name, :topic_name
+				FROM section JOIN course ON course.id = section.course_id JOIN subject ON subject.id = course.subject_id
+				WHERE section.id = :section_id
+				ON CONFLICT DO NOTHING
+				RETURNING instructor_profile.id`
+
 	BookUpdateQuery = `UPDATE book SET (title, url) = (:title, :url)
 					WHERE section_id = :section_id AND title = :title
                     RETURNING book.id`
@@ -110,6 +133,14 @@ const (
                     VALUES (:university_id, :period, :period_date)
                     RETURNING registration.id`
 
+	BuildingUpdateQuery = `UPDATE building SET (name, campus, latitude, longitude) = (:name, :campus, :latitude, :longitude)
+					WHERE university_id = :university_id AND code = :code
+	                RETURNING building.id`
+
+	BuildingInsertQuery = `INSERT INTO building (university_id, code, name, campus, latitude, longitude)
+                    VALUES (:university_id, :code, :name, :campus, :latitude, :longitude)
+                    RETURNING building.id`
+
 	MetaUniExistQuery = `SELECT id FROM metadata
 						WHERE metadata.university_id = :university_id AND metadata.title = :title`
 	MetaUniUpdateQuery = `UPDATE metadata SET (title, content) = (:title, :content)
@@ -157,7 +188,157 @@ const (
 					   WHERE metadata.meeting_id = :meeting_id AND metadata.title = :title
 		               RETURNING metadata.id`
 
-	SerialSubjectUpdateQuery = `UPDATE subject SET data = :data WHERE topic_name = :topic_name RETURNING subject.id`
-	SerialCourseUpdateQuery  = `UPDATE course SET data = :data WHERE topic_name = :topic_name RETURNING course.id`
-	SerialSectionUpdateQuery = `UPDATE section SET data = :data WHERE topic_name = :topic_name RETURNING section.id`
+	SerialSubjectUpdateQuery = `UPDATE subject SET (data, content_hash) = (:data, :content_hash) WHERE topic_name = :topic_name RETURNING subject.id`
+	SerialCourseUpdateQuery  = `UPDATE course SET (data, content_hash, prerequisite_topic_names) = (:data, :content_hash, string_to_array(:prerequisite_topic_names, ',')) WHERE topic_name = :topic_name RETURNING course.id`
+	SerialSectionUpdateQuery = `UPDATE section SET (data, content_hash, cross_listed_topic_names) = (:data, :content_hash, string_to_array(:cross_listed_topic_names, ',')) WHERE topic_name = :topic_name RETURNING section.id`
+
+	SubjectDataQuery = `SELECT subject.data FROM subject WHERE topic_name = :topic_name`
+
+	SubjectTopicsQuery = `SELECT subject.topic_name, university.topic_name AS parent_topic_name, subject.removed_at IS NOT NULL AS removed
+					FROM subject JOIN university ON university.id = subject.university_id
+					WHERE university.topic_name = :topic_name AND subject.season = :season AND subject.year = :year`
+	SubjectRemoveQuery  = `UPDATE subject SET removed_at = now() WHERE topic_name = :topic_name RETURNING subject.id`
+	SubjectRestoreQuery = `UPDATE subject SET removed_at = NULL WHERE topic_name = :topic_name RETURNING subject.id`
+	SubjectDeleteQuery  = `DELETE FROM subject WHERE topic_name = :topic_name RETURNING subject.id`
+
+	CourseTopicsQuery = `SELECT course.topic_name, subject.topic_name AS parent_topic_name, course.removed_at IS NOT NULL AS removed
+					FROM course JOIN subject ON subject.id = course.subject_id JOIN university ON university.id = subject.university_id
+					WHERE university.topic_name = :topic_name AND subject.season = :season AND subject.year = :year`
+	CourseRemoveQuery  = `UPDATE course SET removed_at = now() WHERE topic_name = :topic_name RETURNING course.id`
+	CourseRestoreQuery = `UPDATE course SET removed_at = NULL WHERE topic_name = :topic_name RETURNING course.id`
+	CourseDeleteQuery  = `DELETE FROM course WHERE topic_name = :topic_name RETURNING course.id`
+
+	SectionTopicsQuery = `SELECT section.topic_name, course.topic_name AS parent_topic_name, section.removed_at IS NOT NULL AS removed
+					FROM section JOIN course ON course.id = section.course_id JOIN subject ON subject.id = course.subject_id
+					JOIN university ON university.id = subject.university_id
+					WHERE university.topic_name = :topic_name AND subject.season = :season AND subject.year = :year`
+	SectionRemoveQuery  = `UPDATE section SET removed_at = now() WHERE topic_name = :topic_name RETURNING section.id`
+	SectionRestoreQuery = `UPDATE section SET removed_at = NULL WHERE topic_name = :topic_name RETURNING section.id`
+	SectionDeleteQuery  = `DELETE FROM section WHERE topic_name = :topic_name RETURNING section.id`
+)
+
+// Bulk statements are executed once per run, or once per subject of a stream, and are not prepared. The temporary
+// tables are emptied before each use since they live until the transaction commits.
+const (
+	BulkCreateTablesQuery = `CREATE TEMP TABLE IF NOT EXISTS tmp_subject (university_id BIGINT, name TEXT, number TEXT, season TEXT, year TEXT, term_code INTEGER, term_name TEXT, topic_name TEXT, topic_id TEXT, content_hash TEXT) ON COMMIT DROP;
+		CREATE TEMP TABLE IF NOT EXISTS tmp_course (subject_topic_name TEXT, name TEXT, number TEXT, synopsis TEXT, topic_name TEXT, topic_id TEXT, content_hash TEXT) ON COMMIT DROP;
+		CREATE TEMP TABLE IF NOT EXISTS tmp_section (course_topic_name TEXT, number TEXT, call_number TEXT, max INTEGER, now INTEGER, status STATUS, credits NUMERIC, topic_name TEXT, topic_id TEXT, data BYTEA, cross_listed_topic_names TEXT, waitlist_max INTEGER, waitlist_now INTEGER, content_hash TEXT) ON COMMIT DROP;
+		CREATE TEMP TABLE IF NOT EXISTS tmp_meeting (section_topic_name TEXT, room TEXT, day TEXT, start_time TIME, end_time TIME, class_type TEXT, index INTEGER, building_code TEXT, room_number TEXT, content_hash TEXT) ON COMMIT DROP;
+		CREATE TEMP TABLE IF NOT EXISTS tmp_instructor (section_topic_name TEXT, name TEXT, index INTEGER, normalized_name TEXT, topic_name TEXT) ON COMMIT DROP;
+		CREATE TEMP TABLE IF NOT EXISTS tmp_book (section_topic_name TEXT, title TEXT, url TEXT) ON COMMIT DROP;
+		CREATE TEMP TABLE IF NOT EXISTS tmp_metadata (owner TEXT, topic_name TEXT, meeting_index INTEGER, title TEXT, content TEXT) ON COMMIT DROP;`
+	BulkTruncateTablesQuery = `TRUNCATE tmp_subject, tmp_course, tmp_section, tmp_meeting, tmp_instructor, tmp_book, tmp_metadata`
+
+	BulkMergeSubjectQuery = `INSERT INTO subject (university_id, name, number, season, year, term_code, term_name, topic_name, topic_id, content_hash)
+					SELECT university_id, name, number, season, year, term_code, term_name, topic_name, topic_id, content_hash FROM tmp_subject
+					ON CONFLICT (topic_name) DO NOTHING`
+
+	BulkMergeCourseQuery = `INSERT INTO course (subject_id, name, number, synopsis, topic_name, topic_id, content_hash)
+					SELECT subject.id, t.name, t.number, t.synopsis, t.topic_name, t.topic_id, t.content_hash
+					FROM tmp_course t JOIN subject ON subject.topic_name = t.subject_topic_name
+					ON CONFLICT (topic_name) DO UPDATE SET synopsis = EXCLUDED.synopsis
+					WHERE course.synopsis IS DISTINCT FROM EXCLUDED.synopsis`
+
+	BulkMergeSectionQuery = `INSERT INTO section (course_id, number, call_number, max, now, status, credits, topic_name, topic_id, data, cross_listed_topic_names, waitlist_max, waitlist_now, content_hash)
+					SELECT course.id, t.number, t.call_number, t.max, t.now, t.status, t.credits, t.topic_name, t.topic_id, t.data, string_to_array(t.cross_listed_topic_names, ','), t.waitlist_max, t.waitlist_now, t.content_hash
+					FROM tmp_section t JOIN course ON course.topic_name = t.course_topic_name
+					ON CONFLICT (topic_name) DO UPDATE SET (max, now, status, credits, data, cross_listed_topic_names, waitlist_max, waitlist_now, content_hash) = (EXCLUDED.max, EXCLUDED.now, EXCLUDED.status, EXCLUDED.credits, EXCLUDED.data, EXCLUDED.cross_listed_topic_names, EXCLUDED.waitlist_max, EXCLUDED.waitlist_now, EXCLUDED.content_hash)
+					WHERE section.content_hash IS DISTINCT FROM EXCLUDED.content_hash`
+
+	BulkMergeMeetingQuery = `INSERT INTO meeting (section_id, room, day, start_time, end_time, class_type, index, building_code, room_number, content_hash)
+					SELECT section.id, t.room, t.day, t.start_time, t.end_time, t.class_type, t.index, t.building_code, t.room_number, t.content_hash
+					FROM tmp_meeting t JOIN section ON section.topic_name = t.section_topic_name
+					ON CONFLICT (section_id, index) DO UPDATE SET (room, day, start_time, end_time, class_type, building_code, room_number, content_hash) = (EXCLUDED.room, EXCLUDED.day, EXCLUDED.start_time, EXCLUDED.end_time, EXCLUDED.class_type, EXCLUDED.building_code, EXCLUDED.room_number, EXCLUDED.content_hash)
+					WHERE meeting.content_hash IS DISTINCT FROM EXCLUDED.content_hash`
+
+	BulkMergeInstructorProfileQuery = `INSERT INTO instructor_profile (university_id, name, normalized_name, topic_name)
+					SELECT DISTINCT ON (t.topic_name) subject.university_id, t.name, t.normalized_name, t.topic_name
+					FROM tmp_instructor t JOIN section ON section.topic_name = t.section_topic_name
+					  JOIN course ON course.id = section.course_id JOIN subject ON subject.id = course.subject_id
+					WHERE t.topic_name IS NOT NULL
+					ON CONFLICT DO NOTHING`
+
+	BulkMergeInstructorQuery = `INSERT INTO instructor (section_id, name, index, topic_name)
+					SELECT section.id, t.name, t.index, t.topic_name
+					FROM tmp_instructor t JOIN section ON section.topic_name = t.section_topic_name
+					ON CONFLICT (section_id, index) DO UPDATE SET (name, topic_name) = (EXCLUDED.name, EXCLUDED.topic_name)
+					WHERE (instructor.name, instructor.topic_name) IS DISTINCT FROM (EXCLUDED.name, EXCLUDED.topic_name)`
+
+	BulkMergeBookQuery = `INSERT INTO book (section_id, title, url)
+					SELECT DISTINCT ON (section.id, t.title) section.id, t.title, t.url
+					FROM tmp_book t JOIN section ON section.topic_name = t.section_topic_name
+					ON CONFLICT (title, section_id) DO UPDATE SET url = EXCLUDED.url
+					WHERE book.url IS DISTINCT FROM EXCLUDED.url`
+
+	BulkMergeSubjectMetadataQuery = `INSERT INTO metadata (subject_id, title, content)
+					SELECT DISTINCT ON (subject.id, t.title) subject.id, t.title, t.content
+					FROM tmp_metadata t JOIN subject ON subject.topic_name = t.topic_name
+					WHERE t.owner = 'subject'
+					ON CONFLICT (title, subject_id) DO UPDATE SET content = EXCLUDED.content
+					WHERE metadata.content IS DISTINCT FROM EXCLUDED.content`
+
+	BulkMergeCourseMetadataQuery = `INSERT INTO metadata (course_id, title, content)
+					SELECT DISTINCT ON (course.id, t.title) course.id, t.title, t.content
+					FROM tmp_metadata t JOIN course ON course.topic_name = t.topic_name
+					WHERE t.owner = 'course'
+					ON CONFLICT (title, course_id) DO UPDATE SET content = EXCLUDED.content
+					WHERE metadata.content IS DISTINCT FROM EXCLUDED.content`
+
+	BulkMergeSectionMetadataQuery = `INSERT INTO metadata (section_id, title, content)
+					SELECT DISTINCT ON (section.id, t.title) section.id, t.title, t.content
+					FROM tmp_metadata t JOIN section ON section.topic_name = t.topic_name
+					WHERE t.owner = 'section'
+					ON CONFLICT (title, section_id) DO UPDATE SET content = EXCLUDED.content
+					WHERE metadata.content IS DISTINCT FROM EXCLUDED.content`
+
+	BulkMergeMeetingMetadataQuery = `INSERT INTO metadata (meeting_id, title, content)
+					SELECT DISTINCT ON (meeting.id, t.title) meeting.id, t.title, t.content
+					FROM tmp_metadata t JOIN section ON section.topic_name = t.topic_name
+					JOIN meeting ON meeting.section_id = section.id AND meeting.index = t.meeting_index
+					WHERE t.owner = 'meeting'
+					ON CONFLICT (title, meeting_id) DO UPDATE SET content = EXCLUDED.content
+					WHERE metadata.content IS DISTINCT FROM EXCLUDED.content`
+
+	BulkCreateSerialTableQuery   = `CREATE TEMP TABLE IF NOT EXISTS tmp_serial (owner TEXT, topic_name TEXT, data BYTEA, content_hash TEXT, prerequisite_topic_names TEXT) ON COMMIT DROP`
+	BulkTruncateSerialTableQuery = `TRUNCATE tmp_serial`
+
+	BulkSerialSubjectUpdateQuery = `UPDATE subject SET (data, content_hash) = (t.data, t.content_hash) FROM tmp_serial t
+					WHERE t.owner = 'subject' AND subject.topic_name = t.topic_name`
+
+	BulkSerialCourseUpdateQuery = `UPDATE course SET (data, content_hash, prerequisite_topic_names) = (t.data, t.content_hash, string_to_array(t.prerequisite_topic_names, ',')) FROM tmp_serial t
+					WHERE t.owner = 'course' AND course.topic_name = t.topic_name`
+)
+
+// Section history statements take ages in seconds.
+const (
+	CompactSectionHistoryQuery = `DELETE FROM section_history WHERE id IN (
+					SELECT id FROM (
+						SELECT id,
+							status IS DISTINCT FROM lag(status) OVER (PARTITION BY section_id ORDER BY created_at, id) AS transition,
+							row_number() OVER (PARTITION BY section_id, date_trunc('day', created_at) ORDER BY created_at DESC, id DESC) AS latest
+						FROM section_history
+						WHERE created_at < now() - $1 * interval '1 second'
+							AND created_at >= now() - $2 * interval '1 second'
+					) h
+					WHERE NOT h.transition AND h.latest > 1)`
+
+	DeleteSectionHistoryQuery = `DELETE FROM section_history WHERE created_at < now() - $1 * interval '1 second'`
+)
+
+// Search document statements take the topic names of the courses to update, their sections are updated with them.
+const (
+	CourseSearchDocumentUpdateQuery = `UPDATE course SET search_document =
+					setweight(to_tsvector('english', course.name || ' ' || course.number || ' ' || subject.number), 'A') ||
+					setweight(to_tsvector('english', subject.name), 'B') ||
+					setweight(to_tsvector('english', coalesce(course.synopsis, '')), 'C') ||
+					setweight(to_tsvector('english', coalesce((SELECT string_agg(m.content, ' ') FROM metadata m WHERE m.course_id = course.id), '')), 'D')
+					FROM subject
+					WHERE subject.id = course.subject_id AND course.topic_name = ANY($1)`
+
+	SectionSearchDocumentUpdateQuery = `UPDATE section SET search_document =
+					setweight(to_tsvector('english', section.number || ' ' || section.call_number), 'A') ||
+					setweight(to_tsvector('english', coalesce((SELECT string_agg(i.name, ' ') FROM instructor i WHERE i.section_id = section.id), '')), 'B') ||
+					setweight(to_tsvector('english', coalesce((SELECT string_agg(m.content, ' ') FROM metadata m WHERE m.section_id = section.id), '')), 'D')
+					FROM course
+					WHERE course.id = section.course_id AND course.topic_name = ANY($1)`
 )
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"database/sql"
+	"io"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/tevjef/uct-backend/common/database"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+// processStream ingests a university read from a stream one subject at a time, so only a single subject is held in
+// memory. Each subject is diffed against the subject stored by the previous run instead of the previous payload.
+func (ein *ein) processStream(key string, payload io.Reader) error {
+	latestData := key + ":data:latest"
+
+	counter := &countingReader{r: payload}
+	decoder := model.NewStreamDecoder(counter)
+
+	header, err := decoder.Header()
+	if err != nil {
+		return errors.Wrap(err, "error while unmarshalling new data")
+	}
+
+	validator := model.NewStreamValidator(header, ein.config.validationPolicy)
+
+	go statsCollector(ein, header.TopicName)
+
+	err = ein.transact(func(tx database.Tx) error {
+		txEin := *ein
+		txEin.postgres = tx
+
+		return txEin.ingestStream(decoder, header, validator)
+	})
+
+	collectDatabaseStats(ein.postgres)
+	doneAudit <- true
+	<-doneAudit
+
+	// The report is exported even when ingesting failed, its error is returned by ingestStream
+	ein.exportReport(key, validator.Report())
+
+	if err != nil {
+		return errors.Wrap(err, "error while ingesting university")
+	}
+
+	ein.metrics.payloadBytes.With(prometheus.Labels{"university_name": header.TopicName}).Set(float64(counter.n))
+	// Log bytes received
+	log.WithFields(log.Fields{"bytes": counter.n, "university_name": header.TopicName}).Infoln(latestData)
+
+	return nil
+}
+
+// ingestStream validates, inserts and serializes each subject as it is decoded. Subjects that were linked before
+// the subjects they reference were read are linked and serialized again once the stream ends.
+func (ein *ein) ingestStream(decoder *model.StreamDecoder, header *model.University, validator *model.StreamValidator) error {
+	defer model.TimeTrack(time.Now(), "ingestStream")
+
+	if err := validator.Report().Err(); err != nil {
+		return errors.Wrap(err, "error while validating university")
+	}
+
+	universityId := ein.insertUniversity(*header)
+	topics := newIngestedTopics()
+
+	for {
+		subject, err := decoder.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.Wrap(err, "error while unmarshalling subject")
+		}
+
+		valid := validator.ValidateSubject(subject)
+		if err := validator.Report().Err(); err != nil {
+			return errors.Wrap(err, "error while validating university")
+		} else if !valid {
+			continue
+		}
+
+		topics.add(header.TopicName, subject)
+		ein.ingestSubject(universityId, header.TopicName, subject, validator)
+	}
+
+	for _, topicName := range validator.Unlinked() {
+		ein.relinkSubject(topicName, validator)
+	}
+
+	// Subjects were serialized as they were read and courses that lost a section differ from their stored subject,
+	// so neither still carries a removed course or section
+	if _, _, err := ein.removeMissingTopics(topics); err != nil {
+		return err
+	}
+	ein.applyHistoryPolicy()
+
+	return nil
+}
+
+// ingestSubject inserts the parts of the subject that changed since it was stored and serializes it.
+func (ein *ein) ingestSubject(universityId int64, universityTopicName string, subject *model.Subject, validator *model.StreamValidator) {
+	newUniversity := model.University{Id: universityId, TopicName: universityTopicName, Subjects: []*model.Subject{subject}}
+
+	// Inserting sets the ids of the subject, which must not end up in its serialized data
+	diff := newUniversity
+	diff.Subjects = []*model.Subject{proto.Clone(subject).(*model.Subject)}
+
+	if !ein.config.noDiff {
+		if oldSubject := ein.selectSubject(subject.TopicName); oldSubject != nil {
+			// The stored subject is linked to the subjects of the previous run
+			if err := validator.Link(oldSubject); err != nil {
+				log.WithError(err).WithField("subject", subject.TopicName).Warningln("failed to link stored subject")
+			}
+
+			oldUniversity := model.University{TopicName: universityTopicName, Subjects: []*model.Subject{oldSubject}}
+			universityDiff := model.DiffUniversity(oldUniversity, diff)
+			for _, event := range universityDiff.Changes() {
+				log.WithFields(event.Fields()).Debugln("change")
+			}
+
+			diff = universityDiff.Filter()
+		}
+	}
+
+	ein.insertSubjects(&diff)
+	ein.updateSerialSubjects(newUniversity, diff)
+}
+
+// relinkSubject links a stored subject to every subject of the stream and serializes the subject along with the
+// courses and sections whose links changed.
+func (ein *ein) relinkSubject(topicName string, validator *model.StreamValidator) {
+	subject := ein.selectSubject(topicName)
+	if subject == nil {
+		return
+	}
+
+	hashes := map[string]string{}
+	for _, course := range subject.Courses {
+		hashes[course.TopicName] = course.ContentHash
+		for _, section := range course.Sections {
+			hashes[section.TopicName] = section.ContentHash
+		}
+	}
+
+	if err := validator.Link(subject); err != nil {
+		log.WithError(err).WithField("subject", topicName).Panicln("failed to link subject")
+	}
+
+	ein.updateSerialSubject(subject)
+
+	var courses []*model.Course
+	for _, course := range subject.Courses {
+		if hashes[course.TopicName] != course.ContentHash {
+			courses = append(courses, course)
+			ein.updateSerialCourse(course)
+		}
+		for _, section := range course.Sections {
+			if hashes[section.TopicName] != section.ContentHash {
+				ein.updateSerialSection(section)
+			}
+		}
+	}
+
+	ein.updateSearchDocuments(courses)
+}
+
+// selectSubject returns the serialized subject with the topic name, or nil if it was never serialized.
+func (ein *ein) selectSubject(topicName string) *model.Subject {
+	var data []byte
+	if err := ein.postgres.Get(SubjectDataQuery, &data, serial{TopicName: topicName}); err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		log.WithError(err).WithField("subject", topicName).Panicln("failed to select subject")
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	subject := &model.Subject{}
+	if err := subject.Unmarshal(data); err != nil {
+		log.WithError(err).WithField("subject", topicName).Warningln("failed to unmarshal stored subject")
+		return nil
+	}
+
+	return subject
+}
+
+// countingReader counts the bytes read from a reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
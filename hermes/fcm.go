@@ -6,6 +6,7 @@ import (
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/tevjef/go-fcm"
+	"github.com/tevjef/uct-backend/common/model"
 )
 
 func (hermes *hermes) sendFcmNotification(pair notificationPair) error {
@@ -16,7 +17,19 @@ func (hermes *hermes) sendFcmNotification(pair notificationPair) error {
 	course := pair.n.University.Subjects[0].Courses[0]
 	section := course.Sections[0]
 
-	if pair.n.Status == "Open" {
+	if pair.n.Type == model.WaitlistNotification {
+		title = "A waitlist has opened!"
+		body = "The waitlist for section " + section.Number + " of " + course.Name + " has opened!"
+		if section.WaitlistMax != nil {
+			body = fmt.Sprintf("The waitlist for section %s of %s has opened with %d of %d spots taken!", section.Number, course.Name, section.GetWaitlistNow(), section.GetWaitlistMax())
+		}
+		color = "#FF9800"
+	} else if pair.n.Status == "Open" && pair.n.TopicName != section.TopicName {
+		// Copied from a cross-listed section that shares seats with the subscribed section
+		title = "A section has opened!"
+		body = "Section " + section.Number + " of " + course.Name + ", cross-listed with your section, has opened!"
+		color = "#4CAF50"
+	} else if pair.n.Status == "Open" {
 		title = "A section has opened!"
 		body = "Section " + section.Number + " of " + course.Name + " has opened!"
 		color = "#4CAF50"
@@ -29,6 +42,7 @@ func (hermes *hermes) sendFcmNotification(pair notificationPair) error {
 	data := map[string]string{
 		"notificationId":  fmt.Sprintf("%d", pair.n.NotificationId),
 		"status":          pair.n.Status,
+		"type":            pair.n.Type,
 		"topicName":       pair.n.TopicName,
 		"topicId":         section.TopicId,
 		"title":           title,
@@ -1,8 +1,9 @@
 package main
 
 import (
-	"bytes"
+	"bufio"
 	"errors"
+	"hash"
 	"hash/fnv"
 	"io"
 	"io/ioutil"
@@ -61,16 +62,16 @@ func main() {
 	app := kingpin.New("jet", "A program the wraps a uct scraper and collect it's output").DefaultEnvars()
 
 	app.Flag("output-format", "Choose output format").Short('f').
-		HintOptions(model.Protobuf, model.Json).
-		PlaceHolder("[protobuf, json]").
+		HintOptions(model.Protobuf, model.Json, model.Stream).
+		PlaceHolder("[protobuf, json, stream]").
 		Default("protobuf").
-		EnumVar(&jconf.outputFormat, "protobuf", "json")
+		EnumVar(&jconf.outputFormat, "protobuf", "json", "stream")
 
 	app.Flag("input-format", "Choose input format").
-		HintOptions(model.Protobuf, model.Json).
-		PlaceHolder("[protobuf, json]").
+		HintOptions(model.Protobuf, model.Json, model.Stream).
+		PlaceHolder("[protobuf, json, stream]").
 		Default("protobuf").
-		EnumVar(&jconf.inputFormat, "protobuf", "json")
+		EnumVar(&jconf.inputFormat, "protobuf", "json", "stream")
 
 	app.Flag("daemon", "Run as a daemon with a refesh interval. -1 to disable").
 		DurationVar(&jconf.daemonInterval)
@@ -166,51 +167,110 @@ func (jet *jet) init() {
 			log.WithError(err).Fatal()
 		}
 
-		// Write to file
-		if jet.config.daemonFile != "" {
-			if data, err := ioutil.ReadAll(reader); err != nil {
-				log.WithError(err).Fatalln("failed to read all data")
-			} else {
-				fileName := jet.config.daemonFile + "/" + jet.app.Name + "-" + strconv.FormatInt(time.Now().Unix(), 10) + "." + jet.config.outputFormat
-				log.Debugln("Writing file", fileName)
-				if err = ioutil.WriteFile(fileName, data, 0644); err != nil {
-					log.WithError(err).Fatalln("failed to write file")
-				}
-			}
-			continue
+		out := jet.openOutput()
+		if _, err := io.Copy(out, reader); err != nil {
+			log.WithError(err).Fatalln("failed to write data")
+		}
+		if err := out.Close(); err != nil {
+			log.WithError(err).Fatalln("failed to write data")
 		}
+	}
+}
 
-		// Write to redis
-		if jet.config.daemonInterval > 0 {
-			jet.pushToRedis(reader)
-			continue
+// openOutput returns the writer a scraped university is written to: a file in the daemon directory, redis when
+// running as a daemon, or stdout. The university is only written once the writer is closed.
+func (jet *jet) openOutput() io.WriteCloser {
+	// Write to file
+	if jet.config.daemonFile != "" {
+		fileName := jet.config.daemonFile + "/" + jet.app.Name + "-" + strconv.FormatInt(time.Now().Unix(), 10) + "." + jet.config.outputFormat
+		log.Debugln("Writing file", fileName)
+		file, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to write file")
 		}
+		return &bufferedOutput{Writer: bufio.NewWriter(file), close: file.Close}
+	}
 
-		// Write to stdout
-		io.Copy(os.Stdout, reader)
+	// Write to redis
+	if jet.config.daemonInterval > 0 {
+		return jet.openRedisOutput()
 	}
+
+	// Write to stdout
+	return &bufferedOutput{Writer: bufio.NewWriter(os.Stdout)}
 }
 
-func (jet *jet) pushToRedis(reader *bytes.Reader) {
-	if data, err := ioutil.ReadAll(reader); err != nil {
-		log.WithError(err).Fatalln("failed to read all data")
-	} else {
-		jet.metrics.scraperBytes.WithLabelValues(jet.app.Name).Set(float64(len(data)))
-		log.WithFields(log.Fields{"scraper_name": jet.app.Name, "bytes": len(data), "hash": hash(data)}).Info()
-		if err := jet.redis.Client.Set(jet.redis.NameSpace+":data:latest", data, 0).Err(); err != nil {
-			log.Fatalln(errors.New("failed to connect to redis server"))
-		}
+// bufferedOutput flushes its buffer when it is closed.
+type bufferedOutput struct {
+	*bufio.Writer
+	close func() error
+}
 
-		if _, err := jet.redis.LPushNotExist(redis.ScraperQueue, jet.redis.NameSpace); err != nil {
-			log.Fatalln(errors.New("failed to queue univeristiy for upload"))
-		}
+func (out *bufferedOutput) Close() error {
+	if err := out.Flush(); err != nil {
+		return err
+	}
+	if out.close == nil {
+		return nil
 	}
+	return out.close()
 }
 
-func hash(s []byte) string {
-	h := fnv.New32a()
-	h.Write(s)
-	return strconv.Itoa(int(h.Sum32()))
+// redisChunkSize is the number of bytes appended to redis at once.
+const redisChunkSize = 1 << 20
+
+// redisOutput seals the university in an envelope as it is written and appends it to a staging key. The staging
+// key replaces the latest data of the scraper once the university was written, so ein never reads a partial one.
+type redisOutput struct {
+	jet      *jet
+	staging  string
+	buf      *bufio.Writer
+	envelope *model.EnvelopeWriter
+	hash     hash.Hash32
+	bytes    int
+}
+
+func (jet *jet) openRedisOutput() *redisOutput {
+	staging := jet.redis.NameSpace + ":data:staging"
+	if err := jet.redis.Client.Del(staging).Err(); err != nil {
+		log.Fatalln(errors.New("failed to connect to redis server"))
+	}
+
+	buf := bufio.NewWriterSize(jet.redis.ValueWriter(staging), redisChunkSize)
+	envelope, err := model.NewEnvelopeWriter(buf, jet.app.Name, jet.config.outputFormat, time.Now())
+	if err != nil {
+		log.WithError(err).Fatalln("failed to seal data")
+	}
+
+	return &redisOutput{jet: jet, staging: staging, buf: buf, envelope: envelope, hash: fnv.New32a()}
+}
+
+func (out *redisOutput) Write(p []byte) (int, error) {
+	out.hash.Write(p)
+	out.bytes += len(p)
+	return out.envelope.Write(p)
+}
+
+func (out *redisOutput) Close() error {
+	if err := out.envelope.Close(); err != nil {
+		return errors.New("failed to seal data")
+	}
+	if err := out.buf.Flush(); err != nil {
+		return errors.New("failed to connect to redis server")
+	}
+
+	jet := out.jet
+	jet.metrics.scraperBytes.WithLabelValues(jet.app.Name).Set(float64(out.bytes))
+	log.WithFields(log.Fields{"scraper_name": jet.app.Name, "bytes": out.bytes, "hash": strconv.Itoa(int(out.hash.Sum32())), "schema_version": model.SchemaVersion}).Info()
+
+	if err := jet.redis.Client.Rename(out.staging, jet.redis.NameSpace+":data:latest").Err(); err != nil {
+		return errors.New("failed to connect to redis server")
+	}
+
+	if _, err := jet.redis.LPushNotExist(redis.ScraperQueue, jet.redis.NameSpace); err != nil {
+		return errors.New("failed to queue univeristiy for upload")
+	}
+	return nil
 }
 
 func (jet *jet) entryPoint(result chan model.University) {
@@ -231,6 +291,24 @@ func (jet *jet) entryPoint(result chan model.University) {
 		log.Fatal(err)
 	}
 
+	if jet.config.inputFormat == model.Stream && jet.config.outputFormat == model.Stream {
+		out := jet.forwardStream(stdout)
+
+		if err := cmd.Wait(); err != nil {
+			log.Fatal(err)
+		}
+
+		if out == nil {
+			return
+		}
+		if err := out.Close(); err != nil {
+			log.WithError(err).Fatalln("failed to write data")
+		}
+		jet.metrics.scraperDuration.WithLabelValues(jet.app.Name).Set(time.Since(starTime).Seconds())
+		log.WithFields(log.Fields{"scraper_name": jet.app.Name, "elapsed": time.Since(starTime).Seconds()}).Info()
+		return
+	}
+
 	if err = model.UnmarshalMessage(jet.config.inputFormat, stdout, &school); err != nil {
 		school = model.University{}
 	}
@@ -249,6 +327,38 @@ func (jet *jet) entryPoint(result chan model.University) {
 	}
 }
 
+// forwardStream writes the university the scraper streams to the output one subject at a time, so it is never
+// held in memory. The returned output must be closed once the scraper exited, it is nil when the scraper did not
+// return a university.
+func (jet *jet) forwardStream(r io.Reader) io.WriteCloser {
+	decoder := model.NewStreamDecoder(r)
+	header, err := decoder.Header()
+	if err != nil || header.Name == "" {
+		log.Info("no school data returned:", err)
+		io.Copy(ioutil.Discard, r)
+		return nil
+	}
+
+	out := jet.openOutput()
+	encoder := model.NewStreamEncoder(out)
+	if err := encoder.WriteHeader(header); err != nil {
+		log.WithError(err).Fatalln("failed to write data")
+	}
+
+	for {
+		subject, err := decoder.Next()
+		if err == io.EOF {
+			return out
+		} else if err != nil {
+			log.WithError(err).Fatalln("failed to read scraper output")
+		}
+
+		if err := encoder.WriteSubject(subject); err != nil {
+			log.WithError(err).Fatalln("failed to write data")
+		}
+	}
+}
+
 func parseArgs(str []string) []string {
 	for i, val := range str {
 		if val == "--scraper" {
@@ -128,11 +128,18 @@ func (julia *julia) dispatch(uctNotification model.UCTNotification) {
 	}
 	notificationsOut.With(label).Inc()
 	log.WithFields(log.Fields{"topic": uctNotification.TopicName, "university_name": uctNotification.University.TopicName}).Infoln("queueing")
+
+	// Waitlist notifications are queued apart from status changes of the same section
+	key := uctNotification.TopicName
+	if uctNotification.Type == model.WaitlistNotification {
+		key = model.WaitlistNotification + ":" + key
+	}
+
 	if notificationBytes, err := uctNotification.Marshal(); err != nil {
 		log.WithError(err).Fatalln("failed to marshall notification")
-	} else if _, err := julia.redis.Client.Set(notification.MainQueueData+uctNotification.TopicName, notificationBytes, time.Hour).Result(); err != nil {
+	} else if _, err := julia.redis.Client.Set(notification.MainQueueData+key, notificationBytes, time.Hour).Result(); err != nil {
 		log.WithError(err).Warningln("failed to set notification data")
-	} else if julia.redis.RPush(notification.MainQueue, uctNotification.TopicName); err != nil {
+	} else if julia.redis.RPush(notification.MainQueue, key); err != nil {
 		log.WithError(err).Warningln("failed to push notification unto queue")
 	}
 }
@@ -30,7 +30,8 @@ func (p *Process) Run(fn DispatchFunc) {
 			log.WithFields(log.Fields{
 				"topic":           uctNotification.TopicName,
 				"university_name": uctNotification.University.TopicName}).Infoln("processor_in")
-			if rutgersProcessor.IsMatch(uctNotification.TopicName) {
+			// Only status changes are collapsed
+			if rutgersProcessor.IsMatch(uctNotification.TopicName) && uctNotification.Type != model.WaitlistNotification {
 				rutgersProcessor.In(uctNotification)
 			} else {
 				go func() { p.out <- uctNotification }()
@@ -46,6 +47,29 @@ func (p *Process) Run(fn DispatchFunc) {
 
 func (p *Process) Recv(uctNotification *model.UCTNotification) {
 	p.in <- *uctNotification
+
+	for _, crossListed := range crossListedNotifications(*uctNotification) {
+		p.in <- crossListed
+	}
+}
+
+// crossListedNotifications copies a notification of an opened section to each section cross-listed with it,
+// since they share seats. Processors collapse a copy with the cross-listed section's own notification.
+func crossListedNotifications(uctNotification model.UCTNotification) (notifications []model.UCTNotification) {
+	if uctNotification.Status != "Open" || uctNotification.Type == model.WaitlistNotification {
+		return
+	}
+
+	for _, topicName := range uctNotification.CrossListedTopicNames {
+		if topicName == uctNotification.TopicName {
+			continue
+		}
+		crossListed := uctNotification
+		crossListed.TopicName = topicName
+		crossListed.CrossListedTopicNames = nil
+		notifications = append(notifications, crossListed)
+	}
+	return
 }
 
 type DispatchFunc func(uctNotification model.UCTNotification)
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+func Test_crossListedNotifications(t *testing.T) {
+	opened := model.UCTNotification{
+		NotificationId:        1,
+		TopicName:             "section.1",
+		Status:                "Open",
+		CrossListedTopicNames: []string{"section.2", "section.1", "section.3"},
+	}
+
+	expected := []model.UCTNotification{
+		{NotificationId: 1, TopicName: "section.2", Status: "Open"},
+		{NotificationId: 1, TopicName: "section.3", Status: "Open"},
+	}
+	assert.Equal(t, expected, crossListedNotifications(opened))
+
+	closed := opened
+	closed.Status = "Closed"
+	assert.Empty(t, crossListedNotifications(closed))
+
+	waitlist := opened
+	waitlist.Type = model.WaitlistNotification
+	assert.Empty(t, crossListedNotifications(waitlist))
+}
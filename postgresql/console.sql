@@ -1,32 +1,11 @@
 BEGIN;
 
-CREATE TYPE season AS ENUM (
-  'fall',
-  'spring',
-  'summer',
-  'winter');
-
 CREATE TYPE status AS ENUM (
   'Open',
   'Closed',
   'Cancelled'
 );
 
-CREATE TYPE period AS ENUM (
-  'fall',
-  'spring',
-  'summer',
-  'winter',
-  'start_fall',
-  'start_spring',
-  'start_summer',
-  'start_winter',
-  'end_fall',
-  'end_spring',
-  'end_summer',
-  'end_winter'
-);
-
 CREATE TABLE IF NOT EXISTS public.university
 (
   id serial,
@@ -38,6 +17,7 @@ CREATE TABLE IF NOT EXISTS public.university
   accent_color text NOT NULL,
   topic_name text,
   topic_id text,
+  time_zone TEXT NOT NULL DEFAULT 'America/New_York',
   created_at timestamp without time zone,
   updated_at timestamp without time zone,
   CONSTRAINT university__pk PRIMARY KEY (id),
@@ -55,6 +35,7 @@ COMMENT ON COLUMN public.university.registration_page IS 'The registration page
 COMMENT ON COLUMN public.university.main_color IS 'ARGB hex of the main color of the university';
 COMMENT ON COLUMN public.university.accent_color IS 'ARGB hex of the accent color of the university';
 COMMENT ON COLUMN public.university.topic_name IS 'The topic name of this university. Used to build topic url';
+COMMENT ON COLUMN public.university.time_zone IS 'IANA time zone that meeting times are given in';
 
 CREATE TABLE IF NOT EXISTS public.subject
 (
@@ -62,11 +43,15 @@ CREATE TABLE IF NOT EXISTS public.subject
   university_id BIGINT NOT NULL,
   name text NOT NULL,
   number text NOT NULL,
-  season season NOT NULL,
+  season text NOT NULL,
   year text NOT NULL,
+  term_code INTEGER NOT NULL DEFAULT 0,
+  term_name TEXT,
   topic_name text,
   topic_id text,
   data BYTEA,
+  content_hash TEXT NOT NULL DEFAULT '',
+  removed_at TIMESTAMP,
   created_at timestamp without time zone,
   updated_at timestamp without time zone,
   CONSTRAINT subject__pk PRIMARY KEY (id),
@@ -82,10 +67,14 @@ ALTER TABLE public.subject OWNER TO universityct;
 COMMENT ON COLUMN public.subject.university_id IS 'The university this subject belongs to';
 COMMENT ON COLUMN public.subject.name IS 'The name of the subject';
 COMMENT ON COLUMN public.subject.number IS 'The number of the subject.';
-COMMENT ON COLUMN public.subject.season IS 'The season for which this subject is offered';
+COMMENT ON COLUMN public.subject.season IS 'The key of the term type for which this subject is offered e.g fall or summer_1';
+COMMENT ON COLUMN public.subject.term_code IS 'Orders the terms of a year';
+COMMENT ON COLUMN public.subject.term_name IS 'The name of the term displayed to users e.g Summer Session I';
 COMMENT ON COLUMN public.subject.year IS 'The year this subject is currently offered. Subjects are not guaranteed to be offered every year.';
 COMMENT ON COLUMN public.subject.topic_name IS 'The topic name of this subject. Used to build topic url';
+COMMENT ON COLUMN public.subject.content_hash IS 'SHA-256 of the content of the subject and its children, independent of their order';
 COMMENT ON COLUMN public.subject.updated_at IS 'Time this row was updated';
+COMMENT ON COLUMN public.subject.removed_at IS 'Time this subject disappeared from the scraped data';
 COMMENT ON TABLE public.subject  IS 'Contains the subject offered from a particular university';
 
 
@@ -99,6 +88,10 @@ CREATE TABLE IF NOT EXISTS public.course
   topic_name TEXT NOT NULL,
   topic_id text,
   data BYTEA,
+  prerequisite_topic_names TEXT[] NOT NULL DEFAULT '{}',
+  content_hash TEXT NOT NULL DEFAULT '',
+  search_document TSVECTOR,
+  removed_at TIMESTAMP,
   created_at TIMESTAMP,
   updated_at TIMESTAMP,
   CONSTRAINT course__pk PRIMARY KEY (id),
@@ -118,6 +111,13 @@ COMMENT ON COLUMN public.course.synopsis IS 'Courses typically have a descriptio
 COMMENT ON COLUMN public.course.topic_name IS 'The topic name of this course. Used to build topic url';
 COMMENT ON COLUMN public.course.created_at IS 'Time this row was inserted';
 COMMENT ON COLUMN public.course.updated_at IS 'Time this row was updated';
+COMMENT ON COLUMN public.course.removed_at IS 'Time this course disappeared from the scraped data';
+COMMENT ON COLUMN public.course.prerequisite_topic_names IS 'Topic names of the courses referenced by the prerequisites of this course';
+COMMENT ON COLUMN public.course.content_hash IS 'SHA-256 of the content of the course and its children, independent of their order';
+COMMENT ON COLUMN public.course.search_document IS 'Weighted full-text search document of the name, number, subject, synopsis and metadata of the course';
+
+CREATE INDEX course_prerequisite_topic_names_idx ON public.course USING GIN (prerequisite_topic_names);
+CREATE INDEX course_search_document_idx ON public.course USING GIN (search_document);
 
 CREATE TABLE IF NOT EXISTS public.section
 (
@@ -132,6 +132,12 @@ CREATE TABLE IF NOT EXISTS public.section
   topic_name TEXT,
   topic_id text,
   data BYTEA,
+  cross_listed_topic_names TEXT[] NOT NULL DEFAULT '{}',
+  waitlist_max INTEGER,
+  waitlist_now INTEGER,
+  content_hash TEXT NOT NULL DEFAULT '',
+  search_document TSVECTOR,
+  removed_at TIMESTAMP,
   created_at TIMESTAMP,
   updated_at TIMESTAMP,
   CONSTRAINT section__pk PRIMARY KEY (id),
@@ -154,6 +160,41 @@ COMMENT ON COLUMN public.section.credits IS 'This value may live in the course a
 COMMENT ON COLUMN public.section.topic_name IS 'The topic name of this subject. Used to build topic url';
 COMMENT ON COLUMN public.section.created_at IS 'Time this row was inserted';
 COMMENT ON COLUMN public.section.updated_at IS 'Time this row was updated';
+COMMENT ON COLUMN public.section.removed_at IS 'Time this section disappeared from the scraped data';
+COMMENT ON COLUMN public.section.cross_listed_topic_names IS 'Topic names of the sections cross-listed with this section, which share its seats';
+COMMENT ON COLUMN public.section.waitlist_max IS 'Seats on the waitlist. Null when the university does not publish a waitlist';
+COMMENT ON COLUMN public.section.waitlist_now IS 'Students on the waitlist. Null when the university does not publish a waitlist';
+COMMENT ON COLUMN public.section.content_hash IS 'SHA-256 of the content of the section and its children, independent of their order';
+COMMENT ON COLUMN public.section.search_document IS 'Weighted full-text search document of the number, call number, instructors and metadata of the section';
+
+CREATE INDEX section_cross_listed_topic_names_idx ON public.section USING GIN (cross_listed_topic_names);
+CREATE INDEX section_search_document_idx ON public.section USING GIN (search_document);
+
+
+CREATE TABLE IF NOT EXISTS public.section_history
+(
+  id BIGSERIAL,
+  section_id BIGINT NOT NULL,
+  now INTEGER NOT NULL,
+  max INTEGER NOT NULL,
+  status status NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  CONSTRAINT section_history__pk PRIMARY KEY (id),
+  CONSTRAINT section_history_section_id__fk FOREIGN KEY (section_id) REFERENCES public.section (id) ON DELETE CASCADE ON UPDATE CASCADE
+
+)WITH (OIDS = FALSE);
+
+ALTER TABLE public.section_history OWNER TO universityct;
+
+CREATE INDEX section_history_section_id_created_at_idx ON public.section_history (section_id, created_at);
+CREATE INDEX section_history_created_at_idx ON public.section_history (created_at);
+
+COMMENT ON COLUMN public.section_history.section_id IS 'The section this row records';
+COMMENT ON COLUMN public.section_history.now IS 'Enrollment of the section at this time';
+COMMENT ON COLUMN public.section_history.max IS 'Maximum seats of the section at this time';
+COMMENT ON COLUMN public.section_history.status IS 'Status of the section at this time';
+COMMENT ON COLUMN public.section_history.created_at IS 'Time the section changed to these values';
+COMMENT ON TABLE public.section_history IS 'Appended to whenever the enrollment, seats or status of a section changes';
 
 
 CREATE TABLE IF NOT EXISTS public.meeting
@@ -166,6 +207,9 @@ CREATE TABLE IF NOT EXISTS public.meeting
   end_time TIME,
   class_type TEXT,
   index INTEGER,
+  building_code TEXT,
+  room_number TEXT,
+  content_hash TEXT NOT NULL DEFAULT '',
   created_at TIMESTAMP,
   updated_at TIMESTAMP,
   CONSTRAINT meeting__pk PRIMARY KEY (id),
@@ -183,9 +227,44 @@ COMMENT ON COLUMN public.meeting.start_time IS 'The start time for this meeting.
 COMMENT ON COLUMN public.meeting.end_time IS 'The end time for this meeting. The scraper should extract values of this format. hh:mm(AM|PM)';
 COMMENT ON COLUMN public.meeting.class_type IS 'E,g Lecture, Recitation';
 COMMENT ON COLUMN public.meeting.index IS 'The position of this meeting';
+COMMENT ON COLUMN public.meeting.building_code IS 'The code of the building of the room, references building.code of the university';
+COMMENT ON COLUMN public.meeting.room_number IS 'The room number within the building';
+COMMENT ON COLUMN public.meeting.content_hash IS 'SHA-256 of the content of the meeting and its metadata';
 COMMENT ON COLUMN public.meeting.created_at IS 'Time this row was inserted';
 COMMENT ON COLUMN public.meeting.updated_at IS 'Time this row was updated';
 
+CREATE INDEX meeting_building_code_idx ON public.meeting (building_code);
+
+
+CREATE TABLE IF NOT EXISTS public.building
+(
+  id SERIAL,
+  university_id BIGINT NOT NULL,
+  code TEXT NOT NULL,
+  name TEXT,
+  campus TEXT,
+  latitude DOUBLE PRECISION,
+  longitude DOUBLE PRECISION,
+  created_at TIMESTAMP,
+  updated_at TIMESTAMP,
+  CONSTRAINT building__pk PRIMARY KEY (id),
+  CONSTRAINT building_university_id__fk FOREIGN KEY (university_id) REFERENCES public.university (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT unique_building_code UNIQUE (university_id, code)
+
+)WITH (OIDS = FALSE);
+
+ALTER TABLE public.building OWNER TO universityct;
+
+COMMENT ON COLUMN public.building.university_id IS 'The university this building belongs to';
+COMMENT ON COLUMN public.building.code IS 'The code rooms in this building are written with e.g HLL in HLL-116';
+COMMENT ON COLUMN public.building.name IS 'The name of this building';
+COMMENT ON COLUMN public.building.campus IS 'The campus this building is on';
+COMMENT ON COLUMN public.building.latitude IS 'Latitude of this building';
+COMMENT ON COLUMN public.building.longitude IS 'Longitude of this building';
+COMMENT ON COLUMN public.building.created_at IS 'Time this row was inserted';
+COMMENT ON COLUMN public.building.updated_at IS 'Time this row was updated';
+COMMENT ON TABLE public.building IS 'A building that meetings of a university are held in';
+
 
 CREATE TABLE IF NOT EXISTS public.instructor
 (
@@ -193,6 +272,7 @@ CREATE TABLE IF NOT EXISTS public.instructor
   section_id BIGINT,
   name TEXT,
   index INTEGER,
+  topic_name TEXT,
   created_at TIMESTAMP,
   updated_at TIMESTAMP,
   CONSTRAINT instructor__pk PRIMARY KEY (id),
@@ -208,6 +288,37 @@ COMMENT ON COLUMN public.instructor.name IS 'The name of instructor';
 COMMENT ON COLUMN public.instructor.index IS 'The index of the instructor';
 COMMENT ON COLUMN public.instructor.created_at IS 'Time this row was inserted';
 COMMENT ON COLUMN public.instructor.updated_at IS 'Time this row was updated';
+COMMENT ON COLUMN public.instructor.topic_name IS 'The topic name of the instructor_profile of this instructor. Null for placeholders such as STAFF';
+
+CREATE INDEX instructor_topic_name_idx ON public.instructor (topic_name);
+
+CREATE TABLE IF NOT EXISTS public.instructor_profile
+(
+  id SERIAL,
+  university_id BIGINT NOT NULL,
+  name TEXT NOT NULL,
+  normalized_name TEXT NOT NULL,
+  topic_name TEXT NOT NULL,
+  created_at TIMESTAMP,
+  updated_at TIMESTAMP,
+  CONSTRAINT instructor_profile__pk PRIMARY KEY (id),
+  CONSTRAINT instructor_profile_university_id__fk FOREIGN KEY (university_id) REFERENCES public.university (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT unique_instructor_profile_normalized_name UNIQUE (university_id, normalized_name),
+  CONSTRAINT unique_instructor_profile_topic_name UNIQUE (topic_name)
+
+)WITH (OIDS = FALSE);
+
+ALTER TABLE public.instructor_profile OWNER TO universityct;
+
+COMMENT ON COLUMN public.instructor_profile.university_id IS 'The university this instructor teaches at';
+COMMENT ON COLUMN public.instructor_profile.name IS 'The name of the instructor as first scraped';
+COMMENT ON COLUMN public.instructor_profile.normalized_name IS 'The name of the instructor with its case, spacing and punctuation normalized';
+COMMENT ON COLUMN public.instructor_profile.topic_name IS 'The topic name of this instructor. Referenced by instructor.topic_name';
+COMMENT ON COLUMN public.instructor_profile.created_at IS 'Time this row was inserted';
+COMMENT ON COLUMN public.instructor_profile.updated_at IS 'Time this row was updated';
+COMMENT ON TABLE public.instructor_profile IS 'An instructor identified across the sections and semesters of a university';
+
+CREATE INDEX instructor_name_search_idx ON public.instructor USING GIN (to_tsvector('english', name));
 
 CREATE TABLE IF NOT EXISTS public.book
 (
@@ -268,7 +379,7 @@ CREATE TABLE IF NOT EXISTS public.registration
 (
   id SERIAL,
   university_id BIGINT,
-  period period,
+  period TEXT,
   period_date BIGINT,
   created_at TIMESTAMP,
   updated_at TIMESTAMP,
@@ -280,7 +391,7 @@ CREATE TABLE IF NOT EXISTS public.registration
 
 ALTER TABLE public.registration OWNER TO universityct;
 
-COMMENT ON COLUMN public.registration.period IS 'The period for which a season lasts for a specific university. This has to be manually updated. Comparable with season using ::text';
+COMMENT ON COLUMN public.registration.period IS 'The period for which a season lasts for a specific university. This has to be manually updated. The season in session, prefixed with start_ when registration opens or end_ when the session ends e.g end_summer_1';
 COMMENT ON COLUMN public.registration.period_date IS 'The url of the registration';
 COMMENT ON COLUMN public.registration.created_at IS 'Time this row was inserted';
 COMMENT ON COLUMN public.registration.updated_at IS 'Time this row was updated';
@@ -290,12 +401,18 @@ CREATE TABLE IF NOT EXISTS public.semester
 (
   id SERIAL,
   university_id BIGINT NOT NULL,
-  current_season SEASON NOT NULL,
+  current_season TEXT NOT NULL,
   current_year TEXT NOT NULL,
-  last_season SEASON NOT NULL,
+  current_term_code INTEGER NOT NULL DEFAULT 0,
+  current_term_name TEXT,
+  last_season TEXT NOT NULL,
   last_year TEXT NOT NULL,
-  next_season SEASON NOT NULL,
+  last_term_code INTEGER NOT NULL DEFAULT 0,
+  last_term_name TEXT,
+  next_season TEXT NOT NULL,
   next_year TEXT NOT NULL,
+  next_term_code INTEGER NOT NULL DEFAULT 0,
+  next_term_name TEXT,
   created_at TIMESTAMP,
   updated_at TIMESTAMP,
   CONSTRAINT semesters__pk PRIMARY KEY (id),
@@ -305,6 +422,8 @@ COMMENT ON COLUMN public.semester.id IS 'The primary index';
 COMMENT ON COLUMN public.semester.university_id IS 'The foreign key pointing to the university table';
 COMMENT ON COLUMN public.semester.current_season IS 'The current semester season';
 COMMENT ON COLUMN public.semester.current_year IS 'The current semester year';
+COMMENT ON COLUMN public.semester.current_term_code IS 'Orders the current semester within its year';
+COMMENT ON COLUMN public.semester.current_term_name IS 'The name of the current semester displayed to users';
 
 CREATE TABLE public.notification
 (
@@ -312,6 +431,7 @@ CREATE TABLE public.notification
   university JSONB NOT NULL,
   topic_name TEXT NOT NULL,
   status status NOT NULL,
+  type TEXT NOT NULL DEFAULT 'status',
   ack_at TIMESTAMP,
   message_id BIGINT,
   created_at TIMESTAMP,
@@ -321,6 +441,7 @@ CREATE TABLE public.notification
 COMMENT ON COLUMN public.notification.id IS 'Primary key of this table';
 COMMENT ON COLUMN public.notification.topic_name IS 'The topic name of the section';
 COMMENT ON COLUMN public.notification.status IS 'Status change';
+COMMENT ON COLUMN public.notification.type IS 'What changed, either status or waitlist';
 COMMENT ON COLUMN public.notification.created_at IS 'Time this row was inserted';
 COMMENT ON COLUMN public.notification.updated_at IS 'Time this row was updated';
 COMMENT ON TABLE public.notification IS 'Holds notifications sent to messaging';
@@ -379,6 +500,16 @@ BEFORE INSERT ON public.instructor
 FOR EACH ROW
 EXECUTE PROCEDURE update_row_time_stamp();
 
+CREATE TRIGGER insert_instructor_profile_time_stamps
+BEFORE INSERT ON public.instructor_profile
+FOR EACH ROW
+EXECUTE PROCEDURE update_row_time_stamp();
+
+CREATE TRIGGER insert_building_time_stamps
+BEFORE INSERT ON public.building
+FOR EACH ROW
+EXECUTE PROCEDURE update_row_time_stamp();
+
 CREATE TRIGGER insert_book_time_stamps
 BEFORE INSERT ON public.book
 FOR EACH ROW
@@ -444,6 +575,18 @@ FOR EACH ROW
 WHEN (OLD.* IS DISTINCT FROM NEW.*)
 EXECUTE PROCEDURE update_row_time_stamp();
 
+CREATE TRIGGER update_instructor_profile_time_stamps
+BEFORE UPDATE ON public.instructor_profile
+FOR EACH ROW
+WHEN (OLD.* IS DISTINCT FROM NEW.*)
+EXECUTE PROCEDURE update_row_time_stamp();
+
+CREATE TRIGGER update_building_time_stamps
+BEFORE UPDATE ON public.building
+FOR EACH ROW
+WHEN (OLD.* IS DISTINCT FROM NEW.*)
+EXECUTE PROCEDURE update_row_time_stamp();
+
 CREATE TRIGGER update_book_time_stamps
 BEFORE UPDATE ON public.book
 FOR EACH ROW
@@ -486,6 +629,8 @@ DECLARE
   _course record;
   _section record;
   _temp jsonb;
+  -- Either status or waitlist, passed by the trigger
+  _type text = coalesce(TG_ARGV[0], 'status');
 BEGIN
 
   SELECT university.id, university.name, abbr, main_color, abbr, home_page, registration_page, university.topic_name, university.topic_id
@@ -512,7 +657,7 @@ BEGIN
     JOIN section ON course.id = section.course_id
   WHERE section.id = NEW.id;
 
-  SELECT section.id, section.course_id, section.number, section.call_number, section.now, section.max, section.status, section.credits::TEXT, section.topic_name,section.topic_id, subject.created_at, section.updated_at
+  SELECT section.id, section.course_id, section.number, section.call_number, section.now, section.max, section.status, section.credits::TEXT, section.topic_name,section.topic_id, section.waitlist_max, section.waitlist_now, subject.created_at, section.updated_at
   INTO _section
   FROM university
     JOIN subject ON university.id = subject.university_id
@@ -527,13 +672,15 @@ BEGIN
   _temp = jsonb_strip_nulls(_temp);
 
   -- Log notification and provide an id to acknowledge the notification
-  INSERT INTO public.notification (university, topic_name, status) VALUES (_temp, NEW.topic_name, NEW.status) RETURNING public.notification.id INTO _notification_id;
+  INSERT INTO public.notification (university, topic_name, status, type) VALUES (_temp, NEW.topic_name, NEW.status, _type) RETURNING public.notification.id INTO _notification_id;
 
   -- Build notification
   _notification_json = json_build_object(
       'notification_id', _notification_id,
       'topic_name', NEW.topic_name,
       'status', NEW.status,
+      'cross_listed_topic_names', NEW.cross_listed_topic_names,
+      'type', _type,
       'university', _temp);
 
 
@@ -550,7 +697,39 @@ AFTER UPDATE
 ON public.section
 FOR EACH ROW
 WHEN (OLD.status <> NEW.status)
-EXECUTE PROCEDURE public.notify_status_change();
+EXECUTE PROCEDURE public.notify_status_change('status');
+
+-- A closed section's waitlist has room again
+CREATE TRIGGER notify_waitlist_open
+AFTER UPDATE
+ON public.section
+FOR EACH ROW
+WHEN (NEW.status = 'Closed' AND NEW.waitlist_now < NEW.waitlist_max AND OLD.waitlist_now >= OLD.waitlist_max)
+EXECUTE PROCEDURE public.notify_status_change('waitlist');
+
+CREATE OR REPLACE FUNCTION public.record_section_history()
+  RETURNS trigger AS
+$BODY$
+BEGIN
+  INSERT INTO public.section_history (section_id, now, max, status) VALUES (NEW.id, NEW.now, NEW.max, NEW.status);
+
+  RETURN NULL;
+END;
+$BODY$
+LANGUAGE plpgsql;
+
+CREATE TRIGGER insert_section_history
+AFTER INSERT
+ON public.section
+FOR EACH ROW
+EXECUTE PROCEDURE public.record_section_history();
+
+CREATE TRIGGER update_section_history
+AFTER UPDATE
+ON public.section
+FOR EACH ROW
+WHEN ((OLD.now, OLD.max, OLD.status) IS DISTINCT FROM (NEW.now, NEW.max, NEW.status))
+EXECUTE PROCEDURE public.record_section_history();
 
 COMMIT;
 
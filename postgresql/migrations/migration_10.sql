@@ -0,0 +1,101 @@
+ALTER TABLE public.section ADD COLUMN waitlist_max INTEGER;
+ALTER TABLE public.section ADD COLUMN waitlist_now INTEGER;
+
+COMMENT ON COLUMN public.section.waitlist_max IS 'Seats on the waitlist. Null when the university does not publish a waitlist';
+COMMENT ON COLUMN public.section.waitlist_now IS 'Students on the waitlist. Null when the university does not publish a waitlist';
+
+ALTER TABLE public.notification ADD COLUMN type TEXT NOT NULL DEFAULT 'status';
+
+COMMENT ON COLUMN public.notification.type IS 'What changed, either status or waitlist';
+
+CREATE OR REPLACE FUNCTION public.notify_status_change()
+  RETURNS trigger AS
+$BODY$
+DECLARE
+  _notification_json json;
+  _notification_id integer;
+
+  _university record;
+  _subject record;
+  _course record;
+  _section record;
+  _temp jsonb;
+  -- Either status or waitlist, passed by the trigger
+  _type text = coalesce(TG_ARGV[0], 'status');
+BEGIN
+
+  SELECT university.id, university.name, abbr, main_color, abbr, home_page, registration_page, university.topic_name, university.topic_id
+  INTO _university
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  SELECT subject.id, subject.university_id, subject.name, subject.number, subject.season, subject.year, subject.topic_name, subject.topic_id
+  INTO _subject
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  SELECT course.id, course.subject_id, course.number, course.name, course.synopsis, course.topic_name,course.topic_id
+  INTO _course
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  SELECT section.id, section.course_id, section.number, section.call_number, section.now, section.max, section.status, section.credits::TEXT, section.topic_name,section.topic_id, section.waitlist_max, section.waitlist_now, subject.created_at, section.updated_at
+  INTO _section
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  -- Build university tree
+  _temp = jsonb_set(to_json(_course)::jsonb, '{sections}', json_build_array(to_json(_section))::jsonb);
+  _temp = jsonb_set(to_json(_subject)::jsonb, '{courses}', json_build_array(_temp)::jsonb);
+  _temp = jsonb_set(to_json(_university)::jsonb, '{subjects}', json_build_array(_temp)::jsonb);
+  _temp = jsonb_strip_nulls(_temp);
+
+  -- Log notification and provide an id to acknowledge the notification
+  INSERT INTO public.notification (university, topic_name, status, type) VALUES (_temp, NEW.topic_name, NEW.status, _type) RETURNING public.notification.id INTO _notification_id;
+
+  -- Build notification
+  _notification_json = json_build_object(
+      'notification_id', _notification_id,
+      'topic_name', NEW.topic_name,
+      'status', NEW.status,
+      'cross_listed_topic_names', NEW.cross_listed_topic_names,
+      'type', _type,
+      'university', _temp);
+
+
+  -- Execute pg_notify(channel, notification)
+  PERFORM pg_notify('status_events',_notification_json::text);
+
+  RETURN NULL;
+END;
+$BODY$
+LANGUAGE plpgsql;
+
+DROP TRIGGER notify_status_change ON public.section;
+
+CREATE TRIGGER notify_status_change
+AFTER UPDATE
+ON public.section
+FOR EACH ROW
+WHEN (OLD.status <> NEW.status)
+EXECUTE PROCEDURE public.notify_status_change('status');
+
+-- A closed section's waitlist has room again
+CREATE TRIGGER notify_waitlist_open
+AFTER UPDATE
+ON public.section
+FOR EACH ROW
+WHEN (NEW.status = 'Closed' AND NEW.waitlist_now < NEW.waitlist_max AND OLD.waitlist_now >= OLD.waitlist_max)
+EXECUTE PROCEDURE public.notify_status_change('waitlist');
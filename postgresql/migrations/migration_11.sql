@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS public.instructor_profile
+(
+  id SERIAL,
+  university_id BIGINT NOT NULL,
+  name TEXT NOT NULL,
+  normalized_name TEXT NOT NULL,
+  topic_name TEXT NOT NULL,
+  created_at TIMESTAMP,
+  updated_at TIMESTAMP,
+  CONSTRAINT instructor_profile__pk PRIMARY KEY (id),
+  CONSTRAINT instructor_profile_university_id__fk FOREIGN KEY (university_id) REFERENCES public.university (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT unique_instructor_profile_normalized_name UNIQUE (university_id, normalized_name),
+  CONSTRAINT unique_instructor_profile_topic_name UNIQUE (topic_name)
+
+)WITH (OIDS = FALSE);
+
+ALTER TABLE public.instructor_profile OWNER TO universityct;
+
+COMMENT ON COLUMN public.instructor_profile.university_id IS 'The university this instructor teaches at';
+COMMENT ON COLUMN public.instructor_profile.name IS 'The name of the instructor as first scraped';
+COMMENT ON COLUMN public.instructor_profile.normalized_name IS 'The name of the instructor with its case, spacing and punctuation normalized';
+COMMENT ON COLUMN public.instructor_profile.topic_name IS 'The topic name of this instructor. Referenced by instructor.topic_name';
+COMMENT ON COLUMN public.instructor_profile.created_at IS 'Time this row was inserted';
+COMMENT ON COLUMN public.instructor_profile.updated_at IS 'Time this row was updated';
+COMMENT ON TABLE public.instructor_profile IS 'An instructor identified across the sections and semesters of a university';
+
+CREATE TRIGGER insert_instructor_profile_time_stamps
+BEFORE INSERT ON public.instructor_profile
+FOR EACH ROW
+EXECUTE PROCEDURE update_row_time_stamp();
+
+CREATE TRIGGER update_instructor_profile_time_stamps
+BEFORE UPDATE ON public.instructor_profile
+FOR EACH ROW
+WHEN (OLD.* IS DISTINCT FROM NEW.*)
+EXECUTE PROCEDURE update_row_time_stamp();
+
+ALTER TABLE public.instructor ADD COLUMN topic_name TEXT;
+
+COMMENT ON COLUMN public.instructor.topic_name IS 'The topic name of the instructor_profile of this instructor. Null for placeholders such as STAFF';
+
+CREATE INDEX instructor_topic_name_idx ON public.instructor (topic_name);
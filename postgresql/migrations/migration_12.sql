@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS public.building
+(
+  id SERIAL,
+  university_id BIGINT NOT NULL,
+  code TEXT NOT NULL,
+  name TEXT,
+  campus TEXT,
+  latitude DOUBLE PRECISION,
+  longitude DOUBLE PRECISION,
+  created_at TIMESTAMP,
+  updated_at TIMESTAMP,
+  CONSTRAINT building__pk PRIMARY KEY (id),
+  CONSTRAINT building_university_id__fk FOREIGN KEY (university_id) REFERENCES public.university (id) ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT unique_building_code UNIQUE (university_id, code)
+
+)WITH (OIDS = FALSE);
+
+ALTER TABLE public.building OWNER TO universityct;
+
+COMMENT ON COLUMN public.building.university_id IS 'The university this building belongs to';
+COMMENT ON COLUMN public.building.code IS 'The code rooms in this building are written with e.g HLL in HLL-116';
+COMMENT ON COLUMN public.building.name IS 'The name of this building';
+COMMENT ON COLUMN public.building.campus IS 'The campus this building is on';
+COMMENT ON COLUMN public.building.latitude IS 'Latitude of this building';
+COMMENT ON COLUMN public.building.longitude IS 'Longitude of this building';
+COMMENT ON COLUMN public.building.created_at IS 'Time this row was inserted';
+COMMENT ON COLUMN public.building.updated_at IS 'Time this row was updated';
+COMMENT ON TABLE public.building IS 'A building that meetings of a university are held in';
+
+CREATE TRIGGER insert_building_time_stamps
+BEFORE INSERT ON public.building
+FOR EACH ROW
+EXECUTE PROCEDURE update_row_time_stamp();
+
+CREATE TRIGGER update_building_time_stamps
+BEFORE UPDATE ON public.building
+FOR EACH ROW
+WHEN (OLD.* IS DISTINCT FROM NEW.*)
+EXECUTE PROCEDURE update_row_time_stamp();
+
+ALTER TABLE public.meeting ADD COLUMN building_code TEXT;
+ALTER TABLE public.meeting ADD COLUMN room_number TEXT;
+
+COMMENT ON COLUMN public.meeting.building_code IS 'The code of the building of the room, references building.code of the university';
+COMMENT ON COLUMN public.meeting.room_number IS 'The room number within the building';
+
+CREATE INDEX meeting_building_code_idx ON public.meeting (building_code);
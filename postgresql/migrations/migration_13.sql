@@ -0,0 +1,32 @@
+-- Seasons are no longer limited to fall, spring, summer and winter. A season is the key of a term type and
+-- term_code orders the terms of a year.
+ALTER TABLE public.subject ALTER COLUMN season TYPE TEXT USING season::TEXT;
+ALTER TABLE public.subject ADD COLUMN term_code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE public.subject ADD COLUMN term_name TEXT;
+
+UPDATE public.subject SET
+  term_code = CASE season WHEN 'winter' THEN 10 WHEN 'spring' THEN 20 WHEN 'summer' THEN 30 WHEN 'fall' THEN 40 END,
+  term_name = initcap(season);
+
+COMMENT ON COLUMN public.subject.season IS 'The key of the term type for which this subject is offered e.g fall or summer_1';
+COMMENT ON COLUMN public.subject.term_code IS 'Orders the terms of a year';
+COMMENT ON COLUMN public.subject.term_name IS 'The name of the term displayed to users e.g Summer Session I';
+
+ALTER TABLE public.semester ALTER COLUMN current_season TYPE TEXT USING current_season::TEXT;
+ALTER TABLE public.semester ALTER COLUMN last_season TYPE TEXT USING last_season::TEXT;
+ALTER TABLE public.semester ALTER COLUMN next_season TYPE TEXT USING next_season::TEXT;
+ALTER TABLE public.semester ADD COLUMN current_term_code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE public.semester ADD COLUMN current_term_name TEXT;
+ALTER TABLE public.semester ADD COLUMN last_term_code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE public.semester ADD COLUMN last_term_name TEXT;
+ALTER TABLE public.semester ADD COLUMN next_term_code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE public.semester ADD COLUMN next_term_name TEXT;
+
+COMMENT ON COLUMN public.semester.current_term_code IS 'Orders the current semester within its year';
+COMMENT ON COLUMN public.semester.current_term_name IS 'The name of the current semester displayed to users';
+
+-- Registered term types have periods of their own, e.g summer_1, start_summer_1 and end_summer_1
+ALTER TABLE public.registration ALTER COLUMN period TYPE TEXT USING period::TEXT;
+
+DROP TYPE season;
+DROP TYPE period;
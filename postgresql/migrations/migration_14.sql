@@ -0,0 +1,10 @@
+-- Hash of the content of an entity and its children, ein skips entities whose hash has not changed.
+ALTER TABLE public.subject ADD COLUMN content_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE public.course ADD COLUMN content_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE public.section ADD COLUMN content_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE public.meeting ADD COLUMN content_hash TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN public.subject.content_hash IS 'SHA-256 of the content of the subject and its children, independent of their order';
+COMMENT ON COLUMN public.course.content_hash IS 'SHA-256 of the content of the course and its children, independent of their order';
+COMMENT ON COLUMN public.section.content_hash IS 'SHA-256 of the content of the section and its children, independent of their order';
+COMMENT ON COLUMN public.meeting.content_hash IS 'SHA-256 of the content of the meeting and its metadata';
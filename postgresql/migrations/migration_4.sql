@@ -0,0 +1,7 @@
+ALTER TABLE public.subject ADD COLUMN removed_at TIMESTAMP;
+ALTER TABLE public.course ADD COLUMN removed_at TIMESTAMP;
+ALTER TABLE public.section ADD COLUMN removed_at TIMESTAMP;
+
+COMMENT ON COLUMN public.subject.removed_at IS 'Time this subject disappeared from the scraped data';
+COMMENT ON COLUMN public.course.removed_at IS 'Time this course disappeared from the scraped data';
+COMMENT ON COLUMN public.section.removed_at IS 'Time this section disappeared from the scraped data';
@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS public.section_history
+(
+  id BIGSERIAL,
+  section_id BIGINT NOT NULL,
+  now INTEGER NOT NULL,
+  max INTEGER NOT NULL,
+  status status NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT now(),
+  CONSTRAINT section_history__pk PRIMARY KEY (id),
+  CONSTRAINT section_history_section_id__fk FOREIGN KEY (section_id) REFERENCES public.section (id) ON DELETE CASCADE ON UPDATE CASCADE
+
+)WITH (OIDS = FALSE);
+
+ALTER TABLE public.section_history OWNER TO universityct;
+
+CREATE INDEX section_history_section_id_created_at_idx ON public.section_history (section_id, created_at);
+CREATE INDEX section_history_created_at_idx ON public.section_history (created_at);
+
+COMMENT ON COLUMN public.section_history.section_id IS 'The section this row records';
+COMMENT ON COLUMN public.section_history.now IS 'Enrollment of the section at this time';
+COMMENT ON COLUMN public.section_history.max IS 'Maximum seats of the section at this time';
+COMMENT ON COLUMN public.section_history.status IS 'Status of the section at this time';
+COMMENT ON COLUMN public.section_history.created_at IS 'Time the section changed to these values';
+COMMENT ON TABLE public.section_history IS 'Appended to whenever the enrollment, seats or status of a section changes';
+
+CREATE OR REPLACE FUNCTION public.record_section_history()
+  RETURNS trigger AS
+$BODY$
+BEGIN
+  INSERT INTO public.section_history (section_id, now, max, status) VALUES (NEW.id, NEW.now, NEW.max, NEW.status);
+
+  RETURN NULL;
+END;
+$BODY$
+LANGUAGE plpgsql;
+
+CREATE TRIGGER insert_section_history
+AFTER INSERT
+ON public.section
+FOR EACH ROW
+EXECUTE PROCEDURE public.record_section_history();
+
+CREATE TRIGGER update_section_history
+AFTER UPDATE
+ON public.section
+FOR EACH ROW
+WHEN ((OLD.now, OLD.max, OLD.status) IS DISTINCT FROM (NEW.now, NEW.max, NEW.status))
+EXECUTE PROCEDURE public.record_section_history();
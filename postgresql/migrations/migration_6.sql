@@ -0,0 +1,22 @@
+-- Full-text search documents of courses and sections, kept up to date by ein so that searches use an index.
+ALTER TABLE public.course ADD COLUMN search_document TSVECTOR;
+ALTER TABLE public.section ADD COLUMN search_document TSVECTOR;
+
+COMMENT ON COLUMN public.course.search_document IS 'Weighted full-text search document of the name, number, subject, synopsis and metadata of the course';
+COMMENT ON COLUMN public.section.search_document IS 'Weighted full-text search document of the number, call number, instructors and metadata of the section';
+
+UPDATE public.course SET search_document =
+  setweight(to_tsvector('english', course.name || ' ' || course.number || ' ' || subject.number), 'A') ||
+  setweight(to_tsvector('english', subject.name), 'B') ||
+  setweight(to_tsvector('english', coalesce(course.synopsis, '')), 'C') ||
+  setweight(to_tsvector('english', coalesce((SELECT string_agg(m.content, ' ') FROM public.metadata m WHERE m.course_id = course.id), '')), 'D')
+FROM public.subject WHERE subject.id = course.subject_id;
+
+UPDATE public.section SET search_document =
+  setweight(to_tsvector('english', section.number || ' ' || section.call_number), 'A') ||
+  setweight(to_tsvector('english', coalesce((SELECT string_agg(i.name, ' ') FROM public.instructor i WHERE i.section_id = section.id), '')), 'B') ||
+  setweight(to_tsvector('english', coalesce((SELECT string_agg(m.content, ' ') FROM public.metadata m WHERE m.section_id = section.id), '')), 'D');
+
+CREATE INDEX course_search_document_idx ON public.course USING GIN (search_document);
+CREATE INDEX section_search_document_idx ON public.section USING GIN (search_document);
+CREATE INDEX instructor_name_search_idx ON public.instructor USING GIN (to_tsvector('english', name));
@@ -0,0 +1,3 @@
+ALTER TABLE public.university ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'America/New_York';
+
+COMMENT ON COLUMN public.university.time_zone IS 'IANA time zone that meeting times are given in';
@@ -0,0 +1,5 @@
+ALTER TABLE public.course ADD COLUMN prerequisite_topic_names TEXT[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN public.course.prerequisite_topic_names IS 'Topic names of the courses referenced by the prerequisites of this course';
+
+CREATE INDEX course_prerequisite_topic_names_idx ON public.course USING GIN (prerequisite_topic_names);
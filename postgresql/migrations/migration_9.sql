@@ -0,0 +1,77 @@
+ALTER TABLE public.section ADD COLUMN cross_listed_topic_names TEXT[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN public.section.cross_listed_topic_names IS 'Topic names of the sections cross-listed with this section, which share its seats';
+
+CREATE INDEX section_cross_listed_topic_names_idx ON public.section USING GIN (cross_listed_topic_names);
+
+CREATE OR REPLACE FUNCTION public.notify_status_change()
+  RETURNS trigger AS
+$BODY$
+DECLARE
+  _notification_json json;
+  _notification_id integer;
+
+  _university record;
+  _subject record;
+  _course record;
+  _section record;
+  _temp jsonb;
+BEGIN
+
+  SELECT university.id, university.name, abbr, main_color, abbr, home_page, registration_page, university.topic_name, university.topic_id
+  INTO _university
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  SELECT subject.id, subject.university_id, subject.name, subject.number, subject.season, subject.year, subject.topic_name, subject.topic_id
+  INTO _subject
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  SELECT course.id, course.subject_id, course.number, course.name, course.synopsis, course.topic_name,course.topic_id
+  INTO _course
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  SELECT section.id, section.course_id, section.number, section.call_number, section.now, section.max, section.status, section.credits::TEXT, section.topic_name,section.topic_id, subject.created_at, section.updated_at
+  INTO _section
+  FROM university
+    JOIN subject ON university.id = subject.university_id
+    JOIN course ON subject.id = course.subject_id
+    JOIN section ON course.id = section.course_id
+  WHERE section.id = NEW.id;
+
+  -- Build university tree
+  _temp = jsonb_set(to_json(_course)::jsonb, '{sections}', json_build_array(to_json(_section))::jsonb);
+  _temp = jsonb_set(to_json(_subject)::jsonb, '{courses}', json_build_array(_temp)::jsonb);
+  _temp = jsonb_set(to_json(_university)::jsonb, '{subjects}', json_build_array(_temp)::jsonb);
+  _temp = jsonb_strip_nulls(_temp);
+
+  -- Log notification and provide an id to acknowledge the notification
+  INSERT INTO public.notification (university, topic_name, status) VALUES (_temp, NEW.topic_name, NEW.status) RETURNING public.notification.id INTO _notification_id;
+
+  -- Build notification
+  _notification_json = json_build_object(
+      'notification_id', _notification_id,
+      'topic_name', NEW.topic_name,
+      'status', NEW.status,
+      'cross_listed_topic_names', NEW.cross_listed_topic_names,
+      'university', _temp);
+
+
+  -- Execute pg_notify(channel, notification)
+  PERFORM pg_notify('status_events',_notification_json::text);
+
+  RETURN NULL;
+END;
+$BODY$
+LANGUAGE plpgsql;
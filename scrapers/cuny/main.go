@@ -64,11 +64,11 @@ func main() {
 
 	app.Flag("format", "Choose output format").
 		Short('f').
-		HintOptions(model.Json, model.Protobuf).
-		PlaceHolder("[protobuf, json]").
+		HintOptions(model.Json, model.Protobuf, model.Stream).
+		PlaceHolder("[protobuf, json, stream]").
 		Default("protobuf").
 		Envar("CUNY_OUTPUT_FORMAT").
-		EnumVar(&cconf.outputFormat, "protobuf", "json")
+		EnumVar(&cconf.outputFormat, "protobuf", "json", "stream")
 
 	configFile := app.Flag("config", "Configuration file for the application").
 		Short('c').
@@ -94,7 +94,16 @@ func main() {
 
 func (cuny *cuny) init() {
 	university := cunyMetadata[cuny.config.university]
-	university.ResolvedSemesters = model.ResolveSemesters(time.Now(), registrations)
+
+	now := time.Now()
+	calendar := model.AnnualCalendar(now.Year()-1, now.Year()+1, cunyTerms...)
+	university.Registrations = calendar.Registrations(now)
+
+	resolved, err := calendar.Resolve(now)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to resolve semesters")
+	}
+	university.ResolvedSemesters = resolved
 
 	semesters := [2]*model.Semester{university.ResolvedSemesters.Current, university.ResolvedSemesters.Next}
 
@@ -467,6 +476,8 @@ func (cr *courseScraper) parseSection(doc *goquery.Selection, course *model.Cour
 		section.Max = extraSectionInfo.Max
 		section.Metadata = extraSectionInfo.Metadata
 		section.Credits = extraSectionInfo.Credits
+		section.WaitlistMax = extraSectionInfo.WaitlistMax
+		section.WaitlistNow = extraSectionInfo.WaitlistNow
 	}
 
 	return
@@ -549,12 +560,12 @@ func (sr *sectionScraper) parseAdditionalSectionInfo(doc *goquery.Document, cour
 	section.Max = int64(sr.findMax(doc.Selection))
 	section.Now = int64(sr.findNow(doc.Selection))
 	section.Credits = sr.findUnits(doc.Selection)
+	section.WaitlistMax, section.WaitlistNow = sr.findWaitlist(doc.Selection)
 
 	meta := []func(s *goquery.Selection) *model.Metadata{
 		sr.findRequirements,
 		sr.findClassAttributes,
 		sr.findDesignation,
-		sr.findWaitlist,
 	}
 
 	for _, fn := range meta {
@@ -646,18 +657,14 @@ func (sr *sectionScraper) findAvailableSeats(s *goquery.Selection) int {
 	return sr.findAvailability(s, selectAvailableSeats)
 }
 
-func (sr *sectionScraper) findWaitlist(s *goquery.Selection) *model.Metadata {
-	capacity := sr.findWaitlistCap(s)
-	total := sr.findWaitlistTotal(s)
-
-	if capacity != 0 && total != 0 {
-		return &model.Metadata{
-			Title:   "Waitlist",
-			Content: fmt.Sprintf("Capacity: %d Total: %d", capacity, total),
-		}
+// findWaitlist returns the capacity and total of the waitlist, or nil when the section has no waitlist.
+func (sr *sectionScraper) findWaitlist(s *goquery.Selection) (capacity, total *int64) {
+	if c := int64(sr.findWaitlistCap(s)); c != 0 {
+		t := int64(sr.findWaitlistTotal(s))
+		return &c, &t
 	}
 
-	return nil
+	return nil, nil
 }
 
 func (sr *sectionScraper) findWaitlistCap(s *goquery.Selection) int {
@@ -680,6 +687,14 @@ func (sr *sectionScraper) findAvailability(s *goquery.Selection, id string) int
 	return 0
 }
 
+// Term codes of CUNYfirst, appended to the century and year
+var cunyTermCodes = map[string]string{
+	model.Winter: "0",
+	model.Spring: "2",
+	model.Summer: "7",
+	model.Fall:   "9",
+}
+
 func parseSemester(semester model.Semester) (string, error) {
 	if semester.GetSeason() == "" {
 		return "", fmt.Errorf("season must not be empty")
@@ -689,16 +704,13 @@ func parseSemester(semester model.Semester) (string, error) {
 		return "", fmt.Errorf("season must not be empty")
 	}
 
-	year := strconv.Itoa(int(semester.Year))[2:]
-	if semester.Season == model.Fall {
-		return "1" + year + "9", nil
-	} else if semester.Season == model.Summer {
-		return "1" + year + "7", nil
-	} else if semester.Season == model.Spring {
-		return "1" + year + "2", nil
-	} else {
-		return "1" + year + "0", nil
+	code, ok := cunyTermCodes[semester.Season]
+	if !ok {
+		return "", fmt.Errorf("season %q is not offered", semester.Season)
 	}
+
+	year := strconv.Itoa(int(semester.Year))[2:]
+	return "1" + year + code, nil
 }
 
 func newClient() *http.Client {
@@ -13,7 +13,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "BAR",
 		HomePage:         "http://www.baruch.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -38,7 +38,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "BMCC",
 		HomePage:         "http://bmcc.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -64,7 +64,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "BCC",
 		HomePage:         "http://bcc.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -83,7 +83,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "BKL",
 		HomePage:         "http://www.brooklyn.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -103,7 +103,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "LAW",
 		HomePage:         "http://www.law.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -126,7 +126,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "MED",
 		HomePage:         "https://www.ccny.cuny.edu/csom",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -145,7 +145,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "SPH",
 		HomePage:         "https://sph.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -164,7 +164,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "CCNY",
 		HomePage:         "http://www.ccny.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -189,7 +189,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "CSI",
 		HomePage:         "http://www.csi.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -212,7 +212,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "NCC",
 		HomePage:         "http://www.guttman.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -239,7 +239,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "HOS",
 		HomePage:         "http://www.hostos.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -264,7 +264,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "HTR",
 		HomePage:         "http://www.hunter.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -289,7 +289,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "JJC",
 		HomePage:         "http://www.jjay.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -319,7 +319,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "KCC",
 		HomePage:         "http://www.kingsborough.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -352,7 +352,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "LAG",
 		HomePage:         "http://www.lagcc.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -369,7 +369,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "LEH",
 		HomePage:         "http://www.lehman.edu/",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -387,7 +387,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "MEC",
 		HomePage:         "http://www.mec.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -411,7 +411,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "NYT",
 		HomePage:         "http://www.citytech.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -440,7 +440,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "QNS",
 		HomePage:         "http://www.qc.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -465,7 +465,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "QCC",
 		HomePage:         "http://www.qcc.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -490,7 +490,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "GRD",
 		HomePage:         "http://www.gc.cuny.edu",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -521,7 +521,7 @@ var cunyMetadata = map[string]model.University{
 		Abbr:             "YRK",
 		HomePage:         "http://www.york.cuny.edu/",
 		RegistrationPage: "https://home.cunyfirst.cuny.edu/oam/Portal_Login1.html",
-		Registrations:    registrations,
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{
 			{
 				Title: "About",
@@ -546,53 +546,10 @@ var cunyMetadata = map[string]model.University{
 	},
 }
 
-var registrations = []*model.Registration{
-	{
-		Period:     model.InFall.String(),
-		PeriodDate: time.Date(2000, time.September, 6, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.InSpring.String(),
-		PeriodDate: time.Date(2000, time.January, 17, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.InSummer.String(),
-		PeriodDate: time.Date(2000, time.May, 30, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.InWinter.String(),
-		PeriodDate: time.Date(2000, time.December, 23, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.StartFall.String(),
-		PeriodDate: time.Date(2000, time.March, 20, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.StartSpring.String(),
-		PeriodDate: time.Date(2000, time.October, 5, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.StartSummer.String(),
-		PeriodDate: time.Date(2000, time.January, 14, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.StartWinter.String(),
-		PeriodDate: time.Date(2000, time.September, 21, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.EndFall.String(),
-		PeriodDate: time.Date(2000, time.September, 13, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.EndSpring.String(),
-		PeriodDate: time.Date(2000, time.January, 27, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.EndSummer.String(),
-		PeriodDate: time.Date(2000, time.June, 15, 0, 0, 0, 0, time.UTC).Unix(),
-	},
-	{
-		Period:     model.EndWinter.String(),
-		PeriodDate: time.Date(2000, time.December, 22, 0, 0, 0, 0, time.UTC).Unix(),
-	},
+// cunyTerms is the academic calendar, repeated every year
+var cunyTerms = []model.TermTemplate{
+	{Season: model.Spring, RegistrationOpens: model.Date{Month: time.October, Day: 5}, Start: model.Date{Month: time.January, Day: 17}, End: model.Date{Month: time.May, Day: 29}},
+	{Season: model.Summer, RegistrationOpens: model.Date{Month: time.January, Day: 14}, Start: model.Date{Month: time.May, Day: 30}, End: model.Date{Month: time.August, Day: 16}},
+	{Season: model.Fall, RegistrationOpens: model.Date{Month: time.March, Day: 20}, Start: model.Date{Month: time.September, Day: 6}, End: model.Date{Month: time.December, Day: 22}},
+	{Season: model.Winter, RegistrationOpens: model.Date{Month: time.September, Day: 21}, Start: model.Date{Month: time.December, Day: 23}, End: model.Date{Month: time.January, Day: 16}},
 }
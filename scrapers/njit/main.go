@@ -50,10 +50,10 @@ func main() {
 
 	app.Flag("output-format", "choose output format").
 		Short('f').
-		HintOptions(model.Json, model.Protobuf).
-		PlaceHolder("[protobuf, json]").
+		HintOptions(model.Json, model.Protobuf, model.Stream).
+		PlaceHolder("[protobuf, json, stream]").
 		Default("protobuf").
-		EnumVar(&nconf.outputFormat, "protobuf", "json")
+		EnumVar(&nconf.outputFormat, "protobuf", "json", "stream")
 
 	configFile := app.Flag("config", "configuration file for the application").
 		Required().
@@ -78,7 +78,15 @@ func main() {
 func (njit *njit) init() {
 	university := njitBase
 
-	university.ResolvedSemesters = model.ResolveSemesters(time.Now(), university.Registrations)
+	now := time.Now()
+	calendar := model.AnnualCalendar(now.Year()-1, now.Year()+1, njitTerms...)
+	university.Registrations = calendar.Registrations(now)
+
+	resolved, err := calendar.Resolve(now)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to resolve semesters")
+	}
+	university.ResolvedSemesters = resolved
 
 	semesters := []*model.Semester{
 		university.ResolvedSemesters.Current,
@@ -331,18 +339,20 @@ func buildInstructors(njitCourse *NCourse) (instructors []*model.Instructor) {
 	return
 }
 
+// Term codes of the NJIT registration system, appended to the year
+var njitTermCodes = map[string]string{
+	model.Spring: "10",
+	model.Summer: "70",
+	model.Fall:   "90",
+	model.Winter: "95",
+}
+
 func parseSemester(semester model.Semester) string {
-	year := strconv.Itoa(int(semester.Year))
-	if semester.Season == model.Fall {
-		return year + "90"
-	} else if semester.Season == model.Summer {
-		return year + "70"
-	} else if semester.Season == model.Spring {
-		return year + "10"
-	} else if semester.Season == model.Winter {
-		return year + "95"
+	code, ok := njitTermCodes[semester.Season]
+	if !ok {
+		return ""
 	}
-	return ""
+	return strconv.Itoa(int(semester.Year)) + code
 }
 
 var cookieCutter *cookie.CookieCutter
@@ -43,13 +43,21 @@ func (rutgers *rutgers) getCampus(campus string) model.University {
 
 	university = getRutgers(campus)
 
+	now := time.Now()
+	calendar := model.AnnualCalendar(now.Year()-1, now.Year()+1, rutgersTerms...)
+	university.Registrations = calendar.Registrations(now)
+
 	// Rutgers servers go down for maintenance between 2 and 5 AM UTC every day.
 	// Data scraped from this time would be inaccurate and may lead to unforeseen errors
-	if currentHour := time.Now().In(time.FixedZone("EST", -18000)).Hour(); currentHour >= 2 && currentHour < 5 {
+	if currentHour := now.In(time.FixedZone("EST", -18000)).Hour(); currentHour >= 2 && currentHour < 5 {
 		return university
 	}
 
-	university.ResolvedSemesters = model.ResolveSemesters(time.Now(), university.Registrations)
+	resolved, err := calendar.Resolve(now)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to resolve semesters")
+	}
+	university.ResolvedSemesters = resolved
 
 	semesters := []*model.Semester{
 		university.ResolvedSemesters.Last,
@@ -70,9 +78,46 @@ func (rutgers *rutgers) getCampus(campus string) model.University {
 		university.Subjects = append(university.Subjects, buildSubjects(subjects)...)
 	}
 
+	university.Buildings = buildings(university.Subjects)
+
 	return university
 }
 
+// buildings returns the catalog of buildings that meetings are held in. Rutgers does not publish the names
+// of its buildings, only their codes and the campus they are on.
+func buildings(subjects []*model.Subject) (buildings []*model.Building) {
+	catalog := model.NewBuildingCatalog(nil)
+	seen := map[string]bool{}
+
+	for _, subject := range subjects {
+		for _, course := range subject.Courses {
+			for _, section := range course.Sections {
+				for _, meeting := range section.Meetings {
+					if meeting.Room == nil {
+						continue
+					}
+
+					code, _, ok := catalog.ParseRoom(*meeting.Room)
+					if !ok || seen[code] {
+						continue
+					}
+					seen[code] = true
+
+					building := &model.Building{Code: code}
+					for _, metadata := range meeting.Metadata {
+						if metadata.Title == "Campus" {
+							campus := metadata.Content
+							building.Campus = &campus
+						}
+					}
+					buildings = append(buildings, building)
+				}
+			}
+		}
+	}
+	return
+}
+
 func getSubjects(campus string, semester *model.Semester) []*RSubject {
 	rr := rutgersRequest{
 		host:     "http://sis.rutgers.edu/soc/api",
@@ -124,17 +169,16 @@ func (sr rutgersRequest) requestCourses() (subjects []*RSubject) {
 	return
 }
 
+// Term codes of the Rutgers course API
+var rutgersTermCodes = map[string]string{
+	model.Winter: "0",
+	model.Spring: "1",
+	model.Summer: "7",
+	model.Fall:   "9",
+}
+
 func parseTerm(semester model.Semester) string {
-	if semester.Season == model.Fall {
-		return "9"
-	} else if semester.Season == model.Summer {
-		return "7"
-	} else if semester.Season == model.Spring {
-		return "1"
-	} else if semester.Season == model.Winter {
-		return "0"
-	}
-	return ""
+	return rutgersTermCodes[semester.Season]
 }
 
 func parseYear(semester model.Semester) string {
@@ -176,11 +220,12 @@ func buildCourses(rutgersCourses []*RCourse) (c []*model.Course) {
 func buildSections(rutgerSections []*RSection) (s []*model.Section) {
 	for _, section := range rutgerSections {
 		newSection := &model.Section{
-			Number:     section.Number,
-			CallNumber: section.Index,
-			Status:     section.status,
-			Credits:    section.creditsFloat,
-			Metadata:   section.metadata()}
+			Number:        section.Number,
+			CallNumber:    section.Index,
+			Status:        section.status,
+			Credits:       section.creditsFloat,
+			Metadata:      section.metadata(),
+			CrossListings: section.crossListings()}
 
 		for _, instructor := range section.Instructor {
 			newInstructor := &model.Instructor{Name: instructor.Name}
@@ -196,6 +241,10 @@ func buildSections(rutgerSections []*RSection) (s []*model.Section) {
 				EndTime:   &meeting.EndTime,
 				ClassType: &meeting.ClassType}
 
+			if meeting.CampusName != "" {
+				newMeeting.Metadata = append(newMeeting.Metadata, &model.Metadata{Title: "Campus", Content: meeting.CampusName})
+			}
+
 			newSection.Meetings = append(newSection.Meetings, newMeeting)
 		}
 		s = append(s, newSection)
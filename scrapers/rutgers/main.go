@@ -31,11 +31,11 @@ func main() {
 
 	app.Flag("format", "choose output format").
 		Short('f').
-		HintOptions(model.Json, model.Protobuf).
-		PlaceHolder("[protobuf, json]").
+		HintOptions(model.Json, model.Protobuf, model.Stream).
+		PlaceHolder("[protobuf, json, stream]").
 		Default("protobuf").
 		Envar("RUTGERS_OUTPUT_FORMAT").
-		EnumVar(&rconf.outputFormat, "protobuf", "json")
+		EnumVar(&rconf.outputFormat, "protobuf", "json", "stream")
 
 	app.Flag("latest", "Only output the current and next semester").
 		Short('l').
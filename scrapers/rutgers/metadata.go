@@ -6,62 +6,21 @@ import (
 	"github.com/tevjef/uct-backend/common/model"
 )
 
+// rutgersTerms is the academic calendar, repeated every year
+var rutgersTerms = []model.TermTemplate{
+	{Season: model.Spring, RegistrationOpens: model.Date{Month: time.October, Day: 5}, Start: model.Date{Month: time.January, Day: 17}, End: model.Date{Month: time.May, Day: 29}},
+	{Season: model.Summer, RegistrationOpens: model.Date{Month: time.January, Day: 14}, Start: model.Date{Month: time.May, Day: 30}, End: model.Date{Month: time.August, Day: 16}},
+	{Season: model.Fall, RegistrationOpens: model.Date{Month: time.March, Day: 20}, Start: model.Date{Month: time.September, Day: 6}, End: model.Date{Month: time.December, Day: 22}},
+	{Season: model.Winter, RegistrationOpens: model.Date{Month: time.September, Day: 21}, Start: model.Date{Month: time.December, Day: 23}, End: model.Date{Month: time.January, Day: 16}},
+}
+
 func getRutgers(campus string) model.University {
 	university := model.University{
 		Name:             "Rutgers University–New Brunswick",
 		Abbr:             "RU-NB",
 		HomePage:         "http://newbrunswick.edu/",
 		RegistrationPage: "https://sims.rutgers.edu/webreg/",
-		Registrations: []*model.Registration{
-			{
-				Period:     model.InFall.String(),
-				PeriodDate: time.Date(2000, time.September, 6, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.InSpring.String(),
-				PeriodDate: time.Date(2000, time.January, 17, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.InSummer.String(),
-				PeriodDate: time.Date(2000, time.May, 30, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.InWinter.String(),
-				PeriodDate: time.Date(2000, time.December, 23, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.StartFall.String(),
-				PeriodDate: time.Date(2000, time.March, 20, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.StartSpring.String(),
-				PeriodDate: time.Date(2000, time.October, 5, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.StartSummer.String(),
-				PeriodDate: time.Date(2000, time.January, 14, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.StartWinter.String(),
-				PeriodDate: time.Date(2000, time.September, 21, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.EndFall.String(),
-				PeriodDate: time.Date(2000, time.September, 13, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.EndSpring.String(),
-				PeriodDate: time.Date(2000, time.January, 27, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.EndSummer.String(),
-				PeriodDate: time.Date(2000, time.June, 15, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-			{
-				Period:     model.EndWinter.String(),
-				PeriodDate: time.Date(2000, time.December, 22, 0, 0, 0, 0, time.UTC).Unix(),
-			},
-		},
+		TimeZone:         "America/New_York",
 		Metadata: []*model.Metadata{{
 			Title: "About", Content: aboutNewBrunswick,
 		},
@@ -264,19 +264,18 @@ func (section RSection) instructor() (instructors []*model.Instructor) {
 	return
 }
 
-func (section RSection) metadata() (metadata []*model.Metadata) {
-
-	if len(section.CrossListedSections) > 0 {
-		crossListedSections := []string{}
-		for _, cls := range section.CrossListedSections {
-			crossListedSections = append(crossListedSections, cls.OfferingUnitCode+":"+cls.SubjectCode+":"+cls.CourseNumber+":"+cls.SectionNumber)
-		}
-		metadata = append(metadata, &model.Metadata{
-			Title:   "Cross-listed Sections",
-			Content: strings.Join(crossListedSections, ", "),
+func (section RSection) crossListings() (crossListings []*model.CrossListing) {
+	for _, cls := range section.CrossListedSections {
+		crossListings = append(crossListings, &model.CrossListing{
+			SubjectNumber: cls.SubjectCode,
+			CourseNumber:  cls.CourseNumber,
+			SectionNumber: cls.SectionNumber,
 		})
-
 	}
+	return
+}
+
+func (section RSection) metadata() (metadata []*model.Metadata) {
 
 	if len(section.Comments) > 0 {
 		sort.Sort(commentSorter{section.Comments})
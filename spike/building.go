@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/middleware"
+	"github.com/tevjef/uct-backend/common/middleware/cache"
+	"github.com/tevjef/uct-backend/common/middleware/httperror"
+	mtrace "github.com/tevjef/uct-backend/common/middleware/trace"
+	"github.com/tevjef/uct-backend/common/model"
+	"github.com/tevjef/uct-backend/spike/store"
+)
+
+// buildingsHandler responds with the building catalog of a university.
+func buildingsHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		universityTopicName := strings.ToLower(c.Param("topic"))
+
+		buildings, err := SelectBuildings(c, universityTopicName)
+		if err != nil {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		response := model.Response{
+			Data: &model.Data{Buildings: buildings},
+		}
+		c.Set(middleware.ResponseKey, response)
+	}, expire)
+}
+
+// buildingSectionsHandler responds with the sections of the current semester that meet in a building on a
+// day, ordered by their first meeting there. The optional room query parameter narrows the building to a
+// room and the day query parameter defaults to today in the time zone of the university.
+func buildingSectionsHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		universityTopicName := strings.ToLower(c.Param("topic"))
+		buildingCode := strings.ToUpper(c.Param("code"))
+		roomNumber := strings.ToUpper(c.Query("room"))
+
+		timeZone, err := SelectUniversityTimeZone(c, universityTopicName)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				httperror.NotFound(c, err)
+				return
+			}
+			httperror.ServerError(c, err)
+			return
+		}
+
+		day, err := buildingDay(c.Query("day"), time.Now(), timeZone)
+		if err != nil {
+			httperror.BadRequest(c, err)
+			return
+		}
+
+		sections, err := SelectBuildingSections(c, universityTopicName, buildingCode, roomNumber, day)
+		if err != nil {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		data := &model.Data{Sections: sections}
+
+		// Buildings that are not in the catalog only have their sections
+		building, err := SelectBuilding(c, universityTopicName, buildingCode)
+		if err == nil {
+			data.Buildings = []*model.Building{&building}
+		} else if err != sql.ErrNoRows {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		c.Set(middleware.ResponseKey, model.Response{Data: data})
+	}, expire)
+}
+
+// buildingDay returns the canonical name of the day, or of the weekday it is now in the time zone when the
+// day is empty.
+func buildingDay(day string, now time.Time, timeZone string) (string, error) {
+	if day == "" {
+		location, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return "", err
+		}
+		return now.In(location).Weekday().String(), nil
+	}
+
+	weekday, ok := model.ParseWeekday(day)
+	if !ok {
+		return "", fmt.Errorf("day %q is not a weekday", day)
+	}
+	return weekday.String(), nil
+}
+
+func SelectUniversityTimeZone(ctx context.Context, universityTopicName string) (timeZone string, err error) {
+	defer model.TimeTrack(time.Now(), "SelectUniversityTimeZone")
+	span := mtrace.NewSpan(ctx, "database.SelectUniversityTimeZone")
+	span.SetLabel("topicName", universityTopicName)
+	defer span.Finish()
+
+	m := map[string]interface{}{"topic_name": universityTopicName}
+	err = middleware.Get(ctx, store.SelectUniversityTimeZoneQuery, &timeZone, m)
+	return
+}
+
+func SelectBuildings(ctx context.Context, universityTopicName string) (buildings []*model.Building, err error) {
+	defer model.TimeTrack(time.Now(), "SelectBuildings")
+	span := mtrace.NewSpan(ctx, "database.SelectBuildings")
+	span.SetLabel("topicName", universityTopicName)
+	defer span.Finish()
+
+	m := map[string]interface{}{"topic_name": universityTopicName}
+	err = middleware.Select(ctx, store.SelectBuildingsQuery, &buildings, m)
+	return
+}
+
+func SelectBuilding(ctx context.Context, universityTopicName, buildingCode string) (building model.Building, err error) {
+	defer model.TimeTrack(time.Now(), "SelectBuilding")
+	span := mtrace.NewSpan(ctx, "database.SelectBuilding")
+	span.SetLabel("topicName", universityTopicName+"."+buildingCode)
+	defer span.Finish()
+
+	m := map[string]interface{}{"topic_name": universityTopicName, "code": buildingCode}
+	err = middleware.Get(ctx, store.SelectBuildingQuery, &building, m)
+	return
+}
+
+func SelectBuildingSections(ctx context.Context, universityTopicName, buildingCode, roomNumber, day string) (sections []*model.Section, err error) {
+	defer model.TimeTrack(time.Now(), "SelectBuildingSections")
+	span := mtrace.NewSpan(ctx, "database.SelectBuildingSections")
+	span.SetLabel("topicName", universityTopicName+"."+buildingCode)
+	defer span.Finish()
+
+	var d []store.Data
+	m := map[string]interface{}{
+		"topic_name":    universityTopicName,
+		"building_code": buildingCode,
+		"room_number":   roomNumber,
+		"day":           day,
+	}
+	if err = middleware.Select(ctx, store.SelectBuildingSectionsQuery, &d, m); err != nil {
+		return
+	}
+	for i := range d {
+		s := model.Section{}
+		if err = s.Unmarshal(d[i].Data); err != nil {
+			return
+		}
+		sections = append(sections, &s)
+	}
+	return
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildingDay(t *testing.T) {
+	// Tuesday in UTC, still Monday in New York
+	now := time.Date(2017, time.September, 5, 2, 0, 0, 0, time.UTC)
+
+	day, err := buildingDay("", now, "America/New_York")
+	assert.Nil(t, err)
+	assert.Equal(t, "Monday", day)
+
+	day, err = buildingDay("wed", now, "America/New_York")
+	assert.Nil(t, err)
+	assert.Equal(t, "Wednesday", day)
+
+	_, err = buildingDay("someday", now, "America/New_York")
+	assert.NotNil(t, err)
+
+	_, err = buildingDay("", now, "Nowhere/Unknown")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/ical"
+	"github.com/tevjef/uct-backend/common/middleware"
+	mtrace "github.com/tevjef/uct-backend/common/middleware/trace"
+	"github.com/tevjef/uct-backend/common/model"
+	"github.com/tevjef/uct-backend/spike/store"
+)
+
+const maxCalendarSections = 50
+
+type calendarSection struct {
+	Data         []byte `db:"data"`
+	CourseName   string `db:"course_name"`
+	Season       string `db:"season"`
+	Year         string `db:"year"`
+	UniversityId int64  `db:"university_id"`
+	TimeZone     string `db:"time_zone"`
+}
+
+// calendarHandler renders sections as an iCalendar that can be imported into calendar applications. Sections
+// are the topic in the path or repeated section query parameters. Responses are not protobuf, so errors are
+// written as plain text.
+func calendarHandler(c *gin.Context) {
+	var sectionTopicNames []string
+	seen := map[string]bool{}
+	for _, topicName := range append(c.QueryArray("section"), c.Param("topic")) {
+		if topicName = strings.ToLower(topicName); topicName != "" && !seen[topicName] {
+			seen[topicName] = true
+			sectionTopicNames = append(sectionTopicNames, topicName)
+		}
+	}
+
+	if len(sectionTopicNames) == 0 {
+		c.String(http.StatusBadRequest, "Bad Request: at least one section is required")
+		return
+	} else if len(sectionTopicNames) > maxCalendarSections {
+		c.String(http.StatusBadRequest, fmt.Sprintf("Bad Request: at most %d sections are allowed", maxCalendarSections))
+		return
+	}
+
+	calendar, err := SelectCalendar(c, sectionTopicNames)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			c.String(http.StatusNotFound, "Not Found: "+err.Error())
+			return
+		}
+		c.String(http.StatusInternalServerError, "Internal server error: "+err.Error())
+		return
+	}
+
+	c.Header("Content-Type", ical.ContentType)
+	c.Header("Content-Disposition", `attachment; filename="schedule.ics"`)
+	c.Status(http.StatusOK)
+	if _, err := calendar.WriteTo(c.Writer); err != nil {
+		log.WithError(err).WithField("sections", strings.Join(sectionTopicNames, ",")).Errorln("failed to write calendar")
+		c.Error(err)
+	}
+}
+
+// SelectCalendar builds a calendar of the sections in the time zone of the first section's university.
+func SelectCalendar(ctx context.Context, sectionTopicNames []string) (calendar *ical.Calendar, err error) {
+	defer model.TimeTrack(time.Now(), "SelectCalendar")
+	span := mtrace.NewSpan(ctx, "database.SelectCalendar")
+	span.SetLabel("topicName", strings.Join(sectionTopicNames, ","))
+	defer span.Finish()
+
+	var rows []calendarSection
+	m := map[string]interface{}{"topic_names": strings.Join(sectionTopicNames, ",")}
+	if err = middleware.Select(ctx, store.SelectCalendarSectionsQuery, &rows, m); err != nil {
+		return
+	}
+
+	if len(rows) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	location, err := time.LoadLocation(rows[0].TimeZone)
+	if err != nil {
+		return
+	}
+
+	name := "Schedule"
+	if len(rows) == 1 {
+		name = rows[0].CourseName
+	}
+	calendar = ical.New(name, location, time.Now())
+
+	registrations := map[int64][]*model.Registration{}
+	for _, row := range rows {
+		if _, ok := registrations[row.UniversityId]; !ok {
+			var r []*model.Registration
+			if err = middleware.Select(ctx, store.SelectRegistrationsQuery, &r, map[string]interface{}{"university_id": row.UniversityId}); err != nil {
+				return
+			}
+			registrations[row.UniversityId] = r
+		}
+
+		year, err := strconv.Atoi(row.Year)
+		if err != nil {
+			return nil, err
+		}
+
+		first, last, err := model.SemesterDates(registrations[row.UniversityId], model.Semester{Season: row.Season, Year: int32(year)})
+		if err != nil {
+			return nil, err
+		}
+
+		section := model.Section{}
+		if err = section.Unmarshal(row.Data); err != nil {
+			return nil, err
+		}
+
+		calendar.AddSection(row.CourseName, &section, first, last)
+	}
+
+	return
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/export"
+	"github.com/tevjef/uct-backend/common/middleware"
+	mtrace "github.com/tevjef/uct-backend/common/middleware/trace"
+	"github.com/tevjef/uct-backend/common/model"
+	"github.com/tevjef/uct-backend/spike/store"
+)
+
+// exportHandler writes every section of a university in a semester as a table. The format query parameter is
+// csv or columnar and repeated column query parameters select the columns. Responses are not protobuf, so
+// errors are written as plain text.
+func exportHandler(c *gin.Context) {
+	format := c.DefaultQuery("format", export.CSV)
+	if format != export.CSV && format != export.Columnar {
+		c.String(http.StatusBadRequest, fmt.Sprintf("Bad Request: unknown format %q", format))
+		return
+	}
+
+	columns, err := export.LookupColumns(c.QueryArray("column")...)
+	if err != nil {
+		c.String(http.StatusBadRequest, "Bad Request: "+err.Error())
+		return
+	}
+
+	topicName := strings.ToLower(c.Param("topic"))
+	season := strings.ToLower(c.Param("season"))
+	year := c.Param("year")
+	if _, err := strconv.Atoi(year); err != nil {
+		c.String(http.StatusBadRequest, fmt.Sprintf("Bad Request: invalid year %q", year))
+		return
+	}
+
+	var (
+		started bool
+		writer  = export.NewCSVWriter(c.Writer, columns)
+		rows    []export.Row
+	)
+
+	// Headers are only written with the first subject so that a failed query is still reported with its status.
+	// CSV is written a subject at a time, columnar output needs every row.
+	err = SelectExport(c, topicName, season, year, func(university *model.University, subject *model.Subject) error {
+		if !started {
+			if format == export.CSV {
+				c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s-%s.csv"`, topicName, season, year))
+			}
+			c.Header("Content-Type", export.ContentType(format))
+			c.Status(http.StatusOK)
+			started = true
+		}
+
+		subjectRows := export.FlattenSubject(university, subject, nil)
+		if format == export.CSV {
+			return writer.Write(subjectRows)
+		}
+		rows = append(rows, subjectRows...)
+		return nil
+	})
+
+	if err == nil {
+		if format == export.CSV {
+			err = writer.Flush()
+		} else {
+			err = export.WriteColumnar(c.Writer, columns, rows)
+		}
+	}
+
+	if err != nil {
+		if started {
+			log.WithError(err).WithField("university", topicName).Errorln("failed to write export")
+			c.Error(err)
+			return
+		}
+		if err == sql.ErrNoRows {
+			c.String(http.StatusNotFound, "Not Found: "+err.Error())
+			return
+		}
+		c.String(http.StatusInternalServerError, "Internal server error: "+err.Error())
+	}
+}
+
+// SelectExport calls fn with the university and each subject it has in the semester in turn, so that only one
+// subject is decoded at a time. Returns sql.ErrNoRows without calling fn when there are no subjects.
+func SelectExport(ctx context.Context, uniTopicName, season, year string, fn func(university *model.University, subject *model.Subject) error) error {
+	defer model.TimeTrack(time.Now(), "SelectExport")
+	span := mtrace.NewSpan(ctx, "database.SelectExport")
+	span.SetLabel("topicName", uniTopicName)
+	span.SetLabel("year", year)
+	span.SetLabel("season", season)
+	defer span.Finish()
+
+	university, err := SelectUniversity(ctx, uniTopicName)
+	if err != nil {
+		return err
+	}
+	university.Subjects = nil
+
+	var d []store.Data
+	m := map[string]interface{}{"topic_name": uniTopicName, "subject_season": season, "subject_year": year}
+	if err := middleware.Select(ctx, store.SelectExportSubjectsQuery, &d, m); err != nil {
+		return err
+	}
+
+	if len(d) == 0 {
+		return sql.ErrNoRows
+	}
+
+	for i := range d {
+		subject := &model.Subject{}
+		if err := subject.Unmarshal(d[i].Data); err != nil {
+			return err
+		}
+		if err := fn(&university, subject); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/middleware"
+	"github.com/tevjef/uct-backend/common/middleware/cache"
+	"github.com/tevjef/uct-backend/common/middleware/httperror"
+	mtrace "github.com/tevjef/uct-backend/common/middleware/trace"
+	"github.com/tevjef/uct-backend/common/model"
+	"github.com/tevjef/uct-backend/spike/store"
+)
+
+type teachingRow struct {
+	Data              []byte `db:"data"`
+	Season            string `db:"season"`
+	Year              string `db:"year"`
+	SectionTopicNames string `db:"section_topic_names"`
+}
+
+// instructorSectionsHandler responds with the sections an instructor teaches in the current semester of
+// their university.
+func instructorSectionsHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		instructorTopicName := strings.ToLower(c.Param("topic"))
+
+		profile, err := SelectInstructorProfile(c, instructorTopicName)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				httperror.NotFound(c, err)
+				return
+			}
+			httperror.ServerError(c, err)
+			return
+		}
+
+		sections, err := SelectInstructorSections(c, instructorTopicName)
+		if err != nil {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		response := model.Response{
+			Data: &model.Data{InstructorProfile: &profile, Sections: sections},
+		}
+		c.Set(middleware.ResponseKey, response)
+	}, expire)
+}
+
+// instructorHistoryHandler responds with every course an instructor has taught, newest semester first.
+func instructorHistoryHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		instructorTopicName := strings.ToLower(c.Param("topic"))
+
+		profile, err := SelectInstructorProfile(c, instructorTopicName)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				httperror.NotFound(c, err)
+				return
+			}
+			httperror.ServerError(c, err)
+			return
+		}
+
+		if profile.Teaching, err = SelectInstructorTeaching(c, instructorTopicName); err != nil {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		response := model.Response{
+			Data: &model.Data{InstructorProfile: &profile},
+		}
+		c.Set(middleware.ResponseKey, response)
+	}, expire)
+}
+
+func SelectInstructorProfile(ctx context.Context, instructorTopicName string) (profile model.InstructorProfile, err error) {
+	defer model.TimeTrack(time.Now(), "SelectInstructorProfile")
+	span := mtrace.NewSpan(ctx, "database.SelectInstructorProfile")
+	span.SetLabel("topicName", instructorTopicName)
+	defer span.Finish()
+
+	m := map[string]interface{}{"topic_name": instructorTopicName}
+	err = middleware.Get(ctx, store.SelectInstructorProfileQuery, &profile, m)
+	return
+}
+
+func SelectInstructorSections(ctx context.Context, instructorTopicName string) (sections []*model.Section, err error) {
+	defer model.TimeTrack(time.Now(), "SelectInstructorSections")
+	span := mtrace.NewSpan(ctx, "database.SelectInstructorSections")
+	span.SetLabel("topicName", instructorTopicName)
+	defer span.Finish()
+
+	var d []store.Data
+	m := map[string]interface{}{"topic_name": instructorTopicName}
+	if err = middleware.Select(ctx, store.SelectInstructorSectionsQuery, &d, m); err != nil {
+		return
+	}
+	for i := range d {
+		s := model.Section{}
+		if err = s.Unmarshal(d[i].Data); err != nil {
+			return
+		}
+		sections = append(sections, &s)
+	}
+	return
+}
+
+func SelectInstructorTeaching(ctx context.Context, instructorTopicName string) (teaching []*model.Teaching, err error) {
+	defer model.TimeTrack(time.Now(), "SelectInstructorTeaching")
+	span := mtrace.NewSpan(ctx, "database.SelectInstructorTeaching")
+	span.SetLabel("topicName", instructorTopicName)
+	defer span.Finish()
+
+	var rows []teachingRow
+	m := map[string]interface{}{"topic_name": instructorTopicName}
+	if err = middleware.Select(ctx, store.SelectInstructorTeachingQuery, &rows, m); err != nil {
+		return
+	}
+	for _, row := range rows {
+		course := model.Course{}
+		if err = course.Unmarshal(row.Data); err != nil {
+			return
+		}
+		course.Sections = taughtSections(course.Sections, strings.Split(row.SectionTopicNames, ","))
+		teaching = append(teaching, &model.Teaching{Season: row.Season, Year: row.Year, Course: &course})
+	}
+	return
+}
+
+// taughtSections keeps the sections of a course with the given topic names.
+func taughtSections(sections []*model.Section, topicNames []string) (taught []*model.Section) {
+	keep := map[string]bool{}
+	for _, topicName := range topicNames {
+		keep[topicName] = true
+	}
+	for _, section := range sections {
+		if keep[section.TopicName] {
+			taught = append(taught, section)
+		}
+	}
+	return
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+func Test_taughtSections(t *testing.T) {
+	first := &model.Section{TopicName: "section.1"}
+	second := &model.Section{TopicName: "section.2"}
+	third := &model.Section{TopicName: "section.3"}
+
+	sections := []*model.Section{first, second, third}
+	assert.Equal(t, []*model.Section{first, third}, taughtSections(sections, []string{"section.3", "section.1"}))
+	assert.Empty(t, taughtSections(sections, []string{""}))
+}
@@ -138,11 +138,35 @@ func (spike *spike) init() {
 		v2.GET("/courses/:topic", coursesHandler(10*time.Second))
 		v2.GET("/course/:topic", courseHandler(10*time.Second))
 		v2.GET("/course/:topic/hotness/view", hotnessHandler(10*time.Second))
+		v2.GET("/course/:topic/prerequisites", prerequisitesHandler(time.Minute))
+		v2.GET("/course/:topic/dependents", dependentsHandler(time.Minute))
 		v2.GET("/section/:topic", sectionHandler(10*time.Second))
+		v2.GET("/section/:topic/history", sectionHistoryHandler(time.Minute))
+		v2.GET("/section/:topic/cross-listings", crossListingsHandler(time.Minute))
+		v2.GET("/sections", sectionsHandler(10*time.Second))
+		v2.GET("/instructor/:topic/sections", instructorSectionsHandler(time.Minute))
+		v2.GET("/instructor/:topic/history", instructorHistoryHandler(time.Minute))
+		v2.GET("/buildings/:topic", buildingsHandler(time.Minute))
+		v2.GET("/building/:topic/:code", buildingSectionsHandler(time.Minute))
+		v2.GET("/search", searchHandler(time.Minute))
+		v2.GET("/schedules", scheduleHandler(time.Minute))
 		v2.POST("/subscription", subscriptionHandler())
 		v2.POST("/notification", notificationHandler())
 	}
 
+	// iCalendar responses are not negotiated
+	calendar := r.Group("/calendar")
+	{
+		calendar.GET("", calendarHandler)
+		calendar.GET("/section/:topic", calendarHandler)
+	}
+
+	// Exports are tables for spreadsheets and are not negotiated
+	exports := r.Group("/export")
+	{
+		exports.GET("/:topic/:season/:year", exportHandler)
+	}
+
 	static := r.Group("/static")
 	static.GET("/:file", serveStaticFromGithub)
 
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/middleware"
+	"github.com/tevjef/uct-backend/common/middleware/cache"
+	"github.com/tevjef/uct-backend/common/middleware/httperror"
+	mtrace "github.com/tevjef/uct-backend/common/middleware/trace"
+	"github.com/tevjef/uct-backend/common/model"
+	"github.com/tevjef/uct-backend/spike/store"
+)
+
+// prerequisitesHandler responds with the prerequisite expression of a course and the courses it references
+// that are offered in the same semester.
+func prerequisitesHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		courseTopicName := strings.ToLower(c.Param("topic"))
+
+		course, _, err := SelectCourse(c, courseTopicName)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				httperror.NotFound(c, err)
+				return
+			}
+			httperror.ServerError(c, err)
+			return
+		}
+
+		courses, err := SelectPrerequisiteCourses(c, course.Prerequisite.TopicNames())
+		if err != nil {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		response := model.Response{
+			Data: &model.Data{Prerequisite: course.Prerequisite, Courses: courses},
+		}
+		c.Set(middleware.ResponseKey, response)
+	}, expire)
+}
+
+// dependentsHandler responds with the courses that list a course as a prerequisite.
+func dependentsHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		courseTopicName := strings.ToLower(c.Param("topic"))
+
+		if _, _, err := SelectCourse(c, courseTopicName); err != nil {
+			if err == sql.ErrNoRows {
+				httperror.NotFound(c, err)
+				return
+			}
+			httperror.ServerError(c, err)
+			return
+		}
+
+		courses, err := SelectDependentCourses(c, courseTopicName)
+		if err != nil {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		response := model.Response{
+			Data: &model.Data{Courses: courses},
+		}
+		c.Set(middleware.ResponseKey, response)
+	}, expire)
+}
+
+func SelectPrerequisiteCourses(ctx context.Context, courseTopicNames []string) (courses []*model.Course, err error) {
+	if len(courseTopicNames) == 0 {
+		return
+	}
+
+	defer model.TimeTrack(time.Now(), "SelectPrerequisiteCourses")
+	span := mtrace.NewSpan(ctx, "database.SelectPrerequisiteCourses")
+	span.SetLabel("topicName", strings.Join(courseTopicNames, ","))
+	defer span.Finish()
+
+	m := map[string]interface{}{"topic_names": strings.Join(courseTopicNames, ",")}
+	return selectCourses(ctx, store.SelectPrerequisiteCoursesQuery, m)
+}
+
+func SelectDependentCourses(ctx context.Context, courseTopicName string) (courses []*model.Course, err error) {
+	defer model.TimeTrack(time.Now(), "SelectDependentCourses")
+	span := mtrace.NewSpan(ctx, "database.SelectDependentCourses")
+	span.SetLabel("topicName", courseTopicName)
+	defer span.Finish()
+
+	m := map[string]interface{}{"topic_name": courseTopicName}
+	return selectCourses(ctx, store.SelectDependentCoursesQuery, m)
+}
+
+func selectCourses(ctx context.Context, query string, m map[string]interface{}) (courses []*model.Course, err error) {
+	var d []store.Data
+	if err = middleware.Select(ctx, query, &d, m); err != nil {
+		return
+	}
+	for i := range d {
+		c := model.Course{}
+		if err = c.Unmarshal(d[i].Data); err != nil {
+			return
+		}
+		courses = append(courses, &c)
+	}
+	return
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/middleware"
+	"github.com/tevjef/uct-backend/common/middleware/cache"
+	"github.com/tevjef/uct-backend/common/middleware/httperror"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+const (
+	defaultScheduleLimit = 10
+	maxScheduleCourses   = 10
+	// Upper bound on the number of section combinations considered for a single request
+	maxScheduleSearch = 100000
+)
+
+type scheduleConstraints struct {
+	Courses  []string
+	OpenOnly bool
+	// Minutes since midnight
+	StartAfter int
+	EndBefore  int
+	// Minutes, negative when any gap is allowed
+	MaxGap int
+	Limit  int
+}
+
+type scheduleCandidate struct {
+	section   *model.Section
+	intervals []model.Interval
+}
+
+func scheduleHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		constraints, err := parseScheduleConstraints(c)
+		if err != nil {
+			httperror.BadRequest(c, err)
+			return
+		}
+
+		var courses []*model.Course
+		for _, courseTopicName := range constraints.Courses {
+			course, _, err := SelectCourse(c, courseTopicName)
+			if err != nil {
+				if err == sql.ErrNoRows {
+					httperror.NotFound(c, fmt.Errorf("course not found: %s", courseTopicName))
+					return
+				}
+				httperror.ServerError(c, err)
+				return
+			}
+			courses = append(courses, &course)
+		}
+
+		response := model.Response{
+			Data: &model.Data{Schedules: buildSchedules(courses, constraints)},
+		}
+		c.Set(middleware.ResponseKey, response)
+	}, expire)
+}
+
+// parseScheduleConstraints reads the courses and constraints of the schedule endpoint. Courses are
+// repeated course topic names, times are given in 24-hour HH:MM and the maximum gap in minutes.
+func parseScheduleConstraints(c *gin.Context) (constraints scheduleConstraints, err error) {
+	seen := map[string]bool{}
+	for _, course := range c.QueryArray("course") {
+		if course = strings.ToLower(course); course != "" && !seen[course] {
+			seen[course] = true
+			constraints.Courses = append(constraints.Courses, course)
+		}
+	}
+
+	if len(constraints.Courses) == 0 {
+		return constraints, errors.New("at least one course is required")
+	} else if len(constraints.Courses) > maxScheduleCourses {
+		return constraints, fmt.Errorf("at most %d courses are allowed", maxScheduleCourses)
+	}
+
+	if openOnly := c.Query("open_only"); openOnly != "" {
+		if constraints.OpenOnly, err = strconv.ParseBool(openOnly); err != nil {
+			return constraints, errors.New("open_only must be true or false")
+		}
+	}
+
+	constraints.EndBefore = 24 * 60
+	for _, t := range []struct {
+		name  string
+		value *int
+	}{{"start_after", &constraints.StartAfter}, {"end_before", &constraints.EndBefore}} {
+		if value := c.Query(t.name); value != "" {
+			clock, err := time.Parse("15:04", value)
+			if err != nil {
+				return constraints, fmt.Errorf("%s must be formatted as HH:MM", t.name)
+			}
+			*t.value = clock.Hour()*60 + clock.Minute()
+		}
+	}
+
+	constraints.MaxGap = -1
+	if maxGap := c.Query("max_gap"); maxGap != "" {
+		if constraints.MaxGap, err = strconv.Atoi(maxGap); err != nil || constraints.MaxGap < 0 {
+			return constraints, errors.New("max_gap must be a positive number of minutes")
+		}
+	}
+
+	constraints.Limit = defaultScheduleLimit
+	if limit := c.Query("limit"); limit != "" {
+		if constraints.Limit, err = strconv.Atoi(limit); err != nil || constraints.Limit < 1 || constraints.Limit > maxPageLimit {
+			return constraints, fmt.Errorf("limit must be between 1 and %d", maxPageLimit)
+		}
+	}
+
+	return constraints, nil
+}
+
+// buildSchedules enumerates combinations of one section from each course whose meetings do not overlap
+// and that satisfy the constraints. Schedules are ranked by the fewest days on campus, then the least time
+// between classes, then the latest first class. Sections without scheduled meetings never conflict.
+func buildSchedules(courses []*model.Course, constraints scheduleConstraints) []*model.Schedule {
+	if len(courses) == 0 {
+		return nil
+	}
+
+	candidates := make([][]scheduleCandidate, len(courses))
+	for i, course := range courses {
+		for _, section := range course.Sections {
+			if constraints.OpenOnly && section.Status != model.Open.String() {
+				continue
+			}
+
+			candidate := scheduleCandidate{section: section, intervals: section.Intervals()}
+			if candidate.withinHours(constraints) {
+				candidates[i] = append(candidates[i], candidate)
+			}
+		}
+
+		if len(candidates[i]) == 0 {
+			return nil
+		}
+	}
+
+	// Courses with the fewest candidates are placed first so that conflicts are pruned early
+	order := make([]int, len(courses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(candidates[order[i]]) < len(candidates[order[j]])
+	})
+
+	var schedules []*model.Schedule
+	chosen := make([]scheduleCandidate, len(courses))
+	searched := 0
+
+	var place func(depth int)
+	place = func(depth int) {
+		if searched >= maxScheduleSearch {
+			return
+		}
+		searched++
+
+		if depth == len(order) {
+			if schedule, ok := scoreSchedule(chosen, constraints); ok {
+				schedules = append(schedules, schedule)
+			}
+			return
+		}
+
+		course := order[depth]
+		for _, candidate := range candidates[course] {
+			if candidate.conflicts(chosen, order[:depth]) {
+				continue
+			}
+			chosen[course] = candidate
+			place(depth + 1)
+		}
+	}
+	place(0)
+
+	sort.SliceStable(schedules, func(i, j int) bool {
+		a, b := schedules[i], schedules[j]
+		if a.Days != b.Days {
+			return a.Days < b.Days
+		}
+		if a.GapMinutes != b.GapMinutes {
+			return a.GapMinutes < b.GapMinutes
+		}
+		return a.EarliestStart > b.EarliestStart
+	})
+
+	if len(schedules) > constraints.Limit {
+		schedules = schedules[:constraints.Limit]
+	}
+
+	return schedules
+}
+
+func (candidate scheduleCandidate) withinHours(constraints scheduleConstraints) bool {
+	for _, interval := range candidate.intervals {
+		if interval.Start < constraints.StartAfter || interval.End > constraints.EndBefore {
+			return false
+		}
+	}
+	return true
+}
+
+func (candidate scheduleCandidate) conflicts(chosen []scheduleCandidate, placed []int) bool {
+	for _, course := range placed {
+		for _, a := range candidate.intervals {
+			for _, b := range chosen[course].intervals {
+				if a.Overlaps(b) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// scoreSchedule measures a conflict-free combination of sections, it is rejected when the time between
+// two consecutive classes exceeds the maximum gap.
+func scoreSchedule(chosen []scheduleCandidate, constraints scheduleConstraints) (*model.Schedule, bool) {
+	schedule := &model.Schedule{}
+
+	byDay := map[time.Weekday][]model.Interval{}
+	for _, candidate := range chosen {
+		schedule.Sections = append(schedule.Sections, candidate.section)
+		for _, interval := range candidate.intervals {
+			byDay[interval.Day] = append(byDay[interval.Day], interval)
+		}
+	}
+
+	earliest := -1
+	for _, intervals := range byDay {
+		sort.Slice(intervals, func(i, j int) bool {
+			return intervals[i].Start < intervals[j].Start
+		})
+
+		if earliest < 0 || intervals[0].Start < earliest {
+			earliest = intervals[0].Start
+		}
+
+		for i := 1; i < len(intervals); i++ {
+			// Meetings of the same section may overlap each other
+			gap := intervals[i-1].Gap(intervals[i])
+			if gap < 0 {
+				continue
+			}
+			if constraints.MaxGap >= 0 && gap > constraints.MaxGap {
+				return nil, false
+			}
+			schedule.GapMinutes += int32(gap)
+		}
+	}
+
+	schedule.Days = int32(len(byDay))
+	if earliest >= 0 {
+		schedule.EarliestStart = int32(earliest)
+	}
+
+	return schedule, true
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+func meeting(day, start, end string) *model.Meeting {
+	return &model.Meeting{Day: &day, StartTime: &start, EndTime: &end}
+}
+
+func scheduledSection(number, status string, meetings ...*model.Meeting) *model.Section {
+	return &model.Section{Number: number, Status: status, Meetings: meetings}
+}
+
+func sectionNumbers(schedule *model.Schedule) (numbers []string) {
+	for _, section := range schedule.Sections {
+		numbers = append(numbers, section.Number)
+	}
+	return
+}
+
+func Test_buildSchedules(t *testing.T) {
+	calculus := &model.Course{Sections: []*model.Section{
+		scheduledSection("01", "Open", meeting("Monday", "8:40 AM", "10:00 AM"), meeting("Wednesday", "8:40 AM", "10:00 AM")),
+		scheduledSection("02", "Open", meeting("Monday", "10:20 AM", "11:40 AM"), meeting("Wednesday", "10:20 AM", "11:40 AM")),
+		scheduledSection("03", "Closed", meeting("Tuesday", "12:00 PM", "1:20 PM")),
+	}}
+	physics := &model.Course{Sections: []*model.Section{
+		scheduledSection("01", "Open", meeting("Monday", "10:20 AM", "11:40 AM")),
+		scheduledSection("02", "Open", meeting("Monday", "2:00 PM", "3:20 PM")),
+		scheduledSection("03", "Open", meeting("Thursday", "10:20 AM", "11:40 AM")),
+	}}
+	online := &model.Course{Sections: []*model.Section{
+		scheduledSection("90", "Open", &model.Meeting{}),
+	}}
+
+	tests := []struct {
+		name        string
+		courses     []*model.Course
+		constraints scheduleConstraints
+		want        [][]string
+	}{
+		{
+			name:        "ranked by days then gaps",
+			courses:     []*model.Course{calculus, physics},
+			constraints: scheduleConstraints{EndBefore: 24 * 60, MaxGap: -1, Limit: 10},
+			want: [][]string{
+				{"03", "02"},
+				{"03", "01"},
+				{"03", "03"},
+				{"01", "01"},
+				{"02", "02"},
+				{"01", "02"},
+				{"02", "03"},
+				{"01", "03"},
+			},
+		},
+		{
+			name:        "open only and start after",
+			courses:     []*model.Course{calculus, physics},
+			constraints: scheduleConstraints{OpenOnly: true, StartAfter: 10 * 60, EndBefore: 24 * 60, MaxGap: -1, Limit: 10},
+			want:        [][]string{{"02", "02"}, {"02", "03"}},
+		},
+		{
+			name:        "max gap",
+			courses:     []*model.Course{calculus, physics},
+			constraints: scheduleConstraints{OpenOnly: true, EndBefore: 24 * 60, MaxGap: 60, Limit: 10},
+			want:        [][]string{{"01", "01"}, {"02", "03"}, {"01", "03"}},
+		},
+		{
+			name:        "limit",
+			courses:     []*model.Course{calculus, physics},
+			constraints: scheduleConstraints{EndBefore: 24 * 60, MaxGap: -1, Limit: 1},
+			want:        [][]string{{"03", "02"}},
+		},
+		{
+			name:        "unscheduled sections never conflict",
+			courses:     []*model.Course{online, physics},
+			constraints: scheduleConstraints{EndBefore: 12 * 60, MaxGap: -1, Limit: 10},
+			want:        [][]string{{"90", "01"}, {"90", "03"}},
+		},
+		{
+			name:        "no candidates",
+			courses:     []*model.Course{calculus, physics},
+			constraints: scheduleConstraints{StartAfter: 16 * 60, EndBefore: 24 * 60, MaxGap: -1, Limit: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]string
+			for _, schedule := range buildSchedules(tt.courses, tt.constraints) {
+				got = append(got, sectionNumbers(schedule))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseScheduleConstraints(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    scheduleConstraints
+		wantErr bool
+	}{
+		{
+			name:  "defaults",
+			query: "course=rutgers.640.135&course=Rutgers.750.203&course=rutgers.640.135",
+			want: scheduleConstraints{
+				Courses:   []string{"rutgers.640.135", "rutgers.750.203"},
+				EndBefore: 24 * 60,
+				MaxGap:    -1,
+				Limit:     defaultScheduleLimit,
+			},
+		},
+		{
+			name:  "constraints",
+			query: "course=rutgers.640.135&open_only=true&start_after=10:00&end_before=17:30&max_gap=90&limit=3",
+			want: scheduleConstraints{
+				Courses:    []string{"rutgers.640.135"},
+				OpenOnly:   true,
+				StartAfter: 10 * 60,
+				EndBefore:  17*60 + 30,
+				MaxGap:     90,
+				Limit:      3,
+			},
+		},
+		{name: "missing course", query: "open_only=true", wantErr: true},
+		{name: "invalid open only", query: "course=rutgers.640.135&open_only=sometimes", wantErr: true},
+		{name: "invalid time", query: "course=rutgers.640.135&start_after=10am", wantErr: true},
+		{name: "invalid gap", query: "course=rutgers.640.135&max_gap=-5", wantErr: true},
+		{name: "invalid limit", query: "course=rutgers.640.135&limit=0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/v2/schedules?"+tt.query, nil)
+
+			got, err := parseScheduleConstraints(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
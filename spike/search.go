@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/middleware"
+	"github.com/tevjef/uct-backend/common/middleware/cache"
+	"github.com/tevjef/uct-backend/common/middleware/httperror"
+	mtrace "github.com/tevjef/uct-backend/common/middleware/trace"
+	"github.com/tevjef/uct-backend/common/model"
+	"github.com/tevjef/uct-backend/spike/store"
+)
+
+const (
+	courseResult     = "course"
+	sectionResult    = "section"
+	instructorResult = "instructor"
+)
+
+type searchRow struct {
+	Kind      string  `db:"kind"`
+	TopicName string  `db:"topic_name"`
+	Name      string  `db:"name"`
+	Data      []byte  `db:"data"`
+	Rank      float64 `db:"rank"`
+	Total     int64   `db:"total"`
+}
+
+func searchHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		query := strings.TrimSpace(c.Query("query"))
+		uniTopicName := strings.ToLower(c.Query("university"))
+		season := strings.ToLower(c.Query("season"))
+		year := c.Query("year")
+
+		if query == "" || uniTopicName == "" || season == "" || year == "" {
+			httperror.BadRequest(c, errors.New("query, university, season and year are required"))
+			return
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			httperror.BadRequest(c, err)
+			return
+		}
+
+		if results, err := Search(c, query, uniTopicName, season, year, &page); err != nil {
+			httperror.ServerError(c, err)
+			return
+		} else {
+			response := model.Response{
+				Data: &model.Data{SearchResults: results, Page: &page},
+			}
+			c.Set(middleware.ResponseKey, response)
+		}
+	}, expire)
+}
+
+// Search ranks the courses, sections and instructors of a semester against the query. The total of the page is set
+// to the number of results across all pages.
+func Search(ctx context.Context, query, uniTopicName, season, year string, page *model.Page) (results []*model.SearchResult, err error) {
+	defer model.TimeTrack(time.Now(), "Search")
+	span := mtrace.NewSpan(ctx, "database.Search")
+	span.SetLabel("query", query)
+	defer span.Finish()
+
+	var rows []searchRow
+	m := map[string]interface{}{
+		"query":                 query,
+		"university_topic_name": uniTopicName,
+		"season":                season,
+		"year":                  year,
+		"limit":                 page.Limit,
+		"offset":                page.Offset,
+	}
+	if err = middleware.Select(ctx, store.SearchQuery, &rows, m); err != nil {
+		return
+	}
+
+	for i := range rows {
+		row := rows[i]
+		page.Total = row.Total
+
+		result := &model.SearchResult{Kind: row.Kind, TopicName: row.TopicName, Name: row.Name, Rank: row.Rank}
+		switch row.Kind {
+		case courseResult:
+			result.Course = &model.Course{}
+			err = result.Course.Unmarshal(row.Data)
+		case sectionResult:
+			result.Section = &model.Section{}
+			err = result.Section.Unmarshal(row.Data)
+		}
+		if err != nil {
+			return
+		}
+
+		results = append(results, result)
+	}
+
+	return
+}
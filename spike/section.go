@@ -3,6 +3,9 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,3 +53,284 @@ func SelectSection(ctx context.Context, sectionTopicName string) (section model.
 	err = section.Unmarshal(b)
 	return
 }
+
+// crossListingsHandler responds with the sections cross-listed with a section, which share its seats.
+func crossListingsHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		sectionTopicName := strings.ToLower(c.Param("topic"))
+
+		section, _, err := SelectSection(c, sectionTopicName)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				httperror.NotFound(c, err)
+				return
+			}
+			httperror.ServerError(c, err)
+			return
+		}
+
+		sections, err := SelectCrossListedSections(c, section.CrossListedTopicNames())
+		if err != nil {
+			httperror.ServerError(c, err)
+			return
+		}
+
+		response := model.Response{
+			Data: &model.Data{Sections: sections},
+		}
+		c.Set(middleware.ResponseKey, response)
+	}, expire)
+}
+
+func SelectCrossListedSections(ctx context.Context, sectionTopicNames []string) (sections []*model.Section, err error) {
+	if len(sectionTopicNames) == 0 {
+		return
+	}
+
+	defer model.TimeTrack(time.Now(), "SelectCrossListedSections")
+	span := mtrace.NewSpan(ctx, "database.SelectCrossListedSections")
+	span.SetLabel("topicName", strings.Join(sectionTopicNames, ","))
+	defer span.Finish()
+
+	var d []store.Data
+	m := map[string]interface{}{"topic_names": strings.Join(sectionTopicNames, ",")}
+	if err = middleware.Select(ctx, store.SelectCrossListedSectionsQuery, &d, m); err != nil {
+		return
+	}
+	for i := range d {
+		s := model.Section{}
+		if err = s.Unmarshal(d[i].Data); err != nil {
+			return
+		}
+		sections = append(sections, &s)
+	}
+	return
+}
+
+func sectionHistoryHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		sectionTopicName := strings.ToLower(c.Param("topic"))
+
+		if snapshots, location, err := SelectSectionHistory(c, sectionTopicName); err != nil {
+			if err == sql.ErrNoRows {
+				httperror.NotFound(c, err)
+				return
+			}
+			httperror.ServerError(c, err)
+			return
+		} else {
+			response := model.Response{
+				Data: &model.Data{SectionHistory: &model.SectionHistory{
+					TopicName: sectionTopicName,
+					Snapshots: snapshots,
+					Stats:     sectionHistoryStats(snapshots, location),
+				}},
+			}
+			c.Set(middleware.ResponseKey, response)
+		}
+	}, expire)
+}
+
+type sectionHistoryRow struct {
+	model.SectionSnapshot
+	TimeZone string `db:"time_zone"`
+}
+
+// SelectSectionHistory returns the snapshots of a section and the time zone of its university.
+func SelectSectionHistory(ctx context.Context, sectionTopicName string) (snapshots []*model.SectionSnapshot, location *time.Location, err error) {
+	defer model.TimeTrack(time.Now(), "SelectSectionHistory")
+	span := mtrace.NewSpan(ctx, "database.SelectSectionHistory")
+	span.SetLabel("topicName", sectionTopicName)
+	defer span.Finish()
+
+	var rows []sectionHistoryRow
+	m := map[string]interface{}{"topic_name": sectionTopicName}
+	if err = middleware.Select(ctx, store.SelectSectionHistoryQuery, &rows, m); err != nil {
+		return
+	}
+	if len(rows) == 0 {
+		err = sql.ErrNoRows
+		return
+	}
+
+	if location, err = time.LoadLocation(rows[0].TimeZone); err != nil {
+		return
+	}
+	for i := range rows {
+		snapshots = append(snapshots, &rows[i].SectionSnapshot)
+	}
+	return
+}
+
+// sectionHistoryStats derives how a section fills and reopens from snapshots ordered by time. Hours are in the
+// location of the section's university.
+func sectionHistoryStats(snapshots []*model.SectionSnapshot, location *time.Location) *model.SectionHistoryStats {
+	stats := &model.SectionHistoryStats{TypicalOpenHour: -1}
+
+	var firstOpen *model.SectionSnapshot
+	var openHours [24]int
+
+	for i, snapshot := range snapshots {
+		isOpen := snapshot.Status == model.Open.String()
+
+		if isOpen && firstOpen == nil {
+			firstOpen = snapshot
+		}
+
+		if snapshot.Status == model.Closed.String() && firstOpen != nil && stats.TimeToFill == 0 {
+			stats.TimeToFill = snapshot.CreatedAt - firstOpen.CreatedAt
+		}
+
+		if isOpen && i > 0 && snapshots[i-1].Status == model.Closed.String() {
+			stats.Reopenings++
+			openHours[time.Unix(snapshot.CreatedAt, 0).In(location).Hour()]++
+		}
+	}
+
+	for hour, count := range openHours {
+		if count > 0 && (stats.TypicalOpenHour == -1 || count > openHours[stats.TypicalOpenHour]) {
+			stats.TypicalOpenHour = int32(hour)
+		}
+	}
+
+	return stats
+}
+
+type sectionFilter struct {
+	SubjectTopicName    string `db:"subject_topic_name"`
+	UniversityTopicName string `db:"university_topic_name"`
+	Season              string `db:"season"`
+	Year                string `db:"year"`
+	Status              string `db:"status"`
+	Days                string `db:"days"`
+	StartAfter          string `db:"start_after"`
+	EndBefore           string `db:"end_before"`
+	Credits             string `db:"credits"`
+	ClassType           string `db:"class_type"`
+	Instructor          string `db:"instructor"`
+	Campus              string `db:"campus"`
+	WaitlistOpen        bool   `db:"waitlist_open"`
+	Limit               int64  `db:"limit"`
+	Offset              int64  `db:"offset"`
+}
+
+type filteredSection struct {
+	Data  []byte `db:"data"`
+	Total int64  `db:"total"`
+}
+
+var weekdays = map[string]bool{
+	"monday":    true,
+	"tuesday":   true,
+	"wednesday": true,
+	"thursday":  true,
+	"friday":    true,
+	"saturday":  true,
+	"sunday":    true,
+}
+
+func sectionsHandler(expire time.Duration) gin.HandlerFunc {
+	return cache.CachePage(func(c *gin.Context) {
+		filter, err := parseSectionFilter(c)
+		if err != nil {
+			httperror.BadRequest(c, err)
+			return
+		}
+
+		if sections, page, err := FilterSections(c, filter); err != nil {
+			httperror.ServerError(c, err)
+			return
+		} else {
+			response := model.Response{
+				Data: &model.Data{Sections: sections, Page: &page},
+			}
+			c.Set(middleware.ResponseKey, response)
+		}
+	}, expire)
+}
+
+// parseSectionFilter reads the filters of the sections endpoint. Sections are filtered within a subject,
+// or within the semester of a university when no subject is given. Days may be repeated and times are
+// given in 24-hour HH:MM.
+func parseSectionFilter(c *gin.Context) (filter sectionFilter, err error) {
+	filter.SubjectTopicName = strings.ToLower(c.Query("subject"))
+	filter.UniversityTopicName = strings.ToLower(c.Query("university"))
+	filter.Season = strings.ToLower(c.Query("season"))
+	filter.Year = c.Query("year")
+
+	if filter.SubjectTopicName == "" && (filter.UniversityTopicName == "" || filter.Season == "" || filter.Year == "") {
+		return filter, errors.New("either subject or university, season and year are required")
+	}
+
+	if filter.Status = c.Query("status"); filter.Status != "" && filter.Status != model.Open.String() && filter.Status != model.Closed.String() {
+		return filter, fmt.Errorf("status must be %s or %s", model.Open, model.Closed)
+	}
+
+	var days []string
+	for _, day := range c.QueryArray("day") {
+		if day = strings.ToLower(day); !weekdays[day] {
+			return filter, fmt.Errorf("invalid day: %s", day)
+		}
+		days = append(days, day)
+	}
+	filter.Days = strings.Join(days, ",")
+
+	for _, t := range []struct {
+		name  string
+		value *string
+	}{{"start_after", &filter.StartAfter}, {"end_before", &filter.EndBefore}} {
+		if *t.value = c.Query(t.name); *t.value != "" {
+			if _, err := time.Parse("15:04", *t.value); err != nil {
+				return filter, fmt.Errorf("%s must be formatted as HH:MM", t.name)
+			}
+		}
+	}
+
+	if filter.Credits = c.Query("credits"); filter.Credits != "" {
+		if _, err := strconv.ParseFloat(filter.Credits, 64); err != nil {
+			return filter, errors.New("credits must be a number")
+		}
+	}
+
+	filter.ClassType = c.Query("class_type")
+	filter.Instructor = c.Query("instructor")
+	filter.Campus = c.Query("campus")
+
+	if waitlistOpen := c.Query("waitlist_open"); waitlistOpen != "" {
+		if filter.WaitlistOpen, err = strconv.ParseBool(waitlistOpen); err != nil {
+			return filter, errors.New("waitlist_open must be true or false")
+		}
+	}
+
+	page, err := parsePage(c)
+	filter.Limit = page.Limit
+	filter.Offset = page.Offset
+
+	return filter, err
+}
+
+func FilterSections(ctx context.Context, filter sectionFilter) (sections []*model.Section, page model.Page, err error) {
+	defer model.TimeTrack(time.Now(), "FilterSections")
+	span := mtrace.NewSpan(ctx, "database.FilterSections")
+	span.SetLabel("topicName", filter.SubjectTopicName+filter.UniversityTopicName)
+	defer span.Finish()
+
+	page = model.Page{Limit: filter.Limit, Offset: filter.Offset}
+
+	var rows []filteredSection
+	if err = middleware.Select(ctx, store.FilterSectionsQuery, &rows, filter); err != nil {
+		return
+	}
+
+	for i := range rows {
+		page.Total = rows[i].Total
+
+		section := model.Section{}
+		if err = section.Unmarshal(rows[i].Data); err != nil {
+			return
+		}
+		sections = append(sections, &section)
+	}
+
+	return
+}
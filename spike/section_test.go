@@ -0,0 +1,131 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+func snapshot(status string, at time.Time) *model.SectionSnapshot {
+	return &model.SectionSnapshot{Status: status, CreatedAt: at.Unix()}
+}
+
+func Test_sectionHistoryStats(t *testing.T) {
+	start := time.Date(2017, time.August, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		snapshots []*model.SectionSnapshot
+		location  *time.Location
+		want      *model.SectionHistoryStats
+	}{
+		{
+			name: "never filled",
+			snapshots: []*model.SectionSnapshot{
+				snapshot("Open", start),
+				snapshot("Open", start.Add(time.Hour)),
+			},
+			want: &model.SectionHistoryStats{TypicalOpenHour: -1},
+		},
+		{
+			name: "filled and reopened",
+			snapshots: []*model.SectionSnapshot{
+				snapshot("Open", start),
+				snapshot("Closed", start.Add(2*time.Hour)),
+				snapshot("Open", start.Add(24*time.Hour)),
+				snapshot("Closed", start.Add(25*time.Hour)),
+				snapshot("Open", start.Add(48*time.Hour)),
+				snapshot("Closed", start.Add(49*time.Hour)),
+				snapshot("Open", start.Add(54*time.Hour)),
+			},
+			want: &model.SectionHistoryStats{TimeToFill: int64((2 * time.Hour).Seconds()), Reopenings: 3, TypicalOpenHour: 9},
+		},
+		{
+			name: "closed before first open",
+			snapshots: []*model.SectionSnapshot{
+				snapshot("Closed", start),
+				snapshot("Open", start.Add(5*time.Hour)),
+				snapshot("Closed", start.Add(6*time.Hour)),
+			},
+			want: &model.SectionHistoryStats{TimeToFill: int64(time.Hour.Seconds()), Reopenings: 1, TypicalOpenHour: 14},
+		},
+		{
+			name: "local hour of the university",
+			snapshots: []*model.SectionSnapshot{
+				snapshot("Closed", start),
+				snapshot("Open", start.Add(5*time.Hour)),
+			},
+			location: time.FixedZone("EST", -5*60*60),
+			want:     &model.SectionHistoryStats{Reopenings: 1, TypicalOpenHour: 9},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location := tt.location
+			if location == nil {
+				location = time.UTC
+			}
+			assert.Equal(t, tt.want, sectionHistoryStats(tt.snapshots, location))
+		})
+	}
+}
+
+func Test_parseSectionFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    sectionFilter
+		wantErr bool
+	}{
+		{
+			name:  "subject",
+			query: "subject=rutgers.640&status=Open&day=Tuesday&day=thursday&start_after=14:00&waitlist_open=true",
+			want: sectionFilter{
+				SubjectTopicName: "rutgers.640",
+				Status:           "Open",
+				Days:             "tuesday,thursday",
+				StartAfter:       "14:00",
+				WaitlistOpen:     true,
+				Limit:            defaultPageLimit,
+			},
+		},
+		{
+			name:  "semester",
+			query: "university=Rutgers&season=Fall&year=2017&credits=4&instructor=smith&campus=busch&offset=20",
+			want: sectionFilter{
+				UniversityTopicName: "rutgers",
+				Season:              "fall",
+				Year:                "2017",
+				Credits:             "4",
+				Instructor:          "smith",
+				Campus:              "busch",
+				Limit:               defaultPageLimit,
+				Offset:              20,
+			},
+		},
+		{name: "missing scope", query: "university=rutgers&season=fall", wantErr: true},
+		{name: "invalid status", query: "subject=rutgers.640&status=Cancelled", wantErr: true},
+		{name: "invalid day", query: "subject=rutgers.640&day=someday", wantErr: true},
+		{name: "invalid time", query: "subject=rutgers.640&end_before=2pm", wantErr: true},
+		{name: "invalid credits", query: "subject=rutgers.640&credits=four", wantErr: true},
+		{name: "invalid waitlist_open", query: "subject=rutgers.640&waitlist_open=maybe", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/v2/sections?"+tt.query, nil)
+
+			got, err := parseSectionFilter(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -14,10 +14,18 @@ var Queries = []string{
 	ListSubjectQuery,
 	SelectCourseQuery,
 	ListCoursesQuery,
+	SelectPrerequisiteCoursesQuery,
+	SelectDependentCoursesQuery,
 	SelectSectionQuery,
+	SelectSectionHistoryQuery,
+	SelectCrossListedSectionsQuery,
+	FilterSectionsQuery,
 	SelectMeeting,
 	SelectInstructor,
 	SelectBook,
+	SelectInstructorProfileQuery,
+	SelectInstructorSectionsQuery,
+	SelectInstructorTeachingQuery,
 	UniversityMetadataQuery,
 	SubjectMetadataQuery,
 	CourseMetadataQuery,
@@ -26,36 +34,143 @@ var Queries = []string{
 	SelectUniversityCTE,
 	InsertSubscriptionQuery,
 	InsertNotificationQuery,
+	SearchQuery,
+	SelectCalendarSectionsQuery,
+	SelectRegistrationsQuery,
+	SelectUniversityTimeZoneQuery,
+	SelectBuildingsQuery,
+	SelectBuildingQuery,
+	SelectBuildingSectionsQuery,
+	SelectExportSubjectsQuery,
 }
 
 const (
-	SelectUniversityQuery         = `SELECT id, name, abbr, home_page, registration_page, main_color, accent_color, topic_name, topic_id FROM university WHERE topic_name = :topic_name ORDER BY name`
+	SelectUniversityQuery         = `SELECT id, name, abbr, home_page, registration_page, main_color, accent_color, topic_name, topic_id, time_zone FROM university WHERE topic_name = :topic_name ORDER BY name`
 	ListUniversitiesQuery         = `SELECT topic_name FROM university ORDER BY name`
-	SelectAvailableSemestersQuery = `SELECT season, year FROM subject JOIN university ON university.id = subject.university_id
-									WHERE university.topic_name = :topic_name GROUP BY season, year`
+	SelectAvailableSemestersQuery = `SELECT season, year, term_code, term_name FROM subject JOIN university ON university.id = subject.university_id
+									WHERE university.topic_name = :topic_name AND subject.removed_at IS NULL GROUP BY season, year, term_code, term_name`
 
-	SelectResolvedSemestersQuery = `SELECT current_season, current_year, last_season, last_year, next_season, next_year FROM semester JOIN university ON university.id = semester.university_id
+	SelectResolvedSemestersQuery = `SELECT current_season, current_year, current_term_code, current_term_name, last_season, last_year, last_term_code, last_term_name, next_season, next_year, next_term_code, next_term_name FROM semester JOIN university ON university.id = semester.university_id
 	WHERE university.topic_name = :topic_name`
 
-	SelectProtoSubjectQuery = `SELECT data FROM subject WHERE topic_name = :topic_name`
+	SelectProtoSubjectQuery = `SELECT data FROM subject WHERE topic_name = :topic_name AND removed_at IS NULL`
 
-	SelectProtoSectionQuery = `SELECT data FROM section WHERE topic_name = :topic_name`
+	SelectProtoSectionQuery = `SELECT data FROM section WHERE topic_name = :topic_name AND removed_at IS NULL`
 
-	ListSubjectQuery = `SELECT subject.id, university_id, subject.name, subject.number, subject.season, subject.year, subject.topic_name, subject.topic_id FROM subject JOIN university ON university.id = subject.university_id
+	SelectCrossListedSectionsQuery = `SELECT data FROM section WHERE topic_name = ANY(string_to_array(:topic_names, ',')) AND removed_at IS NULL ORDER BY topic_name`
+
+	ListSubjectQuery = `SELECT subject.id, university_id, subject.name, subject.number, subject.season, subject.year, subject.term_code, coalesce(subject.term_name, '') AS term_name, subject.topic_name, subject.topic_id FROM subject JOIN university ON university.id = subject.university_id
 									AND university.topic_name = :topic_name
 									AND season = :subject_season
-									AND year = :subject_year ORDER BY subject.name`
+									AND year = :subject_year
+									AND subject.removed_at IS NULL ORDER BY subject.name`
+
+	SelectCourseQuery = `SELECT data FROM course WHERE course.topic_name = :topic_name AND course.removed_at IS NULL ORDER BY course.id`
+
+	ListCoursesQuery = `SELECT course.data FROM course JOIN subject ON subject.id = course.subject_id WHERE subject.topic_name = :topic_name AND course.removed_at IS NULL ORDER BY course.number`
+
+	SelectPrerequisiteCoursesQuery = `SELECT data FROM course WHERE topic_name = ANY(string_to_array(:topic_names, ',')) AND removed_at IS NULL ORDER BY number`
+
+	SelectDependentCoursesQuery = `SELECT data FROM course WHERE prerequisite_topic_names @> CAST(ARRAY[:topic_name] AS TEXT[]) AND removed_at IS NULL ORDER BY number`
+
+	SelectSectionQuery = `SELECT id, course_id, number, call_number, now, max, status, credits, topic_name, waitlist_max, waitlist_now FROM section WHERE section.topic_name = :topic_name AND section.removed_at IS NULL`
+
+	SelectSectionHistoryQuery = `SELECT section_history.now, section_history.max, section_history.status, CAST(extract(EPOCH FROM section_history.created_at) AS BIGINT) AS created_at, university.time_zone
+									FROM section_history
+									  JOIN section ON section.id = section_history.section_id
+									  JOIN course ON course.id = section.course_id
+									  JOIN subject ON subject.id = course.subject_id
+									  JOIN university ON university.id = subject.university_id
+									WHERE section.topic_name = :topic_name AND section.removed_at IS NULL
+									ORDER BY section_history.created_at, section_history.id`
+
+	// Every filter is skipped when its parameter is empty. Sections match the day and time filters when all of
+	// their scheduled meetings do, meetings without a day are ignored.
+	FilterSectionsQuery = `SELECT section.data, count(*) OVER () AS total
+	FROM section
+	  JOIN course ON course.id = section.course_id
+	  JOIN subject ON subject.id = course.subject_id
+	  JOIN university ON university.id = subject.university_id
+	WHERE section.removed_at IS NULL AND course.removed_at IS NULL AND subject.removed_at IS NULL
+	  AND (NULLIF(:subject_topic_name, '') IS NOT NULL AND subject.topic_name = :subject_topic_name
+	    OR NULLIF(:subject_topic_name, '') IS NULL AND university.topic_name = :university_topic_name AND subject.season = :season AND subject.year = :year)
+	  AND (NULLIF(:status, '') IS NULL OR CAST(section.status AS TEXT) = :status)
+	  AND (NULLIF(:credits, '') IS NULL OR section.credits = CAST(NULLIF(:credits, '') AS NUMERIC))
+	  AND (NULLIF(:days, '') IS NULL AND NULLIF(:start_after, '') IS NULL AND NULLIF(:end_before, '') IS NULL
+	    OR EXISTS (SELECT 1 FROM meeting WHERE meeting.section_id = section.id AND NULLIF(meeting.day, '') IS NOT NULL)
+	      AND NOT EXISTS (SELECT 1 FROM meeting WHERE meeting.section_id = section.id AND NULLIF(meeting.day, '') IS NOT NULL AND (
+	        NULLIF(:days, '') IS NOT NULL AND lower(meeting.day) <> ALL (string_to_array(lower(:days), ','))
+	        OR NULLIF(:start_after, '') IS NOT NULL AND meeting.start_time < CAST(NULLIF(:start_after, '') AS TIME)
+	        OR NULLIF(:end_before, '') IS NOT NULL AND meeting.end_time > CAST(NULLIF(:end_before, '') AS TIME))))
+	  AND (NULLIF(:class_type, '') IS NULL OR EXISTS (SELECT 1 FROM meeting WHERE meeting.section_id = section.id AND lower(meeting.class_type) = lower(:class_type)))
+	  AND (NULLIF(:instructor, '') IS NULL OR EXISTS (SELECT 1 FROM instructor WHERE instructor.section_id = section.id AND instructor.name ILIKE '%' || :instructor || '%'))
+	  AND (NULLIF(:campus, '') IS NULL OR EXISTS (SELECT 1 FROM metadata LEFT JOIN meeting ON meeting.id = metadata.meeting_id
+	    WHERE (metadata.section_id = section.id OR meeting.section_id = section.id) AND lower(metadata.title) = 'campus' AND metadata.content ILIKE '%' || :campus || '%'))
+	  AND (NOT :waitlist_open OR section.waitlist_now < section.waitlist_max)
+	ORDER BY course.number, section.number, section.id
+	LIMIT :limit OFFSET :offset`
+
+	SelectCalendarSectionsQuery = `SELECT section.data, course.name AS course_name, subject.season, subject.year, university.id AS university_id, university.time_zone
+									FROM section
+									  JOIN course ON course.id = section.course_id
+									  JOIN subject ON subject.id = course.subject_id
+									  JOIN university ON university.id = subject.university_id
+									WHERE section.topic_name = ANY (string_to_array(:topic_names, ',')) AND section.removed_at IS NULL
+									ORDER BY course.name, section.number`
 
-	SelectCourseQuery = `SELECT data FROM course WHERE course.topic_name = :topic_name ORDER BY course.id`
+	SelectRegistrationsQuery = `SELECT CAST(period AS TEXT) AS period, period_date FROM registration WHERE university_id = :university_id`
 
-	ListCoursesQuery = `SELECT course.data FROM course JOIN subject ON subject.id = course.subject_id WHERE subject.topic_name = :topic_name ORDER BY course.number`
+	SelectUniversityTimeZoneQuery = `SELECT time_zone FROM university WHERE topic_name = :topic_name`
 
-	SelectSectionQuery = `SELECT id, course_id, number, call_number, now, max, status, credits, topic_name FROM section WHERE section.topic_name = :topic_name`
+	SelectBuildingsQuery = `SELECT code, name, campus, latitude, longitude FROM building JOIN university ON university.id = building.university_id
+									WHERE university.topic_name = :topic_name ORDER BY code`
+
+	SelectBuildingQuery = `SELECT code, name, campus, latitude, longitude FROM building JOIN university ON university.id = building.university_id
+									WHERE university.topic_name = :topic_name AND building.code = :code`
+
+	SelectBuildingSectionsQuery = `SELECT section.data
+									FROM meeting
+									  JOIN section ON section.id = meeting.section_id
+									  JOIN course ON course.id = section.course_id
+									  JOIN subject ON subject.id = course.subject_id
+									  JOIN semester ON semester.university_id = subject.university_id
+									  JOIN university ON university.id = subject.university_id
+									WHERE university.topic_name = :topic_name AND meeting.building_code = :building_code
+									  AND (:room_number = '' OR meeting.room_number = :room_number) AND meeting.day = :day
+									  AND section.removed_at IS NULL
+									  AND subject.season = semester.current_season AND subject.year = semester.current_year
+									GROUP BY section.id
+									ORDER BY min(meeting.start_time), section.topic_name`
+
+	SelectExportSubjectsQuery = `SELECT subject.data FROM subject JOIN university ON university.id = subject.university_id
+									WHERE university.topic_name = :topic_name AND subject.season = :subject_season AND subject.year = :subject_year
+									  AND subject.removed_at IS NULL ORDER BY subject.number`
 
 	SelectMeeting    = `SELECT section.id, section_id, room, day, start_time, end_time FROM meeting JOIN section ON section.id = meeting.section_id WHERE section_id = :section_id ORDER BY meeting.id`
-	SelectInstructor = `SELECT name FROM instructor WHERE section_id = :section_id ORDER BY index`
+	SelectInstructor = `SELECT name, coalesce(topic_name, '') AS topic_name FROM instructor WHERE section_id = :section_id ORDER BY index`
 	SelectBook       = `SELECT title, url FROM book WHERE section_id = :section_id`
 
+	SelectInstructorProfileQuery = `SELECT name, topic_name FROM instructor_profile WHERE topic_name = :topic_name`
+
+	SelectInstructorSectionsQuery = `SELECT section.data
+									FROM instructor
+									  JOIN section ON section.id = instructor.section_id
+									  JOIN course ON course.id = section.course_id
+									  JOIN subject ON subject.id = course.subject_id
+									  JOIN semester ON semester.university_id = subject.university_id
+									WHERE instructor.topic_name = :topic_name AND section.removed_at IS NULL
+									  AND subject.season = semester.current_season AND subject.year = semester.current_year
+									ORDER BY course.number, section.number`
+
+	SelectInstructorTeachingQuery = `SELECT course.data, subject.season, subject.year, string_agg(section.topic_name, ',') AS section_topic_names
+									FROM instructor
+									  JOIN section ON section.id = instructor.section_id
+									  JOIN course ON course.id = section.course_id
+									  JOIN subject ON subject.id = course.subject_id
+									WHERE instructor.topic_name = :topic_name AND section.removed_at IS NULL
+									GROUP BY course.id, subject.season, subject.year, subject.term_code
+									ORDER BY subject.year DESC, subject.term_code DESC, course.number`
+
 	UniversityMetadataQuery = `SELECT title, content FROM metadata WHERE university_id = :university_id ORDER BY id`
 	SubjectMetadataQuery    = `SELECT title, content FROM metadata WHERE subject_id = :subject_id ORDER BY id`
 	CourseMetadataQuery     = `SELECT title, content FROM metadata WHERE course_id = :course_id ORDER BY id`
@@ -74,15 +189,21 @@ const (
     SELECT json_build_object(
         'current', json_build_object(
             'year', cast(s.current_year as INT),
-            'season', s.current_season
+            'season', s.current_season,
+            'term_code', s.current_term_code,
+            'term_name', s.current_term_name
         ),
         'next', json_build_object(
             'year', cast(s.next_year as INT),
-            'season', s.next_season
+            'season', s.next_season,
+            'term_code', s.next_term_code,
+            'term_name', s.next_term_name
         ),
         'last', json_build_object(
             'year', cast(s.last_year as INT),
-            'season', s.last_season
+            'season', s.last_season,
+            'term_code', s.last_term_code,
+            'term_name', s.last_term_name
         )
     )
     FROM semester s
@@ -99,12 +220,14 @@ const (
     SELECT array_to_json(array_agg(rawSemesters))
     FROM (SELECT
             s.season,
-            cast(s.year as INT)
+            cast(s.year as INT),
+            s.term_code,
+            s.term_name
           FROM subject s
             JOIN university ON university.id = s.university_id
-          WHERE university.topic_name = :topic_name
-		  GROUP BY season, year
-		  ORDER BY s.year DESC) rawSemesters
+          WHERE university.topic_name = :topic_name AND s.removed_at IS NULL
+		  GROUP BY season, year, term_code, term_name
+		  ORDER BY s.year DESC, s.term_code DESC) rawSemesters
 )
 SELECT json_build_object(
     'name', u.name,
@@ -115,6 +238,7 @@ SELECT json_build_object(
     'accent_color', u.accent_color,
     'topic_name', u.topic_name,
     'topic_id', u.topic_id,
+    'time_zone', u.time_zone,
     'available_semesters', (SELECT *
                            FROM available_semesters),
     'resolved_semesters', (SELECT *
@@ -126,4 +250,42 @@ FROM university u
 WHERE u.topic_name = :topic_name
 GROUP BY u.id;
 `
+
+	// Words in the query are OR'ed so that abbreviated course names still match, results that match
+	// more words rank higher.
+	// Courses and sections are matched against the search documents ein maintains, instructors against the
+	// expression their index is built on, so that each branch is answered from a GIN index.
+	SearchQuery = `WITH search AS (
+    SELECT CAST(replace(CAST(plainto_tsquery('english', :query) AS TEXT), '&', '|') AS TSQUERY) AS query
+), semester_course AS (
+    SELECT course.id, course.name, course.topic_name, course.data, course.search_document
+    FROM course
+      JOIN subject ON subject.id = course.subject_id
+      JOIN university ON university.id = subject.university_id
+    WHERE university.topic_name = :university_topic_name
+      AND subject.season = :season
+      AND subject.year = :year
+      AND subject.removed_at IS NULL
+      AND course.removed_at IS NULL
+), document AS (
+    SELECT 'course' AS kind, c.topic_name, c.name, c.data, c.search_document AS document
+    FROM semester_course c, search
+    WHERE c.search_document @@ search.query
+    UNION ALL
+    SELECT 'section', section.topic_name, section.number, section.data, section.search_document
+    FROM section
+      JOIN semester_course c ON c.id = section.course_id, search
+    WHERE section.removed_at IS NULL AND section.search_document @@ search.query
+    UNION ALL
+    SELECT 'instructor', '', i.name, NULL, setweight(to_tsvector('english', i.name), 'A')
+    FROM (SELECT DISTINCT instructor.name
+          FROM instructor
+            JOIN section ON section.id = instructor.section_id
+            JOIN semester_course c ON c.id = section.course_id, search
+          WHERE section.removed_at IS NULL AND to_tsvector('english', instructor.name) @@ search.query) i
+)
+SELECT kind, topic_name, name, data, ts_rank(document, search.query) AS rank, count(*) OVER () AS total
+FROM document, search
+ORDER BY rank DESC, kind, name
+LIMIT :limit OFFSET :offset`
 )
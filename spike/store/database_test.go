@@ -0,0 +1,27 @@
+package store
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// sqlx reads "::" in a named query as an escaped ":", so a Postgres cast like "::TEXT" compiles to ":TEXT" and the
+// statement fails to prepare.
+var leftoverColon = regexp.MustCompile(`:[A-Za-z_]`)
+
+func TestQueries_Compile(t *testing.T) {
+	for _, query := range Queries {
+		// The argument has none of the parameters, only the compiled query is checked
+		compiled, _, err := sqlx.Named(query, map[string]interface{}{})
+		if compiled == "" {
+			t.Errorf("failed to compile %q: %v", query, err)
+			continue
+		}
+
+		if match := leftoverColon.FindString(compiled); match != "" {
+			t.Errorf("compiled query has a leftover %q, use CAST instead of ::\n%s", match, compiled)
+		}
+	}
+}
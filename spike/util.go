@@ -1,10 +1,39 @@
 package main
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/model"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
 )
 
+// parsePage reads the limit and offset query parameters of a paginated endpoint.
+func parsePage(c *gin.Context) (page model.Page, err error) {
+	page.Limit = defaultPageLimit
+
+	if limit := c.Query("limit"); limit != "" {
+		if page.Limit, err = strconv.ParseInt(limit, 10, 64); err != nil || page.Limit < 1 || page.Limit > maxPageLimit {
+			return page, fmt.Errorf("limit must be between 1 and %d", maxPageLimit)
+		}
+	}
+
+	if offset := c.Query("offset"); offset != "" {
+		if page.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil || page.Offset < 0 {
+			return page, fmt.Errorf("offset must be a positive number")
+		}
+	}
+
+	return page, nil
+}
+
 // Rutgers Course Tracker/com.tevinjeffrey.rutgersct (1.0.7.0R; Android 27)
 func parseAndroid(userAgent string) (string, string) {
 	appVersion := ""
@@ -1,6 +1,12 @@
 package main
 
-import "testing"
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tevjef/uct-backend/common/model"
+)
 
 func Test_parseAndroid(t *testing.T) {
 	type args struct {
@@ -73,3 +79,33 @@ func Test_parseios(t *testing.T) {
 		})
 	}
 }
+
+func Test_parsePage(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    model.Page
+		wantErr bool
+	}{
+		{name: "default", query: "", want: model.Page{Limit: defaultPageLimit}},
+		{name: "limit and offset", query: "limit=5&offset=10", want: model.Page{Limit: 5, Offset: 10}},
+		{name: "limit too large", query: "limit=1000", wantErr: true},
+		{name: "negative offset", query: "offset=-1", wantErr: true},
+		{name: "not a number", query: "limit=a", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/v2/search?"+tt.query, nil)
+
+			got, err := parsePage(c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parsePage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !got.Equal(&tt.want) {
+				t.Errorf("parsePage() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}